@@ -5,7 +5,9 @@
 package main
 
 import (
+	"errors"
 	"os"
+	"strconv"
 
 	"github.com/aws/copilot-cli/cmd/copilot/template"
 	"github.com/aws/copilot-cli/internal/pkg/cli"
@@ -22,9 +24,55 @@ func init() {
 
 func main() {
 	cmd := buildRootCmd()
-	if err := cmd.Execute(); err != nil {
+	newerVersion := checkForUpdate()
+	err := cmd.Execute()
+	cli.LogAPICallSummary()
+	if err != nil {
 		log.Errorln(err.Error())
-		os.Exit(1)
+		os.Exit(exitCode(err))
+	}
+	notifyUpdate(newerVersion)
+}
+
+// exitCode returns the exit code of a failed command.
+// It's 1 unless the error carries its own code, such as the exit code of a task run by "task run".
+func exitCode(err error) int {
+	var errWithCode cli.ErrorWithExitCode
+	if errors.As(err, &errWithCode) {
+		return errWithCode.ExitCode()
+	}
+	return 1
+}
+
+// checkForUpdate looks up in the background whether a newer release of the CLI is available.
+// The returned channel receives the newer version only if there is one.
+func checkForUpdate() <-chan string {
+	newerVersion := make(chan string, 1)
+	if disabled, _ := strconv.ParseBool(os.Getenv(version.DisableUpdateCheckEnvVar)); disabled {
+		return newerVersion
+	}
+	checker, err := version.NewUpdateChecker()
+	if err != nil {
+		return newerVersion
+	}
+	go func() {
+		// Errors are ignored as the update check should never fail a command.
+		v, err := checker.NewerVersion()
+		if err != nil || v == "" {
+			return
+		}
+		newerVersion <- v
+	}()
+	return newerVersion
+}
+
+// notifyUpdate prints a notice if the update check has already found a newer version.
+// It never waits for the check to complete.
+func notifyUpdate(newerVersion <-chan string) {
+	select {
+	case v := <-newerVersion:
+		log.Infof("\nA new version %s is available: https://github.com/aws/copilot-cli/releases/latest\n", v)
+	default:
 	}
 }
 
@@ -38,6 +86,9 @@ func buildRootCmd() *cobra.Command {
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
 			// If we don't set a Run() function the help menu doesn't show up.
 			// See https://github.com/spf13/cobra/issues/790
+			cli.DisablePromptsIfNonInteractive(cmd)
+			cli.EnableDebugLogsIfRequested(cmd)
+			cli.EnableFIPSIfRequested(cmd)
 		},
 		SilenceUsage:  true,
 		SilenceErrors: true,
@@ -47,6 +98,9 @@ func buildRootCmd() *cobra.Command {
 	// version information.
 	cmd.Version = version.Version
 	cmd.SetVersionTemplate("copilot version: {{.Version}}\n")
+	cli.AddNoPromptFlag(cmd)
+	cli.AddDebugFlag(cmd)
+	cli.AddFIPSFlag(cmd)
 
 	// NOTE: Order for each grouping below is significant in that it affects help menu output ordering.
 	// "Getting Started" command group.
@@ -66,6 +120,8 @@ func buildRootCmd() *cobra.Command {
 
 	// "Settings" command group.
 	cmd.AddCommand(cli.BuildVersionCmd())
+	cmd.AddCommand(cli.BuildWorkspaceCmd())
+	cmd.AddCommand(cli.BuildSupportBundleCmd())
 	cmd.AddCommand(cli.BuildCompletionCmd(cmd))
 
 	// "Release" command group.
@@ -74,5 +130,8 @@ func buildRootCmd() *cobra.Command {
 
 	// "Debug" command group.
 	cmd.SetUsageTemplate(template.RootUsage)
+
+	// Complete the names of applications, environments, services, and jobs from the config store.
+	cli.RegisterNameCompletions(cmd)
 	return cmd
 }
@@ -0,0 +1,38 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExitCode(t *testing.T) {
+	testCases := map[string]struct {
+		inErr error
+
+		wantedCode int
+	}{
+		"returns 1 for an error without an exit code": {
+			inErr: errors.New("some error"),
+
+			wantedCode: 1,
+		},
+		"returns 1 for the exit error of a child process": {
+			inErr: fmt.Errorf("build and push image: %w", &exec.ExitError{}),
+
+			wantedCode: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedCode, exitCode(tc.inErr))
+		})
+	}
+}
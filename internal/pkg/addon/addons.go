@@ -5,6 +5,7 @@
 package addon
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
 
@@ -18,6 +19,9 @@ const (
 	StackName = "AddonsStack"
 )
 
+// requiredParameters are the parameters that Copilot passes to the addons stack.
+var requiredParameters = []string{"App", "Env", "Name"}
+
 type workspaceReader interface {
 	ReadAddonsDir(svcName string) ([]string, error)
 	ReadAddon(svcName, fileName string) ([]byte, error)
@@ -50,9 +54,72 @@ func New(wlName string) (*Addons, error) {
 // If the addons directory doesn't exist, it returns the empty string and
 // ErrAddonsDirNotExist.
 func (a *Addons) Template() (string, error) {
+	mergedTemplate, err := a.mergedTemplate()
+	if err != nil {
+		return "", err
+	}
+	out, err := yaml.Marshal(mergedTemplate)
+	if err != nil {
+		return "", fmt.Errorf("marshal merged addons template: %w", err)
+	}
+	return string(out), nil
+}
+
+// Validate parses the CloudFormation templates under the "addons/" directory of a workload and returns an error if
+// a template isn't valid YAML, if the templates conflict with each other, if they don't declare any resource,
+// or if they don't declare the parameters that Copilot passes to the addons stack.
+//
+// If the workload doesn't have addons, it returns nil.
+func (a *Addons) Validate() error {
+	mergedTemplate, err := a.mergedTemplate()
+	if err != nil {
+		var notFoundErr *ErrAddonsNotFound
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return err
+	}
+	if len(mergedTemplate.Resources.Content) == 0 {
+		return &errNoResources{wlName: a.wlName}
+	}
+	declared := make(map[string]bool)
+	// The content of a mapping node alternates between keys and values.
+	for i := 0; i < len(mergedTemplate.Parameters.Content); i += 2 {
+		declared[mergedTemplate.Parameters.Content[i].Value] = true
+	}
+	var missing []string
+	for _, param := range requiredParameters {
+		if !declared[param] {
+			missing = append(missing, param)
+		}
+	}
+	if len(missing) != 0 {
+		return &errMissingParameters{
+			wlName: a.wlName,
+			params: missing,
+		}
+	}
+	return nil
+}
+
+// ValidateTemplate returns an error if the content of the addon file fname isn't a YAML CloudFormation template
+// that declares at least one resource.
+func ValidateTemplate(fname string, content []byte) error {
+	tpl := newCFNTemplate(fname)
+	if err := yaml.Unmarshal(content, tpl); err != nil {
+		return fmt.Errorf("unmarshal addon %s: %w", fname, err)
+	}
+	if len(tpl.Resources.Content) == 0 {
+		return &errTemplateNoResources{fname: fname}
+	}
+	return nil
+}
+
+// mergedTemplate parses the YAML templates under the "addons/" directory of a workload and merges them.
+func (a *Addons) mergedTemplate() (*cfnTemplate, error) {
 	fnames, err := a.ws.ReadAddonsDir(a.wlName)
 	if err != nil {
-		return "", &ErrAddonsNotFound{
+		return nil, &ErrAddonsNotFound{
 			WlName:    a.wlName,
 			ParentErr: err,
 		}
@@ -60,7 +127,7 @@ func (a *Addons) Template() (string, error) {
 
 	yamlFiles := filterYAMLfiles(fnames)
 	if len(yamlFiles) == 0 {
-		return "", &ErrAddonsNotFound{
+		return nil, &ErrAddonsNotFound{
 			WlName: a.wlName,
 		}
 	}
@@ -69,21 +136,17 @@ func (a *Addons) Template() (string, error) {
 	for _, fname := range yamlFiles {
 		out, err := a.ws.ReadAddon(a.wlName, fname)
 		if err != nil {
-			return "", fmt.Errorf("read addon %s under %s: %w", fname, a.wlName, err)
+			return nil, fmt.Errorf("read addon %s under %s: %w", fname, a.wlName, err)
 		}
 		tpl := newCFNTemplate(fname)
 		if err := yaml.Unmarshal(out, tpl); err != nil {
-			return "", fmt.Errorf("unmarshal addon %s under %s: %w", fname, a.wlName, err)
+			return nil, fmt.Errorf("unmarshal addon %s under %s: %w", fname, a.wlName, err)
 		}
 		if err := mergedTemplate.merge(tpl); err != nil {
-			return "", err
+			return nil, err
 		}
 	}
-	out, err := yaml.Marshal(mergedTemplate)
-	if err != nil {
-		return "", fmt.Errorf("marshal merged addons template: %w", err)
-	}
-	return string(out), nil
+	return mergedTemplate, nil
 }
 
 func filterYAMLfiles(files []string) []string {
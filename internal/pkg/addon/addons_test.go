@@ -242,3 +242,155 @@ func TestAddons_Template(t *testing.T) {
 		})
 	}
 }
+
+func TestAddons_Validate(t *testing.T) {
+	const testSvcName = "mysvc"
+	testCases := map[string]struct {
+		mockAddons func(ctrl *gomock.Controller) *Addons
+
+		wantedErr error
+	}{
+		"return nil if the workload doesn't have addons": {
+			mockAddons: func(ctrl *gomock.Controller) *Addons {
+				ws := mocks.NewMockworkspaceReader(ctrl)
+				ws.EXPECT().ReadAddonsDir(testSvcName).Return(nil, errors.New("some error"))
+				return &Addons{
+					wlName: testSvcName,
+					ws:     ws,
+				}
+			},
+		},
+		"return err on invalid YAML": {
+			mockAddons: func(ctrl *gomock.Controller) *Addons {
+				ws := mocks.NewMockworkspaceReader(ctrl)
+				ws.EXPECT().ReadAddonsDir(testSvcName).Return([]string{"invalid-yaml.yaml"}, nil)
+
+				tpl, _ := ioutil.ReadFile(filepath.Join("testdata", "validate", "invalid-yaml.yaml"))
+				ws.EXPECT().ReadAddon(testSvcName, "invalid-yaml.yaml").Return(tpl, nil)
+				return &Addons{
+					wlName: testSvcName,
+					ws:     ws,
+				}
+			},
+			wantedErr: errors.New("unmarshal addon invalid-yaml.yaml under mysvc: yaml: line 1: did not find expected key"),
+		},
+		"return err on conflicting templates": {
+			mockAddons: func(ctrl *gomock.Controller) *Addons {
+				ws := mocks.NewMockworkspaceReader(ctrl)
+				ws.EXPECT().ReadAddonsDir(testSvcName).Return([]string{"first.yaml", "invalid-resources.yaml"}, nil)
+
+				first, _ := ioutil.ReadFile(filepath.Join("testdata", "merge", "first.yaml"))
+				ws.EXPECT().ReadAddon(testSvcName, "first.yaml").Return(first, nil)
+
+				second, _ := ioutil.ReadFile(filepath.Join("testdata", "merge", "invalid-resources.yaml"))
+				ws.EXPECT().ReadAddon(testSvcName, "invalid-resources.yaml").Return(second, nil)
+				return &Addons{
+					wlName: testSvcName,
+					ws:     ws,
+				}
+			},
+			wantedErr: errors.New(`resource "MyTable" defined in "first.yaml" at Ln 34, Col 9 is different than in "invalid-resources.yaml" at Ln 3, Col 5`),
+		},
+		"return err if there are no resources": {
+			mockAddons: func(ctrl *gomock.Controller) *Addons {
+				ws := mocks.NewMockworkspaceReader(ctrl)
+				ws.EXPECT().ReadAddonsDir(testSvcName).Return([]string{"no-resources.yaml"}, nil)
+
+				tpl, _ := ioutil.ReadFile(filepath.Join("testdata", "validate", "no-resources.yaml"))
+				ws.EXPECT().ReadAddon(testSvcName, "no-resources.yaml").Return(tpl, nil)
+				return &Addons{
+					wlName: testSvcName,
+					ws:     ws,
+				}
+			},
+			wantedErr: errors.New(`addons for mysvc must declare at least one resource under "Resources"`),
+		},
+		"return err if required parameters are missing": {
+			mockAddons: func(ctrl *gomock.Controller) *Addons {
+				ws := mocks.NewMockworkspaceReader(ctrl)
+				ws.EXPECT().ReadAddonsDir(testSvcName).Return([]string{"missing-params.yaml"}, nil)
+
+				tpl, _ := ioutil.ReadFile(filepath.Join("testdata", "validate", "missing-params.yaml"))
+				ws.EXPECT().ReadAddon(testSvcName, "missing-params.yaml").Return(tpl, nil)
+				return &Addons{
+					wlName: testSvcName,
+					ws:     ws,
+				}
+			},
+			wantedErr: errors.New(`addons for mysvc must declare the parameters "Env", "Name" under "Parameters" as Copilot passes them to the addons stack`),
+		},
+		"valid templates": {
+			mockAddons: func(ctrl *gomock.Controller) *Addons {
+				ws := mocks.NewMockworkspaceReader(ctrl)
+				ws.EXPECT().ReadAddonsDir(testSvcName).Return([]string{"first.yaml", "second.yaml"}, nil)
+
+				first, _ := ioutil.ReadFile(filepath.Join("testdata", "merge", "first.yaml"))
+				ws.EXPECT().ReadAddon(testSvcName, "first.yaml").Return(first, nil)
+
+				second, _ := ioutil.ReadFile(filepath.Join("testdata", "merge", "second.yaml"))
+				ws.EXPECT().ReadAddon(testSvcName, "second.yaml").Return(second, nil)
+				return &Addons{
+					wlName: testSvcName,
+					ws:     ws,
+				}
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addons := tc.mockAddons(ctrl)
+
+			// WHEN
+			err := addons.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	testCases := map[string]struct {
+		inFileName string
+
+		wantedErr error
+	}{
+		"return err on invalid YAML": {
+			inFileName: "invalid-yaml.yaml",
+			wantedErr:  errors.New("unmarshal addon invalid-yaml.yaml: yaml: line 1: did not find expected key"),
+		},
+		"return err if there are no resources": {
+			inFileName: "no-resources.yaml",
+			wantedErr:  errors.New(`addon no-resources.yaml must declare at least one resource under "Resources"`),
+		},
+		"valid template": {
+			inFileName: "missing-params.yaml",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			content, err := ioutil.ReadFile(filepath.Join("testdata", "validate", tc.inFileName))
+			require.NoError(t, err)
+
+			// WHEN
+			err = ValidateTemplate(tc.inFileName, content)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
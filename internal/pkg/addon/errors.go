@@ -6,6 +6,8 @@ package addon
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
@@ -23,6 +25,39 @@ func (e *ErrAddonsNotFound) Error() string {
 	return fmt.Sprintf("read addons directory for %s: no addons found", e.WlName)
 }
 
+// errNoResources occurs when the addon templates of a workload don't declare any resource.
+type errNoResources struct {
+	wlName string
+}
+
+func (e *errNoResources) Error() string {
+	return fmt.Sprintf(`addons for %s must declare at least one resource under "Resources"`, e.wlName)
+}
+
+// errTemplateNoResources occurs when an addon template doesn't declare any resource.
+type errTemplateNoResources struct {
+	fname string
+}
+
+func (e *errTemplateNoResources) Error() string {
+	return fmt.Sprintf(`addon %s must declare at least one resource under "Resources"`, e.fname)
+}
+
+// errMissingParameters occurs when the addon templates of a workload don't declare the parameters that Copilot passes to the addons stack.
+type errMissingParameters struct {
+	wlName string
+	params []string
+}
+
+func (e *errMissingParameters) Error() string {
+	quoted := make([]string, len(e.params))
+	for i, param := range e.params {
+		quoted[i] = strconv.Quote(param)
+	}
+	return fmt.Sprintf(`addons for %s must declare the parameters %s under "Parameters" as Copilot passes them to the addons stack`,
+		e.wlName, strings.Join(quoted, ", "))
+}
+
 type errKeyAlreadyExists struct {
 	Key    string
 	First  *yaml.Node
@@ -0,0 +1,38 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package addon
+
+import (
+	"errors"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Parameters parses the Parameters section of a CloudFormation template and returns the logical IDs of the parameters.
+func Parameters(template string) ([]string, error) {
+	var tpl struct {
+		Parameters yaml.Node `yaml:"Parameters"`
+	}
+	if err := yaml.Unmarshal([]byte(template), &tpl); err != nil {
+		return nil, fmt.Errorf("unmarshal addon cloudformation template: %w", err)
+	}
+	if tpl.Parameters.IsZero() {
+		return nil, nil
+	}
+	if tpl.Parameters.Kind != yaml.MappingNode {
+		return nil, errors.New(`"Parameters" field in cloudformation template is not a map`)
+	}
+	var params []string
+	// The content of a mapping node alternates between keys and values.
+	for i := 0; i < len(tpl.Parameters.Content); i += 2 {
+		params = append(params, tpl.Parameters.Content[i].Value)
+	}
+	return params, nil
+}
+
+// IsRequiredParameter returns true if the parameter is one that Copilot passes to the addons stack.
+func IsRequiredParameter(name string) bool {
+	return contains(requiredParameters, name)
+}
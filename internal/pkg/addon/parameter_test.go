@@ -0,0 +1,71 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package addon
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParameters(t *testing.T) {
+	testCases := map[string]struct {
+		template string
+
+		wantedParams []string
+		wantedErr    error
+	}{
+		"returns an error if the parameters are not a map": {
+			template: `
+Parameters:
+  - App
+Resources:
+  MyBucket:
+    Type: AWS::S3::Bucket`,
+			wantedErr: errors.New(`"Parameters" field in cloudformation template is not a map`),
+		},
+		"returns nothing if the template doesn't declare parameters": {
+			template: `
+Resources:
+  MyBucket:
+    Type: AWS::S3::Bucket`,
+		},
+		"returns the logical IDs of the parameters": {
+			template: `
+Parameters:
+  App:
+    Type: String
+  Env:
+    Type: String
+  Name:
+    Type: String
+  RetentionDays:
+    Type: Number
+    Default: 7
+Resources:
+  MyBucket:
+    Type: AWS::S3::Bucket`,
+			wantedParams: []string{"App", "Env", "Name", "RetentionDays"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			params, err := Parameters(tc.template)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedParams, params)
+		})
+	}
+}
+
+func TestIsRequiredParameter(t *testing.T) {
+	require.True(t, IsRequiredParameter("Env"))
+	require.False(t, IsRequiredParameter("BucketRetentionDays"))
+}
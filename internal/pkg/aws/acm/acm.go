@@ -0,0 +1,41 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package acm provides a client to make API requests to AWS Certificate Manager.
+package acm
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acm"
+)
+
+type api interface {
+	DescribeCertificate(input *acm.DescribeCertificateInput) (*acm.DescribeCertificateOutput, error)
+}
+
+// ACM wraps an AWS Certificate Manager client.
+type ACM struct {
+	client api
+}
+
+// New returns an ACM configured against the input session.
+func New(sess *session.Session) *ACM {
+	return &ACM{
+		client: acm.New(sess),
+	}
+}
+
+// ValidateCertificates returns an error if any of the certificates can't be found in the session's region.
+func (a *ACM) ValidateCertificates(certARNs []string) error {
+	for _, certARN := range certARNs {
+		if _, err := a.client.DescribeCertificate(&acm.DescribeCertificateInput{
+			CertificateArn: aws.String(certARN),
+		}); err != nil {
+			return fmt.Errorf("describe certificate %s: %w", certARN, err)
+		}
+	}
+	return nil
+}
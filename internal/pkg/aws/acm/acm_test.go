@@ -0,0 +1,72 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package acm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/acm/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestACM_ValidateCertificates(t *testing.T) {
+	testCases := map[string]struct {
+		certARNs []string
+
+		setUpMock func(m *mocks.Mockapi)
+
+		wantedError error
+	}{
+		"success": {
+			certARNs: []string{"mockCertARN1", "mockCertARN2"},
+
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeCertificate(&acm.DescribeCertificateInput{
+					CertificateArn: aws.String("mockCertARN1"),
+				}).Return(&acm.DescribeCertificateOutput{}, nil)
+				m.EXPECT().DescribeCertificate(&acm.DescribeCertificateInput{
+					CertificateArn: aws.String("mockCertARN2"),
+				}).Return(&acm.DescribeCertificateOutput{}, nil)
+			},
+		},
+		"failed to describe certificate": {
+			certARNs: []string{"mockCertARN1", "mockCertARN2"},
+
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeCertificate(&acm.DescribeCertificateInput{
+					CertificateArn: aws.String("mockCertARN1"),
+				}).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe certificate mockCertARN1: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			client := ACM{
+				client: mockAPI,
+			}
+
+			err := client.ValidateCertificates(tc.certARNs)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -24,14 +24,18 @@ const (
 	// App Runner Statuses
 	opStatusSucceeded = "SUCCEEDED"
 	opStatusFailed    = "FAILED"
-	svcStatusPaused   = "PAUSED"
-	svcStatusRunning  = "RUNNING"
 
 	// App Runner ImageRepositoryTypes
 	repositoryTypeECR       = "ECR"
 	repositoryTypeECRPublic = "ECR_PUBLIC"
 )
 
+// App Runner service statuses.
+const (
+	ServiceStatusPaused  = "PAUSED"
+	ServiceStatusRunning = "RUNNING"
+)
+
 type api interface {
 	DescribeService(input *apprunner.DescribeServiceInput) (*apprunner.DescribeServiceOutput, error)
 	ListServices(input *apprunner.ListServicesInput) (*apprunner.ListServicesOutput, error)
@@ -116,7 +120,7 @@ func (a *AppRunner) PauseService(svcARN string) error {
 	if err != nil {
 		return fmt.Errorf("pause service operation failed: %w", err)
 	}
-	if resp.OperationId == nil && aws.StringValue(resp.Service.Status) == svcStatusPaused {
+	if resp.OperationId == nil && aws.StringValue(resp.Service.Status) == ServiceStatusPaused {
 		return nil
 	}
 	if err := a.waitForOperation(aws.StringValue(resp.OperationId), svcARN); err != nil {
@@ -133,7 +137,7 @@ func (a *AppRunner) ResumeService(svcARN string) error {
 	if err != nil {
 		return fmt.Errorf("resume service operation failed: %w", err)
 	}
-	if resp.OperationId == nil && aws.StringValue(resp.Service.Status) == svcStatusRunning {
+	if resp.OperationId == nil && aws.StringValue(resp.Service.Status) == ServiceStatusRunning {
 		return nil
 	}
 	if err := a.waitForOperation(aws.StringValue(resp.OperationId), svcARN); err != nil {
@@ -26,6 +26,18 @@ var eventErrorStates = []string{
 	cloudformation.ResourceStatusImportRollbackFailed,
 }
 
+// driftStatuses are the drift statuses of the resources that no longer match their template.
+var driftStatuses = []*string{
+	aws.String(cloudformation.StackResourceDriftStatusModified),
+	aws.String(cloudformation.StackResourceDriftStatusDeleted),
+}
+
+// Drift detections are polled until they complete or until the maximum number of attempts.
+var (
+	driftDetectionPollInterval = 5 * time.Second
+	driftDetectionMaxAttempts  = 60
+)
+
 var waiters = []request.WaiterOption{
 	request.WithWaiterDelay(request.ConstantWaiterDelay(5 * time.Second)), // How long to wait in between poll cfn for updates.
 	request.WithWaiterMaxAttempts(1080),                                   // Wait for at most 90 mins for any cfn action.
@@ -272,7 +284,13 @@ func (c *CloudFormation) Outputs(stack *Stack) (map[string]string, error) {
 
 // Events returns the list of stack events in **chronological** order.
 func (c *CloudFormation) Events(stackName string) ([]StackEvent, error) {
-	return c.events(stackName, func(in *cloudformation.StackEvent) bool { return true })
+	return c.events(stackName, func(in *cloudformation.StackEvent) bool { return true }, 0)
+}
+
+// RecentEvents returns at most limit of the latest stack events in **chronological** order.
+// Pages of events are only requested until the limit is reached.
+func (c *CloudFormation) RecentEvents(stackName string, limit int) ([]StackEvent, error) {
+	return c.events(stackName, func(in *cloudformation.StackEvent) bool { return true }, limit)
 }
 
 // StackResources returns the list of resources created as part of a CloudFormation stack.
@@ -294,7 +312,78 @@ func (c *CloudFormation) StackResources(name string) ([]*StackResource, error) {
 	return resources, nil
 }
 
-func (c *CloudFormation) events(stackName string, match eventMatcher) ([]StackEvent, error) {
+// DriftedResources detects the drift of a stack and returns the resources whose actual configuration
+// was modified or that were deleted outside of CloudFormation.
+// If a drift detection is already running on the stack, it waits for that detection instead of starting a new one.
+// If the detection fails, it returns the drifted resources that CloudFormation could check along with an ErrDriftDetectionFailed.
+func (c *CloudFormation) DriftedResources(stackName string) ([]StackResourceDrift, error) {
+	var detectionID string
+	out, err := c.DetectStackDrift(&cloudformation.DetectStackDriftInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		id, ok := driftDetectionInProgressID(err)
+		if !ok {
+			return nil, fmt.Errorf("detect drift of stack %s: %w", stackName, err)
+		}
+		detectionID = id
+	} else {
+		detectionID = aws.StringValue(out.StackDriftDetectionId)
+	}
+	detectionErr := c.waitForDriftDetection(stackName, detectionID)
+	var errFailed *ErrDriftDetectionFailed
+	if detectionErr != nil && !errors.As(detectionErr, &errFailed) {
+		return nil, detectionErr
+	}
+	var drifts []StackResourceDrift
+	var nextToken *string
+	for {
+		out, err := c.DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+			StackName:                       aws.String(stackName),
+			StackResourceDriftStatusFilters: driftStatuses,
+			NextToken:                       nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe resource drifts of stack %s: %w", stackName, err)
+		}
+		for _, drift := range out.StackResourceDrifts {
+			drifts = append(drifts, StackResourceDrift(*drift))
+		}
+		nextToken = out.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return drifts, detectionErr
+}
+
+// waitForDriftDetection polls the status of a drift detection until it completes.
+func (c *CloudFormation) waitForDriftDetection(stackName, detectionID string) error {
+	for attempt := 0; attempt < driftDetectionMaxAttempts; attempt++ {
+		out, err := c.DescribeStackDriftDetectionStatus(&cloudformation.DescribeStackDriftDetectionStatusInput{
+			StackDriftDetectionId: aws.String(detectionID),
+		})
+		if err != nil {
+			return fmt.Errorf("describe drift detection %s of stack %s: %w", detectionID, stackName, err)
+		}
+		switch aws.StringValue(out.DetectionStatus) {
+		case cloudformation.StackDriftDetectionStatusDetectionComplete:
+			return nil
+		case cloudformation.StackDriftDetectionStatusDetectionFailed:
+			return &ErrDriftDetectionFailed{
+				StackName:   stackName,
+				DetectionID: detectionID,
+				Reason:      aws.StringValue(out.DetectionStatusReason),
+			}
+		}
+		time.Sleep(driftDetectionPollInterval)
+	}
+	return fmt.Errorf("drift detection %s of stack %s did not complete after %s", detectionID, stackName, time.Duration(driftDetectionMaxAttempts)*driftDetectionPollInterval)
+}
+
+// events returns the stack events that match in chronological order.
+// If limit is positive, only the limit most recent matching events are returned.
+func (c *CloudFormation) events(stackName string, match eventMatcher, limit int) ([]StackEvent, error) {
 	var nextToken *string
 	var events []StackEvent
 	for {
@@ -310,6 +399,11 @@ func (c *CloudFormation) events(stackName string, match eventMatcher) ([]StackEv
 				events = append(events, StackEvent(*event))
 			}
 		}
+		// DescribeStackEvents returns the most recent events first, so we can stop paginating once we have enough.
+		if limit > 0 && len(events) >= limit {
+			events = events[:limit]
+			break
+		}
 		nextToken = out.NextToken
 		if nextToken == nil {
 			break
@@ -333,7 +427,7 @@ func (c *CloudFormation) ErrorEvents(stackName string) ([]StackEvent, error) {
 			}
 		}
 		return false
-	})
+	}, 0)
 }
 
 // ListStacksWithTags returns all the stacks in the current AWS account and region with the specified matching
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -1133,6 +1134,102 @@ func TestCloudFormation_Events(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_RecentEvents(t *testing.T) {
+	testCases := map[string]struct {
+		inLimit      int
+		createMock   func(ctrl *gomock.Controller) client
+		wantedEvents []StackEvent
+		wantedErr    error
+	}{
+		"stop paginating once the limit is reached": {
+			inLimit: 2,
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStackEvents(&cloudformation.DescribeStackEventsInput{
+					StackName: aws.String(mockStack.Name),
+				}).Return(&cloudformation.DescribeStackEventsOutput{
+					StackEvents: []*cloudformation.StackEvent{
+						{
+							ResourceType: aws.String("ecs"),
+						},
+						{
+							ResourceType: aws.String("s3"),
+						},
+						{
+							ResourceType: aws.String("iam"),
+						},
+					},
+					NextToken: aws.String("1111"),
+				}, nil)
+				return m
+			},
+			wantedEvents: []StackEvent{
+				{
+					ResourceType: aws.String("s3"),
+				},
+				{
+					ResourceType: aws.String("ecs"),
+				},
+			},
+		},
+		"paginate until the limit is reached": {
+			inLimit: 3,
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				gomock.InOrder(
+					m.EXPECT().DescribeStackEvents(&cloudformation.DescribeStackEventsInput{
+						StackName: aws.String(mockStack.Name),
+					}).Return(&cloudformation.DescribeStackEventsOutput{
+						StackEvents: []*cloudformation.StackEvent{
+							{
+								ResourceType: aws.String("ecs"),
+							},
+						},
+						NextToken: aws.String("1111"),
+					}, nil),
+					m.EXPECT().DescribeStackEvents(&cloudformation.DescribeStackEventsInput{
+						StackName: aws.String(mockStack.Name),
+						NextToken: aws.String("1111"),
+					}).Return(&cloudformation.DescribeStackEventsOutput{
+						StackEvents: []*cloudformation.StackEvent{
+							{
+								ResourceType: aws.String("s3"),
+							},
+						},
+					}, nil),
+				)
+				return m
+			},
+			wantedEvents: []StackEvent{
+				{
+					ResourceType: aws.String("s3"),
+				},
+				{
+					ResourceType: aws.String("ecs"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			events, err := c.RecentEvents(mockStack.Name, tc.inLimit)
+
+			// THEN
+			require.Equal(t, tc.wantedEvents, events)
+			require.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
 func TestStackDescriber_StackResources(t *testing.T) {
 	testCases := map[string]struct {
 		createMock func(ctrl *gomock.Controller) client
@@ -1193,6 +1290,157 @@ func TestStackDescriber_StackResources(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_DriftedResources(t *testing.T) {
+	const (
+		mockStack       = "phonetool-test-api"
+		mockDetectionID = "b78ac9b0-dec1-11e7-a451-503a3c0aa05b"
+	)
+	driftedBucket := &cloudformation.StackResourceDrift{
+		LogicalResourceId:        aws.String("Bucket"),
+		StackResourceDriftStatus: aws.String(cloudformation.StackResourceDriftStatusModified),
+	}
+	deletedQueue := &cloudformation.StackResourceDrift{
+		LogicalResourceId:        aws.String("Queue"),
+		StackResourceDriftStatus: aws.String(cloudformation.StackResourceDriftStatusDeleted),
+	}
+	detectStarted := func(m *mocks.Mockclient) {
+		m.EXPECT().DetectStackDrift(&cloudformation.DetectStackDriftInput{
+			StackName: aws.String(mockStack),
+		}).Return(&cloudformation.DetectStackDriftOutput{
+			StackDriftDetectionId: aws.String(mockDetectionID),
+		}, nil)
+	}
+	detectionComplete := func(m *mocks.Mockclient) {
+		m.EXPECT().DescribeStackDriftDetectionStatus(&cloudformation.DescribeStackDriftDetectionStatusInput{
+			StackDriftDetectionId: aws.String(mockDetectionID),
+		}).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+			DetectionStatus: aws.String(cloudformation.StackDriftDetectionStatusDetectionComplete),
+		}, nil)
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockclient)
+
+		wantedDrifts []StackResourceDrift
+		wantedError  error
+	}{
+		"error if fail to start the drift detection": {
+			setupMocks: func(m *mocks.Mockclient) {
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("detect drift of stack phonetool-test-api: some error"),
+		},
+		"returns the drifted resources that were checked if the drift detection fails": {
+			setupMocks: func(m *mocks.Mockclient) {
+				detectStarted(m)
+				m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+					DetectionStatus:       aws.String(cloudformation.StackDriftDetectionStatusDetectionFailed),
+					DetectionStatusReason: aws.String("some reason"),
+				}, nil)
+				m.EXPECT().DescribeStackResourceDrifts(gomock.Any()).Return(&cloudformation.DescribeStackResourceDriftsOutput{
+					StackResourceDrifts: []*cloudformation.StackResourceDrift{driftedBucket},
+				}, nil)
+			},
+			wantedDrifts: []StackResourceDrift{
+				StackResourceDrift(*driftedBucket),
+			},
+			wantedError: &ErrDriftDetectionFailed{
+				StackName:   mockStack,
+				DetectionID: mockDetectionID,
+				Reason:      "some reason",
+			},
+		},
+		"error if the drift detection fails and the drifted resources can't be described": {
+			setupMocks: func(m *mocks.Mockclient) {
+				detectStarted(m)
+				m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+					DetectionStatus:       aws.String(cloudformation.StackDriftDetectionStatusDetectionFailed),
+					DetectionStatusReason: aws.String("some reason"),
+				}, nil)
+				m.EXPECT().DescribeStackResourceDrifts(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe resource drifts of stack phonetool-test-api: some error"),
+		},
+		"error if the drift detection does not complete": {
+			setupMocks: func(m *mocks.Mockclient) {
+				detectStarted(m)
+				m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+					DetectionStatus: aws.String(cloudformation.StackDriftDetectionStatusDetectionInProgress),
+				}, nil).Times(2)
+			},
+			wantedError: errors.New("drift detection b78ac9b0-dec1-11e7-a451-503a3c0aa05b of stack phonetool-test-api did not complete after 0s"),
+		},
+		"waits for the drift detection that is already in progress": {
+			setupMocks: func(m *mocks.Mockclient) {
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(nil, awserr.New("ValidationError",
+					"Drift detection is already in progress for stack phonetool-test-api with detection id b78ac9b0-dec1-11e7-a451-503a3c0aa05b", nil))
+				detectionComplete(m)
+				m.EXPECT().DescribeStackResourceDrifts(gomock.Any()).Return(&cloudformation.DescribeStackResourceDriftsOutput{}, nil)
+			},
+		},
+		"returns the modified and deleted resources of every page": {
+			setupMocks: func(m *mocks.Mockclient) {
+				detectStarted(m)
+				gomock.InOrder(
+					m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+						DetectionStatus: aws.String(cloudformation.StackDriftDetectionStatusDetectionInProgress),
+					}, nil),
+					m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+						DetectionStatus: aws.String(cloudformation.StackDriftDetectionStatusDetectionComplete),
+					}, nil),
+				)
+				gomock.InOrder(
+					m.EXPECT().DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+						StackName:                       aws.String(mockStack),
+						StackResourceDriftStatusFilters: aws.StringSlice([]string{"MODIFIED", "DELETED"}),
+					}).Return(&cloudformation.DescribeStackResourceDriftsOutput{
+						StackResourceDrifts: []*cloudformation.StackResourceDrift{driftedBucket},
+						NextToken:           aws.String("1111"),
+					}, nil),
+					m.EXPECT().DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+						StackName:                       aws.String(mockStack),
+						StackResourceDriftStatusFilters: aws.StringSlice([]string{"MODIFIED", "DELETED"}),
+						NextToken:                       aws.String("1111"),
+					}).Return(&cloudformation.DescribeStackResourceDriftsOutput{
+						StackResourceDrifts: []*cloudformation.StackResourceDrift{deletedQueue},
+					}, nil),
+				)
+			},
+			wantedDrifts: []StackResourceDrift{
+				StackResourceDrift(*driftedBucket),
+				StackResourceDrift(*deletedQueue),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockclient(ctrl)
+			tc.setupMocks(m)
+			c := CloudFormation{
+				client: m,
+			}
+			defer func(interval time.Duration, attempts int) {
+				driftDetectionPollInterval, driftDetectionMaxAttempts = interval, attempts
+			}(driftDetectionPollInterval, driftDetectionMaxAttempts)
+			driftDetectionPollInterval, driftDetectionMaxAttempts = 0, 2
+
+			// WHEN
+			drifts, err := c.DriftedResources(mockStack)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedDrifts, drifts)
+		})
+	}
+}
+
 func TestCloudFormation_ListStacksWithTags(t *testing.T) {
 	mockAppTag := cloudformation.Tag{
 		Key:   aws.String("copilot-application"),
@@ -5,11 +5,15 @@ package cloudformation
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 )
 
+// driftDetectionIDRegexp matches the ID of a drift detection, which is a UUID.
+var driftDetectionIDRegexp = regexp.MustCompile(`[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`)
+
 // ErrChangeSetEmpty occurs when the change set does not contain any new or updated resources.
 type ErrChangeSetEmpty struct {
 	cs *changeSet
@@ -57,6 +61,29 @@ func (e *ErrStackUpdateInProgress) Error() string {
 	return fmt.Sprintf("stack %s is currently being updated and cannot be deployed to", e.Name)
 }
 
+// ErrDriftDetectionFailed occurs when CloudFormation can't detect drift on every resource of a stack.
+// The drift of the resources that CloudFormation could check is still available.
+type ErrDriftDetectionFailed struct {
+	StackName   string
+	DetectionID string
+	Reason      string
+}
+
+func (e *ErrDriftDetectionFailed) Error() string {
+	return fmt.Sprintf("drift detection %s of stack %s failed: %s", e.DetectionID, e.StackName, e.Reason)
+}
+
+// driftDetectionInProgressID returns the ID of the drift detection that's already running on a stack
+// if the underlying error is caused by it.
+func driftDetectionInProgressID(err error) (string, bool) {
+	aerr, ok := err.(awserr.Error)
+	if !ok || aerr.Code() != "ValidationError" || !strings.Contains(aerr.Message(), "already in progress") {
+		return "", false
+	}
+	id := driftDetectionIDRegexp.FindString(aerr.Message())
+	return id, id != ""
+}
+
 // stackDoesNotExist returns true if the underlying error is a stack doesn't exist.
 func stackDoesNotExist(err error) bool {
 	if aerr, ok := err.(awserr.Error); ok {
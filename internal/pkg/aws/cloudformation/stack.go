@@ -97,6 +97,9 @@ type StackDescription cloudformation.Stack
 // StackResource is an alias the SDK's StackResource type.
 type StackResource cloudformation.StackResource
 
+// StackResourceDrift is an alias the SDK's StackResourceDrift type.
+type StackResourceDrift cloudformation.StackResourceDrift
+
 // SDK returns the underlying struct from the AWS SDK.
 func (d *StackDescription) SDK() *cloudformation.Stack {
 	raw := cloudformation.Stack(*d)
@@ -8,4 +8,5 @@ type InstanceSummary struct {
 	StackID string
 	Account string
 	Region  string
+	Status  string
 }
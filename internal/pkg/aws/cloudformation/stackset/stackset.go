@@ -7,6 +7,7 @@ package stackset
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -21,6 +22,7 @@ type api interface {
 	DeleteStackSet(*cloudformation.DeleteStackSetInput) (*cloudformation.DeleteStackSetOutput, error)
 	DescribeStackSet(*cloudformation.DescribeStackSetInput) (*cloudformation.DescribeStackSetOutput, error)
 	DescribeStackSetOperation(*cloudformation.DescribeStackSetOperationInput) (*cloudformation.DescribeStackSetOperationOutput, error)
+	ListStackSetOperationResults(*cloudformation.ListStackSetOperationResultsInput) (*cloudformation.ListStackSetOperationResultsOutput, error)
 
 	CreateStackInstances(*cloudformation.CreateStackInstancesInput) (*cloudformation.CreateStackInstancesOutput, error)
 	DeleteStackInstances(*cloudformation.DeleteStackInstancesInput) (*cloudformation.DeleteStackInstancesOutput, error)
@@ -45,7 +47,8 @@ func New(s *session.Session) *StackSet {
 	}
 }
 
-// CreateOrUpdateOption allows to initialize or update a stack set with additional properties.
+// CreateOrUpdateOption allows to initialize or update a stack set, or to create or delete its stack instances,
+// with additional properties.
 type CreateOrUpdateOption func(interface{})
 
 // Create creates a new stack set resource, if one already exists then do nothing.
@@ -99,11 +102,12 @@ func (ss *StackSet) UpdateAndWait(name, template string, opts ...CreateOrUpdateO
 }
 
 // Delete removes all the stack instances from a stack set and then deletes the stack set.
-func (ss *StackSet) Delete(name string) error {
+func (ss *StackSet) Delete(name string, opts ...CreateOrUpdateOption) error {
 	summaries, err := ss.InstanceSummaries(name)
 	if err != nil {
 		// If the stack set doesn't exist - just move on.
-		if isNotFoundStackSet(errors.Unwrap(err)) {
+		var errNotFound *ErrStackSetNotFound
+		if errors.As(err, &errNotFound) {
 			return nil
 		}
 		return err
@@ -128,12 +132,16 @@ func (ss *StackSet) Delete(name string) error {
 
 	// Delete the stack instances for those accounts and regions.
 	if len(summaries) > 0 {
-		operation, err := ss.client.DeleteStackInstances(&cloudformation.DeleteStackInstancesInput{
+		in := &cloudformation.DeleteStackInstancesInput{
 			StackSetName: aws.String(name),
 			Accounts:     aws.StringSlice(accounts),
 			Regions:      aws.StringSlice(regions),
 			RetainStacks: aws.Bool(false),
-		})
+		}
+		for _, opt := range opts {
+			opt(in)
+		}
+		operation, err := ss.client.DeleteStackInstances(in)
 		if err != nil {
 			return fmt.Errorf("delete stack instances in regions %v for accounts %v for stackset %s: %w",
 				regions, accounts, name, err)
@@ -156,16 +164,16 @@ func (ss *StackSet) Delete(name string) error {
 }
 
 // CreateInstances creates new stack instances for a stack set within the regions of the specified AWS accounts.
-func (ss *StackSet) CreateInstances(name string, accounts, regions []string) error {
-	if _, err := ss.createInstances(name, accounts, regions); err != nil {
+func (ss *StackSet) CreateInstances(name string, accounts, regions []string, opts ...CreateOrUpdateOption) error {
+	if _, err := ss.createInstances(name, accounts, regions, opts...); err != nil {
 		return err
 	}
 	return nil
 }
 
 // CreateInstancesAndWait creates new stack instances in the regions of the specified AWS accounts, and waits until the operation completes.
-func (ss *StackSet) CreateInstancesAndWait(name string, accounts, regions []string) error {
-	id, err := ss.createInstances(name, accounts, regions)
+func (ss *StackSet) CreateInstancesAndWait(name string, accounts, regions []string, opts ...CreateOrUpdateOption) error {
+	id, err := ss.createInstances(name, accounts, regions, opts...)
 	if err != nil {
 		return err
 	}
@@ -185,6 +193,9 @@ func (ss *StackSet) InstanceSummaries(name string, opts ...InstanceSummariesOpti
 	}
 	resp, err := ss.client.ListStackInstances(in)
 	if err != nil {
+		if isNotFoundStackSet(err) {
+			return nil, fmt.Errorf("list stack instances for stack set %s: %w", name, &ErrStackSetNotFound{name: name})
+		}
 		return nil, fmt.Errorf("list stack instances for stack set %s: %w", name, err)
 	}
 	var summaries []InstanceSummary
@@ -193,6 +204,7 @@ func (ss *StackSet) InstanceSummaries(name string, opts ...InstanceSummariesOpti
 			StackID: aws.StringValue(summary.StackId),
 			Account: aws.StringValue(summary.Account),
 			Region:  aws.StringValue(summary.Region),
+			Status:  aws.StringValue(summary.Status),
 		})
 	}
 	return summaries, nil
@@ -222,12 +234,16 @@ func (ss *StackSet) update(name, template string, opts ...CreateOrUpdateOption)
 	return aws.StringValue(resp.OperationId), nil
 }
 
-func (ss *StackSet) createInstances(name string, accounts, regions []string) (string, error) {
-	resp, err := ss.client.CreateStackInstances(&cloudformation.CreateStackInstancesInput{
+func (ss *StackSet) createInstances(name string, accounts, regions []string, opts ...CreateOrUpdateOption) (string, error) {
+	in := &cloudformation.CreateStackInstancesInput{
 		StackSetName: aws.String(name),
 		Accounts:     aws.StringSlice(accounts),
 		Regions:      aws.StringSlice(regions),
-	})
+	}
+	for _, opt := range opts {
+		opt(in)
+	}
+	resp, err := ss.client.CreateStackInstances(in)
 	if err != nil {
 		return "", fmt.Errorf("create stack instances for stack set %s in regions %v for accounts %v: %w",
 			name, regions, accounts, err)
@@ -275,12 +291,43 @@ func (ss *StackSet) waitForOperation(name, operationID string) error {
 			return fmt.Errorf("operation %s for stack set %s was manually stopped", operationID, name)
 		}
 		if aws.StringValue(response.StackSetOperation.Status) == opStatusFailed {
-			return fmt.Errorf("operation %s for stack set %s failed", operationID, name)
+			return ss.failedOperationErr(name, operationID)
 		}
 		time.Sleep(3 * time.Second)
 	}
 }
 
+// failedOperationErr returns an error that lists the account, region, and reason of every stack instance
+// that failed during the operation.
+func (ss *StackSet) failedOperationErr(name, operationID string) error {
+	in := &cloudformation.ListStackSetOperationResultsInput{
+		StackSetName: aws.String(name),
+		OperationId:  aws.String(operationID),
+	}
+	var failures []string
+	for {
+		resp, err := ss.client.ListStackSetOperationResults(in)
+		if err != nil {
+			return fmt.Errorf("operation %s for stack set %s failed: list operation results: %w", operationID, name, err)
+		}
+		for _, summary := range resp.Summaries {
+			if aws.StringValue(summary.Status) != cloudformation.StackSetOperationResultStatusFailed {
+				continue
+			}
+			failures = append(failures, fmt.Sprintf("account %s in region %s: %s",
+				aws.StringValue(summary.Account), aws.StringValue(summary.Region), aws.StringValue(summary.StatusReason)))
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		in.NextToken = resp.NextToken
+	}
+	if len(failures) == 0 {
+		return fmt.Errorf("operation %s for stack set %s failed", operationID, name)
+	}
+	return fmt.Errorf("operation %s for stack set %s failed: %s", operationID, name, strings.Join(failures, "; "))
+}
+
 // WithDescription sets a description for a stack set.
 func WithDescription(description string) CreateOrUpdateOption {
 	return func(input interface{}) {
@@ -366,6 +413,47 @@ func WithOperationID(operationID string) CreateOrUpdateOption {
 	}
 }
 
+// WithMaxConcurrentPercentage sets the maximum percentage of accounts in which to perform a stack set operation at one time.
+// This functional option can only be used while updating a stack set or creating or deleting its stack instances, otherwise it's a no-op.
+func WithMaxConcurrentPercentage(percentage int) CreateOrUpdateOption {
+	return func(input interface{}) {
+		if prefs := operationPreferences(input); prefs != nil {
+			prefs.MaxConcurrentPercentage = aws.Int64(int64(percentage))
+		}
+	}
+}
+
+// WithFailureTolerancePercentage sets the percentage of accounts per region in which a stack set operation can fail
+// before CloudFormation stops the operation in that region.
+// This functional option can only be used while updating a stack set or creating or deleting its stack instances, otherwise it's a no-op.
+func WithFailureTolerancePercentage(percentage int) CreateOrUpdateOption {
+	return func(input interface{}) {
+		if prefs := operationPreferences(input); prefs != nil {
+			prefs.FailureTolerancePercentage = aws.Int64(int64(percentage))
+		}
+	}
+}
+
+// operationPreferences returns the operation preferences of the input, initializing them if they're not set yet.
+// It returns nil if the input isn't a stack set operation.
+func operationPreferences(input interface{}) *cloudformation.StackSetOperationPreferences {
+	var prefs **cloudformation.StackSetOperationPreferences
+	switch v := input.(type) {
+	case *cloudformation.UpdateStackSetInput:
+		prefs = &v.OperationPreferences
+	case *cloudformation.CreateStackInstancesInput:
+		prefs = &v.OperationPreferences
+	case *cloudformation.DeleteStackInstancesInput:
+		prefs = &v.OperationPreferences
+	default:
+		return nil
+	}
+	if *prefs == nil {
+		*prefs = &cloudformation.StackSetOperationPreferences{}
+	}
+	return *prefs
+}
+
 // FilterSummariesByAccountID limits the accountID for the stack instance summaries to retrieve.
 func FilterSummariesByAccountID(accountID string) InstanceSummariesOption {
 	return func(input *cloudformation.ListStackInstancesInput) {
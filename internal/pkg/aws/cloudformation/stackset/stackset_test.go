@@ -165,6 +165,7 @@ func TestStackSet_Update(t *testing.T) {
 
 	testCases := map[string]struct {
 		mockClient  func(ctrl *gomock.Controller) api
+		opts        []CreateOrUpdateOption
 		wantedError error
 	}{
 		"updates stack with operation is valid": {
@@ -192,6 +193,37 @@ func TestStackSet_Update(t *testing.T) {
 				return m
 			},
 		},
+		"updates stack with operation preferences": {
+			mockClient: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().UpdateStackSet(&cloudformation.UpdateStackSetInput{
+					OperationId:           aws.String(testOperationID),
+					AdministrationRoleARN: aws.String(testAdministrationRole),
+					Description:           aws.String(testDescription),
+					ExecutionRoleName:     aws.String(testExecutionRole),
+					StackSetName:          aws.String(testName),
+					Tags: []*cloudformation.Tag{
+						{
+							Key:   aws.String("owner"),
+							Value: aws.String("boss"),
+						},
+					},
+					OperationPreferences: &cloudformation.StackSetOperationPreferences{
+						RegionConcurrencyType:      aws.String(cloudformation.RegionConcurrencyTypeParallel),
+						MaxConcurrentPercentage:    aws.Int64(100),
+						FailureTolerancePercentage: aws.Int64(10),
+					},
+					TemplateBody: aws.String(testTemplate),
+				}).Return(&cloudformation.UpdateStackSetOutput{
+					OperationId: aws.String(testOperationID),
+				}, nil)
+				return m
+			},
+			opts: []CreateOrUpdateOption{
+				WithMaxConcurrentPercentage(100),
+				WithFailureTolerancePercentage(10),
+			},
+		},
 		"returns ErrStackSetOutOfDate if operation exists already": {
 			mockClient: func(ctrl *gomock.Controller) api {
 				m := mocks.NewMockapi(ctrl)
@@ -245,12 +277,14 @@ func TestStackSet_Update(t *testing.T) {
 			}
 
 			// WHEN
-			err := client.Update(testName, testTemplate,
+			opts := append([]CreateOrUpdateOption{
 				WithOperationID(testOperationID),
 				WithDescription(testDescription),
 				WithAdministrationRoleARN(testAdministrationRole),
 				WithExecutionRoleName(testExecutionRole),
-				WithTags(testTags))
+				WithTags(testTags),
+			}, tc.opts...)
+			err := client.Update(testName, testTemplate, opts...)
 
 			// THEN
 			require.Equal(t, tc.wantedError, err)
@@ -307,10 +341,107 @@ func TestStackSet_UpdateAndWait(t *testing.T) {
 						Status: aws.String(opStatusFailed),
 					},
 				}, nil)
+				m.EXPECT().ListStackSetOperationResults(&cloudformation.ListStackSetOperationResultsInput{
+					StackSetName: aws.String(testName),
+					OperationId:  aws.String("1"),
+				}).Return(&cloudformation.ListStackSetOperationResultsOutput{}, nil)
 				return m
 			},
 			wantedError: fmt.Errorf("operation %s for stack set %s failed", "1", testName),
 		},
+		"returns the failed stack instances if operation failed": {
+			mockClient: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().UpdateStackSet(gomock.Any()).Return(&cloudformation.UpdateStackSetOutput{
+					OperationId: aws.String("1"),
+				}, nil)
+				m.EXPECT().DescribeStackSetOperation(gomock.Any()).Return(&cloudformation.DescribeStackSetOperationOutput{
+					StackSetOperation: &cloudformation.StackSetOperation{
+						Status: aws.String(opStatusFailed),
+					},
+				}, nil)
+				m.EXPECT().ListStackSetOperationResults(gomock.Any()).Return(&cloudformation.ListStackSetOperationResultsOutput{
+					Summaries: []*cloudformation.StackSetOperationResultSummary{
+						{
+							Account:      aws.String("1234"),
+							Region:       aws.String("us-west-2"),
+							Status:       aws.String(cloudformation.StackSetOperationResultStatusSucceeded),
+							StatusReason: aws.String(""),
+						},
+						{
+							Account:      aws.String("5678"),
+							Region:       aws.String("us-east-1"),
+							Status:       aws.String(cloudformation.StackSetOperationResultStatusFailed),
+							StatusReason: aws.String("Account 5678 should have 'AWSCloudFormationStackSetExecutionRole' role"),
+						},
+					},
+				}, nil)
+				return m
+			},
+			wantedError: fmt.Errorf("operation %s for stack set %s failed: %s", "1", testName,
+				"account 5678 in region us-east-1: Account 5678 should have 'AWSCloudFormationStackSetExecutionRole' role"),
+		},
+		"returns the failed stack instances from every page of operation results": {
+			mockClient: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().UpdateStackSet(gomock.Any()).Return(&cloudformation.UpdateStackSetOutput{
+					OperationId: aws.String("1"),
+				}, nil)
+				m.EXPECT().DescribeStackSetOperation(gomock.Any()).Return(&cloudformation.DescribeStackSetOperationOutput{
+					StackSetOperation: &cloudformation.StackSetOperation{
+						Status: aws.String(opStatusFailed),
+					},
+				}, nil)
+				m.EXPECT().ListStackSetOperationResults(&cloudformation.ListStackSetOperationResultsInput{
+					StackSetName: aws.String(testName),
+					OperationId:  aws.String("1"),
+				}).Return(&cloudformation.ListStackSetOperationResultsOutput{
+					Summaries: []*cloudformation.StackSetOperationResultSummary{
+						{
+							Account:      aws.String("1234"),
+							Region:       aws.String("us-west-2"),
+							Status:       aws.String(cloudformation.StackSetOperationResultStatusFailed),
+							StatusReason: aws.String("Stack set operation timed out"),
+						},
+					},
+					NextToken: aws.String("token"),
+				}, nil)
+				m.EXPECT().ListStackSetOperationResults(&cloudformation.ListStackSetOperationResultsInput{
+					StackSetName: aws.String(testName),
+					OperationId:  aws.String("1"),
+					NextToken:    aws.String("token"),
+				}).Return(&cloudformation.ListStackSetOperationResultsOutput{
+					Summaries: []*cloudformation.StackSetOperationResultSummary{
+						{
+							Account:      aws.String("5678"),
+							Region:       aws.String("us-east-1"),
+							Status:       aws.String(cloudformation.StackSetOperationResultStatusFailed),
+							StatusReason: aws.String("Account 5678 should have 'AWSCloudFormationStackSetExecutionRole' role"),
+						},
+					},
+				}, nil)
+				return m
+			},
+			wantedError: fmt.Errorf("operation %s for stack set %s failed: %s", "1", testName,
+				"account 1234 in region us-west-2: Stack set operation timed out; "+
+					"account 5678 in region us-east-1: Account 5678 should have 'AWSCloudFormationStackSetExecutionRole' role"),
+		},
+		"returns a wrapped error if operation failed and results can't be listed": {
+			mockClient: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().UpdateStackSet(gomock.Any()).Return(&cloudformation.UpdateStackSetOutput{
+					OperationId: aws.String("1"),
+				}, nil)
+				m.EXPECT().DescribeStackSetOperation(gomock.Any()).Return(&cloudformation.DescribeStackSetOperationOutput{
+					StackSetOperation: &cloudformation.StackSetOperation{
+						Status: aws.String(opStatusFailed),
+					},
+				}, nil)
+				m.EXPECT().ListStackSetOperationResults(gomock.Any()).Return(nil, testError)
+				return m
+			},
+			wantedError: fmt.Errorf("operation %s for stack set %s failed: list operation results: %w", "1", testName, testError),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -407,6 +538,7 @@ func TestStackSet_WaitForStackSetLastOperationComplete(t *testing.T) {
 
 func TestStackSet_Delete(t *testing.T) {
 	testCases := map[string]struct {
+		inOpts      []CreateOrUpdateOption
 		mockClient  func(ctrl *gomock.Controller) api
 		wantedError error
 	}{
@@ -447,6 +579,42 @@ func TestStackSet_Delete(t *testing.T) {
 				return m
 			},
 		},
+		"deletes stack instances with operation preferences": {
+			inOpts: []CreateOrUpdateOption{
+				WithMaxConcurrentPercentage(50),
+				WithFailureTolerancePercentage(10),
+			},
+			mockClient: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ListStackInstances(gomock.Any()).Return(&cloudformation.ListStackInstancesOutput{
+					Summaries: []*cloudformation.StackInstanceSummary{
+						{
+							Account: aws.String("1234"),
+							Region:  aws.String("us-east-1"),
+						},
+					},
+				}, nil)
+				m.EXPECT().DeleteStackInstances(&cloudformation.DeleteStackInstancesInput{
+					StackSetName: aws.String(testName),
+					Accounts:     aws.StringSlice([]string{"1234"}),
+					Regions:      aws.StringSlice([]string{"us-east-1"}),
+					RetainStacks: aws.Bool(false),
+					OperationPreferences: &cloudformation.StackSetOperationPreferences{
+						MaxConcurrentPercentage:    aws.Int64(50),
+						FailureTolerancePercentage: aws.Int64(10),
+					},
+				}).Return(&cloudformation.DeleteStackInstancesOutput{
+					OperationId: aws.String("1"),
+				}, nil)
+				m.EXPECT().DescribeStackSetOperation(gomock.Any()).Return(&cloudformation.DescribeStackSetOperationOutput{
+					StackSetOperation: &cloudformation.StackSetOperation{
+						Status: aws.String(opStatusSucceeded),
+					},
+				}, nil)
+				m.EXPECT().DeleteStackSet(gomock.Any()).Return(nil, nil)
+				return m
+			},
+		},
 		"successfully exits if stack set does not exist": {
 			mockClient: func(ctrl *gomock.Controller) api {
 				m := mocks.NewMockapi(ctrl)
@@ -490,7 +658,7 @@ func TestStackSet_Delete(t *testing.T) {
 			}
 
 			// WHEN
-			err := client.Delete(testName)
+			err := client.Delete(testName, tc.inOpts...)
 
 			// THEN
 			require.Equal(t, tc.wantedError, err)
@@ -504,6 +672,7 @@ func TestStackSet_CreateInstances(t *testing.T) {
 		testRegions  = []string{"us-west-1"}
 	)
 	testCases := map[string]struct {
+		inOpts      []CreateOrUpdateOption
 		mockClient  func(ctrl *gomock.Controller) api
 		wantedError error
 	}{
@@ -520,6 +689,27 @@ func TestStackSet_CreateInstances(t *testing.T) {
 				return m
 			},
 		},
+		"creates stack instances with operation preferences": {
+			inOpts: []CreateOrUpdateOption{
+				WithMaxConcurrentPercentage(50),
+				WithFailureTolerancePercentage(10),
+			},
+			mockClient: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().CreateStackInstances(&cloudformation.CreateStackInstancesInput{
+					StackSetName: aws.String(testName),
+					Accounts:     aws.StringSlice(testAccounts),
+					Regions:      aws.StringSlice(testRegions),
+					OperationPreferences: &cloudformation.StackSetOperationPreferences{
+						MaxConcurrentPercentage:    aws.Int64(50),
+						FailureTolerancePercentage: aws.Int64(10),
+					},
+				}).Return(&cloudformation.CreateStackInstancesOutput{
+					OperationId: aws.String("1"),
+				}, nil)
+				return m
+			},
+		},
 		"wraps error on unexpected failure": {
 			mockClient: func(ctrl *gomock.Controller) api {
 				m := mocks.NewMockapi(ctrl)
@@ -544,7 +734,7 @@ func TestStackSet_CreateInstances(t *testing.T) {
 			}
 
 			// WHEN
-			err := client.CreateInstances(testName, testAccounts, testRegions)
+			err := client.CreateInstances(testName, testAccounts, testRegions, tc.inOpts...)
 
 			// THEN
 			require.Equal(t, tc.wantedError, err)
@@ -575,6 +765,7 @@ func TestStackSet_InstanceSummaries(t *testing.T) {
 							StackId: aws.String(testName),
 							Account: aws.String(testAccountID),
 							Region:  aws.String(testRegion),
+							Status:  aws.String(cloudformation.StackInstanceStatusCurrent),
 						},
 					},
 				}, nil)
@@ -585,9 +776,18 @@ func TestStackSet_InstanceSummaries(t *testing.T) {
 					StackID: testName,
 					Account: testAccountID,
 					Region:  testRegion,
+					Status:  cloudformation.StackInstanceStatusCurrent,
 				},
 			},
 		},
+		"returns ErrStackSetNotFound if the stack set does not exist": {
+			mockClient: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ListStackInstances(gomock.Any()).Return(nil, awserr.New(cloudformation.ErrCodeStackSetNotFoundException, "", nil))
+				return m
+			},
+			wantedError: fmt.Errorf("list stack instances for stack set %s: %w", testName, &ErrStackSetNotFound{name: testName}),
+		},
 		"wraps error on unexpected failure": {
 			mockClient: func(ctrl *gomock.Controller) api {
 				m := mocks.NewMockapi(ctrl)
@@ -29,6 +29,7 @@ var humanizeDuration = humanize.RelTime
 
 type api interface {
 	DescribeAlarms(input *cloudwatch.DescribeAlarmsInput) (*cloudwatch.DescribeAlarmsOutput, error)
+	DescribeAlarmHistory(input *cloudwatch.DescribeAlarmHistoryInput) (*cloudwatch.DescribeAlarmHistoryOutput, error)
 }
 
 type resourceGetter interface {
@@ -51,6 +52,12 @@ type AlarmStatus struct {
 	UpdatedTimes time.Time `json:"updatedTimes"`
 }
 
+// AlarmHistoryItem contains a state transition of a CloudWatch alarm.
+type AlarmHistoryItem struct {
+	Summary   string    `json:"summary"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
 // New returns a CloudWatch struct configured against the input session.
 func New(s *session.Session) *CloudWatch {
 	return &CloudWatch{
@@ -101,6 +108,30 @@ func (cw *CloudWatch) AlarmStatus(alarms []string) ([]AlarmStatus, error) {
 	return alarmStatus, nil
 }
 
+// AlarmHistory returns up to limit of the most recent state transitions of an alarm, newest first.
+func (cw *CloudWatch) AlarmHistory(alarm string, limit int) ([]AlarmHistoryItem, error) {
+	resp, err := cw.client.DescribeAlarmHistory(&cloudwatch.DescribeAlarmHistoryInput{
+		AlarmName:       aws.String(alarm),
+		HistoryItemType: aws.String(cloudwatch.HistoryItemTypeStateUpdate),
+		MaxRecords:      aws.Int64(int64(limit)),
+		ScanBy:          aws.String(cloudwatch.ScanByTimestampDescending),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe history of CloudWatch alarm %s: %w", alarm, err)
+	}
+	var history []AlarmHistoryItem
+	for _, item := range resp.AlarmHistoryItems {
+		if item == nil {
+			continue
+		}
+		history = append(history, AlarmHistoryItem{
+			Summary:   aws.StringValue(item.HistorySummary),
+			Timestamp: aws.TimeValue(item.Timestamp),
+		})
+	}
+	return history, nil
+}
+
 func (cw *CloudWatch) compositeAlarmsStatus(alarms []*cloudwatch.CompositeAlarm) []AlarmStatus {
 	var alarmStatusList []AlarmStatus
 	for _, alarm := range alarms {
@@ -353,3 +353,81 @@ func TestCloudWatch_AlarmsWithTags(t *testing.T) {
 
 	}
 }
+
+func TestCloudWatch_AlarmHistory(t *testing.T) {
+	mockTime1, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05+00:00")
+	mockTime2, _ := time.Parse(time.RFC3339, "2006-01-02T14:04:05+00:00")
+	testCases := map[string]struct {
+		setupMocks func(m cloudWatchMocks)
+
+		wantErr     error
+		wantHistory []AlarmHistoryItem
+	}{
+		"errors if failed to describe alarm history": {
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().DescribeAlarmHistory(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantErr: fmt.Errorf("describe history of CloudWatch alarm mockAlarmName: some error"),
+		},
+		"success": {
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().DescribeAlarmHistory(&cloudwatch.DescribeAlarmHistoryInput{
+					AlarmName:       aws.String("mockAlarmName"),
+					HistoryItemType: aws.String("StateUpdate"),
+					MaxRecords:      aws.Int64(5),
+					ScanBy:          aws.String("TimestampDescending"),
+				}).Return(&cloudwatch.DescribeAlarmHistoryOutput{
+					AlarmHistoryItems: []*cloudwatch.AlarmHistoryItem{
+						{
+							HistorySummary: aws.String("Alarm updated from OK to ALARM"),
+							Timestamp:      &mockTime1,
+						},
+						nil,
+						{
+							HistorySummary: aws.String("Alarm updated from ALARM to OK"),
+							Timestamp:      &mockTime2,
+						},
+					},
+				}, nil)
+			},
+
+			wantHistory: []AlarmHistoryItem{
+				{
+					Summary:   "Alarm updated from OK to ALARM",
+					Timestamp: mockTime1,
+				},
+				{
+					Summary:   "Alarm updated from ALARM to OK",
+					Timestamp: mockTime2,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcwClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(cloudWatchMocks{
+				cw: mockcwClient,
+			})
+
+			cwSvc := CloudWatch{
+				client: mockcwClient,
+			}
+
+			gotHistory, gotErr := cwSvc.AlarmHistory("mockAlarmName", 5)
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantHistory, gotHistory)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 )
@@ -68,10 +69,13 @@ func (c *CloudWatchLogs) logStreams(logGroup string, logStreams ...string) ([]st
 		OrderBy:      aws.String(cloudwatchlogs.OrderByLastEventTime),
 	})
 	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == cloudwatchlogs.ErrCodeResourceNotFoundException {
+			return nil, &ErrLogGroupNotFound{logGroup: logGroup}
+		}
 		return nil, fmt.Errorf("describe log streams of log group %s: %w", logGroup, err)
 	}
 	if len(resp.LogStreams) == 0 {
-		return nil, fmt.Errorf("no log stream found in log group %s", logGroup)
+		return nil, &ErrLogStreamNotFound{logGroup: logGroup}
 	}
 	var logStreamNames []string
 	for _, logStream := range resp.LogStreams {
@@ -102,6 +106,7 @@ func (c *CloudWatchLogs) LogEvents(opts LogEventsOpts) (*LogEventsOutput, error)
 	for _, logStream := range logStreams {
 		// Set override value
 		in.SetLogStreamName(logStream)
+		in.StartTime = opts.StartTime
 		if streamLastEventTime[logStream] != 0 {
 			// If last event for this log stream exists, increment last log event timestamp
 			// by one to get logs after the last event.
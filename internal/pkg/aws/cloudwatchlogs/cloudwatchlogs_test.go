@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs/mocks"
 	"github.com/golang/mock/gomock"
@@ -199,6 +200,19 @@ func TestLogEvents(t *testing.T) {
 			wantLogEvents: nil,
 			wantErr:       fmt.Errorf("describe log streams of log group %s: %w", "mockLogGroup", mockError),
 		},
+		"returns ErrLogGroupNotFound if the log group does not exist": {
+			logGroupName: "mockLogGroup",
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogStreams(&cloudwatchlogs.DescribeLogStreamsInput{
+					LogGroupName: aws.String("mockLogGroup"),
+					Descending:   aws.Bool(true),
+					OrderBy:      aws.String("LastEventTime"),
+				}).Return(nil, awserr.New(cloudwatchlogs.ErrCodeResourceNotFoundException, "The specified log group does not exist.", nil))
+			},
+
+			wantLogEvents: nil,
+			wantErr:       &ErrLogGroupNotFound{logGroup: "mockLogGroup"},
+		},
 		"returns error if no log stream found": {
 			logGroupName: "mockLogGroup",
 			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
@@ -212,7 +226,71 @@ func TestLogEvents(t *testing.T) {
 			},
 
 			wantLogEvents: nil,
-			wantErr:       fmt.Errorf("no log stream found in log group %s", "mockLogGroup"),
+			wantErr:       &ErrLogStreamNotFound{logGroup: "mockLogGroup"},
+		},
+		"resumes each log stream after its own last event": {
+			logGroupName: "mockLogGroup",
+			startTime:    aws.Int64(1),
+			lastEventTime: map[string]int64{
+				"copilot/mockLogGroup/logStream1": 5,
+			},
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogStreams(&cloudwatchlogs.DescribeLogStreamsInput{
+					LogGroupName: aws.String("mockLogGroup"),
+					Descending:   aws.Bool(true),
+					OrderBy:      aws.String("LastEventTime"),
+				}).Return(&cloudwatchlogs.DescribeLogStreamsOutput{
+					LogStreams: []*cloudwatchlogs.LogStream{
+						{
+							LogStreamName: aws.String("copilot/mockLogGroup/logStream1"),
+						},
+						{
+							LogStreamName: aws.String("copilot/mockLogGroup/logStream2"),
+						},
+					},
+				}, nil)
+				m.EXPECT().GetLogEvents(&cloudwatchlogs.GetLogEventsInput{
+					LogGroupName:  aws.String("mockLogGroup"),
+					LogStreamName: aws.String("copilot/mockLogGroup/logStream1"),
+					StartTime:     aws.Int64(6),
+				}).Return(&cloudwatchlogs.GetLogEventsOutput{
+					Events: []*cloudwatchlogs.OutputLogEvent{
+						{
+							Message:   aws.String("new"),
+							Timestamp: aws.Int64(7),
+						},
+					},
+				}, nil)
+				m.EXPECT().GetLogEvents(&cloudwatchlogs.GetLogEventsInput{
+					LogGroupName:  aws.String("mockLogGroup"),
+					LogStreamName: aws.String("copilot/mockLogGroup/logStream2"),
+					StartTime:     aws.Int64(1),
+				}).Return(&cloudwatchlogs.GetLogEventsOutput{
+					Events: []*cloudwatchlogs.OutputLogEvent{
+						{
+							Message:   aws.String("ingested late"),
+							Timestamp: aws.Int64(3),
+						},
+					},
+				}, nil)
+			},
+
+			wantLogEvents: []*Event{
+				{
+					LogStreamName: "copilot/mockLogGroup/logStream1",
+					Message:       "new",
+					Timestamp:     7,
+				},
+				{
+					LogStreamName: "copilot/mockLogGroup/logStream2",
+					Message:       "ingested late",
+					Timestamp:     3,
+				},
+			},
+			wantLastEventTime: map[string]int64{
+				"copilot/mockLogGroup/logStream1": 7,
+				"copilot/mockLogGroup/logStream2": 3,
+			},
 		},
 		"returns error if fail to get log events": {
 			logGroupName: "mockLogGroup",
@@ -0,0 +1,24 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatchlogs
+
+import "fmt"
+
+// ErrLogGroupNotFound occurs when a CloudWatch Logs log group does not exist.
+type ErrLogGroupNotFound struct {
+	logGroup string
+}
+
+func (e *ErrLogGroupNotFound) Error() string {
+	return fmt.Sprintf("log group %s does not exist", e.logGroup)
+}
+
+// ErrLogStreamNotFound occurs when a CloudWatch Logs log group doesn't have any log stream yet.
+type ErrLogStreamNotFound struct {
+	logGroup string
+}
+
+func (e *ErrLogStreamNotFound) Error() string {
+	return fmt.Sprintf("no log stream found in log group %s", e.logGroup)
+}
@@ -6,6 +6,7 @@ package ec2
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,10 @@ const (
 
 	// TagFilterName is the filter name format for tag filters
 	TagFilterName = "tag:%s"
+
+	allProtocols = "-1"
+	tcpProtocol  = "tcp"
+	anyIPv4CIDR  = "0.0.0.0/0"
 )
 
 var (
@@ -36,6 +41,7 @@ type api interface {
 	DescribeVpcAttribute(input *ec2.DescribeVpcAttributeInput) (*ec2.DescribeVpcAttributeOutput, error)
 	DescribeNetworkInterfaces(input *ec2.DescribeNetworkInterfacesInput) (*ec2.DescribeNetworkInterfacesOutput, error)
 	DescribeRouteTables(input *ec2.DescribeRouteTablesInput) (*ec2.DescribeRouteTablesOutput, error)
+	DescribeAvailabilityZones(input *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error)
 }
 
 // Filter contains the name and values of a filter.
@@ -73,6 +79,7 @@ type VPC struct {
 // Subnet contains the ID and name of a subnet.
 type Subnet struct {
 	Resource
+	AvailabilityZone string
 }
 
 // String formats the elements of a VPC into a display-ready string.
@@ -148,8 +155,17 @@ func (c *EC2) PublicIP(eni string) (string, error) {
 
 // ListVPCs returns names and IDs (or just IDs, if Name tag does not exist) of all VPCs.
 func (c *EC2) ListVPCs() ([]VPC, error) {
+	return c.ListVPCsWithFilters()
+}
+
+// ListVPCsWithFilters returns names and IDs (or just IDs, if Name tag does not exist) of the VPCs that match all the filters.
+// For example, the filter Filter{Name: fmt.Sprintf(TagFilterName, "Name"), Values: []string{"my-vpc"}} matches the VPCs named "my-vpc".
+func (c *EC2) ListVPCsWithFilters(filters ...Filter) ([]VPC, error) {
 	var ec2vpcs []*ec2.Vpc
-	response, err := c.client.DescribeVpcs(&ec2.DescribeVpcsInput{})
+	inputFilters := toEC2Filter(filters)
+	response, err := c.client.DescribeVpcs(&ec2.DescribeVpcsInput{
+		Filters: inputFilters,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("describe VPCs: %w", err)
 	}
@@ -157,6 +173,7 @@ func (c *EC2) ListVPCs() ([]VPC, error) {
 
 	for response.NextToken != nil {
 		response, err = c.client.DescribeVpcs(&ec2.DescribeVpcsInput{
+			Filters:   inputFilters,
 			NextToken: response.NextToken,
 		})
 		if err != nil {
@@ -194,6 +211,35 @@ func (c *EC2) HasDNSSupport(vpcID string) (bool, error) {
 	return aws.BoolValue(resp.EnableDnsSupport.Value), nil
 }
 
+// ListAvailabilityZones returns the names of the availability zones in the region that are available
+// and enabled by default, excluding Local Zones and Wavelength Zones.
+func (c *EC2) ListAvailabilityZones() ([]string, error) {
+	resp, err := c.client.DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{
+		Filters: toEC2Filter([]Filter{
+			{
+				Name:   "state",
+				Values: []string{ec2.AvailabilityZoneStateAvailable},
+			},
+			{
+				Name:   "zone-type",
+				Values: []string{"availability-zone"},
+			},
+			{
+				Name:   "opt-in-status",
+				Values: []string{ec2.AvailabilityZoneOptInStatusOptInNotRequired},
+			},
+		}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe availability zones: %w", err)
+	}
+	var zones []string
+	for _, zone := range resp.AvailabilityZones {
+		zones = append(zones, aws.StringValue(zone.ZoneName))
+	}
+	return zones, nil
+}
+
 // VPCSubnets are all subnets within a VPC.
 type VPCSubnets struct {
 	Public  []Subnet
@@ -244,6 +290,7 @@ func (c *EC2) ListVPCSubnets(vpcID string) (*VPCSubnets, error) {
 				ID:   aws.StringValue(subnet.SubnetId),
 				Name: name,
 			},
+			AvailabilityZone: aws.StringValue(subnet.AvailabilityZone),
 		}
 		if _, ok := publicSubnetMap[s.ID]; ok {
 			publicSubnets = append(publicSubnets, s)
@@ -290,6 +337,114 @@ func (c *EC2) SecurityGroups(filters ...Filter) ([]string, error) {
 	return securityGroups, nil
 }
 
+// SecurityGroup holds the inbound and outbound rules of a security group.
+type SecurityGroup struct {
+	ID       string
+	Name     string
+	Inbound  []SecurityGroupRule
+	Outbound []SecurityGroupRule
+}
+
+// SecurityGroupRule represents a permission of a security group.
+type SecurityGroupRule struct {
+	Protocol string   // "-1" if the rule applies to all protocols.
+	FromPort *int64   // Nil if the rule applies to all ports.
+	ToPort   *int64   // Nil if the rule applies to all ports.
+	Peers    []string // CIDR blocks, prefix list IDs, and security group IDs that the rule applies to.
+}
+
+// AllowsTCP returns true if the rule allows TCP traffic on the port to or from the peer.
+// A peer is either a security group ID or a CIDR block, and the IPv4 "0.0.0.0/0" CIDR block matches any peer.
+func (r SecurityGroupRule) AllowsTCP(port int64, peer string) bool {
+	switch r.Protocol {
+	case allProtocols:
+	case tcpProtocol:
+		if r.FromPort != nil && port < aws.Int64Value(r.FromPort) {
+			return false
+		}
+		if r.ToPort != nil && port > aws.Int64Value(r.ToPort) {
+			return false
+		}
+	default:
+		return false
+	}
+	for _, p := range r.Peers {
+		if p == peer || p == anyIPv4CIDR {
+			return true
+		}
+	}
+	return false
+}
+
+// PortRange returns a human readable range of the ports that the rule applies to.
+func (r SecurityGroupRule) PortRange() string {
+	if r.Protocol == allProtocols || r.FromPort == nil || r.ToPort == nil {
+		return "all"
+	}
+	from, to := aws.Int64Value(r.FromPort), aws.Int64Value(r.ToPort)
+	if from == -1 {
+		return "all"
+	}
+	if from == to {
+		return strconv.FormatInt(from, 10)
+	}
+	return fmt.Sprintf("%d-%d", from, to)
+}
+
+// ProtocolName returns a human readable protocol that the rule applies to.
+func (r SecurityGroupRule) ProtocolName() string {
+	if r.Protocol == allProtocols {
+		return "all"
+	}
+	return r.Protocol
+}
+
+// SecurityGroupRules returns the inbound and outbound rules of the security groups.
+func (c *EC2) SecurityGroupRules(groupIDs ...string) ([]*SecurityGroup, error) {
+	response, err := c.client.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+		GroupIds: aws.StringSlice(groupIDs),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe security groups %s: %w", strings.Join(groupIDs, ", "), err)
+	}
+	var groups []*SecurityGroup
+	for _, sg := range response.SecurityGroups {
+		groups = append(groups, &SecurityGroup{
+			ID:       aws.StringValue(sg.GroupId),
+			Name:     aws.StringValue(sg.GroupName),
+			Inbound:  toSecurityGroupRules(sg.IpPermissions),
+			Outbound: toSecurityGroupRules(sg.IpPermissionsEgress),
+		})
+	}
+	return groups, nil
+}
+
+func toSecurityGroupRules(permissions []*ec2.IpPermission) []SecurityGroupRule {
+	var rules []SecurityGroupRule
+	for _, permission := range permissions {
+		var peers []string
+		for _, ipRange := range permission.IpRanges {
+			peers = append(peers, aws.StringValue(ipRange.CidrIp))
+		}
+		for _, ipRange := range permission.Ipv6Ranges {
+			peers = append(peers, aws.StringValue(ipRange.CidrIpv6))
+		}
+		for _, prefixList := range permission.PrefixListIds {
+			peers = append(peers, aws.StringValue(prefixList.PrefixListId))
+		}
+		for _, pair := range permission.UserIdGroupPairs {
+			peers = append(peers, aws.StringValue(pair.GroupId))
+		}
+		rules = append(rules, SecurityGroupRule{
+			Protocol: aws.StringValue(permission.IpProtocol),
+			FromPort: permission.FromPort,
+			ToPort:   permission.ToPort,
+			Peers:    peers,
+		})
+	}
+	return rules
+}
+
 func (c *EC2) subnets(filters ...Filter) ([]*ec2.Subnet, error) {
 	inputFilters := toEC2Filter(filters)
 	var subnets []*ec2.Subnet
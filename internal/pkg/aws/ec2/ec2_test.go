@@ -158,6 +158,106 @@ func TestEC2_ListVPC(t *testing.T) {
 	}
 }
 
+func TestEC2_ListVPCsWithFilters(t *testing.T) {
+	mockFilter := Filter{
+		Name:   fmt.Sprintf(TagFilterName, deploy.EnvTagKey),
+		Values: []string{"test"},
+	}
+	mockEC2Filters := []*ec2.Filter{
+		{
+			Name:   aws.String("tag:copilot-environment"),
+			Values: aws.StringSlice([]string{"test"}),
+		},
+	}
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedVPC   []VPC
+	}{
+		"fail to describe the next page of vpcs": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcs(&ec2.DescribeVpcsInput{
+					Filters: mockEC2Filters,
+				}).Return(&ec2.DescribeVpcsOutput{
+					Vpcs: []*ec2.Vpc{
+						{
+							VpcId: aws.String("mockVPCID1"),
+						},
+					},
+					NextToken: aws.String("mockNextToken"),
+				}, nil)
+				m.EXPECT().DescribeVpcs(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe VPCs: some error"),
+		},
+		"success with filters applied to every page": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcs(&ec2.DescribeVpcsInput{
+					Filters: mockEC2Filters,
+				}).Return(&ec2.DescribeVpcsOutput{
+					Vpcs: []*ec2.Vpc{
+						{
+							VpcId: aws.String("mockVPCID1"),
+						},
+					},
+					NextToken: aws.String("mockNextToken"),
+				}, nil)
+				m.EXPECT().DescribeVpcs(&ec2.DescribeVpcsInput{
+					Filters:   mockEC2Filters,
+					NextToken: aws.String("mockNextToken"),
+				}).Return(&ec2.DescribeVpcsOutput{
+					Vpcs: []*ec2.Vpc{
+						{
+							VpcId: aws.String("mockVPCID2"),
+							Tags: []*ec2.Tag{
+								{
+									Key:   aws.String("Name"),
+									Value: aws.String("mockVPC2Name"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedVPC: []VPC{
+				{
+					Resource: Resource{
+						ID: "mockVPCID1",
+					},
+				},
+				{
+					Resource: Resource{
+						ID:   "mockVPCID2",
+						Name: "mockVPC2Name",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			vpcs, err := ec2Client.ListVPCsWithFilters(mockFilter)
+			if tc.wantedError != nil {
+				require.EqualError(t, tc.wantedError, err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedVPC, vpcs)
+			}
+		})
+	}
+}
+
 func TestEC2_ListVPCSubnets(t *testing.T) {
 	const (
 		mockVPCID     = "mockVPC"
@@ -241,13 +341,16 @@ func TestEC2_ListVPCSubnets(t *testing.T) {
 				}).Return(&ec2.DescribeSubnetsOutput{
 					Subnets: []*ec2.Subnet{
 						{
-							SubnetId: aws.String("subnet1"),
+							SubnetId:         aws.String("subnet1"),
+							AvailabilityZone: aws.String("us-west-2a"),
 						},
 						{
-							SubnetId: aws.String("subnet2"),
+							SubnetId:         aws.String("subnet2"),
+							AvailabilityZone: aws.String("us-west-2a"),
 						},
 						{
-							SubnetId: aws.String("subnet3"),
+							SubnetId:         aws.String("subnet3"),
+							AvailabilityZone: aws.String("us-west-2b"),
 							Tags: []*ec2.Tag{
 								{
 									Key:   aws.String("Name"),
@@ -263,19 +366,23 @@ func TestEC2_ListVPCSubnets(t *testing.T) {
 					Resource: Resource{
 						ID: "subnet2",
 					},
+					AvailabilityZone: "us-west-2a",
 				},
 				{
 					Resource: Resource{
 						ID:   "subnet3",
 						Name: "mySubnet",
 					},
+					AvailabilityZone: "us-west-2b",
 				},
 			},
 			wantedPrivateSubnets: []Subnet{
 				{
 					Resource: Resource{
 						ID: "subnet1",
-					}},
+					},
+					AvailabilityZone: "us-west-2a",
+				},
 			},
 		},
 	}
@@ -512,6 +619,164 @@ func TestEC2_SecurityGroups(t *testing.T) {
 	}
 }
 
+func TestEC2_SecurityGroupRules(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError  error
+		wantedGroups []*SecurityGroup
+	}{
+		"fail to describe security groups": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecurityGroups(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe security groups sg-1, sg-2: some error"),
+		},
+		"success": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+					GroupIds: aws.StringSlice([]string{"sg-1", "sg-2"}),
+				}).Return(&ec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []*ec2.SecurityGroup{
+						{
+							GroupId:   aws.String("sg-1"),
+							GroupName: aws.String("env-sg"),
+							IpPermissions: []*ec2.IpPermission{
+								{
+									IpProtocol: aws.String("-1"),
+									UserIdGroupPairs: []*ec2.UserIdGroupPair{
+										{GroupId: aws.String("sg-2")},
+									},
+								},
+								{
+									IpProtocol: aws.String("tcp"),
+									FromPort:   aws.Int64(80),
+									ToPort:     aws.Int64(80),
+									IpRanges: []*ec2.IpRange{
+										{CidrIp: aws.String("10.0.0.0/16")},
+									},
+									Ipv6Ranges: []*ec2.Ipv6Range{
+										{CidrIpv6: aws.String("::/0")},
+									},
+									PrefixListIds: []*ec2.PrefixListId{
+										{PrefixListId: aws.String("pl-1")},
+									},
+								},
+							},
+							IpPermissionsEgress: []*ec2.IpPermission{
+								{
+									IpProtocol: aws.String("-1"),
+									IpRanges: []*ec2.IpRange{
+										{CidrIp: aws.String("0.0.0.0/0")},
+									},
+								},
+							},
+						},
+						{
+							GroupId:   aws.String("sg-2"),
+							GroupName: aws.String("lb-sg"),
+						},
+					},
+				}, nil)
+			},
+			wantedGroups: []*SecurityGroup{
+				{
+					ID:   "sg-1",
+					Name: "env-sg",
+					Inbound: []SecurityGroupRule{
+						{
+							Protocol: "-1",
+							Peers:    []string{"sg-2"},
+						},
+						{
+							Protocol: "tcp",
+							FromPort: aws.Int64(80),
+							ToPort:   aws.Int64(80),
+							Peers:    []string{"10.0.0.0/16", "::/0", "pl-1"},
+						},
+					},
+					Outbound: []SecurityGroupRule{
+						{
+							Protocol: "-1",
+							Peers:    []string{"0.0.0.0/0"},
+						},
+					},
+				},
+				{
+					ID:   "sg-2",
+					Name: "lb-sg",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			groups, err := ec2Client.SecurityGroupRules("sg-1", "sg-2")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedGroups, groups)
+			}
+		})
+	}
+}
+
+func TestSecurityGroupRule_AllowsTCP(t *testing.T) {
+	testCases := map[string]struct {
+		rule   SecurityGroupRule
+		port   int64
+		peer   string
+		wanted bool
+	}{
+		"all traffic from the peer": {
+			rule:   SecurityGroupRule{Protocol: "-1", Peers: []string{"sg-1"}},
+			port:   8080,
+			peer:   "sg-1",
+			wanted: true,
+		},
+		"all traffic from another peer": {
+			rule:   SecurityGroupRule{Protocol: "-1", Peers: []string{"sg-2"}},
+			port:   8080,
+			peer:   "sg-1",
+			wanted: false,
+		},
+		"port in range from anywhere": {
+			rule:   SecurityGroupRule{Protocol: "tcp", FromPort: aws.Int64(8000), ToPort: aws.Int64(9000), Peers: []string{"0.0.0.0/0"}},
+			port:   8080,
+			peer:   "sg-1",
+			wanted: true,
+		},
+		"port out of range": {
+			rule:   SecurityGroupRule{Protocol: "tcp", FromPort: aws.Int64(80), ToPort: aws.Int64(80), Peers: []string{"sg-1"}},
+			port:   8080,
+			peer:   "sg-1",
+			wanted: false,
+		},
+		"udp rule": {
+			rule:   SecurityGroupRule{Protocol: "udp", FromPort: aws.Int64(8080), ToPort: aws.Int64(8080), Peers: []string{"sg-1"}},
+			port:   8080,
+			peer:   "sg-1",
+			wanted: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.rule.AllowsTCP(tc.port, tc.peer))
+		})
+	}
+}
+
 func TestEC2_HasDNSSupport(t *testing.T) {
 	testCases := map[string]struct {
 		vpcID string
@@ -564,3 +829,69 @@ func TestEC2_HasDNSSupport(t *testing.T) {
 		})
 	}
 }
+
+func TestEC2_ListAvailabilityZones(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedZones []string
+	}{
+		"fail to describe availability zones": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAvailabilityZones(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe availability zones: some error"),
+		},
+		"success": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("state"),
+							Values: aws.StringSlice([]string{"available"}),
+						},
+						{
+							Name:   aws.String("zone-type"),
+							Values: aws.StringSlice([]string{"availability-zone"}),
+						},
+						{
+							Name:   aws.String("opt-in-status"),
+							Values: aws.StringSlice([]string{"opt-in-not-required"}),
+						},
+					},
+				}).Return(&ec2.DescribeAvailabilityZonesOutput{
+					AvailabilityZones: []*ec2.AvailabilityZone{
+						{
+							ZoneName: aws.String("us-west-2a"),
+						},
+						{
+							ZoneName: aws.String("us-west-2b"),
+						},
+					},
+				}, nil)
+			},
+			wantedZones: []string{"us-west-2a", "us-west-2b"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			zones, err := ec2Client.ListAvailabilityZones()
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedZones, zones)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ package ecs
 import (
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
@@ -32,7 +33,7 @@ type api interface {
 }
 
 type ssmSessionStarter interface {
-	StartSession(ssmSession *ecs.Session) error
+	StartSession(ssmSession *ecs.Session, opts ...exec.CmdOption) error
 }
 
 // ECS wraps an AWS ECS client.
@@ -57,6 +58,7 @@ type ExecuteCommandInput struct {
 	Command   string
 	Task      string
 	Container string
+	Stdout    io.Writer // Optional writer of the output of the command, defaults to standard output.
 }
 
 // New returns a Service configured against the input session.
@@ -234,6 +236,38 @@ func (e *ECS) DefaultCluster() (string, error) {
 	return aws.StringValue(cluster.ClusterArn), nil
 }
 
+// ActiveCluster returns true if the cluster exists and is active.
+func (e *ECS) ActiveCluster(cluster string) (bool, error) {
+	resp, err := e.client.DescribeClusters(&ecs.DescribeClustersInput{
+		Clusters: aws.StringSlice([]string{cluster}),
+	})
+	if err != nil {
+		return false, fmt.Errorf("describe cluster %s: %w", cluster, err)
+	}
+	for _, c := range resp.Clusters {
+		if aws.StringValue(c.Status) == clusterStatusActive {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ClusterCapacityProviders returns the names of the capacity providers associated with an active cluster, such as "FARGATE_SPOT".
+func (e *ECS) ClusterCapacityProviders(cluster string) ([]string, error) {
+	resp, err := e.client.DescribeClusters(&ecs.DescribeClustersInput{
+		Clusters: aws.StringSlice([]string{cluster}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe cluster %s: %w", cluster, err)
+	}
+	for _, c := range resp.Clusters {
+		if aws.StringValue(c.Status) == clusterStatusActive {
+			return aws.StringValueSlice(c.CapacityProviders), nil
+		}
+	}
+	return nil, fmt.Errorf("cluster %s does not exist or is not active", cluster)
+}
+
 // HasDefaultCluster tries to find the default cluster and returns true if there is one.
 func (e *ECS) HasDefaultCluster() (bool, error) {
 	if _, err := e.DefaultCluster(); err != nil {
@@ -328,7 +362,11 @@ func (e *ECS) ExecuteCommand(in ExecuteCommandInput) (err error) {
 		return &ErrExecuteCommand{err: err}
 	}
 	sessID := aws.StringValue(execCmdresp.Session.SessionId)
-	if err = e.newSessStarter().StartSession(execCmdresp.Session); err != nil {
+	var opts []exec.CmdOption
+	if in.Stdout != nil {
+		opts = append(opts, exec.Stdout(in.Stdout))
+	}
+	if err = e.newSessStarter().StartSession(execCmdresp.Session, opts...); err != nil {
 		err = fmt.Errorf("start session %s using ssm plugin: %w", sessID, err)
 	}
 	return err
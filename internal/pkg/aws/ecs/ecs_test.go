@@ -4,8 +4,10 @@
 package ecs
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -638,6 +640,164 @@ func TestECS_DefaultCluster(t *testing.T) {
 	}
 }
 
+func TestECS_ActiveCluster(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantedActive bool
+		wantedError  error
+	}{
+		"active cluster": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().
+					DescribeClusters(&ecs.DescribeClustersInput{
+						Clusters: aws.StringSlice([]string{"arn:aws:ecs:us-east-1:0123456:cluster/cluster1"}),
+					}).
+					Return(&ecs.DescribeClustersOutput{
+						Clusters: []*ecs.Cluster{
+							{
+								ClusterArn: aws.String("arn:aws:ecs:us-east-1:0123456:cluster/cluster1"),
+								Status:     aws.String(clusterStatusActive),
+							},
+						},
+					}, nil)
+			},
+			wantedActive: true,
+		},
+		"inactive cluster": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().
+					DescribeClusters(gomock.Any()).
+					Return(&ecs.DescribeClustersOutput{
+						Clusters: []*ecs.Cluster{
+							{
+								ClusterArn: aws.String("arn:aws:ecs:us-east-1:0123456:cluster/cluster1"),
+								Status:     aws.String("INACTIVE"),
+							},
+						},
+					}, nil)
+			},
+			wantedActive: false,
+		},
+		"cluster not found": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().
+					DescribeClusters(gomock.Any()).
+					Return(&ecs.DescribeClustersOutput{
+						Failures: []*ecs.Failure{
+							{
+								Arn:    aws.String("arn:aws:ecs:us-east-1:0123456:cluster/cluster1"),
+								Reason: aws.String("MISSING"),
+							},
+						},
+					}, nil)
+			},
+			wantedActive: false,
+		},
+		"failed to describe cluster": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().
+					DescribeClusters(gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe cluster arn:aws:ecs:us-east-1:0123456:cluster/cluster1: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			ecs := ECS{
+				client: mockECSClient,
+			}
+			active, err := ecs.ActiveCluster("arn:aws:ecs:us-east-1:0123456:cluster/cluster1")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedActive, active)
+			}
+		})
+	}
+}
+
+func TestECS_ClusterCapacityProviders(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantedProviders []string
+		wantedError     error
+	}{
+		"returns the capacity providers of the cluster": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().
+					DescribeClusters(&ecs.DescribeClustersInput{
+						Clusters: aws.StringSlice([]string{"arn:aws:ecs:us-east-1:0123456:cluster/cluster1"}),
+					}).
+					Return(&ecs.DescribeClustersOutput{
+						Clusters: []*ecs.Cluster{
+							{
+								ClusterArn:        aws.String("arn:aws:ecs:us-east-1:0123456:cluster/cluster1"),
+								Status:            aws.String(clusterStatusActive),
+								CapacityProviders: aws.StringSlice([]string{"FARGATE", "FARGATE_SPOT"}),
+							},
+						},
+					}, nil)
+			},
+			wantedProviders: []string{"FARGATE", "FARGATE_SPOT"},
+		},
+		"cluster not found": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().
+					DescribeClusters(gomock.Any()).
+					Return(&ecs.DescribeClustersOutput{
+						Failures: []*ecs.Failure{
+							{
+								Arn:    aws.String("arn:aws:ecs:us-east-1:0123456:cluster/cluster1"),
+								Reason: aws.String("MISSING"),
+							},
+						},
+					}, nil)
+			},
+			wantedError: fmt.Errorf("cluster arn:aws:ecs:us-east-1:0123456:cluster/cluster1 does not exist or is not active"),
+		},
+		"failed to describe cluster": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().
+					DescribeClusters(gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe cluster arn:aws:ecs:us-east-1:0123456:cluster/cluster1: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			ecs := ECS{
+				client: mockECSClient,
+			}
+			providers, err := ecs.ClusterCapacityProviders("arn:aws:ecs:us-east-1:0123456:cluster/cluster1")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedProviders, providers)
+			}
+		})
+	}
+}
+
 func TestECS_HasDefaultCluster(t *testing.T) {
 	testCases := map[string]struct {
 		mockECSClient func(m *mocks.Mockapi)
@@ -1004,6 +1164,7 @@ func TestECS_ExecuteCommand(t *testing.T) {
 	}
 	mockErr := errors.New("some error")
 	testCases := map[string]struct {
+		inStdout        io.Writer
 		mockAPI         func(m *mocks.Mockapi)
 		mockSessStarter func(m *mocks.MockssmSessionStarter)
 		wantedError     error
@@ -1042,6 +1203,17 @@ func TestECS_ExecuteCommand(t *testing.T) {
 				m.EXPECT().StartSession(mockSess).Return(nil)
 			},
 		},
+		"success with the output of the session written to the writer": {
+			inStdout: &bytes.Buffer{},
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().ExecuteCommand(mockExecCmdIn).Return(&ecs.ExecuteCommandOutput{
+					Session: mockSess,
+				}, nil)
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartSession(mockSess, gomock.Any()).Return(nil)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -1066,6 +1238,7 @@ func TestECS_ExecuteCommand(t *testing.T) {
 				Command:   "mockCommand",
 				Container: "mockContainer",
 				Task:      "mockTask",
+				Stdout:    tc.inStdout,
 			})
 			if tc.wantedError != nil {
 				require.EqualError(t, err, tc.wantedError.Error())
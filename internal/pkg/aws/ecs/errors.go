@@ -64,10 +64,22 @@ func (e *ErrExecuteCommand) Error() string {
 	return fmt.Sprintf("execute command: %s", e.err.Error())
 }
 
+// ErrExitCodeNotFound means a container of a stopped task doesn't have an exit code, for example because it never started.
+type ErrExitCodeNotFound struct {
+	ContainerName string
+	TaskARN       string
+	StoppedReason string
+}
+
+func (e *ErrExitCodeNotFound) Error() string {
+	return fmt.Sprintf("container %s of task %s stopped without an exit code: %s", e.ContainerName, e.TaskARN, e.StoppedReason)
+}
+
 const (
 	missingFieldAttachment         = "attachment"
 	missingFieldDetailENIID        = "detailENIID"
 	missingFieldPrivateIPv4Address = "privateIPv4"
+	missingFieldSubnetID           = "subnetID"
 )
 
 // ErrTaskENIInfoNotFound when some ENI information is not found in a ECS task.
@@ -84,6 +96,8 @@ func (e *ErrTaskENIInfoNotFound) Error() string {
 		return fmt.Sprintf("cannot find network interface ID for task %s", e.TaskARN)
 	case missingFieldPrivateIPv4Address:
 		return fmt.Sprintf("cannot find private IPv4 address for task %s", e.TaskARN)
+	case missingFieldSubnetID:
+		return fmt.Sprintf("cannot find subnet ID for task %s", e.TaskARN)
 	}
 	return ""
 }
@@ -8,6 +8,7 @@ import (
 	reflect "reflect"
 
 	ecs "github.com/aws/aws-sdk-go/service/ecs"
+	exec "github.com/aws/copilot-cli/internal/pkg/exec"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -192,15 +193,20 @@ func (m *MockssmSessionStarter) EXPECT() *MockssmSessionStarterMockRecorder {
 }
 
 // StartSession mocks base method.
-func (m *MockssmSessionStarter) StartSession(ssmSession *ecs.Session) error {
+func (m *MockssmSessionStarter) StartSession(ssmSession *ecs.Session, opts ...exec.CmdOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StartSession", ssmSession)
+	varargs := []interface{}{ssmSession}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartSession", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // StartSession indicates an expected call of StartSession.
-func (mr *MockssmSessionStarterMockRecorder) StartSession(ssmSession interface{}) *gomock.Call {
+func (mr *MockssmSessionStarterMockRecorder) StartSession(ssmSession interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSession", reflect.TypeOf((*MockssmSessionStarter)(nil).StartSession), ssmSession)
+	varargs := append([]interface{}{ssmSession}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSession", reflect.TypeOf((*MockssmSessionStarter)(nil).StartSession), varargs...)
 }
@@ -19,6 +19,11 @@ const (
 	ServiceDeploymentStatusPrimary = "PRIMARY"
 	// ServiceDeploymentStatusActive is the status ACTIVE of an ECS service deployment.
 	ServiceDeploymentStatusActive = "ACTIVE"
+
+	// ServiceDeploymentRolloutStateInProgress is the rollout state IN_PROGRESS of an ECS service deployment.
+	ServiceDeploymentRolloutStateInProgress = "IN_PROGRESS"
+	// ServiceDeploymentRolloutStateFailed is the rollout state FAILED of an ECS service deployment.
+	ServiceDeploymentRolloutStateFailed = "FAILED"
 )
 
 // Service wraps up ECS Service struct.
@@ -33,6 +38,10 @@ type Deployment struct {
 	LaunchType     string    `json:"launchType"`
 	TaskDefinition string    `json:"taskDefinition"`
 	Status         string    `json:"status"`
+
+	// Rollout states are only set for services that use the deployment circuit breaker.
+	RolloutState       string `json:"rolloutState,omitempty"`
+	RolloutStateReason string `json:"rolloutStateReason,omitempty"`
 }
 
 // ServiceStatus contains the status info of a service.
@@ -57,6 +66,9 @@ func (s *Service) ServiceStatus() ServiceStatus {
 			LaunchType:     aws.StringValue(dp.LaunchType),
 			TaskDefinition: aws.StringValue(dp.TaskDefinition),
 			Status:         aws.StringValue(dp.Status),
+
+			RolloutState:       aws.StringValue(dp.RolloutState),
+			RolloutStateReason: aws.StringValue(dp.RolloutStateReason),
 		})
 	}
 
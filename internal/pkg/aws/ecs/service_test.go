@@ -51,6 +51,7 @@ func TestService_ServiceStatus(t *testing.T) {
 					Id:           aws.String("id-4"),
 					DesiredCount: aws.Int64(10),
 					RunningCount: aws.Int64(1),
+					RolloutState: aws.String("IN_PROGRESS"),
 				},
 				{
 					Status: aws.String("INACTIVE"),
@@ -80,6 +81,7 @@ func TestService_ServiceStatus(t *testing.T) {
 					DesiredCount: 10,
 					RunningCount: 1,
 					Status:       "PRIMARY",
+					RolloutState: "IN_PROGRESS",
 				},
 				{
 					Id:     "id-5",
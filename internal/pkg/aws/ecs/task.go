@@ -23,6 +23,7 @@ const (
 	// These field names are not defined as const in sdk.
 	networkInterfaceIDKey          = "networkInterfaceId"
 	privateIPv4AddressKey          = "privateIPv4Address"
+	subnetIDKey                    = "subnetId"
 	networkInterfaceAttachmentType = "ElasticNetworkInterface"
 
 	// TaskContainerHealthStatusUnknown wraps the ECS health status UNKNOWN.
@@ -134,6 +135,41 @@ func (t *Task) PrivateIP() (string, error) {
 	}
 }
 
+// SubnetID returns the ID of the subnet that the task's network interface is in.
+func (t *Task) SubnetID() (string, error) {
+	attachmentENI, err := t.attachmentENI()
+	if err != nil {
+		return "", err
+	}
+	for _, detail := range attachmentENI.Details {
+		if aws.StringValue(detail.Name) == subnetIDKey {
+			return aws.StringValue(detail.Value), nil
+		}
+	}
+	return "", &ErrTaskENIInfoNotFound{
+		MissingField: missingFieldSubnetID,
+		TaskARN:      aws.StringValue(t.TaskArn),
+	}
+}
+
+// ExitCode returns the first non-zero exit code of the containers of the stopped task,
+// or 0 if all the containers exited successfully.
+func (t *Task) ExitCode() (int, error) {
+	for _, container := range t.Containers {
+		if container.ExitCode == nil {
+			return 0, &ErrExitCodeNotFound{
+				ContainerName: aws.StringValue(container.Name),
+				TaskARN:       aws.StringValue(t.TaskArn),
+				StoppedReason: aws.StringValue(t.StoppedReason),
+			}
+		}
+		if code := aws.Int64Value(container.ExitCode); code != 0 {
+			return int(code), nil
+		}
+	}
+	return 0, nil
+}
+
 func (t *Task) attachmentENI() (*ecs.Attachment, error) {
 	// Every Fargate task is provided with an ENI by default (https://docs.aws.amazon.com/AmazonECS/latest/userguide/fargate-task-networking.html).
 	// So an error is warranted if there is no ENI found.
@@ -306,6 +306,147 @@ func TestTask_PrivateIP(t *testing.T) {
 	}
 }
 
+func TestTask_SubnetID(t *testing.T) {
+	testCases := map[string]struct {
+		taskARN      *string
+		attachments  []*ecs.Attachment
+		wantedSubnet string
+		wantedErr    error
+	}{
+		"no matching attachment": {
+			taskARN: aws.String("1"),
+			attachments: []*ecs.Attachment{
+				{
+					Type: aws.String("not ElasticNetworkInterface"),
+				},
+			},
+			wantedErr: &ErrTaskENIInfoNotFound{
+				MissingField: missingFieldAttachment,
+				TaskARN:      "1",
+			},
+		},
+		"no matching detail in network interface attachment": {
+			taskARN: aws.String("1"),
+			attachments: []*ecs.Attachment{
+				{
+					Type: aws.String("ElasticNetworkInterface"),
+					Details: []*ecs.KeyValuePair{
+						{
+							Name:  aws.String("not subnetId"),
+							Value: aws.String("val"),
+						},
+					},
+				},
+			},
+			wantedErr: &ErrTaskENIInfoNotFound{
+				MissingField: missingFieldSubnetID,
+				TaskARN:      "1",
+			},
+		},
+		"successfully retrieve subnet id": {
+			taskARN: aws.String("1"),
+			attachments: []*ecs.Attachment{
+				{
+					Type: aws.String("ElasticNetworkInterface"),
+					Details: []*ecs.KeyValuePair{
+						{
+							Name:  aws.String("networkInterfaceId"),
+							Value: aws.String("eni-123"),
+						},
+						{
+							Name:  aws.String("subnetId"),
+							Value: aws.String("subnet-123"),
+						},
+					},
+				},
+			},
+			wantedSubnet: "subnet-123",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			task := Task{
+				TaskArn:     tc.taskARN,
+				Attachments: tc.attachments,
+			}
+
+			out, err := task.SubnetID()
+			if tc.wantedErr != nil {
+				require.Equal(t, tc.wantedErr, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedSubnet, out)
+			}
+		})
+	}
+}
+
+func TestTask_ExitCode(t *testing.T) {
+	testCases := map[string]struct {
+		containers     []*ecs.Container
+		wantedExitCode int
+		wantedErr      error
+	}{
+		"all containers exited successfully": {
+			containers: []*ecs.Container{
+				{
+					Name:     aws.String("main"),
+					ExitCode: aws.Int64(0),
+				},
+				{
+					Name:     aws.String("sidecar"),
+					ExitCode: aws.Int64(0),
+				},
+			},
+			wantedExitCode: 0,
+		},
+		"returns the first non-zero exit code": {
+			containers: []*ecs.Container{
+				{
+					Name:     aws.String("main"),
+					ExitCode: aws.Int64(0),
+				},
+				{
+					Name:     aws.String("sidecar"),
+					ExitCode: aws.Int64(137),
+				},
+			},
+			wantedExitCode: 137,
+		},
+		"container without an exit code": {
+			containers: []*ecs.Container{
+				{
+					Name: aws.String("main"),
+				},
+			},
+			wantedErr: &ErrExitCodeNotFound{
+				ContainerName: "main",
+				TaskARN:       "1",
+				StoppedReason: "CannotPullContainerError",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			task := Task{
+				TaskArn:       aws.String("1"),
+				StoppedReason: aws.String("CannotPullContainerError"),
+				Containers:    tc.containers,
+			}
+
+			out, err := task.ExitCode()
+			if tc.wantedErr != nil {
+				require.Equal(t, tc.wantedErr, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedExitCode, out)
+			}
+		})
+	}
+}
+
 func Test_TaskID(t *testing.T) {
 	testCases := map[string]struct {
 		taskARN string
@@ -6,6 +6,7 @@ package elbv2
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -17,8 +18,15 @@ const (
 	TargetHealthStateHealthy = elbv2.TargetHealthStateEnumHealthy
 )
 
+const (
+	// describeTagsMaxResourceARNs is the maximum number of resources that can be described in a single DescribeTags call.
+	describeTagsMaxResourceARNs = 20
+)
+
 type api interface {
 	DescribeTargetHealth(input *elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error)
+	DescribeRules(input *elbv2.DescribeRulesInput) (*elbv2.DescribeRulesOutput, error)
+	DescribeTags(input *elbv2.DescribeTagsInput) (*elbv2.DescribeTagsOutput, error)
 }
 
 // ELBV2 wraps an AWS ELBV2 client.
@@ -53,6 +61,99 @@ func (e *ELBV2) TargetsHealth(targetGroupARN string) ([]*TargetHealth, error) {
 	return ret, nil
 }
 
+// ListenerRule contains the routing information of a listener rule.
+type ListenerRule struct {
+	Priority        string
+	Conditions      []string
+	TargetGroupARNs []string
+}
+
+// ListenerRules returns the rules of a listener, including its default rule.
+func (e *ELBV2) ListenerRules(listenerARN string) ([]*ListenerRule, error) {
+	var rules []*ListenerRule
+	var marker *string
+	for {
+		out, err := e.client.DescribeRules(&elbv2.DescribeRulesInput{
+			ListenerArn: aws.String(listenerARN),
+			Marker:      marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe rules for listener %s: %w", listenerARN, err)
+		}
+		for _, rule := range out.Rules {
+			rules = append(rules, &ListenerRule{
+				Priority:        aws.StringValue(rule.Priority),
+				Conditions:      ruleConditions(rule.Conditions),
+				TargetGroupARNs: ruleTargetGroupARNs(rule.Actions),
+			})
+		}
+		marker = out.NextMarker
+		if marker == nil {
+			break
+		}
+	}
+	return rules, nil
+}
+
+// ResourceTags returns the tags of the load balancing resources keyed by resource ARN.
+func (e *ELBV2) ResourceTags(resourceARNs []string) (map[string]map[string]string, error) {
+	tags := make(map[string]map[string]string)
+	for start := 0; start < len(resourceARNs); start += describeTagsMaxResourceARNs {
+		end := start + describeTagsMaxResourceARNs
+		if end > len(resourceARNs) {
+			end = len(resourceARNs)
+		}
+		out, err := e.client.DescribeTags(&elbv2.DescribeTagsInput{
+			ResourceArns: aws.StringSlice(resourceARNs[start:end]),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe tags for resources: %w", err)
+		}
+		for _, desc := range out.TagDescriptions {
+			resourceTags := make(map[string]string)
+			for _, tag := range desc.Tags {
+				resourceTags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+			tags[aws.StringValue(desc.ResourceArn)] = resourceTags
+		}
+	}
+	return tags, nil
+}
+
+func ruleConditions(conditions []*elbv2.RuleCondition) []string {
+	var out []string
+	for _, condition := range conditions {
+		values := condition.Values
+		switch {
+		case condition.PathPatternConfig != nil:
+			values = condition.PathPatternConfig.Values
+		case condition.HostHeaderConfig != nil:
+			values = condition.HostHeaderConfig.Values
+		case condition.SourceIpConfig != nil:
+			values = condition.SourceIpConfig.Values
+		}
+		out = append(out, fmt.Sprintf("%s: %s", aws.StringValue(condition.Field), strings.Join(aws.StringValueSlice(values), ", ")))
+	}
+	return out
+}
+
+func ruleTargetGroupARNs(actions []*elbv2.Action) []string {
+	var arns []string
+	for _, action := range actions {
+		if action.TargetGroupArn != nil {
+			arns = append(arns, aws.StringValue(action.TargetGroupArn))
+			continue
+		}
+		if action.ForwardConfig == nil {
+			continue
+		}
+		for _, tg := range action.ForwardConfig.TargetGroups {
+			arns = append(arns, aws.StringValue(tg.TargetGroupArn))
+		}
+	}
+	return arns
+}
+
 // TargetID returns the target's ID, which is either an instance or an IP address.
 func (t *TargetHealth) TargetID() string {
 	return t.targetID()
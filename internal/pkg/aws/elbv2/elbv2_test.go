@@ -138,6 +138,193 @@ func TestELBV2_TargetsHealth(t *testing.T) {
 	}
 }
 
+func TestELBV2_ListenerRules(t *testing.T) {
+	testCases := map[string]struct {
+		listenerARN string
+
+		setUpMock func(m *mocks.Mockapi)
+
+		wantedOut   []*ListenerRule
+		wantedError error
+	}{
+		"success with pagination": {
+			listenerARN: "listener-1",
+
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String("listener-1"),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							Priority: aws.String("100"),
+							Conditions: []*elbv2.RuleCondition{
+								{
+									Field: aws.String("host-header"),
+									HostHeaderConfig: &elbv2.HostHeaderConditionConfig{
+										Values: aws.StringSlice([]string{"example.com"}),
+									},
+								},
+								{
+									Field: aws.String("path-pattern"),
+									PathPatternConfig: &elbv2.PathPatternConditionConfig{
+										Values: aws.StringSlice([]string{"/api", "/api/*"}),
+									},
+								},
+							},
+							Actions: []*elbv2.Action{
+								{
+									TargetGroupArn: aws.String("group-1"),
+								},
+							},
+						},
+					},
+					NextMarker: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String("listener-1"),
+					Marker:      aws.String("next"),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							Priority: aws.String("default"),
+							Actions: []*elbv2.Action{
+								{
+									ForwardConfig: &elbv2.ForwardActionConfig{
+										TargetGroups: []*elbv2.TargetGroupTuple{
+											{
+												TargetGroupArn: aws.String("group-default"),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+
+			wantedOut: []*ListenerRule{
+				{
+					Priority:        "100",
+					Conditions:      []string{"host-header: example.com", "path-pattern: /api, /api/*"},
+					TargetGroupARNs: []string{"group-1"},
+				},
+				{
+					Priority:        "default",
+					TargetGroupARNs: []string{"group-default"},
+				},
+			},
+		},
+		"failed to describe rules": {
+			listenerARN: "listener-1",
+
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String("listener-1"),
+				}).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe rules for listener listener-1: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			got, err := elbv2Client.ListenerRules(tc.listenerARN)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOut, got)
+			}
+		})
+	}
+}
+
+func TestELBV2_ResourceTags(t *testing.T) {
+	testCases := map[string]struct {
+		resourceARNs []string
+
+		setUpMock func(m *mocks.Mockapi)
+
+		wantedOut   map[string]map[string]string
+		wantedError error
+	}{
+		"success": {
+			resourceARNs: []string{"group-1"},
+
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTags(&elbv2.DescribeTagsInput{
+					ResourceArns: aws.StringSlice([]string{"group-1"}),
+				}).Return(&elbv2.DescribeTagsOutput{
+					TagDescriptions: []*elbv2.TagDescription{
+						{
+							ResourceArn: aws.String("group-1"),
+							Tags: []*elbv2.Tag{
+								{
+									Key:   aws.String("copilot-service"),
+									Value: aws.String("frontend"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+
+			wantedOut: map[string]map[string]string{
+				"group-1": {
+					"copilot-service": "frontend",
+				},
+			},
+		},
+		"failed to describe tags": {
+			resourceARNs: []string{"group-1"},
+
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTags(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe tags for resources: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			got, err := elbv2Client.ResourceTags(tc.resourceARNs)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOut, got)
+			}
+		})
+	}
+}
+
 func TestTargetHealth_HealthStatus(t *testing.T) {
 	testCases := map[string]struct {
 		inTargetHealth *TargetHealth
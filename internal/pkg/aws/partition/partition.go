@@ -0,0 +1,31 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package partition provides functions to find the AWS partition of a region.
+package partition
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// Lookup returns the partition that the region belongs to, to resolve the endpoints of the services in that partition.
+// Regions unknown to the SDK are matched against the region name format of each partition.
+func Lookup(region string) (endpoints.Partition, error) {
+	p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+	if !ok {
+		return endpoints.Partition{}, fmt.Errorf("find the partition for region %s", region)
+	}
+	return p, nil
+}
+
+// FromRegion returns the ID of the partition that the region belongs to, such as "aws", "aws-cn", "aws-us-gov",
+// "aws-iso" or "aws-iso-b", to be used when building ARNs.
+func FromRegion(region string) (string, error) {
+	p, err := Lookup(region)
+	if err != nil {
+		return "", err
+	}
+	return p.ID(), nil
+}
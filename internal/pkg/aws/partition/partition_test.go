@@ -0,0 +1,79 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package partition
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromRegion(t *testing.T) {
+	testCases := map[string]struct {
+		inRegion string
+
+		wantedPartition string
+		wantedErr       error
+	}{
+		"commercial region": {
+			inRegion:        "us-west-2",
+			wantedPartition: "aws",
+		},
+		"commercial region unknown to the SDK": {
+			inRegion:        "ap-southeast-9",
+			wantedPartition: "aws",
+		},
+		"China region": {
+			inRegion:        "cn-northwest-1",
+			wantedPartition: "aws-cn",
+		},
+		"GovCloud region": {
+			inRegion:        "us-gov-east-1",
+			wantedPartition: "aws-us-gov",
+		},
+		"ISO region": {
+			inRegion:        "us-iso-east-1",
+			wantedPartition: "aws-iso",
+		},
+		"ISOB region": {
+			inRegion:        "us-isob-east-1",
+			wantedPartition: "aws-iso-b",
+		},
+		"empty region": {
+			inRegion:  "",
+			wantedErr: errors.New("find the partition for region "),
+		},
+		"unknown region": {
+			inRegion:  "mars-west-1",
+			wantedErr: errors.New("find the partition for region mars-west-1"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := FromRegion(tc.inRegion)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPartition, got)
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	t.Run("returns the partition of the region", func(t *testing.T) {
+		got, err := Lookup("us-gov-west-1")
+
+		require.NoError(t, err)
+		require.Equal(t, "aws-us-gov", got.ID())
+	})
+	t.Run("returns an error if the region doesn't belong to any partition", func(t *testing.T) {
+		_, err := Lookup("mars-west-1")
+
+		require.EqualError(t, err, "find the partition for region mars-west-1")
+	})
+}
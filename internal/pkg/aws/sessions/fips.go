@@ -0,0 +1,82 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessions
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/partition"
+)
+
+// fipsRequiredService is the service that must have a FIPS endpoint in the region of a session.
+// Copilot can't deploy workloads without Amazon ECS, so a region without it doesn't support FIPS.
+const fipsRequiredService = "ecs"
+
+// globalFIPSEndpointIDs are the IDs of the FIPS endpoints of the services that aren't regional, across all partitions.
+var globalFIPSEndpointIDs = map[string][]string{
+	"iam":     {"iam-fips", "iam-govcloud-fips"},
+	"route53": {"fips-aws-global", "fips-aws-us-gov-global"},
+}
+
+// fipsEnabled is true if the sessions should send their requests to FIPS endpoints.
+var fipsEnabled bool
+
+// warnInvalidFIPS warns only once about an invalid COPILOT_USE_FIPS value, even though every session reads it.
+var warnInvalidFIPS sync.Once
+
+// EnableFIPS makes the sessions created from now on send their requests to the FIPS endpoints of the services that have one.
+func EnableFIPS() {
+	fipsEnabled = true
+}
+
+// useFIPS returns true if FIPS endpoints are enabled, or if the COPILOT_USE_FIPS environment variable is set to a true value.
+func useFIPS() bool {
+	return fipsEnabled || envBool(FIPSEnvVar, &warnInvalidFIPS)
+}
+
+// fipsEndpoint resolves the FIPS endpoint of a service in the region, or its regular endpoint if the service doesn't have one.
+func fipsEndpoint(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+	if endpoint, ok := fipsEndpointFor(service, region, opts...); ok {
+		return endpoint, nil
+	}
+	return endpoints.DefaultResolver().EndpointFor(service, region, opts...)
+}
+
+// fipsEndpointFor looks up the FIPS endpoint of a service in the partition of the region.
+// The endpoint model of the SDK lists FIPS endpoints as pseudo regions, such as "fips-us-east-1" or "us-east-1-fips".
+func fipsEndpointFor(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, bool) {
+	p, err := partition.Lookup(region)
+	if err != nil {
+		return endpoints.ResolvedEndpoint{}, false
+	}
+	strictOpts := append([]func(*endpoints.Options){endpoints.StrictMatchingOption}, opts...)
+	ids := append([]string{"fips-" + region, region + "-fips"}, globalFIPSEndpointIDs[service]...)
+	for _, id := range ids {
+		endpoint, err := p.EndpointFor(service, id, strictOpts...)
+		if err == nil {
+			return endpoint, true
+		}
+	}
+	return endpoints.ResolvedEndpoint{}, false
+}
+
+// validateFIPSRegion returns an error if FIPS endpoints are enabled but the region of the session doesn't offer them.
+func validateFIPSRegion(sess *session.Session) error {
+	if !useFIPS() {
+		return nil
+	}
+	region := aws.StringValue(sess.Config.Region)
+	if region == "" || getEnv(EndpointEnvVar) != "" {
+		// The SDK errors on its own without a region, and a custom endpoint takes precedence over FIPS endpoints.
+		return nil
+	}
+	if _, ok := fipsEndpointFor(fipsRequiredService, region); !ok {
+		return fmt.Errorf("FIPS endpoints are not available in region %s: unset %s and the --fips flag, or use another region", region, FIPSEnvVar)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessions
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConfig_FIPS(t *testing.T) {
+	testCases := map[string]struct {
+		inService string
+		inRegion  string
+
+		wantedURL string
+	}{
+		"resolves the FIPS endpoint of a regional service": {
+			inService: "ecs",
+			inRegion:  "us-east-1",
+
+			wantedURL: "https://ecs-fips.us-east-1.amazonaws.com",
+		},
+		"resolves the FIPS endpoint of a service with a region suffixed endpoint": {
+			inService: "cloudformation",
+			inRegion:  "us-west-2",
+
+			wantedURL: "https://cloudformation-fips.us-west-2.amazonaws.com",
+		},
+		"resolves the FIPS endpoint of a global service": {
+			inService: "iam",
+			inRegion:  "us-east-1",
+
+			wantedURL: "https://iam-fips.amazonaws.com",
+		},
+		"resolves the FIPS endpoint of a global service in the partition of the region": {
+			inService: "iam",
+			inRegion:  "us-gov-west-1",
+
+			wantedURL: "https://iam.us-gov.amazonaws.com",
+		},
+		"falls back to the regular endpoint if the service doesn't have a FIPS endpoint": {
+			inService: "apprunner",
+			inRegion:  "us-east-1",
+
+			wantedURL: "https://apprunner.us-east-1.amazonaws.com",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			getEnv = func(key string) string {
+				return map[string]string{
+					FIPSEnvVar: "true",
+				}[key]
+			}
+			defer func() { getEnv = os.Getenv }()
+
+			// WHEN
+			conf := newConfig()
+
+			// THEN
+			resolved, err := conf.EndpointResolver.EndpointFor(tc.inService, tc.inRegion)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedURL, resolved.URL)
+		})
+	}
+	t.Run("uses the default endpoints if FIPS is not enabled", func(t *testing.T) {
+		// GIVEN
+		getEnv = func(key string) string { return "" }
+		defer func() { getEnv = os.Getenv }()
+
+		// WHEN
+		conf := newConfig()
+
+		// THEN
+		require.Nil(t, conf.EndpointResolver)
+	})
+	t.Run("the endpoint override takes precedence over FIPS endpoints", func(t *testing.T) {
+		// GIVEN
+		getEnv = func(key string) string {
+			return map[string]string{
+				FIPSEnvVar:     "true",
+				EndpointEnvVar: "http://localhost:4566",
+			}[key]
+		}
+		defer func() { getEnv = os.Getenv }()
+
+		// WHEN
+		conf := newConfig()
+
+		// THEN
+		resolved, err := conf.EndpointResolver.EndpointFor("ecs", "us-east-1")
+		require.NoError(t, err)
+		require.Equal(t, "http://localhost:4566", resolved.URL)
+	})
+}
+
+func TestValidateFIPSRegion(t *testing.T) {
+	testCases := map[string]struct {
+		inEnv    map[string]string
+		inRegion string
+
+		wantedErr error
+	}{
+		"skips the validation if FIPS is not enabled": {
+			inRegion: "eu-west-3",
+		},
+		"accepts a region with FIPS endpoints": {
+			inEnv:    map[string]string{FIPSEnvVar: "true"},
+			inRegion: "us-gov-west-1",
+		},
+		"skips the validation if the endpoint is overridden": {
+			inEnv: map[string]string{
+				FIPSEnvVar:     "true",
+				EndpointEnvVar: "http://localhost:4566",
+			},
+			inRegion: "eu-west-3",
+		},
+		"errors if the region doesn't offer FIPS endpoints": {
+			inEnv:    map[string]string{FIPSEnvVar: "true"},
+			inRegion: "eu-west-3",
+
+			wantedErr: fmt.Errorf("FIPS endpoints are not available in region eu-west-3: unset COPILOT_USE_FIPS and the --fips flag, or use another region"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			getEnv = func(key string) string {
+				return tc.inEnv[key]
+			}
+			defer func() { getEnv = os.Getenv }()
+			sess, err := session.NewSession(aws.NewConfig().WithRegion(tc.inRegion))
+			require.NoError(t, err)
+
+			// WHEN
+			err = validateFIPSRegion(sess)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
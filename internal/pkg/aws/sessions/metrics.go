@@ -0,0 +1,74 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessions
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// APICallStats holds the number of calls made to the API of an AWS service and their total latency.
+type APICallStats struct {
+	Service string
+	Calls   int
+	Latency time.Duration // Time from the creation of each request to its completion, including retries.
+}
+
+// apiCallRecorder aggregates the calls made by all the sessions per service.
+// Requests can complete concurrently, so the recorder is safe for concurrent use.
+type apiCallRecorder struct {
+	mu    sync.Mutex
+	stats map[string]*APICallStats
+}
+
+var apiCalls = &apiCallRecorder{
+	stats: make(map[string]*APICallStats),
+}
+
+func (rec *apiCallRecorder) record(service string, latency time.Duration) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	stats, ok := rec.stats[service]
+	if !ok {
+		stats = &APICallStats{Service: service}
+		rec.stats[service] = stats
+	}
+	stats.Calls++
+	stats.Latency += latency
+}
+
+func (rec *apiCallRecorder) summary() []APICallStats {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	summary := make([]APICallStats, 0, len(rec.stats))
+	for _, stats := range rec.stats {
+		summary = append(summary, *stats)
+	}
+	sort.Slice(summary, func(i, j int) bool {
+		if summary[i].Latency != summary[j].Latency {
+			return summary[i].Latency > summary[j].Latency
+		}
+		return summary[i].Service < summary[j].Service
+	})
+	return summary
+}
+
+// APICallSummary returns the number of calls and their total latency per AWS service,
+// starting with the service that took the longest. Calls are only recorded once debug logging is enabled.
+func APICallSummary() []APICallStats {
+	return apiCalls.summary()
+}
+
+// metricsHandler returns a request handler that records the latency of each request once it completes.
+func metricsHandler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "MetricsHandler",
+		Fn: func(r *request.Request) {
+			apiCalls.record(r.ClientInfo.ServiceName, time.Since(r.Time))
+		},
+	}
+}
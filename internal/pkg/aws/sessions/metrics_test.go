@@ -0,0 +1,31 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPICallRecorder_Summary(t *testing.T) {
+	// GIVEN
+	rec := &apiCallRecorder{
+		stats: make(map[string]*APICallStats),
+	}
+
+	// WHEN
+	rec.record("ecs", 100*time.Millisecond)
+	rec.record("cloudformation", 2*time.Second)
+	rec.record("ecs", 300*time.Millisecond)
+	rec.record("ssm", 400*time.Millisecond)
+
+	// THEN
+	require.Equal(t, []APICallStats{
+		{Service: "cloudformation", Calls: 1, Latency: 2 * time.Second},
+		{Service: "ecs", Calls: 2, Latency: 400 * time.Millisecond},
+		{Service: "ssm", Calls: 1, Latency: 400 * time.Millisecond},
+	}, rec.summary())
+}
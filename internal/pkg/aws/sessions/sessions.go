@@ -8,15 +8,20 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"regexp"
 	"runtime"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/version"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 )
@@ -24,6 +29,12 @@ import (
 const (
 	userAgentHeader = "User-Agent"
 
+	// EndpointEnvVar is the environment variable that overrides the endpoint of every AWS service,
+	// for example to run Copilot against LocalStack in integration tests.
+	EndpointEnvVar = "COPILOT_AWS_ENDPOINT"
+	// FIPSEnvVar is the environment variable that makes the AWS clients use FIPS endpoints.
+	FIPSEnvVar = "COPILOT_USE_FIPS"
+
 	maxRetriesOnRecoverableFailures = 8 // Default provided by SDK is 3 which means requests are retried up to only 2 seconds.
 	credsTimeout                    = 10 * time.Second
 	clientTimeout                   = 30 * time.Second
@@ -38,6 +49,22 @@ type Provider struct {
 var instance *Provider
 var once sync.Once
 
+var getEnv = os.Getenv
+
+// credentialHeaders matches the lines of the SDK's request logs that contain credentials.
+var credentialHeaders = regexp.MustCompile(`(?mi)^(Authorization|X-Amz-Security-Token):[^\r\n]*`)
+
+// debugLogging is true if the sessions should log the requests sent to AWS.
+var debugLogging bool
+
+// EnableDebugLogging makes the sessions created from now on log the AWS API operations that they call,
+// along with the SDK's request and response logs, to standard error.
+// The sessions also record the number and latency of their calls for the APICallSummary.
+// The bodies of the requests and responses are never logged, and the credentials in the headers are redacted.
+func EnableDebugLogging() {
+	debugLogging = true
+}
+
 // NewProvider returns a session Provider singleton.
 func NewProvider() *Provider {
 	once.Do(func() {
@@ -59,7 +86,10 @@ func (p *Provider) Default() (*session.Session, error) {
 	if err != nil {
 		return nil, err
 	}
-	sess.Handlers.Build.PushBackNamed(userAgentHandler())
+	if err := validateFIPSRegion(sess); err != nil {
+		return nil, err
+	}
+	addHandlers(sess)
 	p.defaultSess = sess
 	return sess, nil
 }
@@ -73,7 +103,10 @@ func (p *Provider) DefaultWithRegion(region string) (*session.Session, error) {
 	if err != nil {
 		return nil, err
 	}
-	sess.Handlers.Build.PushBackNamed(userAgentHandler())
+	if err := validateFIPSRegion(sess); err != nil {
+		return nil, err
+	}
+	addHandlers(sess)
 	return sess, nil
 }
 
@@ -87,7 +120,10 @@ func (p *Provider) FromProfile(name string) (*session.Session, error) {
 	if err != nil {
 		return nil, err
 	}
-	sess.Handlers.Build.PushBackNamed(userAgentHandler())
+	if err := validateFIPSRegion(sess); err != nil {
+		return nil, err
+	}
+	addHandlers(sess)
 	return sess, nil
 }
 
@@ -107,7 +143,10 @@ func (p *Provider) FromRole(roleARN string, region string) (*session.Session, er
 	if err != nil {
 		return nil, err
 	}
-	sess.Handlers.Build.PushBackNamed(userAgentHandler())
+	if err := validateFIPSRegion(sess); err != nil {
+		return nil, err
+	}
+	addHandlers(sess)
 	return sess, nil
 }
 
@@ -121,7 +160,10 @@ func (p *Provider) FromStaticCreds(accessKeyID, secretAccessKey, sessionToken st
 	if err != nil {
 		return nil, fmt.Errorf("create session from static credentials: %w", err)
 	}
-	sess.Handlers.Build.PushBackNamed(userAgentHandler())
+	if err := validateFIPSRegion(sess); err != nil {
+		return nil, err
+	}
+	addHandlers(sess)
 	return sess, nil
 }
 
@@ -148,14 +190,66 @@ func Creds(sess *session.Session) (credentials.Value, error) {
 }
 
 // newConfig returns a config with an end-to-end request timeout and verbose credentials errors.
+// If the COPILOT_AWS_ENDPOINT environment variable is set, all the requests are sent to its endpoint.
+// If FIPS endpoints are enabled, the requests are sent to the FIPS endpoints of the services that have one.
 func newConfig() *aws.Config {
 	c := &http.Client{
 		Timeout: clientTimeout,
 	}
-	return aws.NewConfig().
+	conf := aws.NewConfig().
 		WithHTTPClient(c).
 		WithCredentialsChainVerboseErrors(true).
 		WithMaxRetries(maxRetriesOnRecoverableFailures)
+	if endpoint := getEnv(EndpointEnvVar); endpoint != "" {
+		conf = conf.
+			WithEndpointResolver(endpointResolver(endpoint)).
+			WithS3ForcePathStyle(true) // Bucket names can't be resolved as subdomains of a custom endpoint.
+	} else if useFIPS() {
+		conf = conf.WithEndpointResolver(endpoints.ResolverFunc(fipsEndpoint))
+	}
+	if debugLogging {
+		conf = conf.
+			WithLogLevel(aws.LogDebugWithRequestRetries | aws.LogDebugWithRequestErrors).
+			WithLogger(aws.LoggerFunc(debugLogger))
+	}
+	return conf
+}
+
+// envBool returns true if the environment variable is set to a true value.
+// An invalid value is ignored, and warned about once.
+func envBool(key string, warnInvalid *sync.Once) bool {
+	val := getEnv(key)
+	if val == "" {
+		return false
+	}
+	enabled, err := strconv.ParseBool(val)
+	if err != nil {
+		warnInvalid.Do(func() {
+			log.Warningf("Ignoring %s=%s: must be true or false.\n", key, val)
+		})
+		return false
+	}
+	return enabled
+}
+
+// endpointResolver returns a resolver that sends the requests of every service to the url.
+// Requests are still signed for the region of the session.
+func endpointResolver(url string) endpoints.ResolverFunc {
+	return func(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+		return endpoints.ResolvedEndpoint{
+			URL:           url,
+			SigningRegion: region,
+		}, nil
+	}
+}
+
+// addHandlers adds the request handlers shared by all sessions.
+func addHandlers(sess *session.Session) {
+	sess.Handlers.Build.PushBackNamed(userAgentHandler())
+	if debugLogging {
+		sess.Handlers.Send.PushFrontNamed(debugHandler())
+		sess.Handlers.Complete.PushBackNamed(metricsHandler())
+	}
 }
 
 // userAgentHandler returns a http request handler that sets a custom user agent to all aws requests.
@@ -169,3 +263,18 @@ func userAgentHandler() request.NamedHandler {
 		},
 	}
 }
+
+// debugHandler returns a http request handler that logs the AWS API operation of each request before it's sent.
+func debugHandler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "DebugHandler",
+		Fn: func(r *request.Request) {
+			log.Verbosef("Calling %s %s in %s\n", r.ClientInfo.ServiceName, r.Operation.Name, aws.StringValue(r.Config.Region))
+		},
+	}
+}
+
+// debugLogger writes the SDK's logs to standard error without the credentials sent in the request headers.
+func debugLogger(args ...interface{}) {
+	log.Verboseln(credentialHeaders.ReplaceAllString(fmt.Sprint(args...), "$1: REDACTED"))
+}
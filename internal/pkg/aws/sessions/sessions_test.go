@@ -5,6 +5,7 @@ package sessions
 
 import (
 	"errors"
+	"os"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -140,3 +141,62 @@ func TestCreds(t *testing.T) {
 		})
 	}
 }
+
+func TestNewConfig_DebugLogging(t *testing.T) {
+	// GIVEN
+	defer func() { debugLogging = false }()
+	require.Equal(t, aws.LogOff, newConfig().LogLevel.Value())
+
+	// WHEN
+	EnableDebugLogging()
+	conf := newConfig()
+
+	// THEN
+	require.True(t, conf.LogLevel.AtLeast(aws.LogDebug))
+	require.False(t, conf.LogLevel.Matches(aws.LogDebugWithHTTPBody), "request and response bodies can contain secrets")
+	require.NotNil(t, conf.Logger)
+}
+
+func TestCredentialHeaders(t *testing.T) {
+	in := "POST / HTTP/1.1\r\nHost: ssm.us-west-2.amazonaws.com\r\nAuthorization: AWS4-HMAC-SHA256 Credential=AKID/20210901\r\nX-Amz-Security-Token: token\r\n"
+
+	out := credentialHeaders.ReplaceAllString(in, "$1: REDACTED")
+
+	require.Equal(t, "POST / HTTP/1.1\r\nHost: ssm.us-west-2.amazonaws.com\r\nAuthorization: REDACTED\r\nX-Amz-Security-Token: REDACTED\r\n", out)
+}
+
+func TestNewConfig_EndpointOverride(t *testing.T) {
+	t.Run("uses the default endpoints if the environment variable is not set", func(t *testing.T) {
+		// GIVEN
+		getEnv = func(key string) string { return "" }
+		defer func() { getEnv = os.Getenv }()
+
+		// WHEN
+		conf := newConfig()
+
+		// THEN
+		require.Nil(t, conf.EndpointResolver)
+		require.Nil(t, conf.S3ForcePathStyle)
+	})
+	t.Run("sends every service to the endpoint of the environment variable", func(t *testing.T) {
+		// GIVEN
+		getEnv = func(key string) string {
+			return map[string]string{
+				EndpointEnvVar: "http://localhost:4566",
+			}[key]
+		}
+		defer func() { getEnv = os.Getenv }()
+
+		// WHEN
+		conf := newConfig()
+
+		// THEN
+		for _, service := range []string{"ecs", "cloudformation", "s3"} {
+			resolved, err := conf.EndpointResolver.EndpointFor(service, "us-west-2")
+			require.NoError(t, err)
+			require.Equal(t, "http://localhost:4566", resolved.URL)
+			require.Equal(t, "us-west-2", resolved.SigningRegion)
+		}
+		require.True(t, aws.BoolValue(conf.S3ForcePathStyle))
+	})
+}
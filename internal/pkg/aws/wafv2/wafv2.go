@@ -0,0 +1,44 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package wafv2 provides a client to make API requests to AWS WAF.
+package wafv2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+)
+
+type api interface {
+	GetWebACLForResource(input *wafv2.GetWebACLForResourceInput) (*wafv2.GetWebACLForResourceOutput, error)
+}
+
+// WAFV2 wraps an AWS WAFV2 client.
+type WAFV2 struct {
+	client api
+}
+
+// New returns a WAFV2 configured against the input session.
+func New(sess *session.Session) *WAFV2 {
+	return &WAFV2{
+		client: wafv2.New(sess),
+	}
+}
+
+// WebACLForResource returns the ARN of the web ACL associated with a resource.
+// If no web ACL is associated with the resource, it returns an empty string.
+func (w *WAFV2) WebACLForResource(resourceARN string) (string, error) {
+	out, err := w.client.GetWebACLForResource(&wafv2.GetWebACLForResourceInput{
+		ResourceArn: aws.String(resourceARN),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get web ACL for resource %s: %w", resourceARN, err)
+	}
+	if out.WebACL == nil {
+		return "", nil
+	}
+	return aws.StringValue(out.WebACL.ARN), nil
+}
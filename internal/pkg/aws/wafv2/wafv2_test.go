@@ -0,0 +1,84 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package wafv2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/wafv2/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWAFV2_WebACLForResource(t *testing.T) {
+	testCases := map[string]struct {
+		resourceARN string
+
+		setUpMock func(m *mocks.Mockapi)
+
+		wantedOut   string
+		wantedError error
+	}{
+		"success": {
+			resourceARN: "mockLoadBalancerARN",
+
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetWebACLForResource(&wafv2.GetWebACLForResourceInput{
+					ResourceArn: aws.String("mockLoadBalancerARN"),
+				}).Return(&wafv2.GetWebACLForResourceOutput{
+					WebACL: &wafv2.WebACL{
+						ARN: aws.String("mockWebACLARN"),
+					},
+				}, nil)
+			},
+
+			wantedOut: "mockWebACLARN",
+		},
+		"no web ACL associated": {
+			resourceARN: "mockLoadBalancerARN",
+
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetWebACLForResource(gomock.Any()).Return(&wafv2.GetWebACLForResourceOutput{}, nil)
+			},
+
+			wantedOut: "",
+		},
+		"failed to get web ACL": {
+			resourceARN: "mockLoadBalancerARN",
+
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetWebACLForResource(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("get web ACL for resource mockLoadBalancerARN: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			client := WAFV2{
+				client: mockAPI,
+			}
+
+			got, err := client.WebACLForResource(tc.resourceARN)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOut, got)
+			}
+		})
+	}
+}
@@ -4,12 +4,26 @@
 package cli
 
 import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/spf13/cobra"
 
 	"github.com/aws/copilot-cli/cmd/copilot/template"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+)
+
+// Environment variables that set the preferences of the application StackSet operations.
+const (
+	appStackSetMaxConcurrentPercentageEnvVar    = "COPILOT_STACKSET_MAX_CONCURRENT_PERCENTAGE"
+	appStackSetFailureTolerancePercentageEnvVar = "COPILOT_STACKSET_FAILURE_TOLERANCE_PERCENTAGE"
 )
 
+var getEnv = os.Getenv // Overridden in tests.
+
 // BuildAppCmd builds the top level app command and related subcommands.
 func BuildAppCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -23,6 +37,7 @@ Applications are a collection of services and environments.`,
 	cmd.AddCommand(buildAppInitCommand())
 	cmd.AddCommand(buildAppListCommand())
 	cmd.AddCommand(buildAppShowCmd())
+	cmd.AddCommand(buildAppStatusCmd())
 	cmd.AddCommand(buildAppDeleteCommand())
 	cmd.AddCommand(buildAppUpgradeCmd())
 
@@ -33,3 +48,43 @@ Applications are a collection of services and environments.`,
 
 	return cmd
 }
+
+// newAppCloudFormation returns a CloudFormation client whose application StackSet operations use the preferences
+// set in the environment variables.
+func newAppCloudFormation(sess *session.Session) (deploycfn.CloudFormation, error) {
+	opts, err := appStackSetOpts()
+	if err != nil {
+		return deploycfn.CloudFormation{}, err
+	}
+	return deploycfn.New(sess, opts...), nil
+}
+
+// appStackSetOpts returns the options to configure the application StackSet operations from the
+// COPILOT_STACKSET_MAX_CONCURRENT_PERCENTAGE and COPILOT_STACKSET_FAILURE_TOLERANCE_PERCENTAGE environment variables.
+func appStackSetOpts() ([]deploycfn.Option, error) {
+	var opts []deploycfn.Option
+	if val := getEnv(appStackSetMaxConcurrentPercentageEnvVar); val != "" {
+		percentage, err := parsePercentage(appStackSetMaxConcurrentPercentageEnvVar, val, 1)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, deploycfn.WithAppStackSetMaxConcurrentPercentage(percentage))
+	}
+	if val := getEnv(appStackSetFailureTolerancePercentageEnvVar); val != "" {
+		percentage, err := parsePercentage(appStackSetFailureTolerancePercentageEnvVar, val, 0)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, deploycfn.WithAppStackSetFailureTolerancePercentage(percentage))
+	}
+	return opts, nil
+}
+
+// parsePercentage returns the percentage set in the environment variable key, it must be between min and 100.
+func parsePercentage(key, val string, min int) (int, error) {
+	percentage, err := strconv.Atoi(val)
+	if err != nil || percentage < min || percentage > 100 {
+		return 0, fmt.Errorf("environment variable %s must be an integer between %d and 100, got %q", key, min, val)
+	}
+	return percentage, nil
+}
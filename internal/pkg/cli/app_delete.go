@@ -6,12 +6,15 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
-	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -21,9 +24,13 @@ import (
 )
 
 const (
-	fmtDeleteAppConfirmPrompt = "Are you sure you want to delete application %s?"
+	fmtDeleteAppConfirmPrompt = "Are you sure you want to delete application %s? Type its name to confirm:"
 	deleteAppConfirmHelp      = "This will delete all resources in your application: including services, environments, and pipelines."
 
+	fmtDeleteAppPipelineStartMsg  = "Deleting pipeline %s."
+	fmtDeleteAppPipelineStopMsg   = "Deleted pipeline %s.\n"
+	fmtDeleteAppPipelineFailedMsg = "Error deleting pipeline %s.\n"
+
 	deleteAppCleanResourcesStartMsg = "Cleaning up deployment resources."
 	deleteAppCleanResourcesStopMsg  = "Cleaned up deployment resources.\n"
 
@@ -55,6 +62,7 @@ type deleteAppOpts struct {
 	sessProvider         sessionProvider
 	cfn                  deployer
 	prompt               prompter
+	pipelines            pipelineLister
 	s3                   func(session *session.Session) bucketEmptier
 	svcDeleteExecutor    func(svcName string) (executor, error)
 	jobDeleteExecutor    func(jobName string) (executor, error)
@@ -79,6 +87,10 @@ func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("default session: %w", err)
 	}
+	cfn, err := newAppCloudFormation(defaultSession)
+	if err != nil {
+		return nil, err
+	}
 
 	return &deleteAppOpts{
 		deleteAppVars: vars,
@@ -86,8 +98,9 @@ func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
 		store:         store,
 		ws:            ws,
 		sessProvider:  provider,
-		cfn:           cloudformation.New(defaultSession),
+		cfn:           cfn,
 		prompt:        prompt.New(),
+		pipelines:     codepipeline.New(defaultSession),
 		s3: func(session *session.Session) bucketEmptier {
 			return s3.New(session)
 		},
@@ -164,22 +177,25 @@ func (o *deleteAppOpts) Ask() error {
 		return nil
 	}
 
-	manualConfirm, err := o.prompt.Confirm(
+	typedName, err := o.prompt.Get(
 		fmt.Sprintf(fmtDeleteAppConfirmPrompt, o.name),
 		deleteAppConfirmHelp,
-		prompt.WithTrueDefault())
+		nil,
+		prompt.WithFinalMessage("Confirm:"))
 	if err != nil {
 		return fmt.Errorf("confirm app deletion: %w", err)
 	}
-	if !manualConfirm {
-		return errOperationCancelled
+	if strings.TrimSpace(typedName) != o.name {
+		return fmt.Errorf("%q does not match application name %s: %w", typedName, o.name, errOperationCancelled)
 	}
 	return nil
 }
 
 // Execute deletes the application.
-// It removes all the services from each environment, the environments, the pipeline S3 buckets,
-// the pipeline, the application, removes the variables from the config store, and deletes the local workspace.
+// It removes all the services and jobs from each environment along with their addons stacks, the pipelines,
+// the environments, the pipeline S3 buckets, the application, removes the variables from the config store,
+// and deletes the local workspace.
+// Resources that were already deleted are skipped, so that the command can be re-run after a partial failure.
 func (o *deleteAppOpts) Execute() error {
 	if err := o.deleteSvcs(); err != nil {
 		return err
@@ -189,20 +205,18 @@ func (o *deleteAppOpts) Execute() error {
 		return err
 	}
 
-	if err := o.deleteEnvs(); err != nil {
+	// deletePipelines must happen before deleteAppResources and deleteWs, since the pipeline delete command relies
+	// on the application stackset as well as the workspace directory to still exist.
+	if err := o.deletePipelines(); err != nil {
 		return err
 	}
 
-	if err := o.emptyS3Bucket(); err != nil {
+	if err := o.deleteEnvs(); err != nil {
 		return err
 	}
 
-	// deletePipeline must happen before deleteAppResources and deleteWs, since the pipeline delete command relies
-	// on the application stackset as well as the workspace directory to still exist.
-	if err := o.deletePipeline(); err != nil {
-		if !errors.Is(err, workspace.ErrNoPipelineInWorkspace) {
-			return err
-		}
+	if err := o.emptyS3Bucket(); err != nil {
+		return err
 	}
 
 	if err := o.deleteAppResources(); err != nil {
@@ -295,10 +309,20 @@ func (o *deleteAppOpts) deleteEnvs() error {
 func (o *deleteAppOpts) emptyS3Bucket() error {
 	app, err := o.store.GetApplication(o.name)
 	if err != nil {
+		var errNoSuchApp *config.ErrNoSuchApplication
+		if errors.As(err, &errNoSuchApp) {
+			// The application configuration was deleted by a previous run, so were its resources.
+			return nil
+		}
 		return fmt.Errorf("get application %s: %w", o.name, err)
 	}
 	appResources, err := o.cfn.GetRegionalAppResources(app)
 	if err != nil {
+		var errNoStackSet *stackset.ErrStackSetNotFound
+		if errors.As(err, &errNoStackSet) {
+			// The application resources, including the buckets, were deleted by a previous run.
+			return nil
+		}
 		return fmt.Errorf("get regional application resources for %s: %w", app.Name, err)
 	}
 	o.spinner.Start(deleteAppCleanResourcesStartMsg)
@@ -319,12 +343,32 @@ func (o *deleteAppOpts) emptyS3Bucket() error {
 	return nil
 }
 
-func (o *deleteAppOpts) deletePipeline() error {
+// deletePipelines deletes the pipeline of the workspace along with its secret, and then the other pipelines of the application.
+func (o *deleteAppOpts) deletePipelines() error {
 	cmd, err := o.deletePipelineRunner()
 	if err != nil {
 		return err
 	}
-	return cmd.Run()
+	if err := cmd.Run(); err != nil && !errors.Is(err, workspace.ErrNoPipelineInWorkspace) {
+		return err
+	}
+
+	pipelines, err := o.pipelines.ListPipelineNamesByTags(map[string]string{
+		deploy.AppTagKey: o.name,
+	})
+	if err != nil {
+		return fmt.Errorf("list pipelines for application %s: %w", o.name, err)
+	}
+	for _, pipeline := range pipelines {
+		// The pipeline name is also the name of its stack.
+		o.spinner.Start(fmt.Sprintf(fmtDeleteAppPipelineStartMsg, pipeline))
+		if err := o.cfn.DeletePipeline(pipeline); err != nil {
+			o.spinner.Stop(log.Serrorf(fmtDeleteAppPipelineFailedMsg, pipeline))
+			return fmt.Errorf("delete pipeline %s: %w", pipeline, err)
+		}
+		o.spinner.Stop(log.Ssuccessf(fmtDeleteAppPipelineStopMsg, pipeline))
+	}
+	return nil
 }
 
 func (o *deleteAppOpts) deleteAppResources() error {
@@ -384,5 +428,6 @@ func buildAppDeleteCommand() *cobra.Command {
 
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	markPromptedFlags(cmd, yesFlag)
 	return cmd
 }
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -74,33 +75,37 @@ func TestDeleteAppOpts_Ask(t *testing.T) {
 			setupMocks: func(ctrl *gomock.Controller) {
 				mockPrompter = mocks.NewMockprompter(ctrl)
 				mockPrompter.EXPECT().
-					Confirm(fmt.Sprintf(fmtDeleteAppConfirmPrompt, mockAppName),
+					Get(fmt.Sprintf(fmtDeleteAppConfirmPrompt, mockAppName),
 						deleteAppConfirmHelp,
+						nil,
 						gomock.Any()).
-					Return(false, mockError)
+					Return("", mockError)
 			},
 			want: fmt.Errorf("confirm app deletion: %w", mockError),
 		},
-		"return error if user cancels operation": {skipConfirmation: false,
+		"return error if the typed name does not match": {
+			skipConfirmation: false,
 			setupMocks: func(ctrl *gomock.Controller) {
 				mockPrompter = mocks.NewMockprompter(ctrl)
 				mockPrompter.EXPECT().
-					Confirm(fmt.Sprintf(fmtDeleteAppConfirmPrompt, mockAppName),
+					Get(fmt.Sprintf(fmtDeleteAppConfirmPrompt, mockAppName),
 						deleteAppConfirmHelp,
+						nil,
 						gomock.Any()).
-					Return(false, nil)
+					Return("phonetol", nil)
 			},
-			want: errOperationCancelled,
+			want: fmt.Errorf(`"phonetol" does not match application name phonetool: %w`, errOperationCancelled),
 		},
-		"return nil if user confirms": {
+		"return nil if user types the application name": {
 			skipConfirmation: false,
 			setupMocks: func(ctrl *gomock.Controller) {
 				mockPrompter = mocks.NewMockprompter(ctrl)
 				mockPrompter.EXPECT().
-					Confirm(fmt.Sprintf(fmtDeleteAppConfirmPrompt, mockAppName),
+					Get(fmt.Sprintf(fmtDeleteAppConfirmPrompt, mockAppName),
 						deleteAppConfirmHelp,
+						nil,
 						gomock.Any()).
-					Return(true, nil)
+					Return(mockAppName, nil)
 			},
 			want: nil,
 		},
@@ -138,6 +143,7 @@ type deleteAppMocks struct {
 	taskDeleter     *mocks.Mockexecutor
 	bucketEmptier   *mocks.MockbucketEmptier
 	pipelineDeleter *mocks.MockdeletePipelineRunner
+	pipelineLister  *mocks.MockpipelineLister
 }
 
 func TestDeleteAppOpts_Execute(t *testing.T) {
@@ -191,6 +197,15 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 					mocks.store.EXPECT().ListJobs(mockAppName).Return(mockJobs, nil),
 					mocks.jobDeleter.EXPECT().Execute().Return(nil),
 
+					// deletePipelines
+					mocks.pipelineDeleter.EXPECT().Run().Return(nil),
+					mocks.pipelineLister.EXPECT().ListPipelineNamesByTags(map[string]string{
+						deploy.AppTagKey: mockAppName,
+					}).Return([]string{"pipeline-phonetool-frontend"}, nil),
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtDeleteAppPipelineStartMsg, "pipeline-phonetool-frontend")),
+					mocks.deployer.EXPECT().DeletePipeline("pipeline-phonetool-frontend").Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtDeleteAppPipelineStopMsg, "pipeline-phonetool-frontend")),
+
 					// listEnvs
 					mocks.store.EXPECT().ListEnvironments(mockAppName).Return(mockEnvs, nil),
 
@@ -209,9 +224,6 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 					mocks.bucketEmptier.EXPECT().EmptyBucket(mockResources[0].S3Bucket).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccess(deleteAppCleanResourcesStopMsg)),
 
-					// delete pipeline
-					mocks.pipelineDeleter.EXPECT().Run().Return(nil),
-
 					// deleteAppResources
 					mocks.spinner.EXPECT().Start(deleteAppResourcesStartMsg),
 					mocks.deployer.EXPECT().DeleteApp(mockAppName).Return(nil),
@@ -242,6 +254,10 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 					mocks.store.EXPECT().ListJobs(mockAppName).Return(mockJobs, nil),
 					mocks.jobDeleter.EXPECT().Execute().Return(nil),
 
+					// deletePipelines
+					mocks.pipelineDeleter.EXPECT().Run().Return(workspace.ErrNoPipelineInWorkspace),
+					mocks.pipelineLister.EXPECT().ListPipelineNamesByTags(gomock.Any()).Return(nil, nil),
+
 					// listEnvs
 					mocks.store.EXPECT().ListEnvironments(mockAppName).Return(mockEnvs, nil),
 
@@ -259,8 +275,38 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 					mocks.bucketEmptier.EXPECT().EmptyBucket(mockResources[0].S3Bucket).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccess(deleteAppCleanResourcesStopMsg)),
 
-					// delete pipeline
+					// deleteAppResources
+					mocks.spinner.EXPECT().Start(deleteAppResourcesStartMsg),
+					mocks.deployer.EXPECT().DeleteApp(mockAppName).Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccess(deleteAppResourcesStopMsg)),
+
+					// deleteAppConfigs
+					mocks.spinner.EXPECT().Start(deleteAppConfigStartMsg),
+					mocks.store.EXPECT().DeleteApplication(mockAppName).Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccess(deleteAppConfigStopMsg)),
+
+					// deleteWs
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtDeleteAppWsStartMsg, workspace.SummaryFileName)),
+					mocks.ws.EXPECT().DeleteWorkspaceFile().Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccess(fmt.Sprintf(fmtDeleteAppWsStopMsg, workspace.SummaryFileName))),
+				)
+			},
+			wantedError: nil,
+		},
+		"resumes after the application resources were deleted by a previous run": {
+			appName: mockAppName,
+			setupMocks: func(mocks deleteAppMocks) {
+				gomock.InOrder(
+					// Services, jobs, pipelines and environments are already deleted.
+					mocks.store.EXPECT().ListServices(mockAppName).Return(nil, nil),
+					mocks.store.EXPECT().ListJobs(mockAppName).Return(nil, nil),
 					mocks.pipelineDeleter.EXPECT().Run().Return(workspace.ErrNoPipelineInWorkspace),
+					mocks.pipelineLister.EXPECT().ListPipelineNamesByTags(gomock.Any()).Return(nil, nil),
+					mocks.store.EXPECT().ListEnvironments(mockAppName).Return(nil, nil),
+
+					// emptyS3bucket is skipped since the stack set is gone.
+					mocks.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil),
+					mocks.deployer.EXPECT().GetRegionalAppResources(mockApp).Return(nil, fmt.Errorf("describing application resources: %w", &stackset.ErrStackSetNotFound{})),
 
 					// deleteAppResources
 					mocks.spinner.EXPECT().Start(deleteAppResourcesStartMsg),
@@ -280,6 +326,45 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 			},
 			wantedError: nil,
 		},
+		"skips emptying the buckets if the application configuration was deleted by a previous run": {
+			appName: mockAppName,
+			setupMocks: func(mocks deleteAppMocks) {
+				gomock.InOrder(
+					mocks.store.EXPECT().ListServices(mockAppName).Return(nil, nil),
+					mocks.store.EXPECT().ListJobs(mockAppName).Return(nil, nil),
+					mocks.pipelineDeleter.EXPECT().Run().Return(workspace.ErrNoPipelineInWorkspace),
+					mocks.pipelineLister.EXPECT().ListPipelineNamesByTags(gomock.Any()).Return(nil, nil),
+					mocks.store.EXPECT().ListEnvironments(mockAppName).Return(nil, nil),
+					mocks.store.EXPECT().GetApplication(mockAppName).Return(nil, &config.ErrNoSuchApplication{ApplicationName: mockAppName}),
+
+					mocks.spinner.EXPECT().Start(deleteAppResourcesStartMsg),
+					mocks.deployer.EXPECT().DeleteApp(mockAppName).Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccess(deleteAppResourcesStopMsg)),
+					mocks.spinner.EXPECT().Start(deleteAppConfigStartMsg),
+					mocks.store.EXPECT().DeleteApplication(mockAppName).Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccess(deleteAppConfigStopMsg)),
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtDeleteAppWsStartMsg, workspace.SummaryFileName)),
+					mocks.ws.EXPECT().DeleteWorkspaceFile().Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccess(fmt.Sprintf(fmtDeleteAppWsStopMsg, workspace.SummaryFileName))),
+				)
+			},
+			wantedError: nil,
+		},
+		"errors if a pipeline can't be deleted": {
+			appName: mockAppName,
+			setupMocks: func(mocks deleteAppMocks) {
+				gomock.InOrder(
+					mocks.store.EXPECT().ListServices(mockAppName).Return(nil, nil),
+					mocks.store.EXPECT().ListJobs(mockAppName).Return(nil, nil),
+					mocks.pipelineDeleter.EXPECT().Run().Return(nil),
+					mocks.pipelineLister.EXPECT().ListPipelineNamesByTags(gomock.Any()).Return([]string{"pipeline-phonetool"}, nil),
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtDeleteAppPipelineStartMsg, "pipeline-phonetool")),
+					mocks.deployer.EXPECT().DeletePipeline("pipeline-phonetool").Return(errors.New("some error")),
+					mocks.spinner.EXPECT().Stop(log.Serrorf(fmtDeleteAppPipelineFailedMsg, "pipeline-phonetool")),
+				)
+			},
+			wantedError: errors.New("delete pipeline pipeline-phonetool: some error"),
+		},
 	}
 
 	for name, test := range tests {
@@ -324,6 +409,7 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 			mockRunnerProvider := func() (deletePipelineRunner, error) {
 				return mockRunner, nil
 			}
+			mockPipelineLister := mocks.NewMockpipelineLister(ctrl)
 
 			mocks := deleteAppMocks{
 				spinner:         mockSpinner,
@@ -337,6 +423,7 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 				taskDeleter:     mockTaskDeleteExecutor,
 				bucketEmptier:   mockBucketEmptier,
 				pipelineDeleter: mockRunner,
+				pipelineLister:  mockPipelineLister,
 			}
 			test.setupMocks(mocks)
 
@@ -349,6 +436,7 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 				ws:                   mockWorkspace,
 				sessProvider:         mockSession,
 				cfn:                  mockDeployer,
+				pipelines:            mockPipelineLister,
 				s3:                   mockGetBucketEmptier,
 				svcDeleteExecutor:    mockSvcExecutorProvider,
 				jobDeleteExecutor:    mockJobExecutorProvider,
@@ -361,7 +449,11 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 			err := opts.Execute()
 
 			// THEN
-			require.Equal(t, test.wantedError, err)
+			if test.wantedError != nil {
+				require.EqualError(t, err, test.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
 		})
 	}
 }
@@ -9,14 +9,26 @@ import (
 	"os"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/list"
 	"github.com/spf13/cobra"
 )
 
+type listAppVars struct {
+	outputFormat string
+}
+
 type listAppOpts struct {
+	listAppVars
+
 	store applicationLister
 	w     io.Writer
 }
 
+// Validate returns an error if the values provided by the user are invalid.
+func (o *listAppOpts) Validate() error {
+	return validateListOutputFormat(false, o.outputFormat)
+}
+
 // Execute writes the existing applications.
 func (o *listAppOpts) Execute() error {
 	apps, err := o.store.ListApplications()
@@ -24,6 +36,12 @@ func (o *listAppOpts) Execute() error {
 		return fmt.Errorf("list applications: %w", err)
 	}
 
+	if o.outputFormat == outputFormatCSV {
+		if err := list.CSV(o.w, apps); err != nil {
+			return fmt.Errorf("write applications as CSV: %w", err)
+		}
+		return nil
+	}
 	for _, app := range apps {
 		fmt.Fprintln(o.w, app.Name)
 	}
@@ -33,15 +51,23 @@ func (o *listAppOpts) Execute() error {
 
 // buildAppListCommand builds the command to list existing applications.
 func buildAppListCommand() *cobra.Command {
+	vars := listAppVars{}
 	cmd := &cobra.Command{
 		Use:   "ls",
 		Short: "Lists all the applications in your account.",
 		Example: `
   List all the applications in your account and region.
-  /code $ copilot app ls`,
+  /code $ copilot app ls
+
+  List all the applications in your account and region as CSV.
+  /code $ copilot app ls --output csv`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts := listAppOpts{
-				w: os.Stdout,
+				listAppVars: vars,
+				w:           os.Stdout,
+			}
+			if err := opts.Validate(); err != nil {
+				return err
 			}
 			ssmStore, err := config.NewStore()
 			if err != nil {
@@ -51,5 +77,6 @@ func buildAppListCommand() *cobra.Command {
 			return opts.Execute()
 		}),
 	}
+	cmd.Flags().StringVar(&vars.outputFormat, outputFlag, "", listOutputFlagDescription)
 	return cmd
 }
@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -68,3 +69,59 @@ func TestListAppOpts_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestListAppOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		outputFormat string
+		wantedErr    error
+	}{
+		"valid with no output format": {},
+		"valid with csv output format": {
+			outputFormat: "csv",
+		},
+		"invalid output format": {
+			outputFormat: "yaml",
+			wantedErr:    errors.New("invalid output format yaml: must be one of \"csv\""),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := listAppOpts{
+				listAppVars: listAppVars{
+					outputFormat: tc.outputFormat,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestListAppOpts_ExecuteCSV(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockstore := mocks.NewMockstore(ctrl)
+	mockstore.EXPECT().ListApplications().Return([]*config.Application{
+		{Name: "app1", AccountID: "1234", Domain: "example.com"},
+	}, nil)
+	b := &bytes.Buffer{}
+	opts := listAppOpts{
+		listAppVars: listAppVars{
+			outputFormat: outputFormatCSV,
+		},
+		store: mockstore,
+		w:     b,
+	}
+
+	err := opts.Execute()
+
+	require.NoError(t, err)
+	require.Equal(t, "name,account,domain,domainHostedZoneID,version,tags\napp1,1234,example.com,,,\n", b.String())
+}
@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 
@@ -25,8 +27,9 @@ const (
 )
 
 type showAppVars struct {
-	name             string
-	shouldOutputJSON bool
+	name                      string
+	shouldOutputJSON          bool
+	shouldShowAccountsRegions bool
 }
 
 type showAppOpts struct {
@@ -36,24 +39,27 @@ type showAppOpts struct {
 	w                io.Writer
 	sel              appSelector
 	pipelineSvc      pipelineGetter
+	stackInstances   stackInstanceLister
 	newVersionGetter func(string) (versionGetter, error)
 }
 
 func newShowAppOpts(vars showAppVars) (*showAppOpts, error) {
-	store, err := config.NewStore()
+	ssmStore, err := config.NewStore()
 	if err != nil {
 		return nil, fmt.Errorf("new config store: %w", err)
 	}
+	store := config.NewCachedStore(ssmStore)
 	defaultSession, err := sessions.NewProvider().Default()
 	if err != nil {
 		return nil, fmt.Errorf("default session: %w", err)
 	}
 	return &showAppOpts{
-		showAppVars: vars,
-		store:       store,
-		w:           log.OutputWriter,
-		sel:         selector.NewSelect(prompt.New(), store),
-		pipelineSvc: codepipeline.New(defaultSession),
+		showAppVars:    vars,
+		store:          store,
+		w:              log.OutputWriter,
+		sel:            selector.NewSelect(prompt.New(), store),
+		pipelineSvc:    codepipeline.New(defaultSession),
+		stackInstances: stackset.New(defaultSession),
 		newVersionGetter: func(s string) (versionGetter, error) {
 			d, err := describe.NewAppDescriber(s)
 			if err != nil {
@@ -149,16 +155,41 @@ func (o *showAppOpts) description() (*describe.App, error) {
 	if err != nil {
 		return nil, fmt.Errorf("get version for application %s: %w", o.name, err)
 	}
+	var stackInstances []*describe.AppStackInstance
+	if o.shouldShowAccountsRegions {
+		stackInstances, err = o.appStackInstances()
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &describe.App{
-		Name:      app.Name,
-		Version:   version,
-		URI:       app.Domain,
-		Envs:      trimmedEnvs,
-		Services:  trimmedSvcs,
-		Pipelines: pipelines,
+		Name:           app.Name,
+		Version:        version,
+		URI:            app.Domain,
+		Envs:           trimmedEnvs,
+		Services:       trimmedSvcs,
+		Pipelines:      pipelines,
+		StackInstances: stackInstances,
 	}, nil
 }
 
+// appStackInstances returns the account and region pairs of the application's shared resources stack set.
+func (o *showAppOpts) appStackInstances() ([]*describe.AppStackInstance, error) {
+	summaries, err := o.stackInstances.InstanceSummaries(stack.NameForAppStackSet(o.name))
+	if err != nil {
+		return nil, fmt.Errorf("list stack instances for application %s: %w", o.name, err)
+	}
+	var instances []*describe.AppStackInstance
+	for _, summary := range summaries {
+		instances = append(instances, &describe.AppStackInstance{
+			Account: summary.Account,
+			Region:  summary.Region,
+			Status:  summary.Status,
+		})
+	}
+	return instances, nil
+}
+
 func (o *showAppOpts) askName() error {
 	if o.name != "" {
 		return nil
@@ -180,7 +211,9 @@ func buildAppShowCmd() *cobra.Command {
 		Long:  "Shows configuration, environments and services for an application.",
 		Example: `
   Shows info about the application "my-app"
-  /code $ copilot app show -n my-app`,
+  /code $ copilot app show -n my-app
+  Shows the accounts and regions where the application "my-app" has shared resources
+  /code $ copilot app show -n my-app --account-region`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newShowAppOpts(vars)
 			if err != nil {
@@ -202,5 +235,7 @@ func buildAppShowCmd() *cobra.Command {
 	// The flags bound by viper are available to all sub-commands through viper.GetString({flagName})
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldShowAccountsRegions, accountRegionFlag, false, accountRegionFlagDescription)
+	markPromptedFlags(cmd, nameFlag)
 	return cmd
 }
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -18,10 +19,11 @@ import (
 )
 
 type showAppMocks struct {
-	storeSvc      *mocks.Mockstore
-	sel           *mocks.MockappSelector
-	pipelineSvc   *mocks.MockpipelineGetter
-	versionGetter *mocks.MockversionGetter
+	storeSvc       *mocks.Mockstore
+	sel            *mocks.MockappSelector
+	pipelineSvc    *mocks.MockpipelineGetter
+	versionGetter  *mocks.MockversionGetter
+	stackInstances *mocks.MockstackInstanceLister
 }
 
 func TestShowAppOpts_Validate(t *testing.T) {
@@ -159,7 +161,8 @@ func TestShowAppOpts_Execute(t *testing.T) {
 	testAppName := "my-app"
 	testError := errors.New("some error")
 	testCases := map[string]struct {
-		shouldOutputJSON bool
+		shouldOutputJSON          bool
+		shouldShowAccountsRegions bool
 
 		setupMocks func(mocks showAppMocks)
 
@@ -203,7 +206,7 @@ func TestShowAppOpts_Execute(t *testing.T) {
 				m.versionGetter.EXPECT().Version().Return("v0.0.0", nil)
 			},
 
-			wantedContent: "{\"name\":\"my-app\",\"version\":\"v0.0.0\",\"uri\":\"example.com\",\"environments\":[{\"app\":\"\",\"name\":\"test\",\"region\":\"us-west-2\",\"accountID\":\"123456789\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"},{\"app\":\"\",\"name\":\"prod\",\"region\":\"us-west-1\",\"accountID\":\"123456789\",\"prod\":true,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"}],\"services\":[{\"app\":\"\",\"name\":\"my-svc\",\"type\":\"lb-web-svc\"}],\"pipelines\":[{\"name\":\"pipeline1\",\"region\":\"\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"},{\"name\":\"pipeline2\",\"region\":\"\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"}]}\n",
+			wantedContent: "{\"schemaVersion\":1,\"name\":\"my-app\",\"version\":\"v0.0.0\",\"uri\":\"example.com\",\"environments\":[{\"app\":\"\",\"name\":\"test\",\"region\":\"us-west-2\",\"accountID\":\"123456789\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"},{\"app\":\"\",\"name\":\"prod\",\"region\":\"us-west-1\",\"accountID\":\"123456789\",\"prod\":true,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"}],\"services\":[{\"app\":\"\",\"name\":\"my-svc\",\"type\":\"lb-web-svc\"}],\"pipelines\":[{\"name\":\"pipeline1\",\"region\":\"\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"},{\"name\":\"pipeline2\",\"region\":\"\",\"accountId\":\"\",\"stages\":null,\"createdAt\":\"0001-01-01T00:00:00Z\",\"updatedAt\":\"0001-01-01T00:00:00Z\"}]}\n",
 		},
 		"correctly shows human output": {
 			setupMocks: func(m showAppMocks) {
@@ -325,6 +328,91 @@ Pipelines
   pipeline2
 `,
 		},
+		"correctly shows human output with accounts and regions": {
+			shouldShowAccountsRegions: true,
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:   "my-app",
+					Domain: "example.com",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return([]*config.Workload{
+					{
+						Name: "my-svc",
+						Type: "lb-web-svc",
+					},
+				}, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{
+					{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789",
+					},
+				}, nil)
+				m.pipelineSvc.EXPECT().
+					GetPipelinesByTags(gomock.Eq(map[string]string{"copilot-application": "my-app"})).
+					Return(nil, nil)
+				m.versionGetter.EXPECT().Version().Return(deploy.LatestAppTemplateVersion, nil)
+				m.stackInstances.EXPECT().InstanceSummaries("my-app-infrastructure").Return([]stackset.InstanceSummary{
+					{
+						Account: "123456789",
+						Region:  "us-west-2",
+						Status:  "CURRENT",
+					},
+					{
+						Account: "123456789",
+						Region:  "us-east-1",
+						Status:  "OUTDATED",
+					},
+				}, nil)
+			},
+
+			wantedContent: `About
+
+  Name              my-app
+  Version           v1.0.2 
+  URI               example.com
+
+Environments
+
+  Name              AccountID           Region
+  ----              ---------           ------
+  test              123456789           us-west-2
+
+Services
+
+  Name              Type
+  ----              ----
+  my-svc            lb-web-svc
+
+Pipelines
+
+  Name
+  ----
+
+Accounts and Regions
+
+  Account           Region              Status
+  -------           ------              ------
+  123456789         us-west-2           CURRENT
+  123456789         us-east-1           OUTDATED
+`,
+		},
+		"returns error if fail to list stack instances": {
+			shouldShowAccountsRegions: true,
+			setupMocks: func(m showAppMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name: "my-app",
+				}, nil)
+				m.storeSvc.EXPECT().ListServices("my-app").Return(nil, nil)
+				m.storeSvc.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+				m.pipelineSvc.EXPECT().
+					GetPipelinesByTags(gomock.Eq(map[string]string{"copilot-application": "my-app"})).
+					Return(nil, nil)
+				m.versionGetter.EXPECT().Version().Return(deploy.LatestAppTemplateVersion, nil)
+				m.stackInstances.EXPECT().InstanceSummaries("my-app-infrastructure").Return(nil, testError)
+			},
+			wantedError: fmt.Errorf("list stack instances for application my-app: %w", testError),
+		},
 		"returns error if fail to get application": {
 			shouldOutputJSON: false,
 
@@ -449,22 +537,26 @@ Pipelines
 			mockStoreReader := mocks.NewMockstore(ctrl)
 			mockPLSvc := mocks.NewMockpipelineGetter(ctrl)
 			mockVersionGetter := mocks.NewMockversionGetter(ctrl)
+			mockStackInstances := mocks.NewMockstackInstanceLister(ctrl)
 
 			mocks := showAppMocks{
-				storeSvc:      mockStoreReader,
-				pipelineSvc:   mockPLSvc,
-				versionGetter: mockVersionGetter,
+				storeSvc:       mockStoreReader,
+				pipelineSvc:    mockPLSvc,
+				versionGetter:  mockVersionGetter,
+				stackInstances: mockStackInstances,
 			}
 			tc.setupMocks(mocks)
 
 			opts := &showAppOpts{
 				showAppVars: showAppVars{
-					shouldOutputJSON: tc.shouldOutputJSON,
-					name:             testAppName,
+					shouldOutputJSON:          tc.shouldOutputJSON,
+					shouldShowAccountsRegions: tc.shouldShowAccountsRegions,
+					name:                      testAppName,
 				},
-				store:       mockStoreReader,
-				w:           b,
-				pipelineSvc: mockPLSvc,
+				store:          mockStoreReader,
+				w:              b,
+				pipelineSvc:    mockPLSvc,
+				stackInstances: mockStackInstances,
 				newVersionGetter: func(s string) (versionGetter, error) {
 					return mockVersionGetter, nil
 				},
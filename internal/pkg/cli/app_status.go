@@ -0,0 +1,131 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appStatusNamePrompt     = "Which application's status would you like to show?"
+	appStatusNameHelpPrompt = "Displays the states of the alarms across all services and environments of the application."
+)
+
+type statusAppVars struct {
+	name             string
+	shouldOutputJSON bool
+}
+
+type statusAppOpts struct {
+	statusAppVars
+
+	w                   io.Writer
+	store               store
+	sel                 appSelector
+	statusDescriber     statusDescriber
+	initStatusDescriber func(*statusAppOpts) error // Overridden in tests.
+}
+
+func newStatusAppOpts(vars statusAppVars) (*statusAppOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	return &statusAppOpts{
+		statusAppVars: vars,
+		store:         store,
+		w:             log.OutputWriter,
+		sel:           selector.NewSelect(prompt.New(), store),
+		initStatusDescriber: func(o *statusAppOpts) error {
+			d, err := describe.NewAppStatusDescriber(o.name, store)
+			if err != nil {
+				return fmt.Errorf("creating status describer for application %s: %w", o.name, err)
+			}
+			o.statusDescriber = d
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *statusAppOpts) Validate() error {
+	if o.name != "" {
+		if _, err := o.store.GetApplication(o.name); err != nil {
+			return fmt.Errorf("get application %s: %w", o.name, err)
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *statusAppOpts) Ask() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.sel.Application(appStatusNamePrompt, appStatusNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute displays the rollup of the alarm states of the application.
+func (o *statusAppOpts) Execute() error {
+	if err := o.initStatusDescriber(o); err != nil {
+		return err
+	}
+	appStatus, err := o.statusDescriber.Describe()
+	if err != nil {
+		return fmt.Errorf("describe status of application %s: %w", o.name, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := appStatus.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	} else {
+		fmt.Fprint(o.w, appStatus.HumanString())
+	}
+	return nil
+}
+
+// buildAppStatusCmd builds the command for showing the alarm states of an application.
+func buildAppStatusCmd() *cobra.Command {
+	vars := statusAppVars{}
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Shows the alarm states of an application.",
+		Long:  "Shows a rollup of the alarm states across all services and environments of an application.",
+		Example: `
+  Shows the alarm states of the application "my-app"
+  /code $ copilot app status -n my-app`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newStatusAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	markPromptedFlags(cmd, nameFlag)
+	return cmd
+}
@@ -0,0 +1,196 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppStatus_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp   string
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"errors if the application does not exist": {
+			inputApp: "my-app",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("get application my-app: some error"),
+		},
+		"success": {
+			inputApp: "my-app",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name: "my-app",
+				}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+
+			opts := &statusAppOpts{
+				statusAppVars: statusAppVars{
+					name: tc.inputApp,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAppStatus_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp   string
+		setupMocks func(m *mocks.MockappSelector)
+
+		wantedApp   string
+		wantedError error
+	}{
+		"does not prompt if the application is provided": {
+			inputApp:   "my-app",
+			setupMocks: func(m *mocks.MockappSelector) {},
+
+			wantedApp: "my-app",
+		},
+		"errors if failed to select the application": {
+			setupMocks: func(m *mocks.MockappSelector) {
+				m.EXPECT().Application(appStatusNamePrompt, appStatusNameHelpPrompt).Return("", errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("select application: some error"),
+		},
+		"prompts for the application": {
+			setupMocks: func(m *mocks.MockappSelector) {
+				m.EXPECT().Application(appStatusNamePrompt, appStatusNameHelpPrompt).Return("my-app", nil)
+			},
+
+			wantedApp: "my-app",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSel := mocks.NewMockappSelector(ctrl)
+			tc.setupMocks(mockSel)
+
+			opts := &statusAppOpts{
+				statusAppVars: statusAppVars{
+					name: tc.inputApp,
+				},
+				sel: mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, opts.name)
+			}
+		})
+	}
+}
+
+func TestAppStatus_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		shouldOutputJSON bool
+		setupMocks       func(m *mocks.MockstatusDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if failed to describe the status of the application": {
+			setupMocks: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("describe status of application my-app: some error"),
+		},
+		"success with human output": {
+			setupMocks: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(&mockDescribeData{data: "mockHuman"}, nil)
+			},
+
+			wantedContent: "mockHuman",
+		},
+		"success with JSON output": {
+			shouldOutputJSON: true,
+			setupMocks: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(&mockDescribeData{data: "mockJSON"}, nil)
+			},
+
+			wantedContent: "mockJSON",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockDescriber := mocks.NewMockstatusDescriber(ctrl)
+			tc.setupMocks(mockDescriber)
+
+			opts := &statusAppOpts{
+				statusAppVars: statusAppVars{
+					name:             "my-app",
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				statusDescriber:     mockDescriber,
+				initStatusDescriber: func(*statusAppOpts) error { return nil },
+				w:                   b,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
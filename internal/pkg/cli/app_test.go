@@ -0,0 +1,66 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppStackSetOpts(t *testing.T) {
+	testCases := map[string]struct {
+		inEnv map[string]string
+
+		wantedOptsCount int
+		wantedErr       error
+	}{
+		"no options if the environment variables are not set": {},
+		"options for both environment variables": {
+			inEnv: map[string]string{
+				"COPILOT_STACKSET_MAX_CONCURRENT_PERCENTAGE":    "50",
+				"COPILOT_STACKSET_FAILURE_TOLERANCE_PERCENTAGE": "0",
+			},
+			wantedOptsCount: 2,
+		},
+		"error if the max concurrent percentage is not an integer": {
+			inEnv: map[string]string{
+				"COPILOT_STACKSET_MAX_CONCURRENT_PERCENTAGE": "half",
+			},
+			wantedErr: errors.New(`environment variable COPILOT_STACKSET_MAX_CONCURRENT_PERCENTAGE must be an integer between 1 and 100, got "half"`),
+		},
+		"error if the max concurrent percentage is 0": {
+			inEnv: map[string]string{
+				"COPILOT_STACKSET_MAX_CONCURRENT_PERCENTAGE": "0",
+			},
+			wantedErr: errors.New(`environment variable COPILOT_STACKSET_MAX_CONCURRENT_PERCENTAGE must be an integer between 1 and 100, got "0"`),
+		},
+		"error if the failure tolerance percentage is above 100": {
+			inEnv: map[string]string{
+				"COPILOT_STACKSET_FAILURE_TOLERANCE_PERCENTAGE": "101",
+			},
+			wantedErr: errors.New(`environment variable COPILOT_STACKSET_FAILURE_TOLERANCE_PERCENTAGE must be an integer between 0 and 100, got "101"`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			getEnv = func(key string) string {
+				return tc.inEnv[key]
+			}
+			defer func() { getEnv = os.Getenv }()
+
+			opts, err := appStackSetOpts()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Len(t, opts, tc.wantedOptsCount)
+			}
+		})
+	}
+}
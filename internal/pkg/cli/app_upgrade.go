@@ -11,7 +11,6 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
-	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -63,6 +62,10 @@ func newAppUpgradeOpts(vars appUpgradeVars) (*appUpgradeOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new app describer for application %s: %v", vars.name, err)
 	}
+	upgrader, err := newAppCloudFormation(sess)
+	if err != nil {
+		return nil, err
+	}
 	return &appUpgradeOpts{
 		appUpgradeVars: vars,
 		store:          store,
@@ -71,7 +74,7 @@ func newAppUpgradeOpts(vars appUpgradeVars) (*appUpgradeOpts, error) {
 		route53:        route53.New(sess),
 		sel:            selector.NewSelect(prompt.New(), store),
 		versionGetter:  d,
-		upgrader:       cloudformation.New(sess),
+		upgrader:       upgrader,
 	}, nil
 }
 
@@ -208,5 +211,6 @@ func buildAppUpgradeCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	markPromptedFlags(cmd, nameFlag)
 	return cmd
 }
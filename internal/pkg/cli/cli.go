@@ -7,18 +7,42 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
+// Output formats for the --output flag.
+const (
+	outputFormatJSON = "json"
+	outputFormatCSV  = "csv"
+)
+
+// listOutputFormats are the valid values of the --output flag for list commands.
+var listOutputFormats = []string{outputFormatCSV}
+
+// versionOutputFormats are the valid values of the --output flag for the version command.
+var versionOutputFormats = []string{outputFormatJSON}
+
 const (
 	svcAppNamePrompt     = "Which application does your service belong to?"
 	svcAppNameHelpPrompt = "An application groups all of your services and jobs together."
+
+	// fmtAppRunnerSvcStatus reports the status of an App Runner service once it's paused or resumed.
+	fmtAppRunnerSvcStatus = "Service %s in environment %s is %s.\n"
 )
 
 // tryReadingAppName retrieves the application's name from the workspace if it exists and returns it.
@@ -57,14 +81,164 @@ func reservedArgs(cmd *cobra.Command, args []string) error {
 
 // runCmdE wraps one of the run error methods, PreRunE, RunE, of a cobra command so that if a user
 // types "help" in the arguments the usage string is printed instead of running the command.
+// If the command fails because it needs to prompt while prompts are disabled, the error lists the flags to provide instead.
 func runCmdE(f func(cmd *cobra.Command, args []string) error) func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) error {
 		if len(args) == 1 && args[0] == "help" {
 			_ = cmd.Help() // Help always returns nil.
 			os.Exit(0)
 		}
-		return f(cmd, args)
+		log.Verbosef("Running %s\n", cmd.CommandPath())
+		start := time.Now()
+		err := f(cmd, args)
+		log.Verbosef("%s finished in %s\n", cmd.CommandPath(), time.Since(start).Round(time.Millisecond))
+		var errNoPrompt *prompt.ErrNoPrompt
+		if !errors.As(err, &errNoPrompt) {
+			return err
+		}
+		if missing := missingPromptedFlags(cmd); len(missing) > 0 {
+			return &errMissingRequiredFlags{flags: missing}
+		}
+		return err
+	}
+}
+
+// AddNoPromptFlag adds the --no-prompt flag to the root command so that it's available to all commands.
+func AddNoPromptFlag(rootCmd *cobra.Command) {
+	rootCmd.PersistentFlags().Bool(noPromptFlag, false, noPromptFlagDescription)
+}
+
+// DisablePromptsIfNonInteractive disables prompts if the --no-prompt flag is set or if the standard input is not a terminal.
+func DisablePromptsIfNonInteractive(cmd *cobra.Command) {
+	noPrompt, _ := cmd.Flags().GetBool(noPromptFlag)
+	if noPrompt || !isTerminal(os.Stdin) {
+		prompt.Disable()
+	}
+}
+
+// AddDebugFlag adds the --debug flag to the root command so that it's available to all commands.
+func AddDebugFlag(rootCmd *cobra.Command) {
+	rootCmd.PersistentFlags().Bool(debugFlag, false, debugFlagDescription)
+}
+
+// EnableDebugLogsIfRequested logs the AWS requests and the steps of the command if the --debug flag is set.
+func EnableDebugLogsIfRequested(cmd *cobra.Command) {
+	if debug, _ := cmd.Flags().GetBool(debugFlag); !debug {
+		return
+	}
+	log.EnableVerbose()
+	sessions.EnableDebugLogging()
+}
+
+// AddFIPSFlag adds the --fips flag to the root command so that it's available to all commands.
+func AddFIPSFlag(rootCmd *cobra.Command) {
+	rootCmd.PersistentFlags().Bool(fipsFlag, false, fipsFlagDescription)
+}
+
+// EnableFIPSIfRequested sends the AWS requests to FIPS endpoints if the --fips flag is set.
+func EnableFIPSIfRequested(cmd *cobra.Command) {
+	if fips, _ := cmd.Flags().GetBool(fipsFlag); fips {
+		sessions.EnableFIPS()
+	}
+}
+
+// LogAPICallSummary writes the number of AWS API calls and their total latency per service to standard error
+// if the --debug flag is set, so that users can tell where a slow command spends its time.
+func LogAPICallSummary() {
+	log.Verbosef("%s", apiCallSummary(sessions.APICallSummary()))
+}
+
+// apiCallSummary returns a table of the AWS API calls per service.
+func apiCallSummary(stats []sessions.APICallStats) string {
+	if len(stats) == 0 {
+		return "No AWS API calls were made.\n"
+	}
+	var b strings.Builder
+	b.WriteString("\nAWS API calls\n")
+	w := tabwriter.NewWriter(&b, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "  %s\t%s\t%s\n", "Service", "Calls", "Latency")
+	var total sessions.APICallStats
+	for _, s := range stats {
+		fmt.Fprintf(w, "  %s\t%d\t%s\n", s.Service, s.Calls, s.Latency.Round(time.Millisecond))
+		total.Calls += s.Calls
+		total.Latency += s.Latency
+	}
+	fmt.Fprintf(w, "  %s\t%d\t%s\n", "Total", total.Calls, total.Latency.Round(time.Millisecond))
+	w.Flush()
+	return b.String()
+}
+
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// promptedFlagAnnotation marks the flags that a command prompts for if they're not provided.
+// The value of the annotation lists alternative flags that make the prompt unnecessary.
+const promptedFlagAnnotation = "copilot_prompted_flag"
+
+// markPromptedFlags marks flags that the command prompts for if they're not provided.
+func markPromptedFlags(cmd *cobra.Command, flags ...string) {
+	for _, flag := range flags {
+		markPromptedFlag(cmd, flag)
+	}
+}
+
+// markPromptedFlag marks a flag that the command prompts for if neither it nor any of its alternatives are provided.
+func markPromptedFlag(cmd *cobra.Command, flag string, alternatives ...string) {
+	_ = cmd.Flags().SetAnnotation(flag, promptedFlagAnnotation, alternatives)
+}
+
+// missingPromptedFlags returns the flags that the command prompts for and that weren't provided.
+func missingPromptedFlags(cmd *cobra.Command) []string {
+	var missing []string
+	cmd.Flags().VisitAll(func(f *pflag.Flag) {
+		alternatives, ok := f.Annotations[promptedFlagAnnotation]
+		if !ok || isFlagProvided(cmd, f.Name) {
+			return
+		}
+		for _, alt := range alternatives {
+			if isFlagProvided(cmd, alt) {
+				return
+			}
+		}
+		names := []string{"--" + f.Name}
+		for _, alt := range alternatives {
+			names = append(names, "--"+alt)
+		}
+		missing = append(missing, strings.Join(names, " or "))
+	})
+	return missing
+}
+
+// isFlagProvided returns true if the flag was set by the user or has a non-empty default value, such as the application of the workspace.
+func isFlagProvided(cmd *cobra.Command, name string) bool {
+	f := cmd.Flags().Lookup(name)
+	if f == nil {
+		return false
+	}
+	if f.Changed {
+		return true
+	}
+	switch f.Value.String() {
+	case "", "false", "0", "0s", "[]", "<nil>":
+		return false
 	}
+	return true
+}
+
+type errMissingRequiredFlags struct {
+	flags []string
+}
+
+func (e *errMissingRequiredFlags) Error() string {
+	if len(e.flags) == 1 {
+		return fmt.Sprintf("missing required flag %s: it must be provided when prompts are disabled", e.flags[0])
+	}
+	return fmt.Sprintf("missing required flags %s: they must be provided when prompts are disabled", strings.Join(e.flags, ", "))
 }
 
 // returns true if error type is stack set not exist.
@@ -94,3 +268,25 @@ func relPath(fullPath string) (string, error) {
 	}
 	return path, nil
 }
+
+// parseFormatTemplate parses the Go template passed to the --format flag.
+func parseFormatTemplate(format string) (*template.Template, error) {
+	tmpl, err := template.New(formatFlag).Parse(format)
+	if err != nil {
+		return nil, fmt.Errorf("parse --%s template: %w", formatFlag, err)
+	}
+	return tmpl, nil
+}
+
+// writeFormatted executes the Go template passed to the --format flag against data and writes the result to w.
+func writeFormatted(w io.Writer, format string, data interface{}) error {
+	tmpl, err := parseFormatTemplate(format)
+	if err != nil {
+		return err
+	}
+	if err := tmpl.Execute(w, data); err != nil {
+		return fmt.Errorf("execute --%s template: %w", formatFlag, err)
+	}
+	fmt.Fprintln(w)
+	return nil
+}
@@ -0,0 +1,111 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunCmdE_NoPrompt(t *testing.T) {
+	errNoPrompt := fmt.Errorf("select environment: %w", &prompt.ErrNoPrompt{Message: "Which environment?"})
+	testCases := map[string]struct {
+		inArgs       []string
+		inDefaultApp string
+		inErr        error
+
+		wantedErr error
+	}{
+		"returns other errors as is": {
+			inErr:     errors.New("some error"),
+			wantedErr: errors.New("some error"),
+		},
+		"lists a missing flag": {
+			inArgs:    []string{"--app", "phonetool", "--name", "frontend", "--yes"},
+			inErr:     errNoPrompt,
+			wantedErr: errors.New("missing required flag --env: it must be provided when prompts are disabled"),
+		},
+		"lists all missing flags with their alternatives": {
+			inErr:     errNoPrompt,
+			wantedErr: errors.New("missing required flags --app, --env, --name or --image, --yes: they must be provided when prompts are disabled"),
+		},
+		"considers flags with a default value as provided": {
+			inArgs:       []string{"--image", "nginx", "--env", "test"},
+			inDefaultApp: "phonetool",
+			inErr:        errNoPrompt,
+			wantedErr:    errors.New("missing required flag --yes: it must be provided when prompts are disabled"),
+		},
+		"returns the prompt error if all prompted flags are provided": {
+			inArgs:    []string{"--app", "phonetool", "--env", "test", "--image", "nginx", "--yes"},
+			inErr:     errNoPrompt,
+			wantedErr: errNoPrompt,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			cmd := &cobra.Command{
+				Use: "test",
+				RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+					return tc.inErr
+				}),
+				SilenceErrors: true,
+				SilenceUsage:  true,
+			}
+			cmd.Flags().String(appFlag, tc.inDefaultApp, "")
+			cmd.Flags().String(envFlag, "", "")
+			cmd.Flags().String(nameFlag, "", "")
+			cmd.Flags().String(imageFlag, "", "")
+			cmd.Flags().Bool(yesFlag, false, "")
+			markPromptedFlags(cmd, appFlag, envFlag, yesFlag)
+			markPromptedFlag(cmd, nameFlag, imageFlag)
+			cmd.SetArgs(tc.inArgs)
+
+			// WHEN
+			err := cmd.Execute()
+
+			// THEN
+			require.EqualError(t, err, tc.wantedErr.Error())
+		})
+	}
+}
+
+func TestAPICallSummary(t *testing.T) {
+	testCases := map[string]struct {
+		inStats []sessions.APICallStats
+
+		wanted string
+	}{
+		"no calls": {
+			wanted: "No AWS API calls were made.\n",
+		},
+		"totals the calls of each service": {
+			inStats: []sessions.APICallStats{
+				{Service: "cloudformation", Calls: 3, Latency: 2*time.Second + 400*time.Microsecond},
+				{Service: "ecs", Calls: 2, Latency: 350 * time.Millisecond},
+			},
+			wanted: `
+AWS API calls
+  Service         Calls  Latency
+  cloudformation  3      2s
+  ecs             2      350ms
+  Total           5      2.35s
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, apiCallSummary(tc.inStats))
+		})
+	}
+}
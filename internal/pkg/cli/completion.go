@@ -12,6 +12,7 @@ import (
 
 	"github.com/aws/copilot-cli/cmd/copilot/template"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 )
 
 type shellCompleter interface {
@@ -103,3 +104,155 @@ The code must be evaluated to provide interactive completion of commands.`,
 	}
 	return cmd
 }
+
+// completionFunc returns the dynamic completions of a flag.
+type completionFunc func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
+
+// nameCompleter completes the names of applications, environments, services, and jobs from the config store.
+type nameCompleter struct {
+	newStore func() (store, error) // The store is only created when a completion is requested.
+}
+
+// RegisterNameCompletions registers functions that complete the names of applications, environments, services, and jobs
+// for the flags of the root command and all of its subcommands.
+func RegisterNameCompletions(rootCmd *cobra.Command) {
+	c := &nameCompleter{
+		newStore: func() (store, error) {
+			return config.NewStore()
+		},
+	}
+	c.register(rootCmd)
+}
+
+func (c *nameCompleter) register(cmd *cobra.Command) {
+	for flag, fn := range c.flagCompletions(cmd) {
+		if cmd.Flags().Lookup(flag) == nil {
+			continue
+		}
+		// RegisterFlagCompletionFunc only errors if the flag already has a completion function, which we keep.
+		_ = cmd.RegisterFlagCompletionFunc(flag, fn)
+	}
+	for _, sub := range cmd.Commands() {
+		c.register(sub)
+	}
+}
+
+// flagCompletions returns the completion functions for the flags of cmd, keyed by flag name.
+func (c *nameCompleter) flagCompletions(cmd *cobra.Command) map[string]completionFunc {
+	completions := map[string]completionFunc{
+		appFlag:      c.apps,
+		envFlag:      c.envs,
+		workloadFlag: c.workloads,
+	}
+	if cmd.Name() == "init" {
+		// The --name flag of init commands is the name of a new resource.
+		return completions
+	}
+	parent := cmd.Parent()
+	if parent == nil {
+		return completions
+	}
+	switch parent.Name() {
+	case "app":
+		completions[nameFlag] = c.apps
+	case "env":
+		completions[nameFlag] = c.envs
+	case "svc":
+		completions[nameFlag] = c.svcs
+	case "job":
+		completions[nameFlag] = c.jobs
+	}
+	if !parent.HasParent() && cmd.Name() == "deploy" {
+		completions[nameFlag] = c.workloads
+	}
+	return completions
+}
+
+func (c *nameCompleter) apps(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	return c.complete(func(s store) ([]string, error) {
+		apps, err := s.ListApplications()
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, app := range apps {
+			names = append(names, app.Name)
+		}
+		return names, nil
+	})
+}
+
+func (c *nameCompleter) envs(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	app := completionAppName(cmd)
+	if app == "" {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return c.complete(func(s store) ([]string, error) {
+		envs, err := s.ListEnvironments(app)
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, env := range envs {
+			names = append(names, env.Name)
+		}
+		return names, nil
+	})
+}
+
+func (c *nameCompleter) svcs(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	return c.completeWorkloads(cmd, func(s store, app string) ([]*config.Workload, error) {
+		return s.ListServices(app)
+	})
+}
+
+func (c *nameCompleter) jobs(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	return c.completeWorkloads(cmd, func(s store, app string) ([]*config.Workload, error) {
+		return s.ListJobs(app)
+	})
+}
+
+func (c *nameCompleter) workloads(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	return c.completeWorkloads(cmd, func(s store, app string) ([]*config.Workload, error) {
+		return s.ListWorkloads(app)
+	})
+}
+
+func (c *nameCompleter) completeWorkloads(cmd *cobra.Command, list func(s store, app string) ([]*config.Workload, error)) ([]string, cobra.ShellCompDirective) {
+	app := completionAppName(cmd)
+	if app == "" {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return c.complete(func(s store) ([]string, error) {
+		wklds, err := list(s, app)
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, wkld := range wklds {
+			names = append(names, wkld.Name)
+		}
+		return names, nil
+	})
+}
+
+func (c *nameCompleter) complete(list func(s store) ([]string, error)) ([]string, cobra.ShellCompDirective) {
+	s, err := c.newStore()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	names, err := list(s)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completionAppName returns the value of the --app flag, which defaults to the application of the workspace.
+func completionAppName(cmd *cobra.Command) string {
+	app, err := cmd.Flags().GetString(appFlag)
+	if err != nil {
+		return ""
+	}
+	return app
+}
@@ -4,11 +4,14 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 )
 
@@ -100,3 +103,109 @@ func TestCompletionOpts_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestNameCompleter_Register(t *testing.T) {
+	noop := func(cmd *cobra.Command, args []string) {}
+	testCases := map[string]struct {
+		inArgs     []string
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedOutput string
+	}{
+		"completes application names": {
+			inArgs: []string{"svc", "deploy", "--app", ""},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().ListApplications().Return([]*config.Application{
+					{Name: "phonetool"},
+					{Name: "shoppingcart"},
+				}, nil)
+			},
+			wantedOutput: "phonetool\nshoppingcart\n:4\n",
+		},
+		"completes environment names of the application": {
+			inArgs: []string{"svc", "deploy", "--app", "phonetool", "--env", ""},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{Name: "test"},
+					{Name: "prod"},
+				}, nil)
+			},
+			wantedOutput: "test\nprod\n:4\n",
+		},
+		"completes service names of the application": {
+			inArgs: []string{"svc", "deploy", "--app", "phonetool", "--name", ""},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().ListServices("phonetool").Return([]*config.Workload{
+					{Name: "frontend"},
+					{Name: "backend"},
+				}, nil)
+			},
+			wantedOutput: "frontend\nbackend\n:4\n",
+		},
+		"completes job names of the application": {
+			inArgs: []string{"job", "deploy", "--app", "phonetool", "--name", ""},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().ListJobs("phonetool").Return([]*config.Workload{
+					{Name: "report"},
+				}, nil)
+			},
+			wantedOutput: "report\n:4\n",
+		},
+		"does not complete names without an application": {
+			inArgs:       []string{"svc", "deploy", "--name", ""},
+			setupMocks:   func(m *mocks.Mockstore) {},
+			wantedOutput: ":4\n",
+		},
+		"does not complete the name of a new service": {
+			inArgs:       []string{"svc", "init", "--app", "phonetool", "--name", ""},
+			setupMocks:   func(m *mocks.Mockstore) {},
+			wantedOutput: ":0\n",
+		},
+		"returns an error directive if the store fails": {
+			inArgs: []string{"svc", "deploy", "--app", ""},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().ListApplications().Return(nil, errors.New("some error"))
+			},
+			wantedOutput: ":1\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+
+			root := &cobra.Command{Use: "copilot"}
+			for _, parent := range []string{"svc", "job"} {
+				group := &cobra.Command{Use: parent}
+				for _, sub := range []string{"deploy", "init"} {
+					cmd := &cobra.Command{Use: sub, Run: noop}
+					cmd.Flags().String(appFlag, "", "")
+					cmd.Flags().String(envFlag, "", "")
+					cmd.Flags().String(nameFlag, "", "")
+					group.AddCommand(cmd)
+				}
+				root.AddCommand(group)
+			}
+			c := &nameCompleter{
+				newStore: func() (store, error) {
+					return mockStore, nil
+				},
+			}
+			c.register(root)
+			b := &bytes.Buffer{}
+			root.SetOut(b)
+			root.SetArgs(append([]string{cobra.ShellCompRequestCmd}, tc.inArgs...))
+
+			// WHEN
+			err := root.Execute()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
@@ -181,5 +181,6 @@ func BuildDeployCmd() *cobra.Command {
 	cmd.Annotations = map[string]string{
 		"group": group.Release,
 	}
+	markPromptedFlags(cmd, nameFlag, envFlag)
 	return cmd
 }
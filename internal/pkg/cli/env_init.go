@@ -9,11 +9,15 @@ import (
 	"net"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/acm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/profile"
@@ -29,6 +33,7 @@ import (
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
@@ -67,6 +72,10 @@ https://aws.github.io/copilot-cli/docs/credentials/#environment-credentials`
 	fmtAddEnvToAppStart      = "Linking account %s and region %s to application %s."
 	fmtAddEnvToAppFailed     = "Failed to link account %s and region %s to application %s.\n\n"
 	fmtAddEnvToAppComplete   = "Linked account %s and region %s to application %s.\n\n"
+
+	fmtEnvInitOverlappingEnvsWarning = `%s in application %s already %s account %s and region %s.
+Environments in the same account and region share its service quotas, such as the number of VPCs and Elastic IP addresses.
+`
 )
 
 var (
@@ -77,6 +86,22 @@ var (
 	envInitCustomizedEnvTypes             = []string{envInitDefaultConfigSelectOption, envInitAdjustEnvResourcesSelectOption, envInitImportEnvResourcesSelectOption}
 )
 
+// Supported number of availability zones for a Copilot-managed VPC.
+const (
+	defaultAZCount = 2
+	maxAZCount     = 3
+)
+
+// Capacity providers that services in an environment can use by default.
+var capacityProviderOptions = []string{ecs.TaskCapacityProviderFargate, ecs.TaskCapacityProviderFargateSpot}
+
+// Bounds for the idle timeout of an Application Load Balancer.
+// See https://docs.aws.amazon.com/elasticloadbalancing/latest/application/application-load-balancers.html#connection-idle-timeout
+const (
+	minIdleTimeout = 1 * time.Second
+	maxIdleTimeout = 4000 * time.Second
+)
+
 type importVPCVars struct {
 	ID               string
 	PublicSubnetIDs  []string
@@ -123,6 +148,18 @@ type initEnvVars struct {
 	importVPC importVPCVars // Existing VPC resources to use instead of creating new ones.
 	adjustVPC adjustVPCVars // Configure parameters for VPC resources generated while initializing an environment.
 
+	importClusterARN string // ARN of an existing ECS cluster to use instead of creating a new one.
+	capacityProvider string // Default capacity provider of services deployed to the environment.
+
+	azCount      int    // Number of availability zones to spread the Copilot-managed VPC across.
+	natGateways  string // Number of NAT gateways in the Copilot-managed VPC.
+	vpcEndpoints bool   // True means create VPC endpoints for workloads in private subnets without NAT gateways.
+
+	webACLARN      string        // ARN of the AWS WAF web ACL to associate with the environment's load balancer.
+	importCertARNs []string      // ARNs of existing ACM certificates for the load balancer's HTTPS listener.
+	httpsRedirect  bool          // True means the load balancer's HTTP listener redirects to HTTPS.
+	idleTimeout    time.Duration // Idle timeout of the environment's load balancers.
+
 	tempCreds tempCredsVars // Temporary credentials to initialize the environment. Mutually exclusive with the profile.
 	region    string        // The region to create the environment in.
 }
@@ -138,6 +175,8 @@ type initEnvOpts struct {
 	identity     identityService
 	envIdentity  identityService
 	ec2Client    ec2Client
+	ecsClient    importedClusterDescriber
+	acm          certificateValidator
 	iam          roleManager
 	cfn          stackExistChecker
 	prog         progress
@@ -167,12 +206,17 @@ func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
 		return nil, fmt.Errorf("read named profiles: %w", err)
 	}
 
+	appDeployer, err := newAppCloudFormation(defaultSession)
+	if err != nil {
+		return nil, err
+	}
+
 	prompter := prompt.New()
 	return &initEnvOpts{
 		initEnvVars:  vars,
 		sessProvider: sessProvider,
 		store:        store,
-		appDeployer:  deploycfn.New(defaultSession),
+		appDeployer:  appDeployer,
 		identity:     identity.New(defaultSession),
 		prog:         termprogress.NewSpinner(log.DiagnosticWriter),
 		prompt:       prompter,
@@ -205,6 +249,27 @@ func (o *initEnvOpts) Validate() error {
 	if err := o.validateCustomizedResources(); err != nil {
 		return err
 	}
+	if err := o.validateAZCount(); err != nil {
+		return err
+	}
+	if err := o.validateNATGateways(); err != nil {
+		return err
+	}
+	if err := o.validateImportClusterARN(); err != nil {
+		return err
+	}
+	if err := o.validateCapacityProvider(); err != nil {
+		return err
+	}
+	if err := o.validateWebACLARN(); err != nil {
+		return err
+	}
+	if err := o.validateCertificateARNs(); err != nil {
+		return err
+	}
+	if err := o.validateIdleTimeout(); err != nil {
+		return err
+	}
 	return o.validateCredentials()
 }
 
@@ -222,7 +287,17 @@ func (o *initEnvOpts) Ask() error {
 	if err := o.askEnvRegion(); err != nil {
 		return err
 	}
-	return o.askCustomizedResources()
+	if err := o.askCustomizedResources(); err != nil {
+		return err
+	}
+	// The VPC configuration might have been prompted, so validate the availability zones and NAT gateways against it again.
+	if err := o.validateAZCount(); err != nil {
+		return err
+	}
+	if err := o.validateNATGateways(); err != nil {
+		return err
+	}
+	return o.validateAvailableAZs()
 }
 
 // Execute deploys a new environment with CloudFormation and adds it to SSM.
@@ -233,11 +308,23 @@ func (o *initEnvOpts) Execute() error {
 		// Ensure the app actually exists before we do a deployment.
 		return err
 	}
+	if o.httpsRedirect && !app.RequiresDNSDelegation() && len(o.importCertARNs) == 0 {
+		return fmt.Errorf("--%s requires HTTPS: the application must have a domain or --%s must be specified", httpsRedirectFlag, importCertARNsFlag)
+	}
+	if err := o.validateCertificates(); err != nil {
+		return err
+	}
+	if err := o.validateImportedCluster(); err != nil {
+		return err
+	}
 
 	envCaller, err := o.envIdentity.Get()
 	if err != nil {
 		return fmt.Errorf("get identity: %w", err)
 	}
+	if err := o.warnOverlappingEnvs(envCaller.Account, aws.StringValue(o.sess.Config.Region)); err != nil {
+		return err
+	}
 
 	if app.RequiresDNSDelegation() {
 		if err := o.delegateDNSFromApp(app, envCaller.Account); err != nil {
@@ -289,7 +376,7 @@ func (o *initEnvOpts) Execute() error {
 		return fmt.Errorf("get environment struct for %s: %w", o.name, err)
 	}
 	env.Prod = o.isProduction
-	env.CustomConfig = config.NewCustomizeEnv(o.importVPCConfig(), o.adjustVPCConfig())
+	env.CustomConfig = o.customizeEnv()
 
 	// 6. Store the environment in SSM.
 	if err := o.store.CreateEnvironment(env); err != nil {
@@ -300,6 +387,32 @@ func (o *initEnvOpts) Execute() error {
 	return nil
 }
 
+// warnOverlappingEnvs warns if other environments in the application are already in the same account and region.
+func (o *initEnvOpts) warnOverlappingEnvs(account, region string) error {
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	var overlapping []string
+	for _, env := range envs {
+		if env.Name == o.name {
+			continue
+		}
+		if env.AccountID == account && env.Region == region {
+			overlapping = append(overlapping, color.HighlightUserInput(env.Name))
+		}
+	}
+	if len(overlapping) == 0 {
+		return nil
+	}
+	subject, verb := "Environment "+overlapping[0], "uses"
+	if len(overlapping) > 1 {
+		subject, verb = "Environments "+english.WordSeries(overlapping, "and"), "use"
+	}
+	log.Warningf(fmtEnvInitOverlappingEnvsWarning, subject, color.HighlightUserInput(o.appName), verb, account, region)
+	return nil
+}
+
 // RecommendedActions returns follow-up actions the user can take after successfully executing the command.
 func (o *initEnvOpts) RecommendedActions() []string {
 	return nil
@@ -319,6 +432,12 @@ func (o *initEnvOpts) initRuntimeClients() {
 	if o.iam == nil {
 		o.iam = iam.New(o.sess)
 	}
+	if o.acm == nil {
+		o.acm = acm.New(o.sess)
+	}
+	if o.ecsClient == nil {
+		o.ecsClient = ecs.New(o.sess)
+	}
 }
 
 func (o *initEnvOpts) validateCustomizedResources() error {
@@ -341,6 +460,190 @@ func (o *initEnvOpts) validateCustomizedResources() error {
 	return nil
 }
 
+// validateAZCount returns an error if the number of availability zones is unsupported or doesn't match the subnets.
+func (o *initEnvOpts) validateAZCount() error {
+	if o.azCount == 0 {
+		return nil
+	}
+	if o.azCount < defaultAZCount || o.azCount > maxAZCount {
+		return fmt.Errorf("--%s must be %d or %d", azCountFlag, defaultAZCount, maxAZCount)
+	}
+	if o.importVPC.isSet() {
+		return fmt.Errorf("cannot specify --%s when importing a vpc", azCountFlag)
+	}
+	// Subnets are spread across availability zones in order, one subnet per zone.
+	if n := len(o.adjustVPC.PublicSubnetCIDRs); n != 0 && n != o.azCount {
+		return fmt.Errorf("--%s %d requires %d public subnet CIDRs, but %d are configured", azCountFlag, o.azCount, o.azCount, n)
+	}
+	if n := len(o.adjustVPC.PrivateSubnetCIDRs); n != 0 && n != o.azCount {
+		return fmt.Errorf("--%s %d requires %d private subnet CIDRs, but %d are configured", azCountFlag, o.azCount, o.azCount, n)
+	}
+	return nil
+}
+
+// validateAvailableAZs returns an error if the environment's region doesn't have enough availability zones for the VPC.
+func (o *initEnvOpts) validateAvailableAZs() error {
+	if o.azCount == 0 {
+		return nil
+	}
+	if o.ec2Client == nil {
+		o.ec2Client = ec2.New(o.sess)
+	}
+	zones, err := o.ec2Client.ListAvailabilityZones()
+	if err != nil {
+		return fmt.Errorf("list availability zones: %w", err)
+	}
+	if len(zones) < o.azCount {
+		return fmt.Errorf("--%s %d exceeds the %d availability zones available in region %s", azCountFlag, o.azCount, len(zones), aws.StringValue(o.sess.Config.Region))
+	}
+	return nil
+}
+
+// validateNATGateways returns an error if the NAT gateway or VPC endpoint configuration is invalid, or if the NAT gateways can't be placed in the VPC.
+// Each NAT gateway is created in the public subnet of the same availability zone as its private subnet.
+func (o *initEnvOpts) validateNATGateways() error {
+	if o.vpcEndpoints && o.natGateways != template.NATGatewaysNone {
+		return fmt.Errorf(`--%s requires --%s "%s"`, vpcEndpointsFlag, natGatewaysFlag, template.NATGatewaysNone)
+	}
+	if o.natGateways == "" {
+		return nil
+	}
+	if !contains(o.natGateways, template.NATGatewaysOptions) {
+		return fmt.Errorf("invalid --%s %s: must be one of %s", natGatewaysFlag, o.natGateways, prettify(template.NATGatewaysOptions))
+	}
+	if o.importVPC.isSet() {
+		return fmt.Errorf("cannot specify --%s when importing a vpc", natGatewaysFlag)
+	}
+	if o.natGateways != template.NATGatewaysPerAZ || o.adjustVPC.PublicSubnetCIDRs == nil || o.adjustVPC.PrivateSubnetCIDRs == nil {
+		return nil
+	}
+	// Subnets are spread across availability zones in order, one subnet per zone.
+	if publicAZs, privateAZs := len(o.adjustVPC.PublicSubnetCIDRs), len(o.adjustVPC.PrivateSubnetCIDRs); publicAZs < privateAZs {
+		return fmt.Errorf(`--%s "%s" requires a public subnet in each of the %d availability zones with a private subnet, but only %d public subnets are configured`,
+			natGatewaysFlag, template.NATGatewaysPerAZ, privateAZs, publicAZs)
+	}
+	return nil
+}
+
+func (o *initEnvOpts) validateImportClusterARN() error {
+	if o.importClusterARN == "" {
+		return nil
+	}
+	parsed, err := arn.Parse(o.importClusterARN)
+	if err != nil {
+		return fmt.Errorf("parse --%s %s: %w", importClusterFlag, o.importClusterARN, err)
+	}
+	if parsed.Service != "ecs" || !strings.HasPrefix(parsed.Resource, "cluster/") {
+		return fmt.Errorf("--%s %s must be the ARN of an ECS cluster", importClusterFlag, o.importClusterARN)
+	}
+	return nil
+}
+
+func (o *initEnvOpts) validateCapacityProvider() error {
+	if o.capacityProvider == "" {
+		return nil
+	}
+	if !contains(o.capacityProvider, capacityProviderOptions) {
+		return fmt.Errorf("invalid --%s %s: must be one of %s", capacityProviderFlag, o.capacityProvider, prettify(capacityProviderOptions))
+	}
+	if o.isProduction && o.capacityProvider == ecs.TaskCapacityProviderFargateSpot {
+		return fmt.Errorf("cannot specify --%s %s with --%s: Fargate Spot tasks can be interrupted", capacityProviderFlag, o.capacityProvider, prodEnvFlag)
+	}
+	return nil
+}
+
+func (o *initEnvOpts) validateWebACLARN() error {
+	if o.webACLARN == "" {
+		return nil
+	}
+	parsed, err := arn.Parse(o.webACLARN)
+	if err != nil {
+		return fmt.Errorf("parse --%s %s: %w", webACLARNFlag, o.webACLARN, err)
+	}
+	if parsed.Service != "wafv2" || !strings.HasPrefix(parsed.Resource, "regional/webacl/") {
+		return fmt.Errorf("--%s %s must be the ARN of a regional AWS WAF web ACL", webACLARNFlag, o.webACLARN)
+	}
+	return nil
+}
+
+func (o *initEnvOpts) validateCertificateARNs() error {
+	for _, certARN := range o.importCertARNs {
+		parsed, err := arn.Parse(certARN)
+		if err != nil {
+			return fmt.Errorf("parse --%s %s: %w", importCertARNsFlag, certARN, err)
+		}
+		if parsed.Service != "acm" || !strings.HasPrefix(parsed.Resource, "certificate/") {
+			return fmt.Errorf("--%s %s must be the ARN of an ACM certificate", importCertARNsFlag, certARN)
+		}
+	}
+	return nil
+}
+
+func (o *initEnvOpts) validateIdleTimeout() error {
+	if o.idleTimeout == 0 {
+		return nil
+	}
+	if o.idleTimeout < minIdleTimeout || o.idleTimeout > maxIdleTimeout {
+		return fmt.Errorf("--%s must be between %ds and %ds", idleTimeoutFlag, int(minIdleTimeout.Seconds()), int(maxIdleTimeout.Seconds()))
+	}
+	return nil
+}
+
+// validateCertificates returns an error if the imported certificates don't exist in the environment's region.
+func (o *initEnvOpts) validateCertificates() error {
+	if len(o.importCertARNs) == 0 {
+		return nil
+	}
+	envRegion := aws.StringValue(o.sess.Config.Region)
+	for _, certARN := range o.importCertARNs {
+		parsed, err := arn.Parse(certARN)
+		if err != nil {
+			return fmt.Errorf("parse certificate ARN %s: %w", certARN, err)
+		}
+		if parsed.Region != envRegion {
+			return fmt.Errorf("certificate %s must be in the same region as the environment %s", certARN, envRegion)
+		}
+	}
+	if err := o.acm.ValidateCertificates(o.importCertARNs); err != nil {
+		return fmt.Errorf("validate imported certificates: %w", err)
+	}
+	return nil
+}
+
+// validateImportedCluster returns an error if the imported cluster isn't an active cluster in the environment's region
+// or if the cluster doesn't have the default capacity provider of the environment.
+func (o *initEnvOpts) validateImportedCluster() error {
+	if o.importClusterARN == "" {
+		return nil
+	}
+	parsed, err := arn.Parse(o.importClusterARN)
+	if err != nil {
+		return fmt.Errorf("parse cluster ARN %s: %w", o.importClusterARN, err)
+	}
+	envRegion := aws.StringValue(o.sess.Config.Region)
+	if parsed.Region != envRegion {
+		return fmt.Errorf("cluster %s must be in the same region as the environment %s", o.importClusterARN, envRegion)
+	}
+	active, err := o.ecsClient.ActiveCluster(o.importClusterARN)
+	if err != nil {
+		return fmt.Errorf("validate imported cluster: %w", err)
+	}
+	if !active {
+		return fmt.Errorf("cluster %s does not exist or is not active", o.importClusterARN)
+	}
+	if o.capacityProvider == "" {
+		return nil
+	}
+	providers, err := o.ecsClient.ClusterCapacityProviders(o.importClusterARN)
+	if err != nil {
+		return fmt.Errorf("get capacity providers of imported cluster: %w", err)
+	}
+	if !contains(o.capacityProvider, providers) {
+		return fmt.Errorf("--%s %s is not associated with cluster %s", capacityProviderFlag, o.capacityProvider, o.importClusterARN)
+	}
+	return nil
+}
+
 func (o *initEnvOpts) askAppName() error {
 	if o.appName != "" {
 		return nil
@@ -497,6 +800,40 @@ If you proceed without at least two public subnets, you will not be able to depl
 		}
 		o.importVPC.PrivateSubnetIDs = privateSubnets
 	}
+	return o.validateImportedSubnets()
+}
+
+// validateImportedSubnets returns an error if an imported subnet isn't in the imported VPC, or if the public subnets
+// don't have a subnet in every availability zone of the private subnets, where the load balancer routes traffic to.
+func (o *initEnvOpts) validateImportedSubnets() error {
+	subnets, err := o.ec2Client.ListVPCSubnets(o.importVPC.ID)
+	if err != nil {
+		return fmt.Errorf("list subnets of VPC %s: %w", o.importVPC.ID, err)
+	}
+	zoneOf := make(map[string]string)
+	for _, subnet := range append(subnets.Public, subnets.Private...) {
+		zoneOf[subnet.ID] = subnet.AvailabilityZone
+	}
+	publicZones := make(map[string]bool)
+	for _, id := range o.importVPC.PublicSubnetIDs {
+		zone, ok := zoneOf[id]
+		if !ok {
+			return fmt.Errorf("public subnet %s does not belong to VPC %s", id, o.importVPC.ID)
+		}
+		publicZones[zone] = true
+	}
+	if len(o.importVPC.PublicSubnetIDs) > 0 && len(publicZones) < 2 {
+		return errors.New("public subnets must be in at least two availability zones to enable Load Balancing")
+	}
+	for _, id := range o.importVPC.PrivateSubnetIDs {
+		zone, ok := zoneOf[id]
+		if !ok {
+			return fmt.Errorf("private subnet %s does not belong to VPC %s", id, o.importVPC.ID)
+		}
+		if len(publicZones) > 0 && !publicZones[zone] {
+			return fmt.Errorf("private subnet %s is in availability zone %s, which has no imported public subnet for the load balancer", id, zone)
+		}
+	}
 	return nil
 }
 
@@ -513,9 +850,10 @@ func (o *initEnvOpts) askAdjustResources() error {
 		}
 		o.adjustVPC.CIDR = *vpcCIDR
 	}
+	defaultPublicCIDRs, defaultPrivateCIDRs := defaultSubnetCIDRs(o.azCount)
 	if o.adjustVPC.PublicSubnetCIDRs == nil {
 		publicCIDR, err := o.prompt.Get(envInitPublicCIDRPrompt, envInitPublicCIDRPromptHelp, validateCIDRSlice,
-			prompt.WithDefaultInput(stack.DefaultPublicSubnetCIDRs))
+			prompt.WithDefaultInput(defaultPublicCIDRs))
 		if err != nil {
 			return fmt.Errorf("get public subnet CIDRs: %w", err)
 		}
@@ -523,7 +861,7 @@ func (o *initEnvOpts) askAdjustResources() error {
 	}
 	if o.adjustVPC.PrivateSubnetCIDRs == nil {
 		privateCIDR, err := o.prompt.Get(envInitPrivateCIDRPrompt, envInitPrivateCIDRPromptHelp, validateCIDRSlice,
-			prompt.WithDefaultInput(stack.DefaultPrivateSubnetCIDRs))
+			prompt.WithDefaultInput(defaultPrivateCIDRs))
 		if err != nil {
 			return fmt.Errorf("get private subnet CIDRs: %w", err)
 		}
@@ -545,7 +883,16 @@ func (o *initEnvOpts) importVPCConfig() *config.ImportVPC {
 
 func (o *initEnvOpts) adjustVPCConfig() *config.AdjustVPC {
 	if o.defaultConfig || !o.adjustVPC.isSet() {
-		return nil
+		if o.azCount <= defaultAZCount || o.importVPC.isSet() {
+			return nil
+		}
+		// Spread the default VPC across more availability zones.
+		publicCIDRs, privateCIDRs := defaultSubnetCIDRs(o.azCount)
+		return &config.AdjustVPC{
+			CIDR:               stack.DefaultVPCCIDR,
+			PrivateSubnetCIDRs: strings.Split(privateCIDRs, ","),
+			PublicSubnetCIDRs:  strings.Split(publicCIDRs, ","),
+		}
 	}
 	return &config.AdjustVPC{
 		CIDR:               o.adjustVPC.CIDR.String(),
@@ -554,6 +901,33 @@ func (o *initEnvOpts) adjustVPCConfig() *config.AdjustVPC {
 	}
 }
 
+// defaultSubnetCIDRs returns the default public and private subnet CIDRs for a VPC spread across azCount availability zones.
+func defaultSubnetCIDRs(azCount int) (public, private string) {
+	if azCount == maxAZCount {
+		return stack.DefaultThreeAZPublicSubnetCIDRs, stack.DefaultThreeAZPrivateSubnetCIDRs
+	}
+	return stack.DefaultPublicSubnetCIDRs, stack.DefaultPrivateSubnetCIDRs
+}
+
+func (o *initEnvOpts) customizeEnv() *config.CustomizeEnv {
+	customConfig := config.NewCustomizeEnv(o.importVPCConfig(), o.adjustVPCConfig())
+	if o.webACLARN == "" && len(o.importCertARNs) == 0 && !o.httpsRedirect && o.idleTimeout == 0 && o.natGateways == "" && !o.vpcEndpoints && o.importClusterARN == "" && o.capacityProvider == "" {
+		return customConfig
+	}
+	if customConfig == nil {
+		customConfig = &config.CustomizeEnv{}
+	}
+	customConfig.WebACLARN = o.webACLARN
+	customConfig.ImportCertARNs = o.importCertARNs
+	customConfig.HTTPToHTTPSRedirect = o.httpsRedirect
+	customConfig.IdleTimeout = int64(o.idleTimeout.Seconds())
+	customConfig.NATGateways = o.natGateways
+	customConfig.VPCEndpoints = o.vpcEndpoints
+	customConfig.ImportClusterARN = o.importClusterARN
+	customConfig.CapacityProvider = o.capacityProvider
+	return customConfig
+}
+
 func (o *initEnvOpts) deployEnv(app *config.Application, customResourcesURLs map[string]string) error {
 	caller, err := o.identity.Get()
 	if err != nil {
@@ -569,6 +943,13 @@ func (o *initEnvOpts) deployEnv(app *config.Application, customResourcesURLs map
 		CustomResourcesURLs:      customResourcesURLs,
 		AdjustVPCConfig:          o.adjustVPCConfig(),
 		ImportVPCConfig:          o.importVPCConfig(),
+		WebACLARN:                o.webACLARN,
+		ImportCertARNs:           o.importCertARNs,
+		HTTPToHTTPSRedirect:      o.httpsRedirect,
+		IdleTimeout:              int64(o.idleTimeout.Seconds()),
+		NATGateways:              o.natGateways,
+		VPCEndpoints:             o.vpcEndpoints,
+		ImportClusterARN:         o.importClusterARN,
 		Version:                  deploy.LatestEnvTemplateVersion,
 	}
 
@@ -712,17 +1093,27 @@ func buildEnvInitCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.region, regionFlag, "", envRegionTokenFlagDescription)
 
 	cmd.Flags().BoolVar(&vars.isProduction, prodEnvFlag, false, prodEnvFlagDescription)
+	cmd.Flags().StringVar(&vars.capacityProvider, capacityProviderFlag, "", capacityProviderFlagDescription)
 
 	cmd.Flags().StringVar(&vars.importVPC.ID, vpcIDFlag, "", vpcIDFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.importVPC.PublicSubnetIDs, publicSubnetsFlag, nil, publicSubnetsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.importVPC.PrivateSubnetIDs, privateSubnetsFlag, nil, privateSubnetsFlagDescription)
+	cmd.Flags().StringVar(&vars.importClusterARN, importClusterFlag, "", importClusterFlagDescription)
 
 	cmd.Flags().IPNetVar(&vars.adjustVPC.CIDR, vpcCIDRFlag, net.IPNet{}, vpcCIDRFlagDescription)
 	// TODO: use IPNetSliceVar when it is available (https://github.com/spf13/pflag/issues/273).
 	cmd.Flags().StringSliceVar(&vars.adjustVPC.PublicSubnetCIDRs, publicSubnetCIDRsFlag, nil, publicSubnetCIDRsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.adjustVPC.PrivateSubnetCIDRs, privateSubnetCIDRsFlag, nil, privateSubnetCIDRsFlagDescription)
+	cmd.Flags().IntVar(&vars.azCount, azCountFlag, 0, azCountFlagDescription)
+	cmd.Flags().StringVar(&vars.natGateways, natGatewaysFlag, "", natGatewaysFlagDescription)
+	cmd.Flags().BoolVar(&vars.vpcEndpoints, vpcEndpointsFlag, false, vpcEndpointsFlagDescription)
 	cmd.Flags().BoolVar(&vars.defaultConfig, defaultConfigFlag, false, defaultConfigFlagDescription)
 
+	cmd.Flags().StringVar(&vars.webACLARN, webACLARNFlag, "", webACLARNFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.importCertARNs, importCertARNsFlag, nil, importCertARNsFlagDescription)
+	cmd.Flags().BoolVar(&vars.httpsRedirect, httpsRedirectFlag, false, httpsRedirectFlagDescription)
+	cmd.Flags().DurationVar(&vars.idleTimeout, idleTimeoutFlag, 0, idleTimeoutFlagDescription)
+
 	flags := pflag.NewFlagSet("Common", pflag.ContinueOnError)
 	flags.AddFlag(cmd.Flags().Lookup(appFlag))
 	flags.AddFlag(cmd.Flags().Lookup(nameFlag))
@@ -733,23 +1124,35 @@ func buildEnvInitCmd() *cobra.Command {
 	flags.AddFlag(cmd.Flags().Lookup(regionFlag))
 	flags.AddFlag(cmd.Flags().Lookup(defaultConfigFlag))
 	flags.AddFlag(cmd.Flags().Lookup(prodEnvFlag))
+	flags.AddFlag(cmd.Flags().Lookup(capacityProviderFlag))
 
 	resourcesImportFlag := pflag.NewFlagSet("Import Existing Resources", pflag.ContinueOnError)
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(vpcIDFlag))
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(publicSubnetsFlag))
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(privateSubnetsFlag))
+	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(importClusterFlag))
 
 	resourcesConfigFlag := pflag.NewFlagSet("Configure Default Resources", pflag.ContinueOnError)
 	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(vpcCIDRFlag))
 	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(publicSubnetCIDRsFlag))
 	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(privateSubnetCIDRsFlag))
+	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(azCountFlag))
+	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(natGatewaysFlag))
+	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(vpcEndpointsFlag))
+
+	loadBalancerFlags := pflag.NewFlagSet("Load Balancer", pflag.ContinueOnError)
+	loadBalancerFlags.AddFlag(cmd.Flags().Lookup(webACLARNFlag))
+	loadBalancerFlags.AddFlag(cmd.Flags().Lookup(importCertARNsFlag))
+	loadBalancerFlags.AddFlag(cmd.Flags().Lookup(httpsRedirectFlag))
+	loadBalancerFlags.AddFlag(cmd.Flags().Lookup(idleTimeoutFlag))
 
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
-		"sections":                    "Common,Import Existing Resources,Configure Default Resources",
+		"sections":                    "Common,Import Existing Resources,Configure Default Resources,Load Balancer",
 		"Common":                      flags.FlagUsages(),
 		"Import Existing Resources":   resourcesImportFlag.FlagUsages(),
 		"Configure Default Resources": resourcesConfigFlag.FlagUsages(),
+		"Load Balancer":               loadBalancerFlags.FlagUsages(),
 	}
 
 	cmd.SetUsageTemplate(`{{h1 "Usage"}}{{if .Runnable}}
@@ -766,5 +1169,8 @@ func buildEnvInitCmd() *cobra.Command {
 {{h1 "Examples"}}{{code .Example}}{{end}}
 `)
 
+	markPromptedFlags(cmd, appFlag, nameFlag)
+	markPromptedFlag(cmd, profileFlag, accessKeyIDFlag)
+	markPromptedFlag(cmd, defaultConfigFlag, vpcIDFlag, vpcCIDRFlag)
 	return cmd
 }
@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -36,14 +38,24 @@ type initEnvMocks struct {
 
 func TestInitEnvOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inEnvName     string
-		inAppName     string
-		inDefault     bool
-		inVPCID       string
-		inPublicIDs   []string
-		inPrivateIDs  []string
-		inVPCCIDR     net.IPNet
-		inPublicCIDRs []string
+		inEnvName      string
+		inAppName      string
+		inDefault      bool
+		inVPCID        string
+		inPublicIDs    []string
+		inPrivateIDs   []string
+		inVPCCIDR      net.IPNet
+		inPublicCIDRs  []string
+		inPrivateCIDRs []string
+		inAZCount      int
+		inNATGateways  string
+		inVPCEndpoints bool
+		inClusterARN   string
+		inProd         bool
+		inCapacityProv string
+		inWebACLARN    string
+		inCertARNs     []string
+		inIdleTimeout  time.Duration
 
 		inProfileName     string
 		inAccessKeyID     string
@@ -130,6 +142,141 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 			inPublicIDs:  []string{"mockID", "anotherMockID", "yetAnotherMockID"},
 			inPrivateIDs: []string{"mockID", "anotherMockID"},
 		},
+		"should err if the number of availability zones is unsupported": {
+			inAZCount: 4,
+
+			wantedErrMsg: "--az-count must be 2 or 3",
+		},
+		"should err if the number of availability zones is set for an imported VPC": {
+			inVPCID:      "mockID",
+			inPublicIDs:  []string{"mockID", "anotherMockID"},
+			inPrivateIDs: []string{"mockID", "anotherMockID"},
+			inAZCount:    3,
+
+			wantedErrMsg: "cannot specify --az-count when importing a vpc",
+		},
+		"should err if the number of public subnets doesn't match the number of availability zones": {
+			inPublicCIDRs: []string{"10.0.0.0/24", "10.0.1.0/24"},
+			inAZCount:     3,
+
+			wantedErrMsg: "--az-count 3 requires 3 public subnet CIDRs, but 2 are configured",
+		},
+		"should err if the number of private subnets doesn't match the number of availability zones": {
+			inPublicCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.4.0/24"},
+			inPrivateCIDRs: []string{"10.0.2.0/24", "10.0.3.0/24"},
+			inAZCount:      3,
+
+			wantedErrMsg: "--az-count 3 requires 3 private subnet CIDRs, but 2 are configured",
+		},
+		"valid number of availability zones with the default VPC": {
+			inDefault: true,
+			inAZCount: 3,
+		},
+		"should err if NAT gateways value is invalid": {
+			inNATGateways: "two",
+
+			wantedErrMsg: `invalid --nat-gateways two: must be one of "per-az", "single", "none"`,
+		},
+		"should err if NAT gateways are configured for an imported VPC": {
+			inVPCID:       "mockID",
+			inPublicIDs:   []string{"mockID", "anotherMockID"},
+			inPrivateIDs:  []string{"mockID", "anotherMockID"},
+			inNATGateways: "single",
+
+			wantedErrMsg: "cannot specify --nat-gateways when importing a vpc",
+		},
+		"should err if there are not enough public subnets for a NAT gateway per availability zone": {
+			inPublicCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+			inPrivateCIDRs: []string{"10.0.2.0/24", "10.0.3.0/24", "10.0.4.0/24"},
+			inNATGateways:  "per-az",
+
+			wantedErrMsg: `--nat-gateways "per-az" requires a public subnet in each of the 3 availability zones with a private subnet, but only 2 public subnets are configured`,
+		},
+		"valid single NAT gateway with fewer public subnets": {
+			inPublicCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+			inPrivateCIDRs: []string{"10.0.2.0/24", "10.0.3.0/24", "10.0.4.0/24"},
+			inNATGateways:  "single",
+		},
+		"should err if VPC endpoints are requested with NAT gateways": {
+			inDefault:      true,
+			inNATGateways:  "single",
+			inVPCEndpoints: true,
+
+			wantedErrMsg: `--vpc-endpoints requires --nat-gateways "none"`,
+		},
+		"valid VPC endpoints without NAT gateways": {
+			inDefault:      true,
+			inNATGateways:  "none",
+			inVPCEndpoints: true,
+		},
+		"valid NAT gateways with the default VPC": {
+			inDefault:     true,
+			inNATGateways: "none",
+		},
+		"should err if cluster ARN cannot be parsed": {
+			inClusterARN: "mockCluster",
+
+			wantedErrMsg: "parse --import-cluster-arn mockCluster: arn: invalid prefix",
+		},
+		"should err if cluster ARN is not an ECS cluster": {
+			inClusterARN: "arn:aws:ecs:us-west-2:123456789012:service/mockCluster/mockService",
+
+			wantedErrMsg: "--import-cluster-arn arn:aws:ecs:us-west-2:123456789012:service/mockCluster/mockService must be the ARN of an ECS cluster",
+		},
+		"valid cluster ARN": {
+			inClusterARN: "arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster",
+		},
+		"should err if capacity provider is invalid": {
+			inCapacityProv: "EC2",
+
+			wantedErrMsg: `invalid --default-capacity-provider EC2: must be one of "FARGATE", "FARGATE_SPOT"`,
+		},
+		"should err if spot is the default capacity provider of a production environment": {
+			inProd:         true,
+			inCapacityProv: "FARGATE_SPOT",
+
+			wantedErrMsg: "cannot specify --default-capacity-provider FARGATE_SPOT with --prod: Fargate Spot tasks can be interrupted",
+		},
+		"valid spot capacity provider": {
+			inCapacityProv: "FARGATE_SPOT",
+		},
+		"should err if web ACL ARN cannot be parsed": {
+			inWebACLARN: "mockWebACL",
+
+			wantedErrMsg: "parse --waf-web-acl-arn mockWebACL: arn: invalid prefix",
+		},
+		"should err if web ACL ARN is not a regional web ACL": {
+			inWebACLARN: "arn:aws:wafv2:us-east-1:123456789012:global/webacl/mockWebACL/a1b2c3",
+
+			wantedErrMsg: "--waf-web-acl-arn arn:aws:wafv2:us-east-1:123456789012:global/webacl/mockWebACL/a1b2c3 must be the ARN of a regional AWS WAF web ACL",
+		},
+		"valid web ACL ARN": {
+			inWebACLARN: "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/mockWebACL/a1b2c3",
+		},
+		"should err if certificate ARN cannot be parsed": {
+			inCertARNs: []string{"mockCert"},
+
+			wantedErrMsg: "parse --import-certificate-arns mockCert: arn: invalid prefix",
+		},
+		"should err if certificate ARN is not an ACM certificate": {
+			inCertARNs: []string{"arn:aws:iam::123456789012:server-certificate/mockCert"},
+
+			wantedErrMsg: "--import-certificate-arns arn:aws:iam::123456789012:server-certificate/mockCert must be the ARN of an ACM certificate",
+		},
+		"valid certificate ARNs": {
+			inCertARNs: []string{
+				"arn:aws:acm:us-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012",
+				"arn:aws:acm:us-west-2:123456789012:certificate/87654321-4321-4321-4321-210987654321",
+			},
+		},
+		"should err if idle timeout is out of range": {
+			inIdleTimeout: 4001 * time.Second,
+
+			wantedErrMsg: "--http-idle-timeout must be between 1s and 4000s",
+		},
+		"valid idle timeout": {
+			inIdleTimeout: 10 * time.Minute,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -140,9 +287,13 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 					name:          tc.inEnvName,
 					defaultConfig: tc.inDefault,
 					adjustVPC: adjustVPCVars{
-						PublicSubnetCIDRs: tc.inPublicCIDRs,
-						CIDR:              tc.inVPCCIDR,
+						PublicSubnetCIDRs:  tc.inPublicCIDRs,
+						PrivateSubnetCIDRs: tc.inPrivateCIDRs,
+						CIDR:               tc.inVPCCIDR,
 					},
+					azCount:      tc.inAZCount,
+					natGateways:  tc.inNATGateways,
+					vpcEndpoints: tc.inVPCEndpoints,
 					importVPC: importVPCVars{
 						PublicSubnetIDs:  tc.inPublicIDs,
 						PrivateSubnetIDs: tc.inPrivateIDs,
@@ -155,6 +306,12 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 						SecretAccessKey: tc.inSecretAccessKey,
 						SessionToken:    tc.inSessionToken,
 					},
+					importClusterARN: tc.inClusterARN,
+					isProduction:     tc.inProd,
+					capacityProvider: tc.inCapacityProv,
+					webACLARN:        tc.inWebACLARN,
+					importCertARNs:   tc.inCertARNs,
+					idleTimeout:      tc.inIdleTimeout,
 				},
 			}
 
@@ -186,6 +343,17 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 			Region: aws.String(mockRegion),
 		},
 	}
+	mockVPCSubnets := &ec2.VPCSubnets{
+		Public: []ec2.Subnet{
+			{Resource: ec2.Resource{ID: "mockPublicSubnet"}, AvailabilityZone: "us-west-2a"},
+			{Resource: ec2.Resource{ID: "anotherMockPublicSubnet"}, AvailabilityZone: "us-west-2b"},
+		},
+		Private: []ec2.Subnet{
+			{Resource: ec2.Resource{ID: "mockPrivateSubnet"}, AvailabilityZone: "us-west-2a"},
+			{Resource: ec2.Resource{ID: "anotherMockPrivateSubnet"}, AvailabilityZone: "us-west-2b"},
+			{Resource: ec2.Resource{ID: "mockPrivateSubnetInC"}, AvailabilityZone: "us-west-2c"},
+		},
+	}
 
 	testCases := map[string]struct {
 		inAppName       string
@@ -196,6 +364,8 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 		inDefault       bool
 		inImportVPCVars importVPCVars
 		inAdjustVPCVars adjustVPCVars
+		inAZCount       int
+		inNATGateways   string
 
 		setupMocks func(mocks initEnvMocks)
 
@@ -446,7 +616,8 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 				m.selVPC.EXPECT().PublicSubnets(envInitPublicSubnetsSelectPrompt, "", "mockVPC").
 					Return([]string{}, nil)
 				m.selVPC.EXPECT().PrivateSubnets(envInitPrivateSubnetsSelectPrompt, "", "mockVPC").
-					Return([]string{"mockPrivateSubnet", "anotherMockPrivateSubnet"}, nil)
+					Return([]string{"mockPrivateSubnet", "mockPrivateSubnetInC"}, nil)
+				m.ec2Client.EXPECT().ListVPCSubnets("mockVPC").Return(mockVPCSubnets, nil)
 			},
 		},
 		"success with importing env resources with no flags": {
@@ -463,6 +634,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 					Return([]string{"mockPublicSubnet", "anotherMockPublicSubnet"}, nil)
 				m.selVPC.EXPECT().PrivateSubnets(envInitPrivateSubnetsSelectPrompt, "", "mockVPC").
 					Return([]string{"mockPrivateSubnet", "anotherMockPrivateSubnet"}, nil)
+				m.ec2Client.EXPECT().ListVPCSubnets("mockVPC").Return(mockVPCSubnets, nil)
 			},
 		},
 		"success with importing env resources with flags": {
@@ -471,14 +643,79 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 			inProfile: mockProfile,
 			inImportVPCVars: importVPCVars{
 				ID:               "mockVPCID",
-				PrivateSubnetIDs: []string{"mockPrivateSubnetID", "anotherMockPrivateSubnetID"},
-				PublicSubnetIDs:  []string{"mockPublicSubnetID", "anotherMockPublicSubnetID"},
+				PrivateSubnetIDs: []string{"mockPrivateSubnet", "anotherMockPrivateSubnet"},
+				PublicSubnetIDs:  []string{"mockPublicSubnet", "anotherMockPublicSubnet"},
 			},
 			setupMocks: func(m initEnvMocks) {
 				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
 				m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, gomock.Any(), gomock.Any()).Times(0)
 				m.ec2Client.EXPECT().HasDNSSupport("mockVPCID").Return(true, nil)
+				m.ec2Client.EXPECT().ListVPCSubnets("mockVPCID").Return(mockVPCSubnets, nil)
+			},
+		},
+		"fail to list the subnets of the imported VPC": {
+			inAppName: mockApp,
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inImportVPCVars: importVPCVars{
+				ID:               "mockVPCID",
+				PrivateSubnetIDs: []string{"mockPrivateSubnet", "anotherMockPrivateSubnet"},
+				PublicSubnetIDs:  []string{"mockPublicSubnet", "anotherMockPublicSubnet"},
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.ec2Client.EXPECT().HasDNSSupport("mockVPCID").Return(true, nil)
+				m.ec2Client.EXPECT().ListVPCSubnets("mockVPCID").Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("list subnets of VPC mockVPCID: some error"),
+		},
+		"fail to import a subnet of another VPC": {
+			inAppName: mockApp,
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inImportVPCVars: importVPCVars{
+				ID:               "mockVPCID",
+				PrivateSubnetIDs: []string{"mockPrivateSubnet", "otherVPCPrivateSubnet"},
+				PublicSubnetIDs:  []string{"mockPublicSubnet", "anotherMockPublicSubnet"},
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.ec2Client.EXPECT().HasDNSSupport("mockVPCID").Return(true, nil)
+				m.ec2Client.EXPECT().ListVPCSubnets("mockVPCID").Return(mockVPCSubnets, nil)
+			},
+			wantedError: fmt.Errorf("private subnet otherVPCPrivateSubnet does not belong to VPC mockVPCID"),
+		},
+		"fail to import public subnets in a single availability zone": {
+			inAppName: mockApp,
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inImportVPCVars: importVPCVars{
+				ID:               "mockVPCID",
+				PrivateSubnetIDs: []string{"mockPrivateSubnet", "anotherMockPrivateSubnet"},
+				PublicSubnetIDs:  []string{"mockPublicSubnet", "mockPublicSubnet"},
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.ec2Client.EXPECT().HasDNSSupport("mockVPCID").Return(true, nil)
+				m.ec2Client.EXPECT().ListVPCSubnets("mockVPCID").Return(mockVPCSubnets, nil)
+			},
+			wantedError: fmt.Errorf("public subnets must be in at least two availability zones to enable Load Balancing"),
+		},
+		"fail to import a private subnet in an availability zone without public subnets": {
+			inAppName: mockApp,
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inImportVPCVars: importVPCVars{
+				ID:               "mockVPCID",
+				PrivateSubnetIDs: []string{"mockPrivateSubnet", "mockPrivateSubnetInC"},
+				PublicSubnetIDs:  []string{"mockPublicSubnet", "anotherMockPublicSubnet"},
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.ec2Client.EXPECT().HasDNSSupport("mockVPCID").Return(true, nil)
+				m.ec2Client.EXPECT().ListVPCSubnets("mockVPCID").Return(mockVPCSubnets, nil)
 			},
+			wantedError: fmt.Errorf("private subnet mockPrivateSubnetInC is in availability zone us-west-2c, which has no imported public subnet for the load balancer"),
 		},
 		"fail to get VPC CIDR": {
 			inAppName: mockApp,
@@ -541,6 +778,66 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 					Return(mockSubnetCIDRs, nil)
 			},
 		},
+		"should err if the prompted subnets cannot fit a NAT gateway per availability zone": {
+			inAppName:     mockApp,
+			inEnv:         mockEnv,
+			inProfile:     mockProfile,
+			inNATGateways: "per-az",
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, "", envInitCustomizedEnvTypes).
+					Return(envInitAdjustEnvResourcesSelectOption, nil)
+				m.prompt.EXPECT().Get(envInitVPCCIDRPrompt, envInitVPCCIDRPromptHelp, gomock.Any(), gomock.Any()).
+					Return(mockVPCCIDR, nil)
+				m.prompt.EXPECT().Get(envInitPublicCIDRPrompt, envInitPublicCIDRPromptHelp, gomock.Any(), gomock.Any()).
+					Return("10.10.10.10/24", nil)
+				m.prompt.EXPECT().Get(envInitPrivateCIDRPrompt, envInitPrivateCIDRPromptHelp, gomock.Any(), gomock.Any()).
+					Return(mockSubnetCIDRs, nil)
+			},
+			wantedError: errors.New(`--nat-gateways "per-az" requires a public subnet in each of the 2 availability zones with a private subnet, but only 1 public subnets are configured`),
+		},
+		"should err if failed to list the availability zones": {
+			inAppName: mockApp,
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inDefault: true,
+			inAZCount: 3,
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.ec2Client.EXPECT().ListAvailabilityZones().Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("list availability zones: some error"),
+		},
+		"should err if the region doesn't have enough availability zones": {
+			inAppName: mockApp,
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inDefault: true,
+			inAZCount: 3,
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.ec2Client.EXPECT().ListAvailabilityZones().Return([]string{"us-west-2a", "us-west-2b"}, nil)
+			},
+			wantedError: errors.New("--az-count 3 exceeds the 2 availability zones available in region us-west-2"),
+		},
+		"success with subnets in three availability zones": {
+			inAppName: mockApp,
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inAZCount: 3,
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, "", envInitCustomizedEnvTypes).
+					Return(envInitAdjustEnvResourcesSelectOption, nil)
+				m.prompt.EXPECT().Get(envInitVPCCIDRPrompt, envInitVPCCIDRPromptHelp, gomock.Any(), gomock.Any()).
+					Return(mockVPCCIDR, nil)
+				m.prompt.EXPECT().Get(envInitPublicCIDRPrompt, envInitPublicCIDRPromptHelp, gomock.Any(), gomock.Any()).
+					Return(stack.DefaultThreeAZPublicSubnetCIDRs, nil)
+				m.prompt.EXPECT().Get(envInitPrivateCIDRPrompt, envInitPrivateCIDRPromptHelp, gomock.Any(), gomock.Any()).
+					Return(stack.DefaultThreeAZPrivateSubnetCIDRs, nil)
+				m.ec2Client.EXPECT().ListAvailabilityZones().Return([]string{"us-west-2a", "us-west-2b", "us-west-2c"}, nil)
+			},
+		},
 		"success with adjusting default env config with flags": {
 			inAppName: mockApp,
 			inEnv:     mockEnv,
@@ -586,6 +883,8 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 					defaultConfig: tc.inDefault,
 					adjustVPC:     tc.inAdjustVPCVars,
 					importVPC:     tc.inImportVPCVars,
+					azCount:       tc.inAZCount,
+					natGateways:   tc.inNATGateways,
 				},
 				sessProvider: mocks.sessProvider,
 				selVPC:       mocks.selVPC,
@@ -611,7 +910,11 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 
 func TestInitEnvOpts_Execute(t *testing.T) {
 	testCases := map[string]struct {
-		inProd bool
+		inProd             bool
+		inCertARNs         []string
+		inHTTPSRedirect    bool
+		inClusterARN       string
+		inCapacityProvider string
 
 		expectStore             func(m *mocks.Mockstore)
 		expectDeployer          func(m *mocks.Mockdeployer)
@@ -621,6 +924,8 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 		expectCFN               func(m *mocks.MockstackExistChecker)
 		expectAppCFN            func(m *mocks.MockappResourcesGetter)
 		expectResourcesUploader func(m *mocks.MockcustomResourcesUploader)
+		expectACM               func(m *mocks.MockcertificateValidator)
+		expectECS               func(m *mocks.MockimportedClusterDescriber)
 
 		wantedErrorS string
 	}{
@@ -631,6 +936,90 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 
 			wantedErrorS: "some error",
 		},
+		"returns error if HTTPS redirect is enabled without HTTPS": {
+			inHTTPSRedirect: true,
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+
+			wantedErrorS: "--http-to-https-redirect requires HTTPS: the application must have a domain or --import-certificate-arns must be specified",
+		},
+		"returns error if an imported certificate is in a different region": {
+			inCertARNs: []string{"arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012"},
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+
+			wantedErrorS: "certificate arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012 must be in the same region as the environment us-west-2",
+		},
+		"returns error if imported certificates cannot be validated": {
+			inCertARNs: []string{"arn:aws:acm:us-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012"},
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+			expectACM: func(m *mocks.MockcertificateValidator) {
+				m.EXPECT().ValidateCertificates([]string{"arn:aws:acm:us-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012"}).
+					Return(errors.New("some error"))
+			},
+
+			wantedErrorS: "validate imported certificates: some error",
+		},
+		"returns error if imported cluster is in a different region": {
+			inClusterARN: "arn:aws:ecs:us-east-1:123456789012:cluster/mockCluster",
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+
+			wantedErrorS: "cluster arn:aws:ecs:us-east-1:123456789012:cluster/mockCluster must be in the same region as the environment us-west-2",
+		},
+		"returns error if imported cluster cannot be described": {
+			inClusterARN: "arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster",
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+			expectECS: func(m *mocks.MockimportedClusterDescriber) {
+				m.EXPECT().ActiveCluster("arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster").Return(false, errors.New("some error"))
+			},
+
+			wantedErrorS: "validate imported cluster: some error",
+		},
+		"returns error if imported cluster is not active": {
+			inClusterARN: "arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster",
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+			expectECS: func(m *mocks.MockimportedClusterDescriber) {
+				m.EXPECT().ActiveCluster("arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster").Return(false, nil)
+			},
+
+			wantedErrorS: "cluster arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster does not exist or is not active",
+		},
+		"returns error if capacity providers of imported cluster cannot be described": {
+			inClusterARN:       "arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster",
+			inCapacityProvider: "FARGATE_SPOT",
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+			expectECS: func(m *mocks.MockimportedClusterDescriber) {
+				m.EXPECT().ActiveCluster("arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster").Return(true, nil)
+				m.EXPECT().ClusterCapacityProviders("arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster").Return(nil, errors.New("some error"))
+			},
+
+			wantedErrorS: "get capacity providers of imported cluster: some error",
+		},
+		"returns error if default capacity provider is not associated with imported cluster": {
+			inClusterARN:       "arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster",
+			inCapacityProvider: "FARGATE_SPOT",
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+			expectECS: func(m *mocks.MockimportedClusterDescriber) {
+				m.EXPECT().ActiveCluster("arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster").Return(true, nil)
+				m.EXPECT().ClusterCapacityProviders("arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster").Return([]string{"FARGATE"}, nil)
+			},
+
+			wantedErrorS: "--default-capacity-provider FARGATE_SPOT is not associated with cluster arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster",
+		},
 		"returns identity get error": {
 			expectStore: func(m *mocks.Mockstore) {
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
@@ -640,10 +1029,21 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 			},
 			wantedErrorS: "get identity: some identity error",
 		},
+		"returns error if failed to list environments": {
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+			},
+			expectIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn", Account: "1234"}, nil)
+			},
+			wantedErrorS: "list environments in application phonetool: some error",
+		},
 		"failed to create stack set instance": {
 			expectStore: func(m *mocks.Mockstore) {
 				m.EXPECT().CreateEnvironment(gomock.Any()).Times(0)
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
 			},
 			expectIdentity: func(m *mocks.MockidentityService) {
 				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn", Account: "1234"}, nil)
@@ -668,6 +1068,11 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 		"errors cannot get app resources by region": {
 			expectStore: func(m *mocks.Mockstore) {
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{Name: "test", AccountID: "1234", Region: "us-west-2"},
+					{Name: "staging", AccountID: "1234", Region: "us-west-2"},
+					{Name: "prod", AccountID: "5678", Region: "us-west-2"},
+				}, nil)
 			},
 			expectProgress: func(m *mocks.Mockprogress) {
 				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "1234", "us-west-2", "phonetool"))
@@ -691,6 +1096,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 		"errors cannot read env lambdas": {
 			expectStore: func(m *mocks.Mockstore) {
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
 			},
 			expectProgress: func(m *mocks.Mockprogress) {
 				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "1234", "us-west-2", "phonetool"))
@@ -719,6 +1125,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 		"deletes retained IAM roles if environment stack fails creation": {
 			expectStore: func(m *mocks.Mockstore) {
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
 			},
 			expectProgress: func(m *mocks.Mockprogress) {
 				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "1234", "us-west-2", "phonetool"))
@@ -766,6 +1173,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{
 					Name: "phonetool",
 				}, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
 				m.EXPECT().CreateEnvironment(&config.Environment{
 					App:       "phonetool",
 					Name:      "test",
@@ -814,6 +1222,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 
 			expectStore: func(m *mocks.Mockstore) {
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
 				m.EXPECT().CreateEnvironment(&config.Environment{
 					App:       "phonetool",
 					Name:      "test",
@@ -861,6 +1270,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 		"skips creating stack if environment stack already exists": {
 			expectStore: func(m *mocks.Mockstore) {
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
 				m.EXPECT().CreateEnvironment(&config.Environment{
 					App:       "phonetool",
 					Name:      "test",
@@ -912,6 +1322,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 		"failed to delegate DNS (app has Domain and env and apps are different)": {
 			expectStore: func(m *mocks.Mockstore) {
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool", AccountID: "1234", Domain: "amazon.com"}, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
 			},
 			expectIdentity: func(m *mocks.MockidentityService) {
 				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn", Account: "4567"}, nil).Times(1)
@@ -928,6 +1339,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 		"success with DNS Delegation (app has Domain and env and app are different)": {
 			expectStore: func(m *mocks.Mockstore) {
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool", AccountID: "1234", Domain: "amazon.com"}, nil)
+				m.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
 				m.EXPECT().CreateEnvironment(&config.Environment{
 					App:       "phonetool",
 					Name:      "test",
@@ -994,6 +1406,8 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 			mockCFN := mocks.NewMockstackExistChecker(ctrl)
 			mockResourcesUploader := mocks.NewMockcustomResourcesUploader(ctrl)
 			mockUploader := mocks.NewMockzipAndUploader(ctrl)
+			mockACM := mocks.NewMockcertificateValidator(ctrl)
+			mockECS := mocks.NewMockimportedClusterDescriber(ctrl)
 			if tc.expectStore != nil {
 				tc.expectStore(mockStore)
 			}
@@ -1018,15 +1432,25 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 			if tc.expectResourcesUploader != nil {
 				tc.expectResourcesUploader(mockResourcesUploader)
 			}
+			if tc.expectACM != nil {
+				tc.expectACM(mockACM)
+			}
+			if tc.expectECS != nil {
+				tc.expectECS(mockECS)
+			}
 
 			provider := sessions.NewProvider()
 			sess, _ := provider.DefaultWithRegion("us-west-2")
 
 			opts := &initEnvOpts{
 				initEnvVars: initEnvVars{
-					name:         "test",
-					appName:      "phonetool",
-					isProduction: tc.inProd,
+					name:             "test",
+					appName:          "phonetool",
+					isProduction:     tc.inProd,
+					importCertARNs:   tc.inCertARNs,
+					httpsRedirect:    tc.inHTTPSRedirect,
+					importClusterARN: tc.inClusterARN,
+					capacityProvider: tc.inCapacityProvider,
 				},
 				store:       mockStore,
 				envDeployer: mockDeployer,
@@ -1035,6 +1459,8 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				envIdentity: mockIdentity,
 				iam:         mockIAM,
 				cfn:         mockCFN,
+				acm:         mockACM,
+				ecsClient:   mockECS,
 				prog:        mockProgress,
 				sess:        sess,
 				appCFN:      mockAppCFN,
@@ -1143,3 +1569,67 @@ func TestInitEnvOpts_delegateDNSFromApp(t *testing.T) {
 		})
 	}
 }
+
+func TestInitEnvOpts_adjustVPCConfig(t *testing.T) {
+	testCases := map[string]struct {
+		inDefault       bool
+		inAZCount       int
+		inAdjustVPCVars adjustVPCVars
+
+		wanted *config.AdjustVPC
+	}{
+		"returns nil with the default VPC": {
+			inDefault: true,
+		},
+		"returns nil with the default VPC in two availability zones": {
+			inDefault: true,
+			inAZCount: 2,
+		},
+		"generates subnets for the default VPC in three availability zones": {
+			inDefault: true,
+			inAZCount: 3,
+
+			wanted: &config.AdjustVPC{
+				CIDR:               "10.0.0.0/16",
+				PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.4.0/24"},
+				PrivateSubnetCIDRs: []string{"10.0.2.0/24", "10.0.3.0/24", "10.0.5.0/24"},
+			},
+		},
+		"uses the overridden subnets": {
+			inAZCount: 3,
+			inAdjustVPCVars: adjustVPCVars{
+				CIDR: net.IPNet{
+					IP:   net.IP{10, 1, 0, 0},
+					Mask: net.IPMask{255, 255, 0, 0},
+				},
+				PublicSubnetCIDRs:  []string{"10.1.0.0/24", "10.1.1.0/24", "10.1.2.0/24"},
+				PrivateSubnetCIDRs: []string{"10.1.3.0/24", "10.1.4.0/24", "10.1.5.0/24"},
+			},
+
+			wanted: &config.AdjustVPC{
+				CIDR:               "10.1.0.0/16",
+				PublicSubnetCIDRs:  []string{"10.1.0.0/24", "10.1.1.0/24", "10.1.2.0/24"},
+				PrivateSubnetCIDRs: []string{"10.1.3.0/24", "10.1.4.0/24", "10.1.5.0/24"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &initEnvOpts{
+				initEnvVars: initEnvVars{
+					defaultConfig: tc.inDefault,
+					azCount:       tc.inAZCount,
+					adjustVPC:     tc.inAdjustVPCVars,
+				},
+			}
+
+			// WHEN
+			got := opts.adjustVPCConfig()
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
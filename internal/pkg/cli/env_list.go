@@ -9,8 +9,12 @@ import (
 	"io"
 	"os"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/list"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
@@ -22,9 +26,17 @@ const (
 	envListAppNameHelper = "An application is a collection of related services."
 )
 
+// Ownership of an environment's VPC.
+const (
+	envVPCManaged  = "managed"
+	envVPCImported = "imported"
+)
+
 type listEnvVars struct {
 	appName          string
 	shouldOutputJSON bool
+	outputFormat     string
+	detailed         bool
 }
 
 type listEnvOpts struct {
@@ -33,9 +45,22 @@ type listEnvOpts struct {
 	prompt prompter
 	sel    configSelector
 
+	newEnvDescriber func(app, env string) (envOutputsDescriber, error)
+
 	w io.Writer
 }
 
+// envDetails holds the details of an environment shown with --detailed.
+type envDetails struct {
+	Name      string `json:"name"`
+	Prod      bool   `json:"prod"`
+	Region    string `json:"region"`
+	AccountID string `json:"accountID"`
+	VPC       string `json:"vpc"`   // Whether the VPC is managed by Copilot or imported.
+	VPCID     string `json:"vpcID"` // Empty if the environment stack hasn't exported it.
+	Cluster   string `json:"cluster"`
+}
+
 func newListEnvOpts(vars listEnvVars) (*listEnvOpts, error) {
 	store, err := config.NewStore()
 	if err != nil {
@@ -48,10 +73,29 @@ func newListEnvOpts(vars listEnvVars) (*listEnvOpts, error) {
 		store:       store,
 		sel:         selector.NewConfigSelect(prompter, store),
 		prompt:      prompter,
-		w:           os.Stdout,
+		newEnvDescriber: func(app, env string) (envOutputsDescriber, error) {
+			d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+				App:         app,
+				Env:         env,
+				ConfigStore: store,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("new env describer for environment %s in app %s: %w", env, app, err)
+			}
+			return d, nil
+		},
+		w: os.Stdout,
 	}, nil
 }
 
+// Validate returns an error if the values provided by the user are invalid.
+func (o *listEnvOpts) Validate() error {
+	if o.detailed && o.outputFormat != "" {
+		return fmt.Errorf("--%s and --%s cannot be specified together", detailedFlag, outputFlag)
+	}
+	return validateListOutputFormat(o.shouldOutputJSON, o.outputFormat)
+}
+
 // Ask asks for fields that are required but not passed in.
 func (o *listEnvOpts) Ask() error {
 	if o.appName != "" {
@@ -76,6 +120,9 @@ func (o *listEnvOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	if o.detailed {
+		return o.writeDetails(envs)
+	}
 
 	var out string
 	if o.shouldOutputJSON {
@@ -84,6 +131,12 @@ func (o *listEnvOpts) Execute() error {
 			return err
 		}
 		out = data
+	} else if o.outputFormat == outputFormatCSV {
+		b := &strings.Builder{}
+		if err := list.CSV(b, envs); err != nil {
+			return fmt.Errorf("write environments as CSV: %w", err)
+		}
+		out = b.String()
 	} else {
 		out = o.humanOutput(envs)
 	}
@@ -104,6 +157,73 @@ func (o *listEnvOpts) humanOutput(envs []*config.Environment) string {
 	return b.String()
 }
 
+// writeDetails writes the region, account, VPC, and cluster of each environment as a table or JSON.
+func (o *listEnvOpts) writeDetails(envs []*config.Environment) error {
+	var details []*envDetails
+	for _, env := range envs {
+		d, err := o.envDetails(env)
+		if err != nil {
+			return err
+		}
+		details = append(details, d)
+	}
+	if o.shouldOutputJSON {
+		type serializedEnvs struct {
+			Environments []*envDetails `json:"environments"`
+		}
+		b, err := json.Marshal(serializedEnvs{Environments: details})
+		if err != nil {
+			return fmt.Errorf("marshal environments: %w", err)
+		}
+		fmt.Fprintf(o.w, "%s\n", b)
+		return nil
+	}
+	writer := tabwriter.NewWriter(o.w, 0, 4, 2, ' ', 0)
+	headers := []string{"Name", "Region", "Account ID", "VPC", "Cluster"}
+	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
+	var underlines []string
+	for _, header := range headers {
+		underlines = append(underlines, strings.Repeat("-", len(header)))
+	}
+	fmt.Fprintf(writer, "%s\n", strings.Join(underlines, "\t"))
+	for _, d := range details {
+		name := d.Name
+		if d.Prod {
+			name = fmt.Sprintf("%s (prod)", d.Name)
+		}
+		vpc := d.VPC
+		if d.VPCID != "" {
+			vpc = fmt.Sprintf("%s (%s)", d.VPCID, d.VPC)
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", name, d.Region, d.AccountID, vpc, d.Cluster)
+	}
+	return writer.Flush()
+}
+
+func (o *listEnvOpts) envDetails(env *config.Environment) (*envDetails, error) {
+	vpc := envVPCManaged
+	if env.CustomConfig != nil && env.CustomConfig.ImportVPC != nil {
+		vpc = envVPCImported
+	}
+	d, err := o.newEnvDescriber(o.appName, env.Name)
+	if err != nil {
+		return nil, err
+	}
+	outputs, err := d.Outputs()
+	if err != nil {
+		return nil, fmt.Errorf("get stack outputs of environment %s: %w", env.Name, err)
+	}
+	return &envDetails{
+		Name:      env.Name,
+		Prod:      env.Prod,
+		Region:    env.Region,
+		AccountID: env.AccountID,
+		VPC:       vpc,
+		VPCID:     outputs[stack.EnvOutputVPCID],
+		Cluster:   outputs[stack.EnvOutputClusterID],
+	}, nil
+}
+
 func (o *listEnvOpts) jsonOutput(envs []*config.Environment) (string, error) {
 	type serializedEnvs struct {
 		Environments []*config.Environment `json:"environments"`
@@ -123,12 +243,21 @@ func buildEnvListCmd() *cobra.Command {
 		Short: "Lists all the environments in an application.",
 		Example: `
   Lists all the environments for the frontend application.
-  /code $ copilot env ls -a frontend`,
+  /code $ copilot env ls -a frontend
+
+  Lists all the environments for the frontend application as CSV.
+  /code $ copilot env ls -a frontend --output csv
+
+  Shows the region, account, VPC, and cluster of each environment for the frontend application.
+  /code $ copilot env ls -a frontend --detailed`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newListEnvOpts(vars)
 			if err != nil {
 				return err
 			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
 			if err := opts.Ask(); err != nil {
 				return err
 			}
@@ -137,5 +266,8 @@ func buildEnvListCmd() *cobra.Command {
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFlag, "", listOutputFlagDescription)
+	cmd.Flags().BoolVar(&vars.detailed, detailedFlag, false, envListDetailedFlagDescription)
+	markPromptedFlags(cmd, appFlag)
 	return cmd
 }
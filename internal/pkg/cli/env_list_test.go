@@ -70,10 +70,52 @@ func TestEnvList_Ask(t *testing.T) {
 		})
 	}
 }
+func TestEnvList_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inVars listEnvVars
+
+		wantedErr error
+	}{
+		"error if detailed and csv output are both specified": {
+			inVars: listEnvVars{
+				detailed:     true,
+				outputFormat: outputFormatCSV,
+			},
+			wantedErr: errors.New("--detailed and --output cannot be specified together"),
+		},
+		"detailed with json output": {
+			inVars: listEnvVars{
+				detailed:         true,
+				shouldOutputJSON: true,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &listEnvOpts{
+				listEnvVars: tc.inVars,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestEnvList_Execute(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockError := fmt.Errorf("error")
 	mockstore := mocks.NewMockstore(ctrl)
+	mockDescriber := mocks.NewMockenvOutputsDescriber(ctrl)
+	newMockDescriber := func(app, env string) (envOutputsDescriber, error) {
+		return mockDescriber, nil
+	}
 	defer ctrl.Finish()
 
 	testCases := map[string]struct {
@@ -125,6 +167,28 @@ func TestEnvList_Execute(t *testing.T) {
 			},
 			expectedContent: "test\ntest2\n",
 		},
+		"with csv envs": {
+			listOpts: listEnvOpts{
+				listEnvVars: listEnvVars{
+					outputFormat: outputFormatCSV,
+					appName:      "coolapp",
+				},
+				store: mockstore,
+			},
+			mocking: func() {
+				mockstore.EXPECT().
+					GetApplication(gomock.Eq("coolapp")).
+					Return(&config.Application{}, nil)
+				mockstore.
+					EXPECT().
+					ListEnvironments(gomock.Eq("coolapp")).
+					Return([]*config.Environment{
+						{App: "coolapp", Name: "test", Region: "us-west-2"},
+						{App: "coolapp", Name: "prod", Region: "us-east-1", Prod: true},
+					}, nil)
+			},
+			expectedContent: "app,name,region,accountID,prod,registryURL,executionRoleARN,managerRoleARN,customConfig\ncoolapp,test,us-west-2,,false,,,,\ncoolapp,prod,us-east-1,,true,,,,\n",
+		},
 		"with invalid app name": {
 			expectedErr: mockError,
 			listOpts: listEnvOpts{
@@ -184,6 +248,93 @@ func TestEnvList_Execute(t *testing.T) {
 			},
 			expectedContent: "test\ntest2 (prod)\n",
 		},
+		"with detailed envs": {
+			listOpts: listEnvOpts{
+				listEnvVars: listEnvVars{
+					appName:  "coolapp",
+					detailed: true,
+				},
+				store:           mockstore,
+				newEnvDescriber: newMockDescriber,
+			},
+			mocking: func() {
+				mockstore.EXPECT().
+					GetApplication(gomock.Eq("coolapp")).
+					Return(&config.Application{}, nil)
+				mockstore.
+					EXPECT().
+					ListEnvironments(gomock.Eq("coolapp")).
+					Return([]*config.Environment{
+						{Name: "test", Region: "us-west-2", AccountID: "123456789012"},
+						{Name: "prod", Region: "us-east-1", AccountID: "210987654321", Prod: true, CustomConfig: &config.CustomizeEnv{
+							ImportVPC: &config.ImportVPC{ID: "vpc-2"},
+						}},
+					}, nil)
+				mockDescriber.EXPECT().Outputs().Return(map[string]string{
+					"VpcId":     "vpc-1",
+					"ClusterId": "coolapp-test-Cluster",
+				}, nil)
+				mockDescriber.EXPECT().Outputs().Return(map[string]string{
+					"VpcId":     "vpc-2",
+					"ClusterId": "coolapp-prod-Cluster",
+				}, nil)
+			},
+			expectedContent: `Name         Region     Account ID    VPC               Cluster
+----         ------     ----------    ---               -------
+test         us-west-2  123456789012  vpc-1 (managed)   coolapp-test-Cluster
+prod (prod)  us-east-1  210987654321  vpc-2 (imported)  coolapp-prod-Cluster
+`,
+		},
+		"with detailed json envs": {
+			listOpts: listEnvOpts{
+				listEnvVars: listEnvVars{
+					appName:          "coolapp",
+					detailed:         true,
+					shouldOutputJSON: true,
+				},
+				store:           mockstore,
+				newEnvDescriber: newMockDescriber,
+			},
+			mocking: func() {
+				mockstore.EXPECT().
+					GetApplication(gomock.Eq("coolapp")).
+					Return(&config.Application{}, nil)
+				mockstore.
+					EXPECT().
+					ListEnvironments(gomock.Eq("coolapp")).
+					Return([]*config.Environment{
+						{Name: "test", Region: "us-west-2", AccountID: "123456789012"},
+					}, nil)
+				mockDescriber.EXPECT().Outputs().Return(map[string]string{
+					"VpcId":     "vpc-1",
+					"ClusterId": "coolapp-test-Cluster",
+				}, nil)
+			},
+			expectedContent: "{\"environments\":[{\"name\":\"test\",\"prod\":false,\"region\":\"us-west-2\",\"accountID\":\"123456789012\",\"vpc\":\"managed\",\"vpcID\":\"vpc-1\",\"cluster\":\"coolapp-test-Cluster\"}]}\n",
+		},
+		"with failed call to get detailed stack outputs": {
+			expectedErr: fmt.Errorf("get stack outputs of environment test: error"),
+			listOpts: listEnvOpts{
+				listEnvVars: listEnvVars{
+					appName:  "coolapp",
+					detailed: true,
+				},
+				store:           mockstore,
+				newEnvDescriber: newMockDescriber,
+			},
+			mocking: func() {
+				mockstore.EXPECT().
+					GetApplication(gomock.Eq("coolapp")).
+					Return(&config.Application{}, nil)
+				mockstore.
+					EXPECT().
+					ListEnvironments(gomock.Eq("coolapp")).
+					Return([]*config.Environment{
+						{Name: "test"},
+					}, nil)
+				mockDescriber.EXPECT().Outputs().Return(nil, mockError)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
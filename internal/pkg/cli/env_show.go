@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 
@@ -25,10 +26,12 @@ const (
 )
 
 type showEnvVars struct {
-	appName               string
-	name                  string
-	shouldOutputJSON      bool
-	shouldOutputResources bool
+	appName                  string
+	name                     string
+	shouldOutputJSON         bool
+	shouldOutputResources    bool
+	shouldOutputLoadBalancer bool
+	shouldOutputStackOutputs bool
 }
 
 type showEnvOpts struct {
@@ -42,10 +45,11 @@ type showEnvOpts struct {
 }
 
 func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
-	configStore, err := config.NewStore()
+	ssmStore, err := config.NewStore()
 	if err != nil {
 		return nil, fmt.Errorf("connect to copilot config store: %w", err)
 	}
+	configStore := config.NewCachedStore(ssmStore)
 	deployStore, err := deploy.NewStore(configStore)
 	if err != nil {
 		return nil, fmt.Errorf("connect to copilot deploy store: %w", err)
@@ -59,11 +63,12 @@ func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
 	}
 	opts.initEnvDescriber = func() error {
 		d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
-			App:             opts.appName,
-			Env:             opts.name,
-			ConfigStore:     configStore,
-			DeployStore:     deployStore,
-			EnableResources: opts.shouldOutputResources,
+			App:                opts.appName,
+			Env:                opts.name,
+			ConfigStore:        configStore,
+			DeployStore:        deployStore,
+			EnableResources:    opts.shouldOutputResources,
+			EnableLoadBalancer: opts.shouldOutputLoadBalancer,
 		})
 		if err != nil {
 			return fmt.Errorf("creating describer for environment %s in application %s: %w", opts.name, opts.appName, err)
@@ -76,6 +81,14 @@ func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
 
 // Validate returns an error if the values provided by the user are invalid.
 func (o *showEnvOpts) Validate() error {
+	if o.shouldOutputStackOutputs {
+		if o.shouldOutputResources {
+			return fmt.Errorf("cannot specify both --%s and --%s", outputsFlag, resourcesFlag)
+		}
+		if o.shouldOutputLoadBalancer {
+			return fmt.Errorf("cannot specify both --%s and --%s", outputsFlag, loadBalancerFlag)
+		}
+	}
 	if o.appName != "" {
 		if _, err := o.store.GetApplication(o.appName); err != nil {
 			return err
@@ -103,6 +116,9 @@ func (o *showEnvOpts) Execute() error {
 	if err := o.initEnvDescriber(); err != nil {
 		return err
 	}
+	if o.shouldOutputStackOutputs {
+		return o.showStackOutputs()
+	}
 	env, err := o.describer.Describe()
 	if err != nil {
 		return fmt.Errorf("describe environment %s: %w", o.name, err)
@@ -120,6 +136,34 @@ func (o *showEnvOpts) Execute() error {
 	return nil
 }
 
+// stackOutputs is the JSON output of the --outputs flag of the show commands.
+type stackOutputs struct {
+	SchemaVersion int         `json:"schemaVersion"`
+	Outputs       interface{} `json:"outputs"`
+}
+
+// marshalStackOutputs returns the JSON encoding of the stack outputs along with the schema version of the describe commands.
+func marshalStackOutputs(outputs interface{}) ([]byte, error) {
+	return json.Marshal(stackOutputs{
+		SchemaVersion: describe.JSONSchemaVersion,
+		Outputs:       outputs,
+	})
+}
+
+// showStackOutputs writes the outputs of the environment stack as a JSON map, regardless of the --json flag.
+func (o *showEnvOpts) showStackOutputs() error {
+	outputs, err := o.describer.Outputs()
+	if err != nil {
+		return fmt.Errorf("get the stack outputs of environment %s: %w", o.name, err)
+	}
+	data, err := marshalStackOutputs(outputs)
+	if err != nil {
+		return fmt.Errorf("marshal the stack outputs of environment %s: %w", o.name, err)
+	}
+	fmt.Fprintf(o.w, "%s\n", data)
+	return nil
+}
+
 func (o *showEnvOpts) askApp() error {
 	if o.appName != "" {
 		return nil
@@ -156,7 +200,11 @@ func buildEnvShowCmd() *cobra.Command {
 
 		Example: `
   Shows info about the environment "test".
-  /code $ copilot env show -n test`,
+  /code $ copilot env show -n test
+  Shows the listener rules of the load balancer in the environment "test".
+  /code $ copilot env show -n test --load-balancer
+  Shows the outputs of the CloudFormation stack of the environment "test" as JSON.
+  /code $ copilot env show -n test --outputs`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newShowEnvOpts(vars)
 			if err != nil {
@@ -175,5 +223,8 @@ func buildEnvShowCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputResources, resourcesFlag, false, envResourcesFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputLoadBalancer, loadBalancerFlag, false, envLoadBalancerFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputStackOutputs, outputsFlag, false, envOutputsFlagDescription)
+	markPromptedFlags(cmd, appFlag, nameFlag)
 	return cmd
 }
@@ -28,9 +28,12 @@ type showEnvMocks struct {
 
 func TestEnvShow_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inputApp         string
-		inputEnvironment string
-		setupMocks       func(mocks showEnvMocks)
+		inputApp          string
+		inputEnvironment  string
+		inputOutputs      bool
+		inputResources    bool
+		inputLoadBalancer bool
+		setupMocks        func(mocks showEnvMocks)
 
 		wantedError error
 	}{
@@ -76,6 +79,20 @@ func TestEnvShow_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("some error"),
 		},
+		"outputs with resources": {
+			inputOutputs:   true,
+			inputResources: true,
+			setupMocks:     func(m showEnvMocks) {},
+
+			wantedError: fmt.Errorf("cannot specify both --outputs and --resources"),
+		},
+		"outputs with load balancer": {
+			inputOutputs:      true,
+			inputLoadBalancer: true,
+			setupMocks:        func(m showEnvMocks) {},
+
+			wantedError: fmt.Errorf("cannot specify both --outputs and --load-balancer"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -93,8 +110,11 @@ func TestEnvShow_Validate(t *testing.T) {
 
 			showEnvs := &showEnvOpts{
 				showEnvVars: showEnvVars{
-					name:    tc.inputEnvironment,
-					appName: tc.inputApp,
+					name:                     tc.inputEnvironment,
+					appName:                  tc.inputApp,
+					shouldOutputStackOutputs: tc.inputOutputs,
+					shouldOutputResources:    tc.inputResources,
+					shouldOutputLoadBalancer: tc.inputLoadBalancer,
 				},
 				store: mockStoreReader,
 			}
@@ -249,8 +269,9 @@ func TestEnvShow_Execute(t *testing.T) {
 	}
 
 	testCases := map[string]struct {
-		inputEnv         string
-		shouldOutputJSON bool
+		inputEnv                 string
+		shouldOutputJSON         bool
+		shouldOutputStackOutputs bool
 
 		setupMocks func(mocks showEnvMocks)
 
@@ -325,7 +346,28 @@ Resources
 				)
 			},
 
-			wantedContent: "{\"environment\":{\"app\":\"testApp\",\"name\":\"testEnv\",\"region\":\"us-west-2\",\"accountID\":\"123456789012\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"},\"services\":[{\"app\":\"testApp\",\"name\":\"testSvc1\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc2\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc3\",\"type\":\"load-balanced\"}],\"tags\":{\"copilot-application\":\"testApp\",\"copilot-environment\":\"testEnv\",\"key1\":\"value1\",\"key2\":\"value2\"},\"resources\":[{\"type\":\"AWS::IAM::Role\",\"physicalID\":\"testApp-testEnv-CFNExecutionRole\"},{\"type\":\"testApp-testEnv-Cluster\",\"physicalID\":\"AWS::ECS::Cluster-jI63pYBWU6BZ\"}],\"environmentVPC\":{\"id\":\"\",\"publicSubnetIDs\":null,\"privateSubnetIDs\":null}}\n",
+			wantedContent: "{\"schemaVersion\":1,\"environment\":{\"app\":\"testApp\",\"name\":\"testEnv\",\"region\":\"us-west-2\",\"accountID\":\"123456789012\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\"},\"services\":[{\"app\":\"testApp\",\"name\":\"testSvc1\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc2\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc3\",\"type\":\"load-balanced\"}],\"tags\":{\"copilot-application\":\"testApp\",\"copilot-environment\":\"testEnv\",\"key1\":\"value1\",\"key2\":\"value2\"},\"resources\":[{\"type\":\"AWS::IAM::Role\",\"physicalID\":\"testApp-testEnv-CFNExecutionRole\"},{\"type\":\"testApp-testEnv-Cluster\",\"physicalID\":\"AWS::ECS::Cluster-jI63pYBWU6BZ\"}],\"environmentVPC\":{\"id\":\"\",\"publicSubnetIDs\":null,\"privateSubnetIDs\":null}}\n",
+		},
+		"return error if fail to get the stack outputs": {
+			inputEnv:                 "testEnv",
+			shouldOutputStackOutputs: true,
+			setupMocks: func(m showEnvMocks) {
+				m.describer.EXPECT().Outputs().Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("get the stack outputs of environment testEnv: some error"),
+		},
+		"success with stack outputs": {
+			inputEnv:                 "testEnv",
+			shouldOutputStackOutputs: true,
+			setupMocks: func(m showEnvMocks) {
+				m.describer.EXPECT().Outputs().Return(map[string]string{
+					"ClusterId": "testApp-testEnv-Cluster",
+					"VpcId":     "vpc-012abcd345",
+				}, nil)
+			},
+
+			wantedContent: "{\"schemaVersion\":1,\"outputs\":{\"ClusterId\":\"testApp-testEnv-Cluster\",\"VpcId\":\"vpc-012abcd345\"}}\n",
 		},
 	}
 
@@ -346,8 +388,9 @@ Resources
 
 			showEnvs := &showEnvOpts{
 				showEnvVars: showEnvVars{
-					name:             tc.inputEnv,
-					shouldOutputJSON: tc.shouldOutputJSON,
+					name:                     tc.inputEnv,
+					shouldOutputJSON:         tc.shouldOutputJSON,
+					shouldOutputStackOutputs: tc.shouldOutputStackOutputs,
 				},
 				store:            mockStoreReader,
 				describer:        mockEnvDescriber,
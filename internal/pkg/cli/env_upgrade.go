@@ -268,9 +268,23 @@ func (o *envUpgradeOpts) upgradeEnvironment(upgrader envUpgrader, conf *config.E
 	customResourcesURLs map[string]string, fromVersion, toVersion string) error {
 	var importedVPC *config.ImportVPC
 	var adjustedVPC *config.AdjustVPC
+	var webACLARN string
+	var importCertARNs []string
+	var httpToHTTPSRedirect bool
+	var idleTimeout int64
+	var natGateways string
+	var vpcEndpoints bool
+	var importClusterARN string
 	if conf.CustomConfig != nil {
 		importedVPC = conf.CustomConfig.ImportVPC
 		adjustedVPC = conf.CustomConfig.VPCConfig
+		webACLARN = conf.CustomConfig.WebACLARN
+		importCertARNs = conf.CustomConfig.ImportCertARNs
+		httpToHTTPSRedirect = conf.CustomConfig.HTTPToHTTPSRedirect
+		idleTimeout = conf.CustomConfig.IdleTimeout
+		natGateways = conf.CustomConfig.NATGateways
+		vpcEndpoints = conf.CustomConfig.VPCEndpoints
+		importClusterARN = conf.CustomConfig.ImportClusterARN
 	}
 
 	if err := upgrader.UpgradeEnvironment(&deploy.CreateEnvironmentInput{
@@ -280,6 +294,13 @@ func (o *envUpgradeOpts) upgradeEnvironment(upgrader envUpgrader, conf *config.E
 		CustomResourcesURLs: customResourcesURLs,
 		ImportVPCConfig:     importedVPC,
 		AdjustVPCConfig:     adjustedVPC,
+		WebACLARN:           webACLARN,
+		ImportCertARNs:      importCertARNs,
+		HTTPToHTTPSRedirect: httpToHTTPSRedirect,
+		IdleTimeout:         idleTimeout,
+		NATGateways:         natGateways,
+		VPCEndpoints:        vpcEndpoints,
+		ImportClusterARN:    importClusterARN,
 		CFNServiceRoleARN:   conf.ExecutionRoleARN,
 	}); err != nil {
 		return fmt.Errorf("upgrade environment %s from version %s to version %s: %v", conf.Name, fromVersion, toVersion, err)
@@ -343,12 +364,19 @@ func (o *envUpgradeOpts) upgradeLegacyEnvironmentWithVPCOverrides(upgrader legac
 	fromVersion, toVersion string, albWorkloads []string) error {
 	if conf.CustomConfig != nil {
 		if err := upgrader.UpgradeLegacyEnvironment(&deploy.CreateEnvironmentInput{
-			Version:           toVersion,
-			AppName:           conf.App,
-			Name:              conf.Name,
-			ImportVPCConfig:   conf.CustomConfig.ImportVPC,
-			AdjustVPCConfig:   conf.CustomConfig.VPCConfig,
-			CFNServiceRoleARN: conf.ExecutionRoleARN,
+			Version:             toVersion,
+			AppName:             conf.App,
+			Name:                conf.Name,
+			ImportVPCConfig:     conf.CustomConfig.ImportVPC,
+			AdjustVPCConfig:     conf.CustomConfig.VPCConfig,
+			WebACLARN:           conf.CustomConfig.WebACLARN,
+			ImportCertARNs:      conf.CustomConfig.ImportCertARNs,
+			HTTPToHTTPSRedirect: conf.CustomConfig.HTTPToHTTPSRedirect,
+			IdleTimeout:         conf.CustomConfig.IdleTimeout,
+			NATGateways:         conf.CustomConfig.NATGateways,
+			VPCEndpoints:        conf.CustomConfig.VPCEndpoints,
+			ImportClusterARN:    conf.CustomConfig.ImportClusterARN,
+			CFNServiceRoleARN:   conf.ExecutionRoleARN,
 		}, albWorkloads...); err != nil {
 			return fmt.Errorf("upgrade environment %s from version %s to version %s: %v", conf.Name, fromVersion, toVersion, err)
 		}
@@ -389,5 +417,7 @@ func buildEnvUpgradeCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.all, allFlag, false, upgradeAllEnvsDescription)
+	markPromptedFlags(cmd, appFlag)
+	markPromptedFlag(cmd, nameFlag, allFlag)
 	return cmd
 }
@@ -25,31 +25,67 @@ const (
 	yesFlag      = "yes"
 	jsonFlag     = "json"
 	allFlag      = "all"
+	noPromptFlag = "no-prompt"
+	debugFlag    = "debug"
+	fipsFlag     = "fips"
 
 	// Command specific flags.
 	dockerFileFlag        = "dockerfile"
+	dockerfileDepthFlag   = "dockerfile-search-depth"
 	imageTagFlag          = "tag"
 	resourceTagsFlag      = "resource-tags"
 	stackOutputDirFlag    = "output-dir"
 	limitFlag             = "limit"
+	eventsLimitFlag       = "events-limit"
 	followFlag            = "follow"
+	keepFlag              = "keep"
 	sinceFlag             = "since"
 	startTimeFlag         = "start-time"
 	endTimeFlag           = "end-time"
 	tasksFlag             = "tasks"
+	dependenciesFlag      = "dependencies"
 	logGroupFlag          = "log-group"
+	previousFlag          = "previous"
+	fromFlag              = "from"
+	toFlag                = "to"
 	prodEnvFlag           = "prod"
 	deployFlag            = "deploy"
 	resourcesFlag         = "resources"
+	includeMetricsFlag    = "include-metrics"
+	alarmsOnlyFlag        = "alarms-only"
+	alarmHistoryFlag      = "alarm-history"
+	formatFlag            = "format"
+	watchFlag             = "watch"
+	driftFlag             = "drift"
+	outputFlag            = "output"
+	detailedFlag          = "detailed"
 	githubURLFlag         = "github-url"
 	repoURLFlag           = "url"
 	githubAccessTokenFlag = "github-access-token"
 	gitBranchFlag         = "git-branch"
 	envsFlag              = "environments"
+	testCommandsFlag      = "test-commands"
+	stageTestCommandsFlag = "stage-test-commands"
+	testCommandsFileFlag  = "test-commands-file"
+	noRedactFlag          = "no-redact"
 	domainNameFlag        = "domain"
 	localFlag             = "local"
 	deleteSecretFlag      = "delete-secret"
 	svcPortFlag           = "port"
+	logRouterFlag         = "log-router"
+	logConfigFileFlag     = "log-config-file"
+	editFlag              = "edit"
+	typeHelpFlag          = "type-help"
+	loadBalancerFlag      = "load-balancer"
+	addonsDirFlag         = "addons-dir"
+	envFileFlag           = "env-file"
+	svcStorageFlag        = "storage"
+	autoscalingFlag       = "autoscaling"
+	outputsFlag           = "outputs"
+
+	healthCheckPathFlag             = "health-check-path"
+	healthCheckHealthyThresholdFlag = "healthcheck-healthy-threshold"
+	healthCheckIntervalFlag         = "healthcheck-interval"
 
 	storageTypeFlag              = "storage-type"
 	storagePartitionKeyFlag      = "partition-key"
@@ -81,13 +117,24 @@ const (
 	vpcIDFlag          = "import-vpc-id"
 	publicSubnetsFlag  = "import-public-subnets"
 	privateSubnetsFlag = "import-private-subnets"
+	importClusterFlag  = "import-cluster-arn"
+
+	capacityProviderFlag = "default-capacity-provider"
 
 	vpcCIDRFlag            = "override-vpc-cidr"
 	publicSubnetCIDRsFlag  = "override-public-cidrs"
 	privateSubnetCIDRsFlag = "override-private-cidrs"
+	natGatewaysFlag        = "nat-gateways"
+	vpcEndpointsFlag       = "vpc-endpoints"
+	azCountFlag            = "az-count"
 
 	defaultConfigFlag = "default-config"
 
+	webACLARNFlag      = "waf-web-acl-arn"
+	importCertARNsFlag = "import-certificate-arns"
+	httpsRedirectFlag  = "http-to-https-redirect"
+	idleTimeoutFlag    = "http-idle-timeout"
+
 	accessKeyIDFlag     = "aws-access-key-id"
 	secretAccessKeyFlag = "aws-secret-access-key"
 	sessionTokenFlag    = "aws-session-token"
@@ -105,6 +152,8 @@ const (
 	inputFilePathFlag = "cli-input-yaml"
 
 	includeStateMachineLogsFlag = "include-state-machine"
+
+	accountRegionFlag = "account-region"
 )
 
 // Short flag names.
@@ -182,6 +231,15 @@ const (
 	yesFlagDescription      = "Skips confirmation prompt."
 	execYesFlagDescription  = "Optional. Whether to update the Session Manager Plugin."
 	jsonFlagDescription     = "Optional. Outputs in JSON format."
+	noPromptFlagDescription = `Optional. Disables prompts and errors with the missing flags instead.
+Enabled by default if the standard input is not a terminal.`
+	debugFlagDescription = `Optional. Logs the AWS API calls, the SDK's requests and responses,
+and the steps of the command to standard error.`
+	fipsFlagDescription = `Optional. Sends the AWS requests to FIPS endpoints.
+Errors if the region doesn't offer FIPS endpoints.`
+
+	supportBundleOutputFlagDescription = "Optional. Path of the zip file to write the support bundle to."
+	noRedactFlagDescription            = "Optional. Do not redact the values of secrets and AWS account IDs."
 
 	imageTagFlagDescription     = `Optional. The container image tag.`
 	resourceTagsFlagDescription = `Optional. Labels with a key and value separated by commas.
@@ -192,7 +250,9 @@ Allows you to categorize resources.`
 	limitFlagDescription = `Optional. The maximum number of log events returned. Default is 10
 unless any time filtering flags are set.`
 	followFlagDescription = "Optional. Specifies if the logs should be streamed."
-	sinceFlagDescription  = `Optional. Only return logs newer than a relative duration like 5s, 2m, or 3h.
+	keepFlagDescription   = `Optional. Keep the resources of the task, such as its logs and image repository,
+after the streamed tasks stop. Can only be used with --follow.`
+	sinceFlagDescription = `Optional. Only return logs newer than a relative duration like 5s, 2m, or 3h.
 Defaults to all logs. Only one of start-time / since may be used.`
 	startTimeFlagDescription = `Optional. Only return logs after a specific date (RFC3339).
 Defaults to all logs. Only one of start-time / since may be used.`
@@ -201,20 +261,67 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	tasksLogsFlagDescription               = "Optional. Only return logs from specific task IDs."
 	includeStateMachineLogsFlagDescription = "Optional. Include logs from the state machine executions."
 	logGroupFlagDescription                = "Optional. Only return logs from specific log group."
+	previousFlagDescription                = "Optional. Only return logs from the most recently stopped task."
 
 	deployTestFlagDescription        = `Deploy your service or job to a "test" environment.`
 	githubURLFlagDescription         = "(Deprecated.) Use --url instead. Repository URL to trigger your pipeline."
 	githubAccessTokenFlagDescription = "GitHub personal access token for your repository."
 	gitBranchFlagDescription         = "Branch used to trigger your pipeline."
 	pipelineEnvsFlagDescription      = "Environments to add to the pipeline."
+	testCommandsFlagDescription      = `Optional. Commands to run after deploying to each environment of the pipeline.
+Can be specified multiple times.`
+	stageTestCommandsFlagDescription = `Optional. Command to run after deploying to an environment of the pipeline,
+in the format "environment=command". Can be specified multiple times.`
+	testCommandsFileFlagDescription = `Optional. Path to a YAML file that maps environments of the pipeline
+to the list of commands to run after deploying to them.`
 	domainNameFlagDescription        = "Optional. Your existing custom domain name."
 	envResourcesFlagDescription      = "Optional. Show the resources in your environment."
+	envLoadBalancerFlagDescription   = "Optional. Show the listener rules of your environment's load balancer."
+	envOutputsFlagDescription        = "Optional. Show the outputs of your environment's CloudFormation stack as a JSON map."
+	svcOutputsFlagDescription        = "Optional. Show the outputs of your service's CloudFormation stack per environment as JSON."
 	svcResourcesFlagDescription      = "Optional. Show the resources in your service."
+	svcIncludeMetricsFlagDescription = "Optional. Show links to the CloudWatch metrics of your service per environment."
+	svcShowTasksFlagDescription      = "Optional. Show the private IP, network interface, and subnet of the running tasks of your service."
+	svcShowSGsFlagDescription        = "Optional. Show the security group rules of your service and whether they allow traffic from the load balancer and other services."
+	svcShowDepsFlagDescription       = "Optional. Show the storage resources created by the addons of your service per environment."
+	svcAlarmsOnlyFlagDescription     = "Optional. Only show the status of the CloudWatch alarms of your service."
+	svcAlarmHistoryFlagDescription   = "Optional. Only show up to this number of the most recent state transitions of each alarm of your service."
+	svcFormatFlagDescription         = "Optional. Format the output of your service with a Go template."
+	svcWatchFlagDescription          = "Optional. Refresh the status every few seconds until your service is stable."
+	svcDriftFlagDescription          = "Optional. Show the resources of your service that were modified or deleted outside of CloudFormation."
+	listOutputFlagDescription        = `Optional. Output format. Must be "csv".`
+	envListDetailedFlagDescription   = "Optional. Show the region, account, VPC, and cluster of each environment."
+	versionOutputFlagDescription     = `Optional. Output format. Must be "json".`
+	svcEventsLimitFlagDescription    = `Optional. Show up to this number of the most recent
+CloudFormation stack events of your service per environment.
+Must be between 1 and 100.`
 	pipelineResourcesFlagDescription = "Optional. Show the resources in your pipeline."
 	localSvcFlagDescription          = "Only show services in the workspace."
 	localJobFlagDescription          = "Only show jobs in the workspace."
 	deleteSecretFlagDescription      = "Deletes AWS Secrets Manager secret associated with a pipeline source repository."
 	svcPortFlagDescription           = "The port on which your service listens."
+	logRouterFlagDescription         = `Optional. The FireLens log router sidecar to add to the service.
+Must be "fluentbit".`
+	logConfigFileFlagDescription = `Optional. Path of a custom Fluent Bit configuration file
+in the log router image. Requires --log-router.`
+	addonsDirFlagDescription = `Optional. Directory of addon CloudFormation templates
+to copy under the service's addons folder.`
+	editFlagDescription     = "Optional. Open the generated manifest in $EDITOR before writing it."
+	typeHelpFlagDescription = "Optional. Print a comparison of the service types and exit."
+	envFileFlagDescription  = `Optional. Path to a file of environment variables in the KEY=VALUE format
+to write under "variables" in the manifest.`
+	svcStorageFlagDescription = `Optional. Persistent storage to mount in the main container of
+a Load Balanced Web Service or Backend Service. Must be "efs".`
+	autoscalingFlagDescription = `Optional. Scale the number of tasks of a Load Balanced Web Service
+with target-tracking policies. You will be prompted for the range of tasks.`
+	healthCheckPathFlagDescription = `Optional. Path that the load balancer sends health check requests to
+for a Load Balanced Web Service. Defaults to "/".`
+	healthCheckHealthyThresholdFlagDescription = `Optional. Number of consecutive successful health checks
+before the load balancer considers a task healthy. Must be between 2 and 10.`
+	healthCheckIntervalFlagDescription = `Optional. Time between the load balancer's health checks of a task.
+Must be between 5s and 300s.`
+	dockerfileDepthFlagDescription = `Optional. Number of directory levels below the current directory
+to search for Dockerfiles to choose from. Must be between 0 and 5.`
 
 	storageFlagDescription             = "Name of the storage resource to create."
 	storageWorkloadFlagDescription     = "Name of the service or job to associate with storage."
@@ -252,19 +359,40 @@ Cannot be specified with any other flags.`
 	vpcIDFlagDescription          = "Optional. Use an existing VPC ID."
 	publicSubnetsFlagDescription  = "Optional. Use existing public subnet IDs."
 	privateSubnetsFlagDescription = "Optional. Use existing private subnet IDs."
+	importClusterFlagDescription  = "Optional. Use an existing ECS cluster ARN instead of creating a new cluster."
 
 	vpcCIDRFlagDescription            = "Optional. Global CIDR to use for VPC (default 10.0.0.0/16)."
 	publicSubnetCIDRsFlagDescription  = "Optional. CIDR to use for public subnets (default 10.0.0.0/24,10.0.1.0/24)."
 	privateSubnetCIDRsFlagDescription = "Optional. CIDR to use for private subnets (default 10.0.2.0/24,10.0.3.0/24)."
-
-	defaultConfigFlagDescription = "Optional. Skip prompting and use default environment configuration."
+	natGatewaysFlagDescription        = `Optional. Number of NAT gateways for workloads in private subnets (default "per-az").
+Must be one of "per-az", "single", or "none".
+"single" costs less but private subnets lose internet access if its availability zone fails.`
+	vpcEndpointsFlagDescription = `Optional. Create VPC endpoints for ECR, S3, and CloudWatch Logs so that workloads
+in private subnets can pull images and send logs without NAT gateways. Requires --nat-gateways "none".`
+	capacityProviderFlagDescription = `Optional. Capacity provider that services in the environment use
+unless their manifest sets "capacity_provider" (default "FARGATE").
+Must be one of "FARGATE" or "FARGATE_SPOT". Cannot be "FARGATE_SPOT" for production environments.`
+	azCountFlagDescription = `Optional. Number of availability zones to spread the VPC across, 2 or 3 (default 2).
+Uses 10.0.0.0/24,10.0.1.0/24,10.0.4.0/24 for public subnets and 10.0.2.0/24,10.0.3.0/24,10.0.5.0/24
+for private subnets with 3 availability zones, unless the CIDRs are overridden.`
+
+	defaultConfigFlagDescription = `Optional. Skip prompting for VPC configuration and
+create a new Copilot-managed VPC with default settings.`
+
+	webACLARNFlagDescription      = "Optional. ARN of a regional AWS WAF web ACL to associate with the load balancer."
+	importCertARNsFlagDescription = `Optional. ARNs of existing ACM certificates in the environment's region
+to use for the load balancer's HTTPS listener.`
+	httpsRedirectFlagDescription = `Optional. Redirect HTTP traffic on port 80 to HTTPS with a 301 status code.
+Requires an application with a domain or imported certificates.`
+	idleTimeoutFlagDescription = `Optional. The time a connection to the load balancers can stay idle, between 1s and 4000s.
+Increase it for long-polling or streaming services (default 60s).`
 
 	accessKeyIDFlagDescription     = "Optional. An AWS access key."
 	secretAccessKeyFlagDescription = "Optional. An AWS secret access key."
 	sessionTokenFlagDescription    = "Optional. An AWS session token for temporary credentials."
 	envRegionTokenFlagDescription  = "Optional. An AWS region where the environment will be created."
 
-	retriesFlagDescription = "Optional. The number of times to try restarting the job on a failure."
+	retriesFlagDescription = "Optional. The number of times to try restarting the job on a failure, between 0 and 10."
 	timeoutFlagDescription = `Optional. The total execution time for the task, including retries.
 Accepts valid Go duration strings. For example: "2h", "1h30m", "900s".`
 	scheduleFlagDescription = `The schedule on which to run this job. 
@@ -277,7 +405,12 @@ are also accepted.`
 
 	taskIDFlagDescription      = "Optional. ID of the task you want to exec in."
 	execCommandFlagDescription = `Optional. The command that is passed to a running container.`
-	containerFlagDescription   = "Optional. The specific container you want to exec in. By default the first essential container will be used."
+	containerFlagDescription   = "Optional. The specific container you want to exec in. If the task runs more than one container, you will be prompted to choose one."
+
+	connectTestFromFlagDescription = "Name of the service to test the connection from."
+	connectTestToFlagDescription   = "Name of the service to test the connection to."
 
 	secretOverwriteFlagDescription = "Optional. Whether to overwrite an existing secret."
+
+	accountRegionFlagDescription = "Optional. Show the accounts and regions where the application's shared resources are deployed."
 )
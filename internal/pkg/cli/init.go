@@ -50,6 +50,8 @@ type initVars struct {
 	image          string
 	imageTag       string
 
+	dockerfileDepth int // Number of directory levels searched for Dockerfiles to choose from.
+
 	// Service specific flags
 	port uint16
 
@@ -100,10 +102,10 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 		return nil, err
 	}
 	prompt := prompt.New()
-	sel := selector.NewWorkspaceSelect(prompt, ssm, ws)
+	sel := selector.NewWorkspaceSelect(prompt, ssm, ws, selector.WithDockerfileSearchDepth(vars.dockerfileDepth))
 	spin := termprogress.NewSpinner(log.DiagnosticWriter)
 	id := identity.New(defaultSess)
-	deployer := cloudformation.New(defaultSess)
+	deployer, err := newAppCloudFormation(defaultSess)
 	if err != nil {
 		return nil, err
 	}
@@ -196,11 +198,12 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 		setupWorkloadInit: func(o *initOpts, wkldType string) error {
 			wlInitializer := &initialize.WorkloadInitializer{Store: ssm, Ws: ws, Prog: spin, Deployer: deployer}
 			wkldVars := initWkldVars{
-				appName:        *o.appName,
-				wkldType:       wkldType,
-				name:           vars.svcName,
-				dockerfilePath: vars.dockerfilePath,
-				image:          vars.image,
+				appName:         *o.appName,
+				wkldType:        wkldType,
+				name:            vars.svcName,
+				dockerfilePath:  vars.dockerfilePath,
+				image:           vars.image,
+				dockerfileDepth: vars.dockerfileDepth,
 			}
 			switch t := wkldType; {
 			case t == manifest.ScheduledJobType:
@@ -464,6 +467,7 @@ func BuildInitCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.wkldType, typeFlag, typeFlagShort, "", wkldTypeFlagDescription)
 	cmd.Flags().StringVarP(&vars.dockerfilePath, dockerFileFlag, dockerFileFlagShort, "", dockerFileFlagDescription)
 	cmd.Flags().StringVarP(&vars.image, imageFlag, imageFlagShort, "", imageFlagDescription)
+	cmd.Flags().IntVar(&vars.dockerfileDepth, dockerfileDepthFlag, workspace.DefaultDockerfileSearchDepth, dockerfileDepthFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldDeploy, deployFlag, false, deployTestFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().Uint16Var(&vars.port, svcPortFlag, 0, svcPortFlagDescription)
@@ -474,5 +478,7 @@ func BuildInitCmd() *cobra.Command {
 	cmd.Annotations = map[string]string{
 		"group": group.GettingStarted,
 	}
+	markPromptedFlags(cmd, appFlag, nameFlag, typeFlag, deployFlag)
+	markPromptedFlag(cmd, dockerFileFlag, imageFlag)
 	return cmd
 }
@@ -10,8 +10,11 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -19,6 +22,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	describestack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/initialize"
@@ -172,6 +176,10 @@ type templater interface {
 	Template() (string, error)
 }
 
+type addonsValidator interface {
+	Validate() error
+}
+
 type stackSerializer interface {
 	templater
 	SerializedParameters() (string, error)
@@ -183,6 +191,7 @@ type runner interface {
 
 type eventsWriter interface {
 	WriteEventsUntilStopped() error
+	ExitCode() (int, error)
 }
 
 type defaultSessionProvider interface {
@@ -217,6 +226,10 @@ type describer interface {
 	Describe() (describe.HumanJSONStringer, error)
 }
 
+type stackEventsDescriber interface {
+	Events(limit int) ([]*describestack.Event, error)
+}
+
 type wsFileDeleter interface {
 	DeleteWorkspaceFile() error
 }
@@ -265,6 +278,11 @@ type wsJobReader interface {
 	wsJobLister
 }
 
+type wsWlManifestReader interface {
+	wsSvcReader
+	wsJobReader
+}
+
 type wsWlReader interface {
 	WorkloadNames() ([]string, error)
 }
@@ -279,7 +297,7 @@ type wsWlDirReader interface {
 	wsSvcReader
 	copilotDirGetter
 	wsWlReader
-	ListDockerfiles() ([]string, error)
+	ListDockerfiles(depth int) ([]string, error)
 	Summary() (*workspace.Summary, error)
 }
 
@@ -293,6 +311,11 @@ type wsAppManager interface {
 	Summary() (*workspace.Summary, error)
 }
 
+type wsSummaryRepairer interface {
+	Summary() (*workspace.Summary, error)
+	OverwriteSummary(appName string) error
+}
+
 type wsAddonManager interface {
 	WriteAddon(f encoding.BinaryMarshaler, svc, name string) (string, error)
 	wsWlReader
@@ -400,18 +423,45 @@ type statusDescriber interface {
 	Describe() (describe.HumanJSONStringer, error)
 }
 
+type alarmStatusDescriber interface {
+	DescribeAlarms() (describe.HumanJSONStringer, error)
+	DescribeAlarmHistory(limit int) (describe.HumanJSONStringer, error)
+}
+
 type envDescriber interface {
 	Describe() (*describe.EnvDescription, error)
+	Outputs() (map[string]string, error)
 }
 
 type versionGetter interface {
 	Version() (string, error)
 }
 
+type envOutputsDescriber interface {
+	Outputs() (map[string]string, error)
+}
+
+type svcOutputsDescriber interface {
+	Outputs() (map[string]string, error)
+}
+
+type stackInstanceLister interface {
+	InstanceSummaries(name string, opts ...stackset.InstanceSummariesOption) ([]stackset.InstanceSummary, error)
+}
+
 type endpointGetter interface {
 	ServiceDiscoveryEndpoint() (string, error)
 }
 
+type loadBalancerRulesDescriber interface {
+	LoadBalancerRules() ([]*describe.LoadBalancerRule, error)
+}
+
+type workloadEnvDescriber interface {
+	endpointGetter
+	loadBalancerRulesDescriber
+}
+
 type envTemplater interface {
 	EnvironmentTemplate(appName, envName string) (string, error)
 }
@@ -434,9 +484,13 @@ type appUpgrader interface {
 	UpgradeApplication(in *deploy.CreateAppInput) error
 }
 
+type pipelineLister interface {
+	ListPipelineNamesByTags(tags map[string]string) ([]string, error)
+}
+
 type pipelineGetter interface {
 	GetPipeline(pipelineName string) (*codepipeline.Pipeline, error)
-	ListPipelineNamesByTags(tags map[string]string) ([]string, error)
+	pipelineLister
 	GetPipelinesByTags(tags map[string]string) ([]*codepipeline.Pipeline, error)
 }
 
@@ -509,10 +563,26 @@ type credsSelector interface {
 
 type ec2Client interface {
 	HasDNSSupport(vpcID string) (bool, error)
+	ListAvailabilityZones() ([]string, error)
+	ListVPCSubnets(vpcID string) (*ec2.VPCSubnets, error)
+}
+
+type importedClusterDescriber interface {
+	ActiveCluster(cluster string) (bool, error)
+	ClusterCapacityProviders(cluster string) ([]string, error)
+}
+
+type clusterCapacityProvidersGetter interface {
+	ClusterCapacityProviders(cluster string) ([]string, error)
+}
+
+type certificateValidator interface {
+	ValidateCertificates(certARNs []string) error
 }
 
 type serviceResumer interface {
 	ResumeService(string) error
+	DescribeService(svcARN string) (*apprunner.Service, error)
 }
 
 type jobInitializer interface {
@@ -531,6 +601,10 @@ type serviceDescriber interface {
 	DescribeService(app, env, svc string) (*ecs.ServiceDesc, error)
 }
 
+type serviceDiscoveryURIGetter interface {
+	ServiceDiscoveryURI(envName string) (string, error)
+}
+
 type apprunnerServiceDescriber interface {
 	ServiceARN() (string, error)
 }
@@ -595,4 +669,5 @@ type secretPutter interface {
 
 type servicePauser interface {
 	PauseService(svcARN string) error
+	DescribeService(svcARN string) (*apprunner.Service, error)
 }
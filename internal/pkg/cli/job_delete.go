@@ -83,6 +83,10 @@ func newDeleteJobOpts(vars deleteJobVars) (*deleteJobOpts, error) {
 	if err != nil {
 		return nil, err
 	}
+	appCFN, err := newAppCloudFormation(defaultSession)
+	if err != nil {
+		return nil, err
+	}
 	prompter := prompt.New()
 	return &deleteJobOpts{
 		deleteJobVars: vars,
@@ -92,7 +96,7 @@ func newDeleteJobOpts(vars deleteJobVars) (*deleteJobOpts, error) {
 		prompt:  prompt.New(),
 		sel:     selector.NewConfigSelect(prompter, store),
 		sess:    provider,
-		appCFN:  cloudformation.New(defaultSession),
+		appCFN:  appCFN,
 		newWlDeleter: func(session *session.Session) wlDeleter {
 			return cloudformation.New(session)
 		},
@@ -402,5 +406,6 @@ func buildJobDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	markPromptedFlags(cmd, appFlag, nameFlag, yesFlag)
 	return cmd
 }
@@ -10,7 +10,6 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
 	"github.com/aws/copilot-cli/internal/pkg/config"
-	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/initialize"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
@@ -38,6 +37,9 @@ jobs or those which require specific execution schedules.`
 	fmtJobInitTypeHelp = "A %s is a task which is invoked on a set schedule, with optional retry logic."
 )
 
+// maxJobRetries is the maximum number of times a job can be retried on a failure.
+const maxJobRetries = 10
+
 var jobTypeHints = map[string]string{
 	manifest.ScheduledJobType: "Scheduled event to State Machine to Fargate",
 }
@@ -61,6 +63,10 @@ type initJobOpts struct {
 	sel          initJobSelector
 	dockerEngine dockerEngine
 
+	// Absolute path to the root of the workspace. Relative Dockerfile paths are resolved from it.
+	// If empty, they are resolved from the current directory where the workspace will be created.
+	wsRoot string
+
 	// Outputs stored on successful actions.
 	manifestPath string
 	os           string
@@ -87,16 +93,20 @@ func newInitJobOpts(vars initJobVars) (*initJobOpts, error) {
 	if err != nil {
 		return nil, err
 	}
+	deployer, err := newAppCloudFormation(sess)
+	if err != nil {
+		return nil, err
+	}
 
 	jobInitter := &initialize.WorkloadInitializer{
 		Store:    store,
 		Ws:       ws,
 		Prog:     termprogress.NewSpinner(log.DiagnosticWriter),
-		Deployer: cloudformation.New(sess),
+		Deployer: deployer,
 	}
 
 	prompter := prompt.New()
-	sel := selector.NewWorkspaceSelect(prompter, store, ws)
+	sel := selector.NewWorkspaceSelect(prompter, store, ws, selector.WithDockerfileSearchDepth(vars.dockerfileDepth))
 
 	return &initJobOpts{
 		initJobVars: vars,
@@ -107,6 +117,7 @@ func newInitJobOpts(vars initJobVars) (*initJobOpts, error) {
 		prompt:       prompter,
 		sel:          sel,
 		dockerEngine: exec.NewDockerCommand(),
+		wsRoot:       workspaceRoot(ws),
 		initParser: func(path string) dockerfileParser {
 			return exec.NewDockerfile(fs, path)
 		},
@@ -132,10 +143,13 @@ func (o *initJobOpts) Validate() error {
 		return fmt.Errorf("--%s and --%s cannot be specified together", dockerFileFlag, imageFlag)
 	}
 	if o.dockerfilePath != "" {
-		if _, err := o.fs.Stat(o.dockerfilePath); err != nil {
+		if _, err := o.fs.Stat(resolveDockerfilePath(o.wsRoot, o.dockerfilePath)); err != nil {
 			return err
 		}
 	}
+	if err := validateDockerfileDepth(o.dockerfileDepth); err != nil {
+		return err
+	}
 	if o.schedule != "" {
 		if err := validateSchedule(o.schedule); err != nil {
 			return err
@@ -146,8 +160,8 @@ func (o *initJobOpts) Validate() error {
 			return err
 		}
 	}
-	if o.retries < 0 {
-		return errors.New("number of retries must be non-negative")
+	if o.retries < 0 || o.retries > maxJobRetries {
+		return fmt.Errorf("number of retries must be between 0 and %d", maxJobRetries)
 	}
 	return nil
 }
@@ -181,7 +195,7 @@ func (o *initJobOpts) Execute() error {
 	var hc *manifest.ContainerHealthCheck
 	var err error
 	if o.dockerfilePath != "" {
-		hc, err = parseHealthCheck(o.initParser(o.dockerfilePath))
+		hc, err = parseHealthCheck(o.initParser(resolveDockerfilePath(o.wsRoot, o.dockerfilePath)))
 		if err != nil {
 			log.Warningf("Cannot parse the HEALTHCHECK instruction from the Dockerfile: %v\n", err)
 		}
@@ -197,7 +211,7 @@ func (o *initJobOpts) Execute() error {
 			App:            o.appName,
 			Name:           o.name,
 			Type:           o.wkldType,
-			DockerfilePath: o.dockerfilePath,
+			DockerfilePath: resolveDockerfilePath(o.wsRoot, o.dockerfilePath),
 			Image:          o.image,
 			Platform: &manifest.PlatformConfig{
 				OS:   o.os,
@@ -302,7 +316,10 @@ func (o *initJobOpts) askDockerfile() (isDfSelected bool, err error) {
 	if df == selector.DockerfilePromptUseImage {
 		return false, nil
 	}
-	o.dockerfilePath = df
+	o.dockerfilePath, err = dockerfilePathFromRoot(o.wsRoot, df)
+	if err != nil {
+		return false, err
+	}
 	return true, nil
 }
 
@@ -376,10 +393,13 @@ func buildJobInitCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.timeout, timeoutFlag, "", timeoutFlagDescription)
 	cmd.Flags().IntVar(&vars.retries, retriesFlag, 0, retriesFlagDescription)
 	cmd.Flags().StringVarP(&vars.image, imageFlag, imageFlagShort, "", imageFlagDescription)
+	cmd.Flags().IntVar(&vars.dockerfileDepth, dockerfileDepthFlag, workspace.DefaultDockerfileSearchDepth, dockerfileDepthFlagDescription)
 
 	cmd.Annotations = map[string]string{
 		"group": group.Develop,
 	}
 
+	markPromptedFlags(cmd, jobTypeFlag, nameFlag, scheduleFlag)
+	markPromptedFlag(cmd, dockerFileFlag, imageFlag)
 	return cmd
 }
@@ -21,13 +21,14 @@ import (
 
 func TestJobInitOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inAppName        string
-		inJobName        string
-		inDockerfilePath string
-		inImage          string
-		inTimeout        string
-		inRetries        int
-		inSchedule       string
+		inAppName         string
+		inJobName         string
+		inDockerfilePath  string
+		inImage           string
+		inTimeout         string
+		inRetries         int
+		inSchedule        string
+		inDockerfileDepth int
 
 		mockFileSystem func(mockFS afero.Fs)
 		wantedErr      error
@@ -124,7 +125,12 @@ func TestJobInitOpts_Validate(t *testing.T) {
 		"invalid number of times to retry": {
 			inAppName: "phonetool",
 			inRetries: -3,
-			wantedErr: errors.New("number of retries must be non-negative"),
+			wantedErr: errors.New("number of retries must be between 0 and 10"),
+		},
+		"too many retries": {
+			inAppName: "phonetool",
+			inRetries: 11,
+			wantedErr: errors.New("number of retries must be between 0 and 10"),
 		},
 		"fail if both image and dockerfile are set": {
 			inAppName:        "phonetool",
@@ -132,6 +138,11 @@ func TestJobInitOpts_Validate(t *testing.T) {
 			inImage:          "mockImage",
 			wantedErr:        fmt.Errorf("--dockerfile and --image cannot be specified together"),
 		},
+		"fail if the Dockerfile search depth is too deep": {
+			inAppName:         "phonetool",
+			inDockerfileDepth: 6,
+			wantedErr:         errors.New("invalid --dockerfile-search-depth 6: must be between 0 and 5"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -139,10 +150,11 @@ func TestJobInitOpts_Validate(t *testing.T) {
 			opts := initJobOpts{
 				initJobVars: initJobVars{
 					initWkldVars: initWkldVars{
-						appName:        tc.inAppName,
-						name:           tc.inJobName,
-						image:          tc.inImage,
-						dockerfilePath: tc.inDockerfilePath,
+						appName:         tc.inAppName,
+						name:            tc.inJobName,
+						image:           tc.inImage,
+						dockerfilePath:  tc.inDockerfilePath,
+						dockerfileDepth: tc.inDockerfileDepth,
 					},
 					timeout:  tc.inTimeout,
 					retries:  tc.inRetries,
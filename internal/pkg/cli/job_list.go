@@ -43,6 +43,7 @@ func newListJobOpts(vars listWkldVars) (*listJobOpts, error) {
 
 		ShowLocalJobs: vars.shouldOutputJSON,
 		OutputJSON:    vars.shouldOutputJSON,
+		OutputCSV:     vars.outputFormat == outputFormatCSV,
 	}
 
 	return &listJobOpts{
@@ -53,6 +54,11 @@ func newListJobOpts(vars listWkldVars) (*listJobOpts, error) {
 	}, nil
 }
 
+// Validate returns an error if the values provided by the user are invalid.
+func (o *listJobOpts) Validate() error {
+	return validateListOutputFormat(o.shouldOutputJSON, o.outputFormat)
+}
+
 func (o *listJobOpts) Ask() error {
 	if o.appName != "" {
 		return nil
@@ -81,12 +87,18 @@ func buildJobListCmd() *cobra.Command {
 		Short: "Lists all the jobs in an application.",
 		Example: `
   Lists all the jobs for the "myapp" application.
-  /code $ copilot job ls --app myapp`,
+  /code $ copilot job ls --app myapp
+
+  Lists all the jobs for the "myapp" application as CSV.
+  /code $ copilot job ls --app myapp --output csv`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newListJobOpts(vars)
 			if err != nil {
 				return err
 			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
 			if err := opts.Ask(); err != nil {
 				return err
 			}
@@ -96,5 +108,7 @@ func buildJobListCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldShowLocalWorkloads, localFlag, false, localJobFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFlag, "", listOutputFlagDescription)
+	markPromptedFlags(cmd, appFlag)
 	return cmd
 }
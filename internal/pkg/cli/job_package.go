@@ -105,7 +105,7 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 			addonsWriter:     ioutil.Discard,
 			fs:               &afero.Afero{Fs: afero.NewOsFs()},
 			stackSerializer:  o.stackSerializer,
-			newEndpointGetter: func(app, env string) (endpointGetter, error) {
+			newEnvDescriber: func(app, env string) (workloadEnvDescriber, error) {
 				d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 					App:         app,
 					Env:         env,
@@ -221,5 +221,6 @@ func buildJobPackageCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVar(&vars.tag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringVar(&vars.outputDir, stackOutputDirFlag, "", stackOutputDirFlagDescription)
+	markPromptedFlags(cmd, nameFlag, envFlag)
 	return cmd
 }
@@ -10,8 +10,11 @@ import (
 	reflect "reflect"
 
 	session "github.com/aws/aws-sdk-go/aws/session"
+	apprunner "github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	stackset "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
 	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	s3 "github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	ssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
@@ -20,6 +23,7 @@ import (
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	stack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	describe "github.com/aws/copilot-cli/internal/pkg/describe"
+	stack0 "github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	ecs0 "github.com/aws/copilot-cli/internal/pkg/ecs"
 	exec "github.com/aws/copilot-cli/internal/pkg/exec"
 	initialize "github.com/aws/copilot-cli/internal/pkg/initialize"
@@ -1573,6 +1577,43 @@ func (mr *MocktemplaterMockRecorder) Template() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*Mocktemplater)(nil).Template))
 }
 
+// MockaddonsValidator is a mock of addonsValidator interface.
+type MockaddonsValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockaddonsValidatorMockRecorder
+}
+
+// MockaddonsValidatorMockRecorder is the mock recorder for MockaddonsValidator.
+type MockaddonsValidatorMockRecorder struct {
+	mock *MockaddonsValidator
+}
+
+// NewMockaddonsValidator creates a new mock instance.
+func NewMockaddonsValidator(ctrl *gomock.Controller) *MockaddonsValidator {
+	mock := &MockaddonsValidator{ctrl: ctrl}
+	mock.recorder = &MockaddonsValidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockaddonsValidator) EXPECT() *MockaddonsValidatorMockRecorder {
+	return m.recorder
+}
+
+// Validate mocks base method.
+func (m *MockaddonsValidator) Validate() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockaddonsValidatorMockRecorder) Validate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockaddonsValidator)(nil).Validate))
+}
+
 // MockstackSerializer is a mock of stackSerializer interface.
 type MockstackSerializer struct {
 	ctrl     *gomock.Controller
@@ -1691,6 +1732,21 @@ func (m *MockeventsWriter) EXPECT() *MockeventsWriterMockRecorder {
 	return m.recorder
 }
 
+// ExitCode mocks base method.
+func (m *MockeventsWriter) ExitCode() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExitCode")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExitCode indicates an expected call of ExitCode.
+func (mr *MockeventsWriterMockRecorder) ExitCode() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExitCode", reflect.TypeOf((*MockeventsWriter)(nil).ExitCode))
+}
+
 // WriteEventsUntilStopped mocks base method.
 func (m *MockeventsWriter) WriteEventsUntilStopped() error {
 	m.ctrl.T.Helper()
@@ -2031,6 +2087,44 @@ func (mr *MockdescriberMockRecorder) Describe() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*Mockdescriber)(nil).Describe))
 }
 
+// MockstackEventsDescriber is a mock of stackEventsDescriber interface.
+type MockstackEventsDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackEventsDescriberMockRecorder
+}
+
+// MockstackEventsDescriberMockRecorder is the mock recorder for MockstackEventsDescriber.
+type MockstackEventsDescriberMockRecorder struct {
+	mock *MockstackEventsDescriber
+}
+
+// NewMockstackEventsDescriber creates a new mock instance.
+func NewMockstackEventsDescriber(ctrl *gomock.Controller) *MockstackEventsDescriber {
+	mock := &MockstackEventsDescriber{ctrl: ctrl}
+	mock.recorder = &MockstackEventsDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackEventsDescriber) EXPECT() *MockstackEventsDescriberMockRecorder {
+	return m.recorder
+}
+
+// Events mocks base method.
+func (m *MockstackEventsDescriber) Events(limit int) ([]*stack0.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Events", limit)
+	ret0, _ := ret[0].([]*stack0.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Events indicates an expected call of Events.
+func (mr *MockstackEventsDescriberMockRecorder) Events(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MockstackEventsDescriber)(nil).Events), limit)
+}
+
 // MockwsFileDeleter is a mock of wsFileDeleter interface.
 type MockwsFileDeleter struct {
 	ctrl     *gomock.Controller
@@ -2523,6 +2617,89 @@ func (mr *MockwsJobReaderMockRecorder) ReadJobManifest(jobName interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadJobManifest", reflect.TypeOf((*MockwsJobReader)(nil).ReadJobManifest), jobName)
 }
 
+// MockwsWlManifestReader is a mock of wsWlManifestReader interface.
+type MockwsWlManifestReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsWlManifestReaderMockRecorder
+}
+
+// MockwsWlManifestReaderMockRecorder is the mock recorder for MockwsWlManifestReader.
+type MockwsWlManifestReaderMockRecorder struct {
+	mock *MockwsWlManifestReader
+}
+
+// NewMockwsWlManifestReader creates a new mock instance.
+func NewMockwsWlManifestReader(ctrl *gomock.Controller) *MockwsWlManifestReader {
+	mock := &MockwsWlManifestReader{ctrl: ctrl}
+	mock.recorder = &MockwsWlManifestReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsWlManifestReader) EXPECT() *MockwsWlManifestReaderMockRecorder {
+	return m.recorder
+}
+
+// JobNames mocks base method.
+func (m *MockwsWlManifestReader) JobNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JobNames indicates an expected call of JobNames.
+func (mr *MockwsWlManifestReaderMockRecorder) JobNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobNames", reflect.TypeOf((*MockwsWlManifestReader)(nil).JobNames))
+}
+
+// ReadJobManifest mocks base method.
+func (m *MockwsWlManifestReader) ReadJobManifest(jobName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadJobManifest", jobName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadJobManifest indicates an expected call of ReadJobManifest.
+func (mr *MockwsWlManifestReaderMockRecorder) ReadJobManifest(jobName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadJobManifest", reflect.TypeOf((*MockwsWlManifestReader)(nil).ReadJobManifest), jobName)
+}
+
+// ReadServiceManifest mocks base method.
+func (m *MockwsWlManifestReader) ReadServiceManifest(svcName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadServiceManifest", svcName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadServiceManifest indicates an expected call of ReadServiceManifest.
+func (mr *MockwsWlManifestReaderMockRecorder) ReadServiceManifest(svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MockwsWlManifestReader)(nil).ReadServiceManifest), svcName)
+}
+
+// ServiceNames mocks base method.
+func (m *MockwsWlManifestReader) ServiceNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceNames indicates an expected call of ServiceNames.
+func (mr *MockwsWlManifestReaderMockRecorder) ServiceNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNames", reflect.TypeOf((*MockwsWlManifestReader)(nil).ServiceNames))
+}
+
 // MockwsWlReader is a mock of wsWlReader interface.
 type MockwsWlReader struct {
 	ctrl     *gomock.Controller
@@ -2683,18 +2860,18 @@ func (mr *MockwsWlDirReaderMockRecorder) JobNames() *gomock.Call {
 }
 
 // ListDockerfiles mocks base method.
-func (m *MockwsWlDirReader) ListDockerfiles() ([]string, error) {
+func (m *MockwsWlDirReader) ListDockerfiles(depth int) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDockerfiles")
+	ret := m.ctrl.Call(m, "ListDockerfiles", depth)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListDockerfiles indicates an expected call of ListDockerfiles.
-func (mr *MockwsWlDirReaderMockRecorder) ListDockerfiles() *gomock.Call {
+func (mr *MockwsWlDirReaderMockRecorder) ListDockerfiles(depth interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDockerfiles", reflect.TypeOf((*MockwsWlDirReader)(nil).ListDockerfiles))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDockerfiles", reflect.TypeOf((*MockwsWlDirReader)(nil).ListDockerfiles), depth)
 }
 
 // ReadJobManifest mocks base method.
@@ -2877,6 +3054,58 @@ func (mr *MockwsAppManagerMockRecorder) Summary() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockwsAppManager)(nil).Summary))
 }
 
+// MockwsSummaryRepairer is a mock of wsSummaryRepairer interface.
+type MockwsSummaryRepairer struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsSummaryRepairerMockRecorder
+}
+
+// MockwsSummaryRepairerMockRecorder is the mock recorder for MockwsSummaryRepairer.
+type MockwsSummaryRepairerMockRecorder struct {
+	mock *MockwsSummaryRepairer
+}
+
+// NewMockwsSummaryRepairer creates a new mock instance.
+func NewMockwsSummaryRepairer(ctrl *gomock.Controller) *MockwsSummaryRepairer {
+	mock := &MockwsSummaryRepairer{ctrl: ctrl}
+	mock.recorder = &MockwsSummaryRepairerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsSummaryRepairer) EXPECT() *MockwsSummaryRepairerMockRecorder {
+	return m.recorder
+}
+
+// OverwriteSummary mocks base method.
+func (m *MockwsSummaryRepairer) OverwriteSummary(appName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OverwriteSummary", appName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OverwriteSummary indicates an expected call of OverwriteSummary.
+func (mr *MockwsSummaryRepairerMockRecorder) OverwriteSummary(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OverwriteSummary", reflect.TypeOf((*MockwsSummaryRepairer)(nil).OverwriteSummary), appName)
+}
+
+// Summary mocks base method.
+func (m *MockwsSummaryRepairer) Summary() (*workspace.Summary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Summary")
+	ret0, _ := ret[0].(*workspace.Summary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Summary indicates an expected call of Summary.
+func (mr *MockwsSummaryRepairerMockRecorder) Summary() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockwsSummaryRepairer)(nil).Summary))
+}
+
 // MockwsAddonManager is a mock of wsAddonManager interface.
 type MockwsAddonManager struct {
 	ctrl     *gomock.Controller
@@ -4207,6 +4436,59 @@ func (mr *MockstatusDescriberMockRecorder) Describe() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstatusDescriber)(nil).Describe))
 }
 
+// MockalarmStatusDescriber is a mock of alarmStatusDescriber interface.
+type MockalarmStatusDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockalarmStatusDescriberMockRecorder
+}
+
+// MockalarmStatusDescriberMockRecorder is the mock recorder for MockalarmStatusDescriber.
+type MockalarmStatusDescriberMockRecorder struct {
+	mock *MockalarmStatusDescriber
+}
+
+// NewMockalarmStatusDescriber creates a new mock instance.
+func NewMockalarmStatusDescriber(ctrl *gomock.Controller) *MockalarmStatusDescriber {
+	mock := &MockalarmStatusDescriber{ctrl: ctrl}
+	mock.recorder = &MockalarmStatusDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockalarmStatusDescriber) EXPECT() *MockalarmStatusDescriberMockRecorder {
+	return m.recorder
+}
+
+// DescribeAlarmHistory mocks base method.
+func (m *MockalarmStatusDescriber) DescribeAlarmHistory(limit int) (describe.HumanJSONStringer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAlarmHistory", limit)
+	ret0, _ := ret[0].(describe.HumanJSONStringer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAlarmHistory indicates an expected call of DescribeAlarmHistory.
+func (mr *MockalarmStatusDescriberMockRecorder) DescribeAlarmHistory(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAlarmHistory", reflect.TypeOf((*MockalarmStatusDescriber)(nil).DescribeAlarmHistory), limit)
+}
+
+// DescribeAlarms mocks base method.
+func (m *MockalarmStatusDescriber) DescribeAlarms() (describe.HumanJSONStringer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAlarms")
+	ret0, _ := ret[0].(describe.HumanJSONStringer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAlarms indicates an expected call of DescribeAlarms.
+func (mr *MockalarmStatusDescriberMockRecorder) DescribeAlarms() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAlarms", reflect.TypeOf((*MockalarmStatusDescriber)(nil).DescribeAlarms))
+}
+
 // MockenvDescriber is a mock of envDescriber interface.
 type MockenvDescriber struct {
 	ctrl     *gomock.Controller
@@ -4245,6 +4527,21 @@ func (mr *MockenvDescriberMockRecorder) Describe() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockenvDescriber)(nil).Describe))
 }
 
+// Outputs mocks base method.
+func (m *MockenvDescriber) Outputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Outputs indicates an expected call of Outputs.
+func (mr *MockenvDescriberMockRecorder) Outputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MockenvDescriber)(nil).Outputs))
+}
+
 // MockversionGetter is a mock of versionGetter interface.
 type MockversionGetter struct {
 	ctrl     *gomock.Controller
@@ -4283,6 +4580,125 @@ func (mr *MockversionGetterMockRecorder) Version() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockversionGetter)(nil).Version))
 }
 
+// MockenvOutputsDescriber is a mock of envOutputsDescriber interface.
+type MockenvOutputsDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvOutputsDescriberMockRecorder
+}
+
+// MockenvOutputsDescriberMockRecorder is the mock recorder for MockenvOutputsDescriber.
+type MockenvOutputsDescriberMockRecorder struct {
+	mock *MockenvOutputsDescriber
+}
+
+// NewMockenvOutputsDescriber creates a new mock instance.
+func NewMockenvOutputsDescriber(ctrl *gomock.Controller) *MockenvOutputsDescriber {
+	mock := &MockenvOutputsDescriber{ctrl: ctrl}
+	mock.recorder = &MockenvOutputsDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvOutputsDescriber) EXPECT() *MockenvOutputsDescriberMockRecorder {
+	return m.recorder
+}
+
+// Outputs mocks base method.
+func (m *MockenvOutputsDescriber) Outputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Outputs indicates an expected call of Outputs.
+func (mr *MockenvOutputsDescriberMockRecorder) Outputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MockenvOutputsDescriber)(nil).Outputs))
+}
+
+// MocksvcOutputsDescriber is a mock of svcOutputsDescriber interface.
+type MocksvcOutputsDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcOutputsDescriberMockRecorder
+}
+
+// MocksvcOutputsDescriberMockRecorder is the mock recorder for MocksvcOutputsDescriber.
+type MocksvcOutputsDescriberMockRecorder struct {
+	mock *MocksvcOutputsDescriber
+}
+
+// NewMocksvcOutputsDescriber creates a new mock instance.
+func NewMocksvcOutputsDescriber(ctrl *gomock.Controller) *MocksvcOutputsDescriber {
+	mock := &MocksvcOutputsDescriber{ctrl: ctrl}
+	mock.recorder = &MocksvcOutputsDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksvcOutputsDescriber) EXPECT() *MocksvcOutputsDescriberMockRecorder {
+	return m.recorder
+}
+
+// Outputs mocks base method.
+func (m *MocksvcOutputsDescriber) Outputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Outputs indicates an expected call of Outputs.
+func (mr *MocksvcOutputsDescriberMockRecorder) Outputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MocksvcOutputsDescriber)(nil).Outputs))
+}
+
+// MockstackInstanceLister is a mock of stackInstanceLister interface.
+type MockstackInstanceLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackInstanceListerMockRecorder
+}
+
+// MockstackInstanceListerMockRecorder is the mock recorder for MockstackInstanceLister.
+type MockstackInstanceListerMockRecorder struct {
+	mock *MockstackInstanceLister
+}
+
+// NewMockstackInstanceLister creates a new mock instance.
+func NewMockstackInstanceLister(ctrl *gomock.Controller) *MockstackInstanceLister {
+	mock := &MockstackInstanceLister{ctrl: ctrl}
+	mock.recorder = &MockstackInstanceListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackInstanceLister) EXPECT() *MockstackInstanceListerMockRecorder {
+	return m.recorder
+}
+
+// InstanceSummaries mocks base method.
+func (m *MockstackInstanceLister) InstanceSummaries(name string, opts ...stackset.InstanceSummariesOption) ([]stackset.InstanceSummary, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "InstanceSummaries", varargs...)
+	ret0, _ := ret[0].([]stackset.InstanceSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstanceSummaries indicates an expected call of InstanceSummaries.
+func (mr *MockstackInstanceListerMockRecorder) InstanceSummaries(name interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceSummaries", reflect.TypeOf((*MockstackInstanceLister)(nil).InstanceSummaries), varargs...)
+}
+
 // MockendpointGetter is a mock of endpointGetter interface.
 type MockendpointGetter struct {
 	ctrl     *gomock.Controller
@@ -4321,6 +4737,97 @@ func (mr *MockendpointGetterMockRecorder) ServiceDiscoveryEndpoint() *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceDiscoveryEndpoint", reflect.TypeOf((*MockendpointGetter)(nil).ServiceDiscoveryEndpoint))
 }
 
+// MockloadBalancerRulesDescriber is a mock of loadBalancerRulesDescriber interface.
+type MockloadBalancerRulesDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockloadBalancerRulesDescriberMockRecorder
+}
+
+// MockloadBalancerRulesDescriberMockRecorder is the mock recorder for MockloadBalancerRulesDescriber.
+type MockloadBalancerRulesDescriberMockRecorder struct {
+	mock *MockloadBalancerRulesDescriber
+}
+
+// NewMockloadBalancerRulesDescriber creates a new mock instance.
+func NewMockloadBalancerRulesDescriber(ctrl *gomock.Controller) *MockloadBalancerRulesDescriber {
+	mock := &MockloadBalancerRulesDescriber{ctrl: ctrl}
+	mock.recorder = &MockloadBalancerRulesDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockloadBalancerRulesDescriber) EXPECT() *MockloadBalancerRulesDescriberMockRecorder {
+	return m.recorder
+}
+
+// LoadBalancerRules mocks base method.
+func (m *MockloadBalancerRulesDescriber) LoadBalancerRules() ([]*describe.LoadBalancerRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadBalancerRules")
+	ret0, _ := ret[0].([]*describe.LoadBalancerRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadBalancerRules indicates an expected call of LoadBalancerRules.
+func (mr *MockloadBalancerRulesDescriberMockRecorder) LoadBalancerRules() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadBalancerRules", reflect.TypeOf((*MockloadBalancerRulesDescriber)(nil).LoadBalancerRules))
+}
+
+// MockworkloadEnvDescriber is a mock of workloadEnvDescriber interface.
+type MockworkloadEnvDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkloadEnvDescriberMockRecorder
+}
+
+// MockworkloadEnvDescriberMockRecorder is the mock recorder for MockworkloadEnvDescriber.
+type MockworkloadEnvDescriberMockRecorder struct {
+	mock *MockworkloadEnvDescriber
+}
+
+// NewMockworkloadEnvDescriber creates a new mock instance.
+func NewMockworkloadEnvDescriber(ctrl *gomock.Controller) *MockworkloadEnvDescriber {
+	mock := &MockworkloadEnvDescriber{ctrl: ctrl}
+	mock.recorder = &MockworkloadEnvDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockworkloadEnvDescriber) EXPECT() *MockworkloadEnvDescriberMockRecorder {
+	return m.recorder
+}
+
+// LoadBalancerRules mocks base method.
+func (m *MockworkloadEnvDescriber) LoadBalancerRules() ([]*describe.LoadBalancerRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadBalancerRules")
+	ret0, _ := ret[0].([]*describe.LoadBalancerRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadBalancerRules indicates an expected call of LoadBalancerRules.
+func (mr *MockworkloadEnvDescriberMockRecorder) LoadBalancerRules() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadBalancerRules", reflect.TypeOf((*MockworkloadEnvDescriber)(nil).LoadBalancerRules))
+}
+
+// ServiceDiscoveryEndpoint mocks base method.
+func (m *MockworkloadEnvDescriber) ServiceDiscoveryEndpoint() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceDiscoveryEndpoint")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceDiscoveryEndpoint indicates an expected call of ServiceDiscoveryEndpoint.
+func (mr *MockworkloadEnvDescriberMockRecorder) ServiceDiscoveryEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceDiscoveryEndpoint", reflect.TypeOf((*MockworkloadEnvDescriber)(nil).ServiceDiscoveryEndpoint))
+}
+
 // MockenvTemplater is a mock of envTemplater interface.
 type MockenvTemplater struct {
 	ctrl     *gomock.Controller
@@ -4561,6 +5068,44 @@ func (mr *MockappUpgraderMockRecorder) UpgradeApplication(in interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeApplication", reflect.TypeOf((*MockappUpgrader)(nil).UpgradeApplication), in)
 }
 
+// MockpipelineLister is a mock of pipelineLister interface.
+type MockpipelineLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineListerMockRecorder
+}
+
+// MockpipelineListerMockRecorder is the mock recorder for MockpipelineLister.
+type MockpipelineListerMockRecorder struct {
+	mock *MockpipelineLister
+}
+
+// NewMockpipelineLister creates a new mock instance.
+func NewMockpipelineLister(ctrl *gomock.Controller) *MockpipelineLister {
+	mock := &MockpipelineLister{ctrl: ctrl}
+	mock.recorder = &MockpipelineListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineLister) EXPECT() *MockpipelineListerMockRecorder {
+	return m.recorder
+}
+
+// ListPipelineNamesByTags mocks base method.
+func (m *MockpipelineLister) ListPipelineNamesByTags(tags map[string]string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPipelineNamesByTags", tags)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPipelineNamesByTags indicates an expected call of ListPipelineNamesByTags.
+func (mr *MockpipelineListerMockRecorder) ListPipelineNamesByTags(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelineNamesByTags", reflect.TypeOf((*MockpipelineLister)(nil).ListPipelineNamesByTags), tags)
+}
+
 // MockpipelineGetter is a mock of pipelineGetter interface.
 type MockpipelineGetter struct {
 	ctrl     *gomock.Controller
@@ -5440,6 +5985,164 @@ func (mr *Mockec2ClientMockRecorder) HasDNSSupport(vpcID interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDNSSupport", reflect.TypeOf((*Mockec2Client)(nil).HasDNSSupport), vpcID)
 }
 
+// ListAvailabilityZones mocks base method.
+func (m *Mockec2Client) ListAvailabilityZones() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAvailabilityZones")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAvailabilityZones indicates an expected call of ListAvailabilityZones.
+func (mr *Mockec2ClientMockRecorder) ListAvailabilityZones() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAvailabilityZones", reflect.TypeOf((*Mockec2Client)(nil).ListAvailabilityZones))
+}
+
+// ListVPCSubnets mocks base method.
+func (m *Mockec2Client) ListVPCSubnets(vpcID string) (*ec2.VPCSubnets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVPCSubnets", vpcID)
+	ret0, _ := ret[0].(*ec2.VPCSubnets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVPCSubnets indicates an expected call of ListVPCSubnets.
+func (mr *Mockec2ClientMockRecorder) ListVPCSubnets(vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCSubnets", reflect.TypeOf((*Mockec2Client)(nil).ListVPCSubnets), vpcID)
+}
+
+// MockimportedClusterDescriber is a mock of importedClusterDescriber interface.
+type MockimportedClusterDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockimportedClusterDescriberMockRecorder
+}
+
+// MockimportedClusterDescriberMockRecorder is the mock recorder for MockimportedClusterDescriber.
+type MockimportedClusterDescriberMockRecorder struct {
+	mock *MockimportedClusterDescriber
+}
+
+// NewMockimportedClusterDescriber creates a new mock instance.
+func NewMockimportedClusterDescriber(ctrl *gomock.Controller) *MockimportedClusterDescriber {
+	mock := &MockimportedClusterDescriber{ctrl: ctrl}
+	mock.recorder = &MockimportedClusterDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimportedClusterDescriber) EXPECT() *MockimportedClusterDescriberMockRecorder {
+	return m.recorder
+}
+
+// ActiveCluster mocks base method.
+func (m *MockimportedClusterDescriber) ActiveCluster(cluster string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActiveCluster", cluster)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActiveCluster indicates an expected call of ActiveCluster.
+func (mr *MockimportedClusterDescriberMockRecorder) ActiveCluster(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveCluster", reflect.TypeOf((*MockimportedClusterDescriber)(nil).ActiveCluster), cluster)
+}
+
+// ClusterCapacityProviders mocks base method.
+func (m *MockimportedClusterDescriber) ClusterCapacityProviders(cluster string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterCapacityProviders", cluster)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClusterCapacityProviders indicates an expected call of ClusterCapacityProviders.
+func (mr *MockimportedClusterDescriberMockRecorder) ClusterCapacityProviders(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterCapacityProviders", reflect.TypeOf((*MockimportedClusterDescriber)(nil).ClusterCapacityProviders), cluster)
+}
+
+// MockclusterCapacityProvidersGetter is a mock of clusterCapacityProvidersGetter interface.
+type MockclusterCapacityProvidersGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockclusterCapacityProvidersGetterMockRecorder
+}
+
+// MockclusterCapacityProvidersGetterMockRecorder is the mock recorder for MockclusterCapacityProvidersGetter.
+type MockclusterCapacityProvidersGetterMockRecorder struct {
+	mock *MockclusterCapacityProvidersGetter
+}
+
+// NewMockclusterCapacityProvidersGetter creates a new mock instance.
+func NewMockclusterCapacityProvidersGetter(ctrl *gomock.Controller) *MockclusterCapacityProvidersGetter {
+	mock := &MockclusterCapacityProvidersGetter{ctrl: ctrl}
+	mock.recorder = &MockclusterCapacityProvidersGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockclusterCapacityProvidersGetter) EXPECT() *MockclusterCapacityProvidersGetterMockRecorder {
+	return m.recorder
+}
+
+// ClusterCapacityProviders mocks base method.
+func (m *MockclusterCapacityProvidersGetter) ClusterCapacityProviders(cluster string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterCapacityProviders", cluster)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClusterCapacityProviders indicates an expected call of ClusterCapacityProviders.
+func (mr *MockclusterCapacityProvidersGetterMockRecorder) ClusterCapacityProviders(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterCapacityProviders", reflect.TypeOf((*MockclusterCapacityProvidersGetter)(nil).ClusterCapacityProviders), cluster)
+}
+
+// MockcertificateValidator is a mock of certificateValidator interface.
+type MockcertificateValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockcertificateValidatorMockRecorder
+}
+
+// MockcertificateValidatorMockRecorder is the mock recorder for MockcertificateValidator.
+type MockcertificateValidatorMockRecorder struct {
+	mock *MockcertificateValidator
+}
+
+// NewMockcertificateValidator creates a new mock instance.
+func NewMockcertificateValidator(ctrl *gomock.Controller) *MockcertificateValidator {
+	mock := &MockcertificateValidator{ctrl: ctrl}
+	mock.recorder = &MockcertificateValidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcertificateValidator) EXPECT() *MockcertificateValidatorMockRecorder {
+	return m.recorder
+}
+
+// ValidateCertificates mocks base method.
+func (m *MockcertificateValidator) ValidateCertificates(certARNs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateCertificates", certARNs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateCertificates indicates an expected call of ValidateCertificates.
+func (mr *MockcertificateValidatorMockRecorder) ValidateCertificates(certARNs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCertificates", reflect.TypeOf((*MockcertificateValidator)(nil).ValidateCertificates), certARNs)
+}
+
 // MockserviceResumer is a mock of serviceResumer interface.
 type MockserviceResumer struct {
 	ctrl     *gomock.Controller
@@ -5463,6 +6166,21 @@ func (m *MockserviceResumer) EXPECT() *MockserviceResumerMockRecorder {
 	return m.recorder
 }
 
+// DescribeService mocks base method.
+func (m *MockserviceResumer) DescribeService(svcARN string) (*apprunner.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeService", svcARN)
+	ret0, _ := ret[0].(*apprunner.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeService indicates an expected call of DescribeService.
+func (mr *MockserviceResumerMockRecorder) DescribeService(svcARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeService", reflect.TypeOf((*MockserviceResumer)(nil).DescribeService), svcARN)
+}
+
 // ResumeService mocks base method.
 func (m *MockserviceResumer) ResumeService(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -5628,6 +6346,44 @@ func (mr *MockserviceDescriberMockRecorder) DescribeService(app, env, svc interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeService", reflect.TypeOf((*MockserviceDescriber)(nil).DescribeService), app, env, svc)
 }
 
+// MockserviceDiscoveryURIGetter is a mock of serviceDiscoveryURIGetter interface.
+type MockserviceDiscoveryURIGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceDiscoveryURIGetterMockRecorder
+}
+
+// MockserviceDiscoveryURIGetterMockRecorder is the mock recorder for MockserviceDiscoveryURIGetter.
+type MockserviceDiscoveryURIGetterMockRecorder struct {
+	mock *MockserviceDiscoveryURIGetter
+}
+
+// NewMockserviceDiscoveryURIGetter creates a new mock instance.
+func NewMockserviceDiscoveryURIGetter(ctrl *gomock.Controller) *MockserviceDiscoveryURIGetter {
+	mock := &MockserviceDiscoveryURIGetter{ctrl: ctrl}
+	mock.recorder = &MockserviceDiscoveryURIGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceDiscoveryURIGetter) EXPECT() *MockserviceDiscoveryURIGetterMockRecorder {
+	return m.recorder
+}
+
+// ServiceDiscoveryURI mocks base method.
+func (m *MockserviceDiscoveryURIGetter) ServiceDiscoveryURI(envName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceDiscoveryURI", envName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceDiscoveryURI indicates an expected call of ServiceDiscoveryURI.
+func (mr *MockserviceDiscoveryURIGetterMockRecorder) ServiceDiscoveryURI(envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceDiscoveryURI", reflect.TypeOf((*MockserviceDiscoveryURIGetter)(nil).ServiceDiscoveryURI), envName)
+}
+
 // MockapprunnerServiceDescriber is a mock of apprunnerServiceDescriber interface.
 type MockapprunnerServiceDescriber struct {
 	ctrl     *gomock.Controller
@@ -6268,6 +7024,21 @@ func (m *MockservicePauser) EXPECT() *MockservicePauserMockRecorder {
 	return m.recorder
 }
 
+// DescribeService mocks base method.
+func (m *MockservicePauser) DescribeService(svcARN string) (*apprunner.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeService", svcARN)
+	ret0, _ := ret[0].(*apprunner.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeService indicates an expected call of DescribeService.
+func (mr *MockservicePauserMockRecorder) DescribeService(svcARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeService", reflect.TypeOf((*MockservicePauser)(nil).DescribeService), svcARN)
+}
+
 // PauseService mocks base method.
 func (m *MockservicePauser) PauseService(svcARN string) error {
 	m.ctrl.T.Helper()
@@ -6281,3 +7052,4 @@ func (mr *MockservicePauserMockRecorder) PauseService(svcARN interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseService", reflect.TypeOf((*MockservicePauser)(nil).PauseService), svcARN)
 }
+
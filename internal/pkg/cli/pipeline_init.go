@@ -32,6 +32,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -42,6 +43,13 @@ const (
 	pipelineSelectURLHelpPrompt = `The repository linked to your pipeline.
 Pushing to this repository will trigger your pipeline build stage.
 Please enter full repository URL, e.g. "https://github.com/myCompany/myRepo", or the owner/rep, e.g. "myCompany/myRepo"`
+
+	pipelineTestConfirmPrompt     = "Would you like to run tests after deploying to your environments?"
+	pipelineTestConfirmHelpPrompt = `Test commands, such as integration or end-to-end tests, run after a stage is deployed.
+Your change is promoted to the next stage only if the tests succeed.`
+	fmtPipelineTestCommandsPrompt  = "What command would you like to run after deploying to %s?"
+	pipelineTestCommandsHelpPrompt = `The command runs in a CodeBuild project with the aws/codebuild/amazonlinux2-x86_64-standard:3.0 image, e.g. "make integ-test".
+Leave it empty to skip testing this stage.`
 )
 
 const (
@@ -61,6 +69,10 @@ const (
 	bbURL           = "bitbucket.org"
 	defaultBBBranch = "main"
 	fmtBBRepoURL    = "https://%s/%s/%s" // Ex: "https://bitbucket.org/repoOwner/repoName"
+	// For a GitLab repository.
+	glURL           = "gitlab.com"
+	defaultGLBranch = "main"
+	fmtGLRepoURL    = "https://%s/%s/%s" // Ex: "https://gitlab.com/repoOwner/repoName"
 )
 
 var (
@@ -69,11 +81,14 @@ var (
 )
 
 type initPipelineVars struct {
-	appName           string
-	environments      []string
-	repoURL           string
-	repoBranch        string
-	githubAccessToken string
+	appName              string
+	environments         []string
+	repoURL              string
+	repoBranch           string
+	githubAccessToken    string
+	testCommands         []string
+	perStageTestCommands []string
+	testCommandsFile     string
 }
 
 type initPipelineOpts struct {
@@ -97,9 +112,10 @@ type initPipelineOpts struct {
 	ccRegion  string
 
 	// Caches variables
-	fs         *afero.Afero
-	buffer     bytes.Buffer
-	envConfigs []*config.Environment
+	fs                *afero.Afero
+	buffer            bytes.Buffer
+	envConfigs        []*config.Environment
+	stageTestCommands map[string][]string // Test commands to run after deploying to each environment.
 }
 
 type artifactBucket struct {
@@ -162,6 +178,10 @@ func (o *initPipelineOpts) Validate() error {
 		}
 	}
 
+	if err := o.parseStageTestCommands(); err != nil {
+		return err
+	}
+
 	if o.environments != nil {
 		for _, env := range o.environments {
 			_, err := o.store.GetEnvironment(o.appName, env)
@@ -176,13 +196,21 @@ func (o *initPipelineOpts) Validate() error {
 
 // Ask prompts for fields that are required but not passed in.
 func (o *initPipelineOpts) Ask() error {
+	// Only prompt for test commands if the stages were selected interactively.
+	shouldAskTestCommands := len(o.environments) == 0 && len(o.testCommands) == 0 && len(o.stageTestCommands) == 0
 	if err := o.askEnvs(); err != nil {
 		return err
 	}
+	if err := o.validateTestStages(); err != nil {
+		return err
+	}
 	if err := o.askRepository(); err != nil {
 		return err
 	}
-	return nil
+	if !shouldAskTestCommands {
+		return nil
+	}
+	return o.askTestCommands()
 }
 
 // Execute writes the pipeline manifest file.
@@ -217,8 +245,8 @@ func (o *initPipelineOpts) RequiredActions() []string {
 func (o *initPipelineOpts) validateURL(url string) error {
 	// Note: no longer calling `validateDomainName` because if users use git-remote-codecommit
 	// (the HTTPS (GRC) protocol) to connect to CodeCommit, the url does not have any periods.
-	if !strings.Contains(url, githubURL) && !strings.Contains(url, ccIdentifier) && !strings.Contains(url, bbURL) {
-		return errors.New("Copilot currently accepts URLs to only GitHub, CodeCommit, and Bitbucket repository sources")
+	if !isSupportedRepoURL(url) {
+		return errors.New("Copilot currently accepts URLs to only GitHub, CodeCommit, Bitbucket, and GitLab repository sources")
 	}
 	return nil
 }
@@ -247,6 +275,28 @@ func (o *initPipelineOpts) askEnvs() error {
 	return nil
 }
 
+func (o *initPipelineOpts) askTestCommands() error {
+	addTests, err := o.prompt.Confirm(pipelineTestConfirmPrompt, pipelineTestConfirmHelpPrompt, prompt.WithFinalMessage("Test stages:"))
+	if err != nil {
+		return fmt.Errorf("confirm adding test commands: %w", err)
+	}
+	if !addTests {
+		return nil
+	}
+	o.stageTestCommands = make(map[string][]string)
+	for _, env := range o.environments {
+		cmd, err := o.prompt.Get(fmt.Sprintf(fmtPipelineTestCommandsPrompt, color.HighlightUserInput(env)), pipelineTestCommandsHelpPrompt, nil,
+			prompt.WithFinalMessage(fmt.Sprintf("%s tests:", env)))
+		if err != nil {
+			return fmt.Errorf("get test command for environment %s: %w", env, err)
+		}
+		if cmd = strings.TrimSpace(cmd); cmd != "" {
+			o.stageTestCommands[env] = []string{cmd}
+		}
+	}
+	return nil
+}
+
 func (o *initPipelineOpts) askRepository() error {
 	var err error
 	if o.repoURL == "" {
@@ -262,6 +312,8 @@ func (o *initPipelineOpts) askRepository() error {
 		return o.parseCodeCommitRepoDetails()
 	case strings.Contains(o.repoURL, bbURL):
 		return o.parseBitbucketRepoDetails()
+	case strings.Contains(o.repoURL, glURL):
+		return o.parseGitLabRepoDetails()
 	}
 	return nil
 }
@@ -327,6 +379,21 @@ func (o *initPipelineOpts) parseBitbucketRepoDetails() error {
 	return nil
 }
 
+func (o *initPipelineOpts) parseGitLabRepoDetails() error {
+	o.provider = manifest.GitLabProviderName
+	repoDetails, err := glRepoURL(o.repoURL).parse()
+	if err != nil {
+		return err
+	}
+	o.repoName = repoDetails.name
+	o.repoOwner = repoDetails.owner
+
+	if o.repoBranch == "" {
+		o.repoBranch = defaultGLBranch
+	}
+	return nil
+}
+
 func (o *initPipelineOpts) selectURL() error {
 	// Fetches and parses all remote repositories.
 	err := o.runner.Run("git", []string{"remote", "-v"}, exec.Stdout(&o.buffer))
@@ -367,6 +434,8 @@ func (o *initPipelineOpts) selectURL() error {
 // ssh		ssh://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample (push)
 // bbhttps	https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service.git (fetch)
 // bbssh	ssh://git@bitbucket.org:teamsinspace/documentation-tests.git (fetch)
+// glhttps	https://gitlab.com/gitlab-org/gitaly.git (fetch)
+// glssh	git@gitlab.com:gitlab-org/security/gitaly.git (push)
 
 // parseGitRemoteResults returns just the trimmed middle column (url) of the `git remote -v` results,
 // and skips urls from unsupported sources.
@@ -375,7 +444,7 @@ func (o *initPipelineOpts) parseGitRemoteResult(s string) ([]string, error) {
 	urlSet := make(map[string]bool)
 	items := strings.Split(s, "\n")
 	for _, item := range items {
-		if !strings.Contains(item, githubURL) && !strings.Contains(item, ccIdentifier) && !strings.Contains(item, bbURL) {
+		if !isSupportedRepoURL(item) {
 			continue
 		}
 		cols := strings.Split(item, "\t")
@@ -405,6 +474,21 @@ type bbRepoDetails struct {
 	owner string
 }
 
+type glRepoURL string
+type glRepoDetails struct {
+	name  string
+	owner string
+}
+
+func isSupportedRepoURL(url string) bool {
+	for _, identifier := range []string{githubURL, ccIdentifier, bbURL, glURL} {
+		if strings.Contains(url, identifier) {
+			return true
+		}
+	}
+	return false
+}
+
 func (url ghRepoURL) parse() (ghRepoDetails, error) {
 	urlString := string(url)
 	regexPattern := regexp.MustCompile(`.*(github.com)(:|\/)`)
@@ -473,6 +557,25 @@ func (url bbRepoURL) parse() (bbRepoDetails, error) {
 	}, nil
 }
 
+// GitLab URLs, post-parseGitRemoteResults(), may look like:
+// https://gitlab.com/gitlab-org/gitaly
+// git@gitlab.com:gitlab-org/security/gitaly
+// Repositories can be nested in subgroups, so the owner is the full path of the group.
+func (url glRepoURL) parse() (glRepoDetails, error) {
+	urlString := string(url)
+	regexPattern := regexp.MustCompile(`.*(gitlab.com)(:|\/)`)
+	parsedURL := strings.TrimPrefix(urlString, regexPattern.FindString(urlString))
+	parsedURL = strings.TrimSuffix(parsedURL, ".git")
+	ownerRepo := strings.Split(parsedURL, "/")
+	if len(ownerRepo) < 2 {
+		return glRepoDetails{}, fmt.Errorf("unable to parse the GitLab repository owner and name from %s: please pass the repository URL with the format `--url https://gitlab.com/{owner}/{repositoryName}`", url)
+	}
+	return glRepoDetails{
+		name:  ownerRepo[len(ownerRepo)-1],
+		owner: strings.Join(ownerRepo[:len(ownerRepo)-1], "/"),
+	}, nil
+}
+
 func (o *initPipelineOpts) storeGitHubAccessToken() error {
 	secretName := o.secretName()
 	_, err := o.secretsmanager.CreateSecret(secretName, o.githubAccessToken)
@@ -504,6 +607,7 @@ func (o *initPipelineOpts) createPipelineManifest() error {
 		stage := manifest.PipelineStage{
 			Name:             env.Name,
 			RequiresApproval: env.Prod,
+			TestCommands:     o.testCommandsFor(env.Name),
 		}
 		stages = append(stages, stage)
 	}
@@ -583,6 +687,51 @@ Update the %s phase to unit test your services before pushing the images.
 	return nil
 }
 
+// parseStageTestCommands reads the commands to run after deploying to specific environments
+// from the --stage-test-commands and --test-commands-file flags.
+func (o *initPipelineOpts) parseStageTestCommands() error {
+	stageCmds := make(map[string][]string)
+	if o.testCommandsFile != "" {
+		content, err := afero.ReadFile(o.fs, o.testCommandsFile)
+		if err != nil {
+			return fmt.Errorf("read test commands file %s: %w", o.testCommandsFile, err)
+		}
+		if err := yaml.Unmarshal(content, &stageCmds); err != nil {
+			return fmt.Errorf("unmarshal test commands file %s: %w", o.testCommandsFile, err)
+		}
+	}
+	for _, flagValue := range o.perStageTestCommands {
+		parts := strings.SplitN(flagValue, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+			return fmt.Errorf(`--%s %q must be in the format "environment=command"`, stageTestCommandsFlag, flagValue)
+		}
+		env := strings.TrimSpace(parts[0])
+		stageCmds[env] = append(stageCmds[env], strings.TrimSpace(parts[1]))
+	}
+	if len(stageCmds) != 0 {
+		o.stageTestCommands = stageCmds
+	}
+	return nil
+}
+
+// validateTestStages returns an error if test commands are specified for an environment that is not a stage of the pipeline.
+func (o *initPipelineOpts) validateTestStages() error {
+	for env := range o.stageTestCommands {
+		if !contains(env, o.environments) {
+			return fmt.Errorf("test commands are specified for environment %s, which is not a stage of the pipeline", env)
+		}
+	}
+	return nil
+}
+
+// testCommandsFor returns the commands to run after deploying to the environment.
+// Commands passed with the --test-commands flag run after every stage, followed by the commands of the stage.
+func (o *initPipelineOpts) testCommandsFor(env string) []string {
+	var cmds []string
+	cmds = append(cmds, o.testCommands...)
+	return append(cmds, o.stageTestCommands[env]...)
+}
+
 func (o *initPipelineOpts) secretName() string {
 	return fmt.Sprintf(fmtSecretName, o.appName, o.repoName)
 }
@@ -619,6 +768,11 @@ func (o *initPipelineOpts) pipelineProvider() (manifest.Provider, error) {
 			RepositoryURL: fmt.Sprintf(fmtBBRepoURL, bbURL, o.repoOwner, o.repoName),
 			Branch:        o.repoBranch,
 		}
+	case manifest.GitLabProviderName:
+		config = &manifest.GitLabProperties{
+			RepositoryURL: fmt.Sprintf(fmtGLRepoURL, glURL, o.repoOwner, o.repoName),
+			Branch:        o.repoBranch,
+		}
 	default:
 		return nil, fmt.Errorf("unable to create pipeline source provider for %s", o.repoName)
 	}
@@ -664,7 +818,17 @@ func buildPipelineInitCmd() *cobra.Command {
   Create a pipeline for the services in your workspace.
   /code $ copilot pipeline init \
   /code  --url https://github.com/gitHubUserName/myFrontendApp.git \
-  /code  --environments "stage,prod"`,
+  /code  --environments "stage,prod"
+  Run integration tests after deploying to each environment.
+  /code $ copilot pipeline init \
+  /code  --url https://github.com/gitHubUserName/myFrontendApp.git \
+  /code  --environments "stage,prod" \
+  /code  --test-commands "make integ-test"
+  Run smoke tests after deploying to the "prod" environment only.
+  /code $ copilot pipeline init \
+  /code  --url https://github.com/gitHubUserName/myFrontendApp.git \
+  /code  --environments "stage,prod" \
+  /code  --stage-test-commands "prod=make smoke-test"`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitPipelineOpts(vars)
 			if err != nil {
@@ -695,6 +859,10 @@ func buildPipelineInitCmd() *cobra.Command {
 	_ = cmd.Flags().MarkHidden(githubAccessTokenFlag)
 	cmd.Flags().StringVarP(&vars.repoBranch, gitBranchFlag, gitBranchFlagShort, "", gitBranchFlagDescription)
 	cmd.Flags().StringSliceVarP(&vars.environments, envsFlag, envsFlagShort, []string{}, pipelineEnvsFlagDescription)
+	cmd.Flags().StringArrayVar(&vars.testCommands, testCommandsFlag, nil, testCommandsFlagDescription)
+	cmd.Flags().StringArrayVar(&vars.perStageTestCommands, stageTestCommandsFlag, nil, stageTestCommandsFlagDescription)
+	cmd.Flags().StringVar(&vars.testCommandsFile, testCommandsFileFlag, "", testCommandsFileFlagDescription)
 
+	markPromptedFlags(cmd, envsFlag, repoURLFlag)
 	return cmd
 }
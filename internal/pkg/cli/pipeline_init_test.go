@@ -26,11 +26,16 @@ import (
 
 func TestInitPipelineOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inAppName     string
-		inrepoURL     string
-		inEnvs        []string
-		setupMocks    func(m *mocks.Mockstore)
-		expectedError error
+		inAppName            string
+		inrepoURL            string
+		inEnvs               []string
+		inStageTestCommands  []string
+		inTestCommandsFile   string
+		testCommandsFileBody string
+		setupMocks           func(m *mocks.Mockstore)
+
+		expectedStageTestCommands map[string][]string
+		expectedError             error
 	}{
 		"empty app name": {
 			inAppName:     "",
@@ -53,7 +58,7 @@ func TestInitPipelineOpts_Validate(t *testing.T) {
 				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
 			},
 
-			expectedError: errors.New("Copilot currently accepts URLs to only GitHub, CodeCommit, and Bitbucket repository sources"),
+			expectedError: errors.New("Copilot currently accepts URLs to only GitHub, CodeCommit, Bitbucket, and GitLab repository sources"),
 		},
 		"invalid environments": {
 			inAppName: "my-app",
@@ -105,6 +110,45 @@ func TestInitPipelineOpts_Validate(t *testing.T) {
 
 			expectedError: nil,
 		},
+		"invalid stage test commands": {
+			inAppName:           "my-app",
+			inStageTestCommands: []string{"make integ-test"},
+
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+
+			expectedError: errors.New(`--stage-test-commands "make integ-test" must be in the format "environment=command"`),
+		},
+		"fails to read the test commands file": {
+			inAppName:          "my-app",
+			inTestCommandsFile: "tests.yml",
+
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+
+			expectedError: errors.New("read test commands file tests.yml: open tests.yml: file does not exist"),
+		},
+		"success with stage test commands from flags and file": {
+			inAppName:           "my-app",
+			inStageTestCommands: []string{"prod=make smoke-test", " test = make integ-test=all"},
+			inTestCommandsFile:  "tests.yml",
+			testCommandsFileBody: `test:
+  - make lint
+prod:
+  - make e2e-test
+`,
+
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+
+			expectedStageTestCommands: map[string][]string{
+				"test": {"make lint", "make integ-test=all"},
+				"prod": {"make e2e-test", "make smoke-test"},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -117,13 +161,20 @@ func TestInitPipelineOpts_Validate(t *testing.T) {
 
 			tc.setupMocks(mockStore)
 
+			fs := &afero.Afero{Fs: afero.NewMemMapFs()}
+			if tc.testCommandsFileBody != "" {
+				require.NoError(t, fs.WriteFile(tc.inTestCommandsFile, []byte(tc.testCommandsFileBody), 0644))
+			}
 			opts := &initPipelineOpts{
 				initPipelineVars: initPipelineVars{
-					appName:      tc.inAppName,
-					repoURL:      tc.inrepoURL,
-					environments: tc.inEnvs,
+					appName:              tc.inAppName,
+					repoURL:              tc.inrepoURL,
+					environments:         tc.inEnvs,
+					perStageTestCommands: tc.inStageTestCommands,
+					testCommandsFile:     tc.inTestCommandsFile,
 				},
 				store: mockStore,
+				fs:    fs,
 			}
 
 			// WHEN
@@ -131,9 +182,10 @@ func TestInitPipelineOpts_Validate(t *testing.T) {
 
 			// THEN
 			if tc.expectedError != nil {
-				require.Equal(t, tc.expectedError, err)
+				require.EqualError(t, err, tc.expectedError.Error())
 			} else {
 				require.NoError(t, err)
+				require.Equal(t, tc.expectedStageTestCommands, opts.stageTestCommands)
 			}
 		})
 	}
@@ -158,6 +210,8 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 		inRepoURL           string
 		inGitHubAccessToken string
 		inGitBranch         string
+		inTestCommands      []string
+		inStageTestCommands map[string][]string
 
 		mockPrompt       func(m *mocks.Mockprompter)
 		mockRunner       func(m *mocks.Mockrunner)
@@ -173,6 +227,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 		expectedGitHubOwner       string
 		expectedGitHubAccessToken string
 		expectedCodeCommitRegion  string
+		expectedStageTestCommands map[string][]string
 		expectedError             error
 	}{
 		"no flags, prompts for all input, success case for GitHub": {
@@ -200,6 +255,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 			},
 			mockPrompt: func(m *mocks.Mockprompter) {
 				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), gomock.Any()).Return(githubAnotherURL, nil).Times(1)
+				m.EXPECT().Confirm(pipelineTestConfirmPrompt, gomock.Any(), gomock.Any()).Return(false, nil)
 			},
 			mockSessProvider: func(m *mocks.MocksessionProvider) {},
 
@@ -234,6 +290,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 			},
 			mockPrompt: func(m *mocks.Mockprompter) {
 				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), gomock.Any()).Return(codecommitSSHURL, nil).Times(1)
+				m.EXPECT().Confirm(pipelineTestConfirmPrompt, gomock.Any(), gomock.Any()).Return(false, nil)
 			},
 			mockSessProvider: func(m *mocks.MocksessionProvider) {
 				m.EXPECT().Default().Return(&session.Session{
@@ -250,6 +307,150 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 			expectedEnvironments:     []string{"test", "prod"},
 			expectedError:            nil,
 		},
+		"no flags, success case for GitLab in a different region than the app": {
+			inEnvironments: []string{},
+			inRepoURL:      "",
+			buffer:         *bytes.NewBufferString("archer\tgit@github.com:goodGoose/bhaOS (fetch)\narcher\tgit@gitlab.com:goodGoose/flock/bhaOS.git (fetch)\n"),
+
+			mockSelector: func(m *mocks.MockpipelineSelector) {
+				m.EXPECT().Environments(pipelineSelectEnvPrompt, gomock.Any(), "my-app", gomock.Any()).Return([]string{"test"}, nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{
+					Name:   "test",
+					Region: "us-west-2",
+				}, nil)
+			},
+			mockRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), gomock.Any()).Return("git@gitlab.com:goodGoose/flock/bhaOS", nil).Times(1)
+				m.EXPECT().Confirm(pipelineTestConfirmPrompt, gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			mockSessProvider: func(m *mocks.MocksessionProvider) {},
+
+			expectedRepoURL:      "git@gitlab.com:goodGoose/flock/bhaOS",
+			expectedGitHubOwner:  "goodGoose/flock",
+			expectedRepoName:     "bhaOS",
+			expectedRepoBranch:   "main",
+			expectedEnvironments: []string{"test"},
+			expectedError:        nil,
+		},
+		"prompts for the test commands of each stage": {
+			inEnvironments: []string{},
+			inRepoURL:      "",
+			buffer:         *bytes.NewBufferString("archer\tgit@github.com:goodGoose/bhaOS (fetch)\n"),
+
+			mockSelector: func(m *mocks.MockpipelineSelector) {
+				m.EXPECT().Environments(pipelineSelectEnvPrompt, gomock.Any(), "my-app", gomock.Any()).Return([]string{"test", "prod"}, nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "prod").Return(&config.Environment{Name: "prod"}, nil)
+			},
+			mockRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), gomock.Any()).Return(githubAnotherURL, nil)
+				m.EXPECT().Confirm(pipelineTestConfirmPrompt, gomock.Any(), gomock.Any()).Return(true, nil)
+				m.EXPECT().Get(fmt.Sprintf(fmtPipelineTestCommandsPrompt, "test"), gomock.Any(), gomock.Any(), gomock.Any()).Return(" make integ-test ", nil)
+				m.EXPECT().Get(fmt.Sprintf(fmtPipelineTestCommandsPrompt, "prod"), gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil)
+			},
+			mockSessProvider: func(m *mocks.MocksessionProvider) {},
+
+			expectedGitHubOwner:  githubOwner,
+			expectedRepoName:     githubAnotherRepoName,
+			expectedEnvironments: []string{"test", "prod"},
+			expectedStageTestCommands: map[string][]string{
+				"test": {"make integ-test"},
+			},
+		},
+		"returns error if fail to get a test command": {
+			inEnvironments: []string{},
+			inRepoURL:      "",
+			buffer:         *bytes.NewBufferString("archer\tgit@github.com:goodGoose/bhaOS (fetch)\n"),
+
+			mockSelector: func(m *mocks.MockpipelineSelector) {
+				m.EXPECT().Environments(pipelineSelectEnvPrompt, gomock.Any(), "my-app", gomock.Any()).Return([]string{"test"}, nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+			},
+			mockRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), gomock.Any()).Return(githubAnotherURL, nil)
+				m.EXPECT().Confirm(pipelineTestConfirmPrompt, gomock.Any(), gomock.Any()).Return(true, nil)
+				m.EXPECT().Get(fmt.Sprintf(fmtPipelineTestCommandsPrompt, "test"), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			mockSessProvider: func(m *mocks.MocksessionProvider) {},
+
+			expectedError: errors.New("get test command for environment test: some error"),
+		},
+		"does not prompt for test commands if they are passed as flags": {
+			inTestCommands: []string{"make integ-test"},
+			inRepoURL:      "",
+			buffer:         *bytes.NewBufferString("archer\tgit@github.com:goodGoose/bhaOS (fetch)\n"),
+
+			mockSelector: func(m *mocks.MockpipelineSelector) {
+				m.EXPECT().Environments(pipelineSelectEnvPrompt, gomock.Any(), "my-app", gomock.Any()).Return([]string{"test"}, nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+			},
+			mockRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), gomock.Any()).Return(githubAnotherURL, nil)
+			},
+			mockSessProvider: func(m *mocks.MocksessionProvider) {},
+
+			expectedGitHubOwner:  githubOwner,
+			expectedRepoName:     githubAnotherRepoName,
+			expectedEnvironments: []string{"test"},
+		},
+		"does not prompt for test commands if they are passed for a stage": {
+			inStageTestCommands: map[string][]string{"test": {"make integ-test"}},
+			inRepoURL:           "",
+			buffer:              *bytes.NewBufferString("archer\tgit@github.com:goodGoose/bhaOS (fetch)\n"),
+
+			mockSelector: func(m *mocks.MockpipelineSelector) {
+				m.EXPECT().Environments(pipelineSelectEnvPrompt, gomock.Any(), "my-app", gomock.Any()).Return([]string{"test"}, nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+			},
+			mockRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(pipelineSelectURLPrompt, gomock.Any(), gomock.Any()).Return(githubAnotherURL, nil)
+			},
+			mockSessProvider: func(m *mocks.MocksessionProvider) {},
+
+			expectedGitHubOwner:       githubOwner,
+			expectedRepoName:          githubAnotherRepoName,
+			expectedEnvironments:      []string{"test"},
+			expectedStageTestCommands: map[string][]string{"test": {"make integ-test"}},
+		},
+		"returns error if test commands are passed for an environment that is not a stage": {
+			inEnvironments:      []string{"test"},
+			inStageTestCommands: map[string][]string{"prod": {"make smoke-test"}},
+
+			mockSelector: func(m *mocks.MockpipelineSelector) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+			},
+			mockRunner:       func(m *mocks.Mockrunner) {},
+			mockPrompt:       func(m *mocks.Mockprompter) {},
+			mockSessProvider: func(m *mocks.MocksessionProvider) {},
+
+			expectedError: errors.New("test commands are specified for environment prod, which is not a stage of the pipeline"),
+		},
 		"returns error if fail to list environments": {
 			inEnvironments: []string{},
 
@@ -323,7 +524,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 			},
 			mockSessProvider: func(m *mocks.MocksessionProvider) {},
 
-			expectedError: fmt.Errorf("Copilot currently accepts URLs to only GitHub, CodeCommit, and Bitbucket repository sources"),
+			expectedError: fmt.Errorf("Copilot currently accepts URLs to only GitHub, CodeCommit, Bitbucket, and GitLab repository sources"),
 		},
 		"returns error if fail to parse GitHub URL": {
 			inEnvironments:      []string{},
@@ -505,13 +706,15 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 					environments:      tc.inEnvironments,
 					repoURL:           tc.inRepoURL,
 					githubAccessToken: tc.inGitHubAccessToken,
+					testCommands:      tc.inTestCommands,
 				},
-				prompt:       mockPrompt,
-				runner:       mockRunner,
-				sessProvider: mocksSessProvider,
-				buffer:       tc.buffer,
-				sel:          mockSelector,
-				store:        mockStore,
+				stageTestCommands: tc.inStageTestCommands,
+				prompt:            mockPrompt,
+				runner:            mockRunner,
+				sessProvider:      mocksSessProvider,
+				buffer:            tc.buffer,
+				sel:               mockSelector,
+				store:             mockStore,
 			}
 
 			tc.mockPrompt(mockPrompt)
@@ -533,6 +736,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				require.Equal(t, tc.expectedGitHubAccessToken, opts.githubAccessToken)
 				require.Equal(t, tc.expectedCodeCommitRegion, opts.ccRegion)
 				require.ElementsMatch(t, tc.expectedEnvironments, opts.environments)
+				require.Equal(t, tc.expectedStageTestCommands, opts.stageTestCommands)
 			}
 		})
 	}
@@ -718,6 +922,45 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		"writes manifest and buildspec for GL provider": {
+			inProvider: "GitLab",
+			inEnvConfigs: []*config.Environment{
+				{
+					Name: "test",
+					Prod: false,
+				},
+			},
+			inRepoName: "goose",
+			inBranch:   "dev",
+			inAppName:  "badgoose",
+
+			mockSecretsManager: func(m *mocks.MocksecretsManager) {},
+			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("/buildspec.yml", nil)
+			},
+			mockParser: func(m *templatemocks.MockParser) {
+				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
+					Buffer: bytes.NewBufferString("hello"),
+				}, nil)
+			},
+			mockStoreSvc: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("badgoose").Return(&config.Application{
+					Name: "badgoose",
+				}, nil)
+			},
+			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetRegionalAppResources(&config.Application{
+					Name: "badgoose",
+				}).Return([]*stack.AppRegionalResources{
+					{
+						Region:   "us-west-2",
+						S3Bucket: "gooseBucket",
+					},
+				}, nil)
+			},
+			expectedError: nil,
+		},
 		"does not return an error if secret already exists": {
 			inProvider: "GitHubV1",
 			inEnvConfigs: []*config.Environment{
@@ -1075,13 +1318,14 @@ koke	git://github.com/koke/grit.git (push)
 https	https://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample (fetch)
 fed	codecommit::us-west-2://aws-sample (fetch)
 ssh	ssh://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample (push)
-bb	https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service.git (push)`,
+bb	https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service.git (push)
+gl	git@gitlab.com:gitlab-org/gitaly.git (fetch)`,
 
-			expectedURLs:  []string{"git@github.com:badgoose/grit", "https://github.com/badgoose/cli", "https://github.com/koke/grit", "git://github.com/koke/grit", "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample", "codecommit::us-west-2://aws-sample", "ssh://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample", "https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service"},
+			expectedURLs:  []string{"git@github.com:badgoose/grit", "https://github.com/badgoose/cli", "https://github.com/koke/grit", "git://github.com/koke/grit", "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample", "codecommit::us-west-2://aws-sample", "ssh://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample", "https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service", "git@gitlab.com:gitlab-org/gitaly"},
 			expectedError: nil,
 		},
-		"don't add to URL list if it is not a GitHub or CodeCommit or Bitbucket URL": {
-			inRemoteResult: `badgoose	verybad@gitlab.com/whatever (fetch)`,
+		"don't add to URL list if it is not a GitHub or CodeCommit or Bitbucket or GitLab URL": {
+			inRemoteResult: `badgoose	verybad@example.com/whatever (fetch)`,
 
 			expectedURLs:  []string{},
 			expectedError: nil,
@@ -1244,3 +1488,56 @@ func TestInitPipelineBBRepoURL_parse(t *testing.T) {
 		})
 	}
 }
+
+func TestInitPipelineGLRepoURL_parse(t *testing.T) {
+	testCases := map[string]struct {
+		inRepoURL glRepoURL
+
+		expectedDetails glRepoDetails
+		expectedError   error
+	}{
+		"successfully parses https url": {
+			inRepoURL: "https://gitlab.com/gitlab-org/gitaly",
+
+			expectedDetails: glRepoDetails{
+				name:  "gitaly",
+				owner: "gitlab-org",
+			},
+		},
+		"successfully parses ssh url with .git suffix": {
+			inRepoURL: "git@gitlab.com:gitlab-org/gitaly.git",
+
+			expectedDetails: glRepoDetails{
+				name:  "gitaly",
+				owner: "gitlab-org",
+			},
+		},
+		"successfully parses a repository in a subgroup": {
+			inRepoURL: "https://gitlab.com/gitlab-org/security/gitaly",
+
+			expectedDetails: glRepoDetails{
+				name:  "gitaly",
+				owner: "gitlab-org/security",
+			},
+		},
+		"returns an error if the owner is missing": {
+			inRepoURL: "https://gitlab.com/gitaly",
+
+			expectedError: fmt.Errorf("unable to parse the GitLab repository owner and name from https://gitlab.com/gitaly: please pass the repository URL with the format `--url https://gitlab.com/{owner}/{repositoryName}`"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			details, err := glRepoURL.parse(tc.inRepoURL)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.Equal(t, tc.expectedDetails, details)
+			}
+		})
+	}
+}
@@ -142,5 +142,6 @@ func buildPipelineListCmd() *cobra.Command {
 
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	markPromptedFlags(cmd, appFlag)
 	return cmd
 }
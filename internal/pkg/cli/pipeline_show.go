@@ -254,5 +254,6 @@ func buildPipelineShowCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputResources, resourcesFlag, false, pipelineResourcesFlagDescription)
 
+	markPromptedFlags(cmd, appFlag)
 	return cmd
 }
@@ -243,5 +243,6 @@ Shows status of the pipeline "pipeline-myapp-myrepo".
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
 
+	markPromptedFlags(cmd, appFlag)
 	return cmd
 }
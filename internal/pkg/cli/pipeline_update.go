@@ -12,7 +12,6 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
-	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -85,9 +84,14 @@ func newUpdatePipelineOpts(vars updatePipelineVars) (*updatePipelineOpts, error)
 		return nil, fmt.Errorf("new workspace client: %w", err)
 	}
 
+	pipelineDeployer, err := newAppCloudFormation(defaultSession)
+	if err != nil {
+		return nil, err
+	}
+
 	return &updatePipelineOpts{
 		app:                app,
-		pipelineDeployer:   deploycfn.New(defaultSession),
+		pipelineDeployer:   pipelineDeployer,
 		region:             aws.StringValue(defaultSession.Config.Region),
 		updatePipelineVars: vars,
 		envStore:           store,
@@ -525,5 +525,8 @@ Create secrets from input.yml. For the format of the YAML file, please see https
 	cmd.Flags().StringToStringVar(&vars.values, valuesFlag, nil, secretValuesFlagDescription)
 	cmd.Flags().BoolVar(&vars.overwrite, overwriteFlag, false, secretOverwriteFlagDescription)
 	cmd.Flags().StringVar(&vars.inputFilePath, inputFilePathFlag, "", secretInputFilePathFlagDescription)
+	for _, flag := range []string{appFlag, nameFlag, valuesFlag} {
+		markPromptedFlag(cmd, flag, inputFilePathFlag)
+	}
 	return cmd
 }
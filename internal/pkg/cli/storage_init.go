@@ -167,8 +167,9 @@ type initStorageOpts struct {
 	ws    wsAddonManager
 	store store
 
-	sel    wsSelector
-	prompt prompter
+	sel      wsSelector
+	prompt   prompter
+	noPrompt bool // True if prompts are disabled, in which case all required flags must be provided.
 }
 
 func newStorageInitOpts(vars initStorageVars) (*initStorageOpts, error) {
@@ -187,11 +188,12 @@ func newStorageInitOpts(vars initStorageVars) (*initStorageOpts, error) {
 		initStorageVars: vars,
 		appName:         tryReadingAppName(),
 
-		fs:     &afero.Afero{Fs: afero.NewOsFs()},
-		store:  store,
-		ws:     ws,
-		sel:    selector.NewWorkspaceSelect(prompter, store, ws),
-		prompt: prompter,
+		fs:       &afero.Afero{Fs: afero.NewOsFs()},
+		store:    store,
+		ws:       ws,
+		sel:      selector.NewWorkspaceSelect(prompter, store, ws),
+		prompt:   prompter,
+		noPrompt: prompt.Disabled(),
 	}, nil
 }
 
@@ -234,6 +236,16 @@ func (o *initStorageOpts) Validate() error {
 		if err := validateEngine(o.rdsEngine); err != nil {
 			return err
 		}
+		if o.rdsInitialDBName != "" {
+			// The initial database name can only be validated once the engine type is known.
+			validator, err := dbNameValidator(o.rdsEngine)
+			if err != nil {
+				return err
+			}
+			if err := validator(o.rdsInitialDBName); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -267,6 +279,11 @@ func (o *initStorageOpts) validateDDB() error {
 }
 
 func (o *initStorageOpts) Ask() error {
+	if o.noPrompt {
+		if err := o.validateRequiredFlags(); err != nil {
+			return err
+		}
+	}
 	if err := o.askStorageWl(); err != nil {
 		return err
 	}
@@ -316,6 +333,27 @@ https://aws.github.io/copilot-cli/docs/developing/additional-aws-resources/#what
 	return nil
 }
 
+// validateRequiredFlags returns an error naming all the required flags that are missing.
+func (o *initStorageOpts) validateRequiredFlags() error {
+	var missing []string
+	if o.storageType == "" {
+		missing = append(missing, fmt.Sprintf("--%s", storageTypeFlag))
+	}
+	if o.storageName == "" {
+		missing = append(missing, fmt.Sprintf("--%s", nameFlag))
+	}
+	if o.workloadName == "" {
+		missing = append(missing, fmt.Sprintf("--%s", workloadFlag))
+	}
+	if o.storageType == dynamoDBStorageType && o.partitionKey == "" {
+		missing = append(missing, fmt.Sprintf("--%s", storagePartitionKeyFlag))
+	}
+	if len(missing) > 0 {
+		return &errMissingRequiredFlags{flags: missing}
+	}
+	return nil
+}
+
 func (o *initStorageOpts) askStorageType() error {
 	if o.storageType != "" {
 		return nil
@@ -549,18 +587,13 @@ func (o *initStorageOpts) askAuroraEngineType() error {
 }
 
 func (o *initStorageOpts) askAuroraInitialDBName() error {
-	var validator func(interface{}) error
-	switch o.rdsEngine {
-	case engineTypeMySQL:
-		validator = validateMySQLDBName
-	case engineTypePostgreSQL:
-		validator = validatePostgreSQLDBName
-	default:
-		return errors.New("unknown engine type")
+	validator, err := dbNameValidator(o.rdsEngine)
+	if err != nil {
+		return err
 	}
 
 	if o.rdsInitialDBName != "" {
-		// The flag input is validated here because it needs engine type to determine which validator to use.
+		// The flag input is validated here because the engine type might have been prompted for after Validate.
 		return validator(o.rdsInitialDBName)
 	}
 
@@ -575,6 +608,18 @@ func (o *initStorageOpts) askAuroraInitialDBName() error {
 	return nil
 }
 
+// dbNameValidator returns the validator of the initial database name for the engine type.
+func dbNameValidator(engine string) (func(interface{}) error, error) {
+	switch engine {
+	case engineTypeMySQL:
+		return validateMySQLDBName, nil
+	case engineTypePostgreSQL:
+		return validatePostgreSQLDBName, nil
+	default:
+		return nil, errors.New("unknown engine type")
+	}
+}
+
 func (o *initStorageOpts) validateWorkloadName() error {
 	names, err := o.ws.WorkloadNames()
 	if err != nil {
@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -31,6 +32,7 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 		inNoSort      bool
 		inNoLSI       bool
 		inEngine      string
+		inDBName      string
 
 		mockWs    func(m *mocks.MockwsAddonManager)
 		mockStore func(m *mocks.Mockstore)
@@ -174,6 +176,34 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 
 			wantedErr: errors.New("invalid engine type mysql: must be one of \"MySQL\", \"PostgreSQL\""),
 		},
+		"invalid initial database name for the engine": {
+			inAppName: "meow",
+			inEngine:  engineTypeMySQL,
+			inDBName:  "_mydb",
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: fmt.Errorf(fmtErrInvalidDBNameCharacters, "_mydb"),
+		},
+		"initial database name too long for PostgreSQL": {
+			inAppName: "meow",
+			inEngine:  engineTypePostgreSQL,
+			inDBName:  strings.Repeat("a", 64),
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: fmt.Errorf(fmtErrValueBadSize, 1, 63),
+		},
+		"valid initial database name for the engine": {
+			inAppName: "meow",
+			inEngine:  engineTypePostgreSQL,
+			inDBName:  "mydb",
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -186,15 +216,16 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 			tc.mockStore(mockStore)
 			opts := initStorageOpts{
 				initStorageVars: initStorageVars{
-					storageType:  tc.inStorageType,
-					storageName:  tc.inStorageName,
-					workloadName: tc.inSvcName,
-					partitionKey: tc.inPartition,
-					sortKey:      tc.inSort,
-					lsiSorts:     tc.inLSISorts,
-					noLSI:        tc.inNoLSI,
-					noSort:       tc.inNoSort,
-					rdsEngine:    tc.inEngine,
+					storageType:      tc.inStorageType,
+					storageName:      tc.inStorageName,
+					workloadName:     tc.inSvcName,
+					partitionKey:     tc.inPartition,
+					sortKey:          tc.inSort,
+					lsiSorts:         tc.inLSISorts,
+					noLSI:            tc.inNoLSI,
+					noSort:           tc.inNoSort,
+					rdsEngine:        tc.inEngine,
+					rdsInitialDBName: tc.inDBName,
 				},
 				appName: tc.inAppName,
 				ws:      mockWs,
@@ -245,6 +276,7 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 
 		inDBEngine      string
 		inInitialDBName string
+		inNoPrompt      bool
 
 		mockPrompt func(m *mocks.Mockprompter)
 		mockCfg    func(m *mocks.MockwsSelector)
@@ -254,6 +286,29 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 
 		wantedVars *initStorageVars
 	}{
+		"error with all missing required flags if prompts are disabled": {
+			inAppName:  wantedAppName,
+			inNoPrompt: true,
+
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			mockCfg:    func(m *mocks.MockwsSelector) {},
+			mockStore:  func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("missing required flags --storage-type, --name, --workload: they must be provided when prompts are disabled"),
+		},
+		"error with missing partition key if prompts are disabled for a DynamoDB table": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageName: wantedTableName,
+			inStorageType: dynamoDBStorageType,
+			inNoPrompt:    true,
+
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			mockCfg:    func(m *mocks.MockwsSelector) {},
+			mockStore:  func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("missing required flag --partition-key: it must be provided when prompts are disabled"),
+		},
 		"error if fail to get workload type": {
 			inAppName:     wantedAppName,
 			inStorageName: wantedBucketName,
@@ -968,10 +1023,11 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 					rdsEngine:        tc.inDBEngine,
 					rdsInitialDBName: tc.inInitialDBName,
 				},
-				appName: tc.inAppName,
-				sel:     mockConfig,
-				prompt:  mockPrompt,
-				store:   mockStore,
+				appName:  tc.inAppName,
+				sel:      mockConfig,
+				prompt:   mockPrompt,
+				store:    mockStore,
+				noPrompt: tc.inNoPrompt,
 			}
 			tc.mockPrompt(mockPrompt)
 			tc.mockCfg(mockConfig)
@@ -0,0 +1,468 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"runtime"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	describestack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultSupportBundleOutput = "copilot-support-bundle.zip"
+	supportBundleEventsLimit   = 25 // Number of the most recent stack events to collect per stack.
+	redactedValue              = "REDACTED"
+)
+
+var (
+	accountIDRegexp = regexp.MustCompile(`\b\d{12}\b`)
+
+	// Fields of a secret that are kept when redacting, because they don't contain the secret itself.
+	unredactedSecretFields = map[string]bool{
+		"name":        true,
+		"container":   true,
+		"environment": true,
+	}
+)
+
+type supportBundleVars struct {
+	appName  string
+	output   string
+	noRedact bool
+}
+
+type supportBundleOpts struct {
+	supportBundleVars
+
+	store store
+	ws    wsWlManifestReader // Nil if the command isn't run from a workspace.
+	fs    afero.Fs
+
+	// Overridden in tests.
+	newEnvDescriber         func(env string) (envDescriber, error)
+	newSvcDescriber         func(svc *config.Workload) (describer, error)
+	newStackEventsDescriber func(env *config.Environment) (stackEventsDescriber, error)
+}
+
+// bundleFile is a file of the support bundle.
+type bundleFile struct {
+	name    string
+	content []byte
+}
+
+func newSupportBundleOpts(vars supportBundleVars) (*supportBundleOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to copilot config store: %w", err)
+	}
+	store := config.NewCachedStore(configStore)
+	deployStore, err := deploy.NewStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to copilot deploy store: %w", err)
+	}
+	opts := &supportBundleOpts{
+		supportBundleVars: vars,
+		store:             store,
+		fs:                afero.NewOsFs(),
+		newEnvDescriber: func(env string) (envDescriber, error) {
+			return describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+				App:             vars.appName,
+				Env:             env,
+				EnableResources: true,
+				ConfigStore:     store,
+				DeployStore:     deployStore,
+			})
+		},
+		newSvcDescriber: func(svc *config.Workload) (describer, error) {
+			return newServiceDescriber(svc, store, deployStore, showSvcVars{
+				shouldOutputResources: true,
+				eventsLimit:           supportBundleEventsLimit,
+			})
+		},
+		newStackEventsDescriber: func(env *config.Environment) (stackEventsDescriber, error) {
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("assume role for environment %s: %w", env.Name, err)
+			}
+			return describestack.NewStackDescriber(stack.NameForEnv(env.App, env.Name), sess), nil
+		},
+	}
+	// The manifests are only collected when the command is run from a workspace.
+	if ws, err := workspace.New(); err == nil {
+		opts.ws = ws
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *supportBundleOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	if filepath.Ext(o.output) != ".zip" {
+		return fmt.Errorf("output file %s must have a .zip extension", o.output)
+	}
+	exists, err := afero.Exists(o.fs, o.output)
+	if err != nil {
+		return fmt.Errorf("check if file %s exists: %w", o.output, err)
+	}
+	if exists {
+		return fmt.Errorf("file %s already exists", o.output)
+	}
+	return nil
+}
+
+// Execute collects the state of the application and writes it to a zip file.
+func (o *supportBundleOpts) Execute() error {
+	files, err := o.collect()
+	if err != nil {
+		return err
+	}
+	if !o.noRedact {
+		for _, f := range files {
+			redacted, err := redact(f.name, f.content)
+			if err != nil {
+				return fmt.Errorf("redact %s: %w", f.name, err)
+			}
+			f.content = redacted
+		}
+	}
+	if err := o.writeZip(files); err != nil {
+		return err
+	}
+	log.Successf("Wrote the support bundle of application %s to %s.\n", color.HighlightUserInput(o.appName), color.HighlightResource(o.output))
+	if o.noRedact {
+		log.Warningln("The support bundle isn't redacted. Remove any sensitive information before sharing it.")
+	}
+	return nil
+}
+
+func (o *supportBundleOpts) collect() ([]*bundleFile, error) {
+	info, err := json.MarshalIndent(versionInfo{
+		Version:   version.Version,
+		GitCommit: version.GitCommit,
+		BuildDate: version.BuildDate,
+		GoVersion: runtime.Version(),
+	}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal version information: %w", err)
+	}
+	files := []*bundleFile{
+		{
+			name:    "version.json",
+			content: info,
+		},
+	}
+	manifests, err := o.manifests()
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, manifests...)
+	envs, err := o.environments()
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, envs...)
+	svcs, err := o.services()
+	if err != nil {
+		return nil, err
+	}
+	return append(files, svcs...), nil
+}
+
+// manifests returns the manifest of each service and job in the workspace.
+// A manifest that can't be read is replaced with a file that records the error,
+// so that a single broken manifest doesn't prevent collecting the rest of the bundle.
+func (o *supportBundleOpts) manifests() ([]*bundleFile, error) {
+	if o.ws == nil {
+		log.Warningln("Manifests aren't collected because the command isn't run from a workspace.")
+		return nil, nil
+	}
+	var files []*bundleFile
+	svcs, err := o.ws.ServiceNames()
+	if err != nil {
+		return nil, fmt.Errorf("list services in the workspace: %w", err)
+	}
+	for _, svc := range svcs {
+		mft, err := o.ws.ReadServiceManifest(svc)
+		if err != nil {
+			files = append(files, manifestErrFile(svc, fmt.Errorf("read manifest of service %s: %w", svc, err)))
+			continue
+		}
+		files = append(files, &bundleFile{name: fmt.Sprintf("manifests/%s.yml", svc), content: mft})
+	}
+	jobs, err := o.ws.JobNames()
+	if err != nil {
+		return nil, fmt.Errorf("list jobs in the workspace: %w", err)
+	}
+	for _, job := range jobs {
+		mft, err := o.ws.ReadJobManifest(job)
+		if err != nil {
+			files = append(files, manifestErrFile(job, fmt.Errorf("read manifest of job %s: %w", job, err)))
+			continue
+		}
+		files = append(files, &bundleFile{name: fmt.Sprintf("manifests/%s.yml", job), content: mft})
+	}
+	return files, nil
+}
+
+// manifestErrFile warns that the manifest of a workload is skipped and returns the file recording why.
+func manifestErrFile(name string, err error) *bundleFile {
+	log.Warningf("Skip collecting the manifest of %s: %v\n", name, err)
+	return &bundleFile{name: fmt.Sprintf("manifests/%s.error.txt", name), content: []byte(err.Error())}
+}
+
+// environments returns the description and the recent stack events of each environment.
+// Environments that can't be described are skipped with a warning, so that a single broken
+// environment doesn't prevent collecting the rest of the bundle.
+func (o *supportBundleOpts) environments() ([]*bundleFile, error) {
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	var files []*bundleFile
+	for _, env := range envs {
+		desc, err := o.envDescription(env.Name)
+		if err != nil {
+			log.Warningf("Skip describing environment %s: %v\n", env.Name, err)
+		} else {
+			files = append(files, &bundleFile{name: fmt.Sprintf("environments/%s.json", env.Name), content: desc})
+		}
+
+		events, err := o.envStackEvents(env)
+		if err != nil {
+			log.Warningf("Skip collecting stack events of environment %s: %v\n", env.Name, err)
+			continue
+		}
+		files = append(files, &bundleFile{name: fmt.Sprintf("environments/%s-events.json", env.Name), content: events})
+	}
+	return files, nil
+}
+
+// services returns the description of each service, which includes its resources and recent stack events
+// in every environment it's deployed to.
+func (o *supportBundleOpts) services() ([]*bundleFile, error) {
+	svcs, err := o.store.ListServices(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("list services in application %s: %w", o.appName, err)
+	}
+	var files []*bundleFile
+	for _, svc := range svcs {
+		d, err := o.newSvcDescriber(svc)
+		if err != nil {
+			log.Warningf("Skip describing service %s: %v\n", svc.Name, err)
+			continue
+		}
+		desc, err := d.Describe()
+		if err != nil {
+			log.Warningf("Skip describing service %s: %v\n", svc.Name, err)
+			continue
+		}
+		out, err := desc.JSONString()
+		if err != nil {
+			log.Warningf("Skip describing service %s: %v\n", svc.Name, err)
+			continue
+		}
+		files = append(files, &bundleFile{name: fmt.Sprintf("services/%s.json", svc.Name), content: []byte(out)})
+	}
+	return files, nil
+}
+
+func (o *supportBundleOpts) envDescription(env string) ([]byte, error) {
+	d, err := o.newEnvDescriber(env)
+	if err != nil {
+		return nil, err
+	}
+	desc, err := d.Describe()
+	if err != nil {
+		return nil, err
+	}
+	out, err := desc.JSONString()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(out), nil
+}
+
+func (o *supportBundleOpts) envStackEvents(env *config.Environment) ([]byte, error) {
+	d, err := o.newStackEventsDescriber(env)
+	if err != nil {
+		return nil, err
+	}
+	events, err := d.Events(supportBundleEventsLimit)
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.MarshalIndent(events, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal stack events: %w", err)
+	}
+	return b, nil
+}
+
+func (o *supportBundleOpts) writeZip(files []*bundleFile) error {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, f := range files {
+		fw, err := w.Create(f.name)
+		if err != nil {
+			return fmt.Errorf("add %s to the support bundle: %w", f.name, err)
+		}
+		if _, err := fw.Write(f.content); err != nil {
+			return fmt.Errorf("write %s to the support bundle: %w", f.name, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("close support bundle: %w", err)
+	}
+	if err := afero.WriteFile(o.fs, o.output, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("write support bundle to %s: %w", o.output, err)
+	}
+	return nil
+}
+
+// redact removes the values of secrets and the AWS account IDs from the content of a file of the support bundle.
+func redact(name string, content []byte) ([]byte, error) {
+	var err error
+	switch filepath.Ext(name) {
+	case ".yml":
+		content, err = redactYAMLSecrets(content)
+	case ".json":
+		content, err = redactJSONSecrets(content)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return accountIDRegexp.ReplaceAll(content, []byte(redactedValue)), nil
+}
+
+func redactYAMLSecrets(in []byte) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(in, &doc); err != nil {
+		return nil, fmt.Errorf("unmarshal YAML: %w", err)
+	}
+	redactYAMLNode(&doc, false)
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, fmt.Errorf("marshal YAML: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func redactYAMLNode(node *yaml.Node, inSecrets bool) {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		if inSecrets {
+			node.Value = redactedValue
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if inSecrets && unredactedSecretFields[key.Value] {
+				continue
+			}
+			redactYAMLNode(value, inSecrets || key.Value == "secrets")
+		}
+	default:
+		for _, child := range node.Content {
+			redactYAMLNode(child, inSecrets)
+		}
+	}
+}
+
+func redactJSONSecrets(in []byte) ([]byte, error) {
+	var doc interface{}
+	if err := json.Unmarshal(in, &doc); err != nil {
+		return nil, fmt.Errorf("unmarshal JSON: %w", err)
+	}
+	out, err := json.MarshalIndent(redactJSONValue(doc, false), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal JSON: %w", err)
+	}
+	return out, nil
+}
+
+func redactJSONValue(v interface{}, inSecrets bool) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for key, child := range val {
+			if inSecrets && unredactedSecretFields[key] {
+				continue
+			}
+			val[key] = redactJSONValue(child, inSecrets || key == "secrets")
+		}
+		return val
+	case []interface{}:
+		for i, child := range val {
+			val[i] = redactJSONValue(child, inSecrets)
+		}
+		return val
+	case string:
+		if inSecrets {
+			return redactedValue
+		}
+		return val
+	default:
+		return val
+	}
+}
+
+// BuildSupportBundleCmd builds the command for collecting the state of an application into a support bundle.
+func BuildSupportBundleCmd() *cobra.Command {
+	vars := supportBundleVars{}
+	cmd := &cobra.Command{
+		Use:   "support-bundle",
+		Short: "Collects the state of your application into a zip file.",
+		Long: `Collects the state of your application into a zip file that you can attach to a support case.
+The bundle contains the manifests of your workspace, the description of your environments and services,
+their recent stack events, and the version of the CLI.
+The values of secrets and AWS account IDs are redacted.`,
+		Example: `
+  Collect the state of the "my-app" application into "bundle.zip".
+  /code $ copilot support-bundle -a my-app --output bundle.zip`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSupportBundleOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+		Annotations: map[string]string{
+			"group": group.Settings,
+		},
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.output, outputFlag, defaultSupportBundleOutput, supportBundleOutputFlagDescription)
+	cmd.Flags().BoolVar(&vars.noRedact, noRedactFlag, false, noRedactFlagDescription)
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
@@ -0,0 +1,323 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	describestack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+type supportBundleMocks struct {
+	store  *mocks.Mockstore
+	ws     *mocks.MockwsWlManifestReader
+	envDes *mocks.MockenvDescriber
+	svcDes *mocks.Mockdescriber
+	events *mocks.MockstackEventsDescriber
+}
+
+func TestSupportBundleOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		inOutput   string
+		setupMocks func(m supportBundleMocks, fs afero.Fs)
+
+		wantedErr error
+	}{
+		"error if there is no application": {
+			setupMocks: func(m supportBundleMocks, fs afero.Fs) {},
+			wantedErr:  errNoAppInWorkspace,
+		},
+		"error if the application does not exist": {
+			inAppName: "phonetool",
+			setupMocks: func(m supportBundleMocks, fs afero.Fs) {
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get application phonetool: some error"),
+		},
+		"error if the output is not a zip file": {
+			inAppName: "phonetool",
+			inOutput:  "bundle.tar",
+			setupMocks: func(m supportBundleMocks, fs afero.Fs) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+			},
+			wantedErr: errors.New("output file bundle.tar must have a .zip extension"),
+		},
+		"error if the output file already exists": {
+			inAppName: "phonetool",
+			inOutput:  "bundle.zip",
+			setupMocks: func(m supportBundleMocks, fs afero.Fs) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				require.NoError(t, afero.WriteFile(fs, "bundle.zip", []byte("hello"), 0644))
+			},
+			wantedErr: errors.New("file bundle.zip already exists"),
+		},
+		"success": {
+			inAppName: "phonetool",
+			inOutput:  "bundle.zip",
+			setupMocks: func(m supportBundleMocks, fs afero.Fs) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := supportBundleMocks{
+				store: mocks.NewMockstore(ctrl),
+			}
+			fs := afero.NewMemMapFs()
+			tc.setupMocks(m, fs)
+			opts := &supportBundleOpts{
+				supportBundleVars: supportBundleVars{
+					appName: tc.inAppName,
+					output:  tc.inOutput,
+				},
+				store: m.store,
+				fs:    fs,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSupportBundleOpts_Execute(t *testing.T) {
+	const (
+		mockManifest = `name: api
+type: Backend Service
+variables:
+  ACCOUNT: "123456789012"
+secrets:
+  GITHUB_TOKEN: /copilot/phonetool/test/secrets/token
+`
+		mockSvcDescription = `{"service":"api","secrets":[{"name":"TOKEN","container":"api","environment":"test","valueFrom":"arn:aws:ssm:us-west-2:123456789012:parameter/token"}]}`
+	)
+	testEnv := &config.Environment{App: "phonetool", Name: "test", Region: "us-west-2", AccountID: "123456789012"}
+	testSvc := &config.Workload{App: "phonetool", Name: "api", Type: "Backend Service"}
+	testCases := map[string]struct {
+		inNoRedact bool
+		setupMocks func(m supportBundleMocks)
+
+		wantedFiles map[string]string
+		wantedErr   error
+	}{
+		"records the error of a manifest that cannot be read and collects the others": {
+			setupMocks: func(m supportBundleMocks) {
+				m.ws.EXPECT().ServiceNames().Return([]string{"api", "fe"}, nil)
+				m.ws.EXPECT().ReadServiceManifest("api").Return(nil, errors.New("some error"))
+				m.ws.EXPECT().ReadServiceManifest("fe").Return([]byte("name: fe\n"), nil)
+				m.ws.EXPECT().JobNames().Return([]string{"report"}, nil)
+				m.ws.EXPECT().ReadJobManifest("report").Return(nil, errors.New("some error"))
+				m.store.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+				m.store.EXPECT().ListServices("phonetool").Return(nil, nil)
+			},
+			wantedFiles: map[string]string{
+				"version.json":               "",
+				"manifests/api.error.txt":    "read manifest of service api: some error",
+				"manifests/fe.yml":           "name: fe\n",
+				"manifests/report.error.txt": "read manifest of job report: some error",
+			},
+		},
+		"error if fail to list environments": {
+			setupMocks: func(m supportBundleMocks) {
+				m.ws.EXPECT().ServiceNames().Return(nil, nil)
+				m.ws.EXPECT().JobNames().Return(nil, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list environments in application phonetool: some error"),
+		},
+		"skips environments and services that cannot be described": {
+			setupMocks: func(m supportBundleMocks) {
+				m.ws.EXPECT().ServiceNames().Return(nil, nil)
+				m.ws.EXPECT().JobNames().Return(nil, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv}, nil)
+				m.envDes.EXPECT().Describe().Return(nil, errors.New("some error"))
+				m.events.EXPECT().Events(supportBundleEventsLimit).Return(nil, errors.New("some error"))
+				m.store.EXPECT().ListServices("phonetool").Return([]*config.Workload{testSvc}, nil)
+				m.svcDes.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedFiles: map[string]string{
+				"version.json": "",
+			},
+		},
+		"redacts secrets and account IDs": {
+			setupMocks: func(m supportBundleMocks) {
+				m.ws.EXPECT().ServiceNames().Return([]string{"api"}, nil)
+				m.ws.EXPECT().ReadServiceManifest("api").Return([]byte(mockManifest), nil)
+				m.ws.EXPECT().JobNames().Return(nil, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv}, nil)
+				m.envDes.EXPECT().Describe().Return(&describe.EnvDescription{Environment: testEnv}, nil)
+				m.events.EXPECT().Events(supportBundleEventsLimit).Return([]*describestack.Event{
+					{
+						Timestamp:    time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC),
+						LogicalID:    "Cluster",
+						ResourceType: "AWS::ECS::Cluster",
+						Status:       "CREATE_COMPLETE",
+					},
+				}, nil)
+				m.store.EXPECT().ListServices("phonetool").Return([]*config.Workload{testSvc}, nil)
+				m.svcDes.EXPECT().Describe().Return(&mockDescribeData{data: mockSvcDescription}, nil)
+			},
+			wantedFiles: map[string]string{
+				"version.json": "",
+				"manifests/api.yml": `name: api
+type: Backend Service
+variables:
+  ACCOUNT: "REDACTED"
+secrets:
+  GITHUB_TOKEN: REDACTED
+`,
+				"environments/test.json": `{
+  "environment": {
+    "accountID": "REDACTED",
+    "app": "phonetool",
+    "executionRoleARN": "",
+    "managerRoleARN": "",
+    "name": "test",
+    "prod": false,
+    "region": "us-west-2",
+    "registryURL": ""
+  },
+  "environmentVPC": {
+    "id": "",
+    "privateSubnetIDs": null,
+    "publicSubnetIDs": null
+  },
+  "schemaVersion": 1,
+  "services": null
+}`,
+				"environments/test-events.json": `[
+  {
+    "logicalID": "Cluster",
+    "status": "CREATE_COMPLETE",
+    "timestamp": "2021-09-01T00:00:00Z",
+    "type": "AWS::ECS::Cluster"
+  }
+]`,
+				"services/api.json": `{
+  "secrets": [
+    {
+      "container": "api",
+      "environment": "test",
+      "name": "TOKEN",
+      "valueFrom": "REDACTED"
+    }
+  ],
+  "service": "api"
+}`,
+			},
+		},
+		"keeps the original content if redaction is disabled": {
+			inNoRedact: true,
+			setupMocks: func(m supportBundleMocks) {
+				m.ws.EXPECT().ServiceNames().Return([]string{"api"}, nil)
+				m.ws.EXPECT().ReadServiceManifest("api").Return([]byte(mockManifest), nil)
+				m.ws.EXPECT().JobNames().Return(nil, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+				m.store.EXPECT().ListServices("phonetool").Return([]*config.Workload{testSvc}, nil)
+				m.svcDes.EXPECT().Describe().Return(&mockDescribeData{data: mockSvcDescription}, nil)
+			},
+			wantedFiles: map[string]string{
+				"version.json":      "",
+				"manifests/api.yml": mockManifest,
+				"services/api.json": mockSvcDescription,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := supportBundleMocks{
+				store:  mocks.NewMockstore(ctrl),
+				ws:     mocks.NewMockwsWlManifestReader(ctrl),
+				envDes: mocks.NewMockenvDescriber(ctrl),
+				svcDes: mocks.NewMockdescriber(ctrl),
+				events: mocks.NewMockstackEventsDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+			fs := afero.NewMemMapFs()
+			opts := &supportBundleOpts{
+				supportBundleVars: supportBundleVars{
+					appName:  "phonetool",
+					output:   "bundle.zip",
+					noRedact: tc.inNoRedact,
+				},
+				store: m.store,
+				ws:    m.ws,
+				fs:    fs,
+				newEnvDescriber: func(env string) (envDescriber, error) {
+					return m.envDes, nil
+				},
+				newSvcDescriber: func(svc *config.Workload) (describer, error) {
+					return m.svcDes, nil
+				},
+				newStackEventsDescriber: func(env *config.Environment) (stackEventsDescriber, error) {
+					return m.events, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			files := readZip(t, fs, "bundle.zip")
+			require.Len(t, files, len(tc.wantedFiles))
+			require.Contains(t, files["version.json"], `"version"`)
+			for name, wanted := range tc.wantedFiles {
+				if name == "version.json" {
+					continue
+				}
+				require.Equal(t, wanted, files[name], name)
+			}
+		})
+	}
+}
+
+func readZip(t *testing.T, fs afero.Fs, name string) map[string]string {
+	content, err := afero.ReadFile(fs, name)
+	require.NoError(t, err)
+	r, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	require.NoError(t, err)
+	files := make(map[string]string)
+	for _, f := range r.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		b, err := ioutil.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		files[f.Name] = string(b)
+	}
+	return files
+}
@@ -0,0 +1,322 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcConnectTestFromPrompt     = "From which service would you like to test the connection?"
+	svcConnectTestFromHelpPrompt = `Copilot runs the connectivity probe in one of the chosen service's tasks.
+The task is chosen at random, and the first essential container is used.`
+	svcConnectTestToPrompt     = "To which service would you like to test the connection?"
+	svcConnectTestToHelpPrompt = "The probe connects to the service discovery endpoint of the chosen service."
+
+	// fmtConnectTestProbeCmd is the command run in the source container. It opens a TCP connection with nc if it's installed,
+	// otherwise sends an HTTP request with curl, and prints whether the connection succeeded.
+	// The session manager plugin doesn't return the exit code of the command, so the probe succeeded
+	// only if its output contains the success message.
+	fmtConnectTestProbeCmd = `/bin/sh -c 'if command -v nc >/dev/null 2>&1; then nc -z -w 5 %[1]s %[2]s; ` +
+		`elif command -v curl >/dev/null 2>&1; then curl -s -o /dev/null --connect-timeout 5 http://%[1]s:%[2]s; ` +
+		`else echo "Neither nc nor curl is installed in the container."; exit 2; fi ` +
+		`&& echo "Connection to %[1]s:%[2]s succeeded." || { echo "Connection to %[1]s:%[2]s failed."; exit 1; }'`
+	fmtConnectTestProbeSucceeded = "Connection to %s:%s succeeded."
+)
+
+type svcConnectTestVars struct {
+	appName          string
+	envName          string
+	fromSvc          string
+	toSvc            string
+	skipConfirmation *bool // If nil, we will prompt to upgrade the ssm plugin.
+}
+
+type svcConnectTestOpts struct {
+	svcConnectTestVars
+
+	store              store
+	sel                deploySelector
+	newSvcDescriber    func(*session.Session) serviceDescriber
+	newCommandExecutor func(*session.Session) ecsCommandExecutor
+	newURIGetter       func(svc, svcType string) (serviceDiscoveryURIGetter, error)
+	ssmPluginManager   ssmPluginManager
+	prompter           prompter
+	w                  io.Writer
+	// Override in unit test
+	randInt func(int) int
+}
+
+func newSvcConnectTestOpts(vars svcConnectTestVars) (*svcConnectTestOpts, error) {
+	ssmStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(ssmStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	opts := &svcConnectTestOpts{
+		svcConnectTestVars: vars,
+		store:              ssmStore,
+		sel:                selector.NewDeploySelect(prompt.New(), ssmStore, deployStore),
+		newSvcDescriber: func(s *session.Session) serviceDescriber {
+			return ecs.New(s)
+		},
+		newCommandExecutor: func(s *session.Session) ecsCommandExecutor {
+			return awsecs.New(s)
+		},
+		randInt: func(x int) int {
+			rand.Seed(time.Now().Unix())
+			return rand.Intn(x)
+		},
+		ssmPluginManager: exec.NewSSMPluginCommand(nil),
+		prompter:         prompt.New(),
+		w:                os.Stdout,
+	}
+	opts.newURIGetter = func(svc, svcType string) (serviceDiscoveryURIGetter, error) {
+		cfg := describe.NewServiceConfig{
+			App:         opts.appName,
+			Svc:         svc,
+			ConfigStore: ssmStore,
+		}
+		switch svcType {
+		case manifest.LoadBalancedWebServiceType:
+			return describe.NewLBWebServiceDescriber(describe.NewLBWebServiceConfig{
+				NewServiceConfig: cfg,
+				DeployStore:      deployStore,
+			})
+		case manifest.BackendServiceType:
+			return describe.NewBackendServiceDescriber(describe.NewBackendServiceConfig{
+				NewServiceConfig: cfg,
+				DeployStore:      deployStore,
+			})
+		default:
+			return nil, fmt.Errorf("service %s of type %s can't be reached with service discovery", svc, svcType)
+		}
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcConnectTestOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.fromSvc != "" {
+		if _, err := o.store.GetService(o.appName, o.fromSvc); err != nil {
+			return err
+		}
+	}
+	if o.toSvc != "" {
+		if _, err := o.store.GetService(o.appName, o.toSvc); err != nil {
+			return err
+		}
+	}
+	if o.fromSvc != "" && o.fromSvc == o.toSvc {
+		return fmt.Errorf("--%s and --%s must be different services", fromFlag, toFlag)
+	}
+	return validateSSMBinary(o.prompter, o.ssmPluginManager, o.skipConfirmation)
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcConnectTestOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	if err := o.askFromSvcEnvName(); err != nil {
+		return err
+	}
+	return o.askToSvcName()
+}
+
+// Execute runs a connectivity probe from a running container of the source service
+// to the service discovery endpoint of the target service.
+func (o *svcConnectTestOpts) Execute() error {
+	from, err := o.store.GetWorkload(o.appName, o.fromSvc)
+	if err != nil {
+		return fmt.Errorf("get workload %s: %w", o.fromSvc, err)
+	}
+	if from.Type == manifest.RequestDrivenWebServiceType {
+		return fmt.Errorf("testing the connection from a service is not supported for services with type: '%s'", manifest.RequestDrivenWebServiceType)
+	}
+	to, err := o.store.GetWorkload(o.appName, o.toSvc)
+	if err != nil {
+		return fmt.Errorf("get workload %s: %w", o.toSvc, err)
+	}
+	uriGetter, err := o.newURIGetter(o.toSvc, to.Type)
+	if err != nil {
+		return err
+	}
+	endpoint, err := uriGetter.ServiceDiscoveryURI(o.envName)
+	if err != nil {
+		return fmt.Errorf("get service discovery endpoint of service %s in environment %s: %w", o.toSvc, o.envName, err)
+	}
+	if endpoint == describe.BlankServiceDiscoveryURI {
+		return fmt.Errorf("service %s can't be reached with service discovery in environment %s: it doesn't expose a port", o.toSvc, o.envName)
+	}
+	host, port, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return fmt.Errorf("parse service discovery endpoint %s: %w", endpoint, err)
+	}
+
+	sess, err := o.envSession()
+	if err != nil {
+		return err
+	}
+	svcDesc, err := o.newSvcDescriber(sess).DescribeService(o.appName, o.envName, o.fromSvc)
+	if err != nil {
+		return fmt.Errorf("describe ECS service for %s in environment %s: %w", o.fromSvc, o.envName, err)
+	}
+	tasks := awsecs.FilterRunningTasks(svcDesc.Tasks)
+	if len(tasks) == 0 {
+		return fmt.Errorf("found no running task for service %s in environment %s", o.fromSvc, o.envName)
+	}
+	taskID, err := awsecs.TaskID(aws.StringValue(tasks[o.randInt(len(tasks))].TaskArn))
+	if err != nil {
+		return err
+	}
+	// The first essential container is named with the workload name.
+	container := o.fromSvc
+	command := fmt.Sprintf(fmtConnectTestProbeCmd, host, port)
+	log.Infof("Test the connection from container %s in task %s to %s.\n",
+		color.HighlightUserInput(container), color.HighlightResource(taskID), color.HighlightResource(endpoint))
+	var out bytes.Buffer
+	if err := o.newCommandExecutor(sess).ExecuteCommand(awsecs.ExecuteCommandInput{
+		Cluster:   svcDesc.ClusterName,
+		Command:   command,
+		Container: container,
+		Task:      taskID,
+		Stdout:    io.MultiWriter(o.w, &out),
+	}); err != nil {
+		var errExecCmd *awsecs.ErrExecuteCommand
+		if errors.As(err, &errExecCmd) {
+			log.Errorf("Failed to run the connectivity probe. Is %s set in the manifest of service %s?\n", color.HighlightCode("exec: true"), o.fromSvc)
+			return fmt.Errorf("run connectivity probe in container %s: %w", container, err)
+		}
+		return fmt.Errorf("test connection from service %s to %s: %w", o.fromSvc, endpoint, err)
+	}
+	if !strings.Contains(out.String(), fmt.Sprintf(fmtConnectTestProbeSucceeded, host, port)) {
+		return fmt.Errorf("connection from service %s to %s failed", o.fromSvc, endpoint)
+	}
+	return nil
+}
+
+func (o *svcConnectTestOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcConnectTestOpts) askFromSvcEnvName() error {
+	deployedService, err := o.sel.DeployedService(svcConnectTestFromPrompt, svcConnectTestFromHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.fromSvc))
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
+	}
+	o.fromSvc = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+func (o *svcConnectTestOpts) askToSvcName() error {
+	deployedService, err := o.sel.DeployedService(svcConnectTestToPrompt, svcConnectTestToHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.toSvc))
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
+	}
+	if deployedService.Svc == o.fromSvc {
+		return fmt.Errorf("--%s and --%s must be different services", fromFlag, toFlag)
+	}
+	o.toSvc = deployedService.Svc
+	return nil
+}
+
+func (o *svcConnectTestOpts) envSession() (*session.Session, error) {
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	return sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+}
+
+// buildSvcConnectTestCmd builds the command for testing the connection between two services.
+func buildSvcConnectTestCmd() *cobra.Command {
+	vars := svcConnectTestVars{}
+	var skipPrompt bool
+	cmd := &cobra.Command{
+		Use:   "connect-test",
+		Short: "Test the network connection from one service to another.",
+		Long: `Test the network connection from one service to another.
+Copilot runs a probe in a running container of the source service
+against the service discovery endpoint of the target service.`,
+		Example: `
+  Test whether the "frontend" service can reach the "api" service in the "test" environment.
+  /code $ copilot svc connect-test --from frontend --to api -e test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcConnectTestOpts(vars)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(yesFlag) {
+				opts.skipConfirmation = aws.Bool(false)
+				if skipPrompt {
+					opts.skipConfirmation = aws.Bool(true)
+				}
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVar(&vars.fromSvc, fromFlag, "", connectTestFromFlagDescription)
+	cmd.Flags().StringVar(&vars.toSvc, toFlag, "", connectTestToFlagDescription)
+	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
+
+	cmd.SetUsageTemplate(template.Usage)
+	markPromptedFlags(cmd, appFlag, envFlag, fromFlag, toFlag)
+	return cmd
+}
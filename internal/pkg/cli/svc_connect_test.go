@@ -0,0 +1,314 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcConnectTestMocks struct {
+	storeSvc           *mocks.Mockstore
+	ecsSvcDescriber    *mocks.MockserviceDescriber
+	ecsCommandExecutor *mocks.MockecsCommandExecutor
+	uriGetter          *mocks.MockserviceDiscoveryURIGetter
+	ssmPluginManager   *mocks.MockssmPluginManager
+}
+
+func TestSvcConnectTest_Validate(t *testing.T) {
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		inFromSvc  string
+		inToSvc    string
+		setupMocks func(mocks svcConnectTestMocks)
+
+		wantedError error
+	}{
+		"should bubble error if cannot get the target service": {
+			inFromSvc: "frontend",
+			inToSvc:   "api",
+			setupMocks: func(m svcConnectTestMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil),
+					m.storeSvc.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{Name: "my-env"}, nil),
+					m.storeSvc.EXPECT().GetService("my-app", "frontend").Return(&config.Workload{Name: "frontend"}, nil),
+					m.storeSvc.EXPECT().GetService("my-app", "api").Return(nil, mockErr),
+				)
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"should return error if the source and target services are the same": {
+			inFromSvc: "api",
+			inToSvc:   "api",
+			setupMocks: func(m svcConnectTestMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.storeSvc.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{Name: "my-env"}, nil)
+				m.storeSvc.EXPECT().GetService("my-app", "api").Return(&config.Workload{Name: "api"}, nil).Times(2)
+			},
+
+			wantedError: fmt.Errorf("--from and --to must be different services"),
+		},
+		"success": {
+			inFromSvc: "frontend",
+			inToSvc:   "api",
+			setupMocks: func(m svcConnectTestMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil),
+					m.storeSvc.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{Name: "my-env"}, nil),
+					m.storeSvc.EXPECT().GetService("my-app", "frontend").Return(&config.Workload{Name: "frontend"}, nil),
+					m.storeSvc.EXPECT().GetService("my-app", "api").Return(&config.Workload{Name: "api"}, nil),
+					m.ssmPluginManager.EXPECT().ValidateBinary().Return(nil),
+				)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockSSMPluginManager := mocks.NewMockssmPluginManager(ctrl)
+			tc.setupMocks(svcConnectTestMocks{
+				storeSvc:         mockStore,
+				ssmPluginManager: mockSSMPluginManager,
+			})
+
+			opts := &svcConnectTestOpts{
+				svcConnectTestVars: svcConnectTestVars{
+					appName:          "my-app",
+					envName:          "my-env",
+					fromSvc:          tc.inFromSvc,
+					toSvc:            tc.inToSvc,
+					skipConfirmation: aws.Bool(true),
+				},
+				store:            mockStore,
+				ssmPluginManager: mockSSMPluginManager,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcConnectTest_Execute(t *testing.T) {
+	const mockTaskARN = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/mockTaskID"
+	mockFrontend := config.Workload{
+		App:  "mockApp",
+		Name: "frontend",
+		Type: "Load Balanced Web Service",
+	}
+	mockAPI := config.Workload{
+		App:  "mockApp",
+		Name: "api",
+		Type: "Backend Service",
+	}
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(mocks svcConnectTestMocks)
+
+		wantedError error
+	}{
+		"return error if the source service is a Request-Driven Web Service": {
+			setupMocks: func(m svcConnectTestMocks) {
+				m.storeSvc.EXPECT().GetWorkload("mockApp", "frontend").Return(&config.Workload{
+					Name: "frontend",
+					Type: "Request-Driven Web Service",
+				}, nil)
+			},
+			wantedError: fmt.Errorf("testing the connection from a service is not supported for services with type: 'Request-Driven Web Service'"),
+		},
+		"return error if fail to get the service discovery endpoint of the target service": {
+			setupMocks: func(m svcConnectTestMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetWorkload("mockApp", "frontend").Return(&mockFrontend, nil),
+					m.storeSvc.EXPECT().GetWorkload("mockApp", "api").Return(&mockAPI, nil),
+					m.uriGetter.EXPECT().ServiceDiscoveryURI("mockEnv").Return("", mockError),
+				)
+			},
+			wantedError: fmt.Errorf("get service discovery endpoint of service api in environment mockEnv: some error"),
+		},
+		"return error if the target service doesn't expose a port": {
+			setupMocks: func(m svcConnectTestMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetWorkload("mockApp", "frontend").Return(&mockFrontend, nil),
+					m.storeSvc.EXPECT().GetWorkload("mockApp", "api").Return(&mockAPI, nil),
+					m.uriGetter.EXPECT().ServiceDiscoveryURI("mockEnv").Return("-", nil),
+				)
+			},
+			wantedError: fmt.Errorf("service api can't be reached with service discovery in environment mockEnv: it doesn't expose a port"),
+		},
+		"return error if the source service has no running task": {
+			setupMocks: func(m svcConnectTestMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetWorkload("mockApp", "frontend").Return(&mockFrontend, nil),
+					m.storeSvc.EXPECT().GetWorkload("mockApp", "api").Return(&mockAPI, nil),
+					m.uriGetter.EXPECT().ServiceDiscoveryURI("mockEnv").Return("api.mockEnv.mockApp.local:8080", nil),
+					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
+						Name: "mockEnv",
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "frontend").Return(&ecs.ServiceDesc{
+						Tasks: []*awsecs.Task{
+							{
+								TaskArn:    aws.String(mockTaskARN),
+								LastStatus: aws.String("PROVISIONING"),
+							},
+						},
+					}, nil),
+				)
+			},
+			wantedError: fmt.Errorf("found no running task for service frontend in environment mockEnv"),
+		},
+		"return error if the session fails": {
+			setupMocks: func(m svcConnectTestMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetWorkload("mockApp", "frontend").Return(&mockFrontend, nil),
+					m.storeSvc.EXPECT().GetWorkload("mockApp", "api").Return(&mockAPI, nil),
+					m.uriGetter.EXPECT().ServiceDiscoveryURI("mockEnv").Return("api.mockEnv.mockApp.local:8080", nil),
+					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
+						Name: "mockEnv",
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "frontend").Return(&ecs.ServiceDesc{
+						ClusterName: "mockCluster",
+						Tasks: []*awsecs.Task{
+							{
+								TaskArn:    aws.String(mockTaskARN),
+								LastStatus: aws.String("RUNNING"),
+							},
+						},
+					}, nil),
+					m.ecsCommandExecutor.EXPECT().ExecuteCommand(gomock.Any()).Return(errors.New("start session: exit status 1")),
+				)
+			},
+			wantedError: fmt.Errorf("test connection from service frontend to api.mockEnv.mockApp.local:8080: start session: exit status 1"),
+		},
+		"return error if the probe doesn't report a successful connection": {
+			setupMocks: func(m svcConnectTestMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetWorkload("mockApp", "frontend").Return(&mockFrontend, nil),
+					m.storeSvc.EXPECT().GetWorkload("mockApp", "api").Return(&mockAPI, nil),
+					m.uriGetter.EXPECT().ServiceDiscoveryURI("mockEnv").Return("api.mockEnv.mockApp.local:8080", nil),
+					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
+						Name: "mockEnv",
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "frontend").Return(&ecs.ServiceDesc{
+						ClusterName: "mockCluster",
+						Tasks: []*awsecs.Task{
+							{
+								TaskArn:    aws.String(mockTaskARN),
+								LastStatus: aws.String("RUNNING"),
+							},
+						},
+					}, nil),
+					m.ecsCommandExecutor.EXPECT().ExecuteCommand(gomock.Any()).DoAndReturn(func(in awsecs.ExecuteCommandInput) error {
+						_, err := in.Stdout.Write([]byte("Connection to api.mockEnv.mockApp.local:8080 failed.\n"))
+						return err
+					}),
+				)
+			},
+			wantedError: fmt.Errorf("connection from service frontend to api.mockEnv.mockApp.local:8080 failed"),
+		},
+		"success": {
+			setupMocks: func(m svcConnectTestMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetWorkload("mockApp", "frontend").Return(&mockFrontend, nil),
+					m.storeSvc.EXPECT().GetWorkload("mockApp", "api").Return(&mockAPI, nil),
+					m.uriGetter.EXPECT().ServiceDiscoveryURI("mockEnv").Return("api.mockEnv.mockApp.local:8080", nil),
+					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
+						Name: "mockEnv",
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "frontend").Return(&ecs.ServiceDesc{
+						ClusterName: "mockCluster",
+						Tasks: []*awsecs.Task{
+							{
+								TaskArn:    aws.String(mockTaskARN),
+								LastStatus: aws.String("RUNNING"),
+							},
+						},
+					}, nil),
+					m.ecsCommandExecutor.EXPECT().ExecuteCommand(gomock.Any()).DoAndReturn(func(in awsecs.ExecuteCommandInput) error {
+						require.Equal(t, "mockCluster", in.Cluster)
+						require.Equal(t, fmt.Sprintf(fmtConnectTestProbeCmd, "api.mockEnv.mockApp.local", "8080"), in.Command)
+						require.Equal(t, "frontend", in.Container)
+						require.Equal(t, "mockTaskID", in.Task)
+						_, err := in.Stdout.Write([]byte("Connection to api.mockEnv.mockApp.local:8080 succeeded.\n"))
+						return err
+					}),
+				)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockSvcDescriber := mocks.NewMockserviceDescriber(ctrl)
+			mockCommandExecutor := mocks.NewMockecsCommandExecutor(ctrl)
+			mockURIGetter := mocks.NewMockserviceDiscoveryURIGetter(ctrl)
+			tc.setupMocks(svcConnectTestMocks{
+				storeSvc:           mockStore,
+				ecsSvcDescriber:    mockSvcDescriber,
+				ecsCommandExecutor: mockCommandExecutor,
+				uriGetter:          mockURIGetter,
+			})
+
+			opts := &svcConnectTestOpts{
+				svcConnectTestVars: svcConnectTestVars{
+					appName: "mockApp",
+					envName: "mockEnv",
+					fromSvc: "frontend",
+					toSvc:   "api",
+				},
+				store: mockStore,
+				newSvcDescriber: func(_ *session.Session) serviceDescriber {
+					return mockSvcDescriber
+				},
+				newCommandExecutor: func(_ *session.Session) ecsCommandExecutor {
+					return mockCommandExecutor
+				},
+				newURIGetter: func(_, _ string) (serviceDiscoveryURIGetter, error) {
+					return mockURIGetter, nil
+				},
+				w:       ioutil.Discard,
+				randInt: func(i int) int { return 0 },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -77,6 +77,10 @@ func newDeleteSvcOpts(vars deleteSvcVars) (*deleteSvcOpts, error) {
 	if err != nil {
 		return nil, err
 	}
+	appCFN, err := newAppCloudFormation(defaultSession)
+	if err != nil {
+		return nil, err
+	}
 	prompter := prompt.New()
 
 	return &deleteSvcOpts{
@@ -87,7 +91,7 @@ func newDeleteSvcOpts(vars deleteSvcVars) (*deleteSvcOpts, error) {
 		prompt:  prompter,
 		sess:    provider,
 		sel:     selector.NewConfigSelect(prompter, store),
-		appCFN:  cloudformation.New(defaultSession),
+		appCFN:  appCFN,
 		getSvcCFN: func(session *awssession.Session) wlDeleter {
 			return cloudformation.New(session)
 		},
@@ -373,5 +377,6 @@ func buildSvcDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	markPromptedFlags(cmd, appFlag, nameFlag, yesFlag)
 	return cmd
 }
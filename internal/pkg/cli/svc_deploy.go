@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
@@ -62,6 +64,8 @@ type deploySvcOpts struct {
 	envUpgradeCmd      actionCommand
 	newAppVersionGetter func(string) (versionGetter, error)
 	endpointGetter     endpointGetter
+	lbRulesDescriber   loadBalancerRulesDescriber
+	clusterDescriber   clusterCapacityProvidersGetter
 
 	spinner progress
 	sel     wsSelector
@@ -262,8 +266,9 @@ func (o *deploySvcOpts) configureClients() error {
 
 	// CF client against env account profile AND target environment region
 	o.svcCFN = cloudformation.New(envSession)
+	o.clusterDescriber = ecs.New(envSession)
 
-	o.endpointGetter, err = describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+	envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 		App:         o.appName,
 		Env:         o.envName,
 		ConfigStore: o.store,
@@ -271,6 +276,8 @@ func (o *deploySvcOpts) configureClients() error {
 	if err != nil {
 		return fmt.Errorf("initiate env describer: %w", err)
 	}
+	o.endpointGetter = envDescriber
+	o.lbRulesDescriber = envDescriber
 	addonsSvc, err := addon.New(o.name)
 	if err != nil {
 		return fmt.Errorf("initiate addons service: %w", err)
@@ -399,11 +406,18 @@ func (o *deploySvcOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, e
 	if err != nil {
 		return nil, err
 	}
+	cluster, err := o.importedCluster()
+	if err != nil {
+		return nil, err
+	}
 	if !o.buildRequired {
 		return &stack.RuntimeConfig{
 			AddonsTemplateURL:        addonsURL,
 			AdditionalTags:           tags.Merge(o.targetApp.Tags, o.resourceTags),
 			ServiceDiscoveryEndpoint: endpoint,
+			EnvCapacityProvider:      envCapacityProvider(o.targetEnvironment),
+			EnvPublicSubnetCount:     o.targetEnvironment.PublicSubnetCount(),
+			ImportedCluster:          cluster,
 		}, nil
 	}
 	resources, err := o.appCFN.GetAppResourcesByRegion(o.targetApp, o.targetEnvironment.Region)
@@ -427,9 +441,37 @@ func (o *deploySvcOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, e
 			Digest:   o.imageDigest,
 		},
 		ServiceDiscoveryEndpoint: endpoint,
+		EnvCapacityProvider:      envCapacityProvider(o.targetEnvironment),
+		EnvPublicSubnetCount:     o.targetEnvironment.PublicSubnetCount(),
+		ImportedCluster:          cluster,
 	}, nil
 }
 
+// importedCluster returns the cluster imported by the target environment along with its capacity providers,
+// or nil if the environment created its own cluster.
+func (o *deploySvcOpts) importedCluster() (*stack.ImportedCluster, error) {
+	if o.targetEnvironment.CustomConfig == nil || o.targetEnvironment.CustomConfig.ImportClusterARN == "" {
+		return nil, nil
+	}
+	arn := o.targetEnvironment.CustomConfig.ImportClusterARN
+	providers, err := o.clusterDescriber.ClusterCapacityProviders(arn)
+	if err != nil {
+		return nil, fmt.Errorf("get capacity providers of imported cluster: %w", err)
+	}
+	return &stack.ImportedCluster{
+		ARN:               arn,
+		CapacityProviders: providers,
+	}, nil
+}
+
+// envCapacityProvider returns the default capacity provider of services in the environment.
+func envCapacityProvider(env *config.Environment) string {
+	if env.CustomConfig == nil {
+		return ""
+	}
+	return env.CustomConfig.CapacityProvider
+}
+
 func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.StackConfiguration, error) {
 	mft, err := o.manifest()
 	if err != nil {
@@ -442,6 +484,9 @@ func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 	var conf cloudformation.StackConfiguration
 	switch t := mft.(type) {
 	case *manifest.LoadBalancedWebService:
+		if rc.RulePriority, err = rulePriority(o.lbRulesDescriber, o.envName, o.name, aws.StringValue(t.Path), publicListeners); err != nil {
+			return nil, err
+		}
 		if o.targetApp.RequiresDNSDelegation() {
 			var appVersionGetter versionGetter
 			if appVersionGetter, err = o.newAppVersionGetter(o.appName); err != nil {
@@ -451,12 +496,19 @@ func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 				return nil, err
 			}
 			conf, err = stack.NewHTTPSLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
+		} else if o.targetEnvironment.HasImportedCerts() {
+			conf, err = stack.NewImportedCertsLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
 		} else {
 			conf, err = stack.NewLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
 		}
 	case *manifest.RequestDrivenWebService:
 		conf, err = stack.NewRequestDrivenWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
 	case *manifest.BackendService:
+		if t.HTTP != nil {
+			if rc.RulePriority, err = rulePriority(o.lbRulesDescriber, o.envName, o.name, aws.StringValue(t.HTTP.Path), internalListeners); err != nil {
+				return nil, err
+			}
+		}
 		conf, err = stack.NewBackendService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
 	default:
 		return nil, fmt.Errorf("unknown manifest type %T while creating the CloudFormation stack", t)
@@ -467,6 +519,51 @@ func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 	return conf, nil
 }
 
+var (
+	publicListeners   = []string{describe.HTTPListenerName, describe.HTTPSListenerName}
+	internalListeners = []string{describe.InternalHTTPListenerName}
+)
+
+// rulePriority returns the priority of the rules of service svcName routed on path in environment envName.
+// It's the priority derived from the service's name and path, or the next one that isn't taken by a rule
+// of another service on the listeners.
+func rulePriority(describer loadBalancerRulesDescriber, envName, svcName, path string, listeners []string) (int, error) {
+	rules, err := describer.LoadBalancerRules()
+	if err != nil {
+		return 0, fmt.Errorf("get listener rules of environment %s: %w", envName, err)
+	}
+	onListeners := make(map[string]bool)
+	for _, listener := range listeners {
+		onListeners[listener] = true
+	}
+	taken := make(map[int]bool)
+	owned := make(map[int]bool)
+	for _, rule := range rules {
+		if !onListeners[rule.Listener] {
+			continue
+		}
+		priority, err := strconv.Atoi(rule.Priority)
+		if err != nil {
+			// The default rule of a listener doesn't have a numeric priority.
+			continue
+		}
+		if rule.Service == svcName {
+			owned[priority] = true
+			continue
+		}
+		taken[priority] = true
+	}
+	// Rules that don't forward to a service, such as HTTP to HTTPS redirects, share the priority of the service's rules.
+	for priority := range owned {
+		delete(taken, priority)
+	}
+	priority, err := stack.AvailableRulePriority(svcName, path, taken)
+	if err != nil {
+		return 0, fmt.Errorf("find listener rule priority in environment %s: %w", envName, err)
+	}
+	return priority, nil
+}
+
 func (o *deploySvcOpts) deploySvc(addonsURL string) error {
 	conf, err := o.stackConfiguration(addonsURL)
 	if err != nil {
@@ -623,5 +720,6 @@ func buildSvcDeployCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 
+	markPromptedFlags(cmd, nameFlag, envFlag)
 	return cmd
 }
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/golang/mock/gomock"
@@ -509,17 +511,21 @@ func TestSvcDeployOpts_stackConfiguration(t *testing.T) {
 		mockAddonsURL = "mockAddonsURL"
 	)
 	tests := map[string]struct {
-		inAlias        string
-		inApp          *config.Application
-		inEnvironment  *config.Environment
-		inBuildRequire bool
+		inAlias            string
+		inApp              *config.Application
+		inEnvironment      *config.Environment
+		inBuildRequire     bool
+		inCapacityProvider *string
 
 		mockWorkspace          func(m *mocks.MockwsSvcDirReader)
 		mockAppResourcesGetter func(m *mocks.MockappResourcesGetter)
 		mockAppVersionGetter   func(m *mocks.MockversionGetter)
 		mockEndpointGetter     func(m *mocks.MockendpointGetter)
+		mockLBRulesDescriber   func(m *mocks.MockloadBalancerRulesDescriber)
+		mockClusterDescriber   func(m *mocks.MockclusterCapacityProvidersGetter)
 
-		wantErr error
+		wantedHTTPSEnabled string
+		wantErr            error
 	}{
 		"fail to read service manifest": {
 			mockWorkspace: func(m *mocks.MockwsSvcDirReader) {
@@ -528,6 +534,7 @@ func TestSvcDeployOpts_stackConfiguration(t *testing.T) {
 			mockAppResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
 			mockAppVersionGetter:   func(m *mocks.MockversionGetter) {},
 			mockEndpointGetter:     func(m *mocks.MockendpointGetter) {},
+			mockLBRulesDescriber:   func(m *mocks.MockloadBalancerRulesDescriber) {},
 			wantErr:                fmt.Errorf("read service %s manifest file: %w", mockSvcName, mockError),
 		},
 		"fail to get app resources": {
@@ -551,6 +558,7 @@ func TestSvcDeployOpts_stackConfiguration(t *testing.T) {
 				m.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
 			},
 			mockAppVersionGetter: func(m *mocks.MockversionGetter) {},
+			mockLBRulesDescriber: func(m *mocks.MockloadBalancerRulesDescriber) {},
 			wantErr:              fmt.Errorf("get application %s resources from region us-west-2: %w", mockAppName, mockError),
 		},
 		"cannot to find ECR repo": {
@@ -578,6 +586,7 @@ func TestSvcDeployOpts_stackConfiguration(t *testing.T) {
 				m.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
 			},
 			mockAppVersionGetter: func(m *mocks.MockversionGetter) {},
+			mockLBRulesDescriber: func(m *mocks.MockloadBalancerRulesDescriber) {},
 			wantErr:              fmt.Errorf("ECR repository not found for service mockSvc in region us-west-2 and account 1234567890"),
 		},
 		"fail to get app version": {
@@ -597,6 +606,9 @@ func TestSvcDeployOpts_stackConfiguration(t *testing.T) {
 			mockAppVersionGetter: func(m *mocks.MockversionGetter) {
 				m.EXPECT().Version().Return("", mockError)
 			},
+			mockLBRulesDescriber: func(m *mocks.MockloadBalancerRulesDescriber) {
+				m.EXPECT().LoadBalancerRules().Return(nil, nil)
+			},
 			mockEndpointGetter: func(m *mocks.MockendpointGetter) {
 				m.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
 			},
@@ -619,6 +631,9 @@ func TestSvcDeployOpts_stackConfiguration(t *testing.T) {
 			mockAppVersionGetter: func(m *mocks.MockversionGetter) {
 				m.EXPECT().Version().Return("v0.0.0", nil)
 			},
+			mockLBRulesDescriber: func(m *mocks.MockloadBalancerRulesDescriber) {
+				m.EXPECT().LoadBalancerRules().Return(nil, nil)
+			},
 			mockEndpointGetter: func(m *mocks.MockendpointGetter) {
 				m.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
 			},
@@ -641,11 +656,35 @@ func TestSvcDeployOpts_stackConfiguration(t *testing.T) {
 			mockAppVersionGetter: func(m *mocks.MockversionGetter) {
 				m.EXPECT().Version().Return("v1.0.0", nil)
 			},
+			mockLBRulesDescriber: func(m *mocks.MockloadBalancerRulesDescriber) {
+				m.EXPECT().LoadBalancerRules().Return(nil, nil)
+			},
 			mockEndpointGetter: func(m *mocks.MockendpointGetter) {
 				m.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
 			},
 			wantErr: fmt.Errorf("alias is not supported in hosted zones not managed by Copilot"),
 		},
+		"fail to get the listener rules of the environment": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mockWorkspace: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+			},
+			mockAppResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
+			mockAppVersionGetter:   func(m *mocks.MockversionGetter) {},
+			mockEndpointGetter: func(m *mocks.MockendpointGetter) {
+				m.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+			},
+			mockLBRulesDescriber: func(m *mocks.MockloadBalancerRulesDescriber) {
+				m.EXPECT().LoadBalancerRules().Return(nil, mockError)
+			},
+			wantErr: fmt.Errorf("get listener rules of environment %s: %w", mockEnvName, mockError),
+		},
 		"success": {
 			inAlias: "v1.mockDomain",
 			inEnvironment: &config.Environment{
@@ -663,9 +702,111 @@ func TestSvcDeployOpts_stackConfiguration(t *testing.T) {
 			mockAppVersionGetter: func(m *mocks.MockversionGetter) {
 				m.EXPECT().Version().Return("v1.0.0", nil)
 			},
+			mockLBRulesDescriber: func(m *mocks.MockloadBalancerRulesDescriber) {
+				m.EXPECT().LoadBalancerRules().Return(nil, nil)
+			},
+			mockEndpointGetter: func(m *mocks.MockendpointGetter) {
+				m.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+			},
+			wantedHTTPSEnabled: "true",
+		},
+		"success with an environment that only has imported certificates": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+				CustomConfig: &config.CustomizeEnv{
+					ImportCertARNs: []string{"arn:aws:acm:us-west-2:123456789012:certificate/1234"},
+				},
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mockWorkspace: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+			},
+			mockAppResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
+			mockAppVersionGetter:   func(m *mocks.MockversionGetter) {},
+			mockLBRulesDescriber: func(m *mocks.MockloadBalancerRulesDescriber) {
+				m.EXPECT().LoadBalancerRules().Return(nil, nil)
+			},
+			mockEndpointGetter: func(m *mocks.MockendpointGetter) {
+				m.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+			},
+			wantedHTTPSEnabled: "true",
+		},
+		"success with an environment without HTTPS": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mockWorkspace: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+			},
+			mockAppResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
+			mockAppVersionGetter:   func(m *mocks.MockversionGetter) {},
+			mockLBRulesDescriber: func(m *mocks.MockloadBalancerRulesDescriber) {
+				m.EXPECT().LoadBalancerRules().Return(nil, nil)
+			},
+			mockEndpointGetter: func(m *mocks.MockendpointGetter) {
+				m.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+			},
+			wantedHTTPSEnabled: "false",
+		},
+		"fail to get capacity providers of imported cluster": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+				CustomConfig: &config.CustomizeEnv{
+					ImportClusterARN: "arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster",
+				},
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mockWorkspace: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+			},
+			mockAppResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
+			mockAppVersionGetter:   func(m *mocks.MockversionGetter) {},
+			mockLBRulesDescriber:   func(m *mocks.MockloadBalancerRulesDescriber) {},
+			mockEndpointGetter: func(m *mocks.MockendpointGetter) {
+				m.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+			},
+			mockClusterDescriber: func(m *mocks.MockclusterCapacityProvidersGetter) {
+				m.EXPECT().ClusterCapacityProviders("arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster").Return(nil, mockError)
+			},
+			wantErr: fmt.Errorf("get capacity providers of imported cluster: %w", mockError),
+		},
+		"success with an imported cluster": {
+			inCapacityProvider: aws.String("FARGATE_SPOT"),
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+				CustomConfig: &config.CustomizeEnv{
+					ImportClusterARN: "arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster",
+				},
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mockWorkspace: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+			},
+			mockAppResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
+			mockAppVersionGetter:   func(m *mocks.MockversionGetter) {},
+			mockLBRulesDescriber: func(m *mocks.MockloadBalancerRulesDescriber) {
+				m.EXPECT().LoadBalancerRules().Return(nil, nil)
+			},
 			mockEndpointGetter: func(m *mocks.MockendpointGetter) {
 				m.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
 			},
+			mockClusterDescriber: func(m *mocks.MockclusterCapacityProvidersGetter) {
+				m.EXPECT().ClusterCapacityProviders("arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster").Return([]string{"FARGATE", "FARGATE_SPOT"}, nil)
+			},
+			wantedHTTPSEnabled: "false",
 		},
 	}
 
@@ -678,10 +819,16 @@ func TestSvcDeployOpts_stackConfiguration(t *testing.T) {
 			mockAppResourcesGetter := mocks.NewMockappResourcesGetter(ctrl)
 			mockAppVersionGetter := mocks.NewMockversionGetter(ctrl)
 			mockEndpointGetter := mocks.NewMockendpointGetter(ctrl)
+			mockLBRulesDescriber := mocks.NewMockloadBalancerRulesDescriber(ctrl)
 			tc.mockWorkspace(mockWorkspace)
 			tc.mockAppResourcesGetter(mockAppResourcesGetter)
 			tc.mockAppVersionGetter(mockAppVersionGetter)
 			tc.mockEndpointGetter(mockEndpointGetter)
+			tc.mockLBRulesDescriber(mockLBRulesDescriber)
+			mockClusterDescriber := mocks.NewMockclusterCapacityProvidersGetter(ctrl)
+			if tc.mockClusterDescriber != nil {
+				tc.mockClusterDescriber(mockClusterDescriber)
+			}
 
 			opts := deploySvcOpts{
 				deployWkldVars: deployWkldVars{
@@ -696,6 +843,8 @@ func TestSvcDeployOpts_stackConfiguration(t *testing.T) {
 					return mockAppVersionGetter, nil
 				},
 				endpointGetter:    mockEndpointGetter,
+				lbRulesDescriber:  mockLBRulesDescriber,
+				clusterDescriber:  mockClusterDescriber,
 				targetApp:         tc.inApp,
 				targetEnvironment: tc.inEnvironment,
 				unmarshal: func(b []byte) (manifest.WorkloadManifest, error) {
@@ -707,17 +856,125 @@ func TestSvcDeployOpts_stackConfiguration(t *testing.T) {
 							RoutingRule: manifest.RoutingRule{
 								Alias: aws.String(tc.inAlias),
 							},
+							TaskConfig: manifest.TaskConfig{
+								Count: manifest.Count{
+									Value: aws.Int(1),
+								},
+							},
+							CapacityProvider: tc.inCapacityProvider,
 						},
 					}, nil
 				},
 			}
 
-			_, gotErr := opts.stackConfiguration(mockAddonsURL)
+			conf, gotErr := opts.stackConfiguration(mockAddonsURL)
 
 			if tc.wantErr != nil {
 				require.EqualError(t, gotErr, tc.wantErr.Error())
 			} else {
 				require.NoError(t, gotErr)
+				params, err := conf.Parameters()
+				require.NoError(t, err)
+				for _, param := range params {
+					if aws.StringValue(param.ParameterKey) == stack.LBWebServiceHTTPSParamKey {
+						require.Equal(t, tc.wantedHTTPSEnabled, aws.StringValue(param.ParameterValue))
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRulePriority(t *testing.T) {
+	const (
+		mockEnvName = "mockEnv"
+		mockSvcName = "mockSvc"
+	)
+	mockError := errors.New("some error")
+	derived := stack.RulePriority(mockSvcName, "api")
+
+	tests := map[string]struct {
+		inListeners          []string
+		mockLBRulesDescriber func(m *mocks.MockloadBalancerRulesDescriber)
+
+		wantedPriority int
+		wantedErr      error
+	}{
+		"fail to get the listener rules": {
+			inListeners: publicListeners,
+			mockLBRulesDescriber: func(m *mocks.MockloadBalancerRulesDescriber) {
+				m.EXPECT().LoadBalancerRules().Return(nil, mockError)
+			},
+			wantedErr: fmt.Errorf("get listener rules of environment mockEnv: some error"),
+		},
+		"use the derived priority if no other service has it": {
+			inListeners: publicListeners,
+			mockLBRulesDescriber: func(m *mocks.MockloadBalancerRulesDescriber) {
+				m.EXPECT().LoadBalancerRules().Return([]*describe.LoadBalancerRule{
+					{Listener: "HTTP", Priority: "default"},
+					{Listener: "HTTP", Priority: "50000", Service: "frontend"},
+					{Listener: "HTTP", Priority: strconv.Itoa(derived), Service: mockSvcName},
+				}, nil)
+			},
+			wantedPriority: derived,
+		},
+		"probe the next free priority if another service has the derived priority": {
+			inListeners: publicListeners,
+			mockLBRulesDescriber: func(m *mocks.MockloadBalancerRulesDescriber) {
+				m.EXPECT().LoadBalancerRules().Return([]*describe.LoadBalancerRule{
+					{Listener: "HTTPS", Priority: strconv.Itoa(derived), Service: "frontend"},
+					{Listener: "HTTP", Priority: strconv.Itoa(derived)},
+					{Listener: "HTTPS", Priority: strconv.Itoa(derived + 1), Service: "backend"},
+				}, nil)
+			},
+			wantedPriority: derived + 2,
+		},
+		"keep the priority shared with the service's own redirect rule": {
+			inListeners: publicListeners,
+			mockLBRulesDescriber: func(m *mocks.MockloadBalancerRulesDescriber) {
+				m.EXPECT().LoadBalancerRules().Return([]*describe.LoadBalancerRule{
+					{Listener: "HTTPS", Priority: strconv.Itoa(derived), Service: mockSvcName},
+					{Listener: "HTTP", Priority: strconv.Itoa(derived)},
+				}, nil)
+			},
+			wantedPriority: derived,
+		},
+		"ignore the rules of the internal listener for a public service": {
+			inListeners: publicListeners,
+			mockLBRulesDescriber: func(m *mocks.MockloadBalancerRulesDescriber) {
+				m.EXPECT().LoadBalancerRules().Return([]*describe.LoadBalancerRule{
+					{Listener: "Internal HTTP", Priority: strconv.Itoa(derived), Service: "backend"},
+				}, nil)
+			},
+			wantedPriority: derived,
+		},
+		"probe the next free priority on the internal listener for an internal service": {
+			inListeners: internalListeners,
+			mockLBRulesDescriber: func(m *mocks.MockloadBalancerRulesDescriber) {
+				m.EXPECT().LoadBalancerRules().Return([]*describe.LoadBalancerRule{
+					{Listener: "HTTP", Priority: strconv.Itoa(derived + 1), Service: "frontend"},
+					{Listener: "Internal HTTP", Priority: strconv.Itoa(derived), Service: "backend"},
+				}, nil)
+			},
+			wantedPriority: derived + 1,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLBRulesDescriber := mocks.NewMockloadBalancerRulesDescriber(ctrl)
+			tc.mockLBRulesDescriber(mockLBRulesDescriber)
+
+			got, err := rulePriority(mockLBRulesDescriber, mockEnvName, mockSvcName, "api", tc.inListeners)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedPriority, got)
 			}
 		})
 	}
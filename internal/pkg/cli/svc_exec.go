@@ -30,7 +30,10 @@ import (
 const (
 	svcExecNamePrompt     = "Into which service would you like to execute?"
 	svcExecNameHelpPrompt = `Copilot runs your command in one of your chosen service's tasks.
-The task is chosen at random, and the first essential container is used.`
+The task is chosen at random. If it runs more than one container, you will be asked to choose one.`
+	svcExecContainerPrompt     = "Into which container of task %s would you like to execute?"
+	svcExecContainerHelpPrompt = `Copilot runs your command in the selected container.
+The first essential container is named after your service.`
 
 	ssmPluginInstallPrompt = `Looks like the Session Manager plugin is not installed yet.
 Would you like to install the plugin to execute into the container?`
@@ -132,11 +135,18 @@ func (o *svcExecOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("describe ECS service for %s in environment %s: %w", o.name, o.envName, err)
 	}
-	taskID, err := o.selectTask(awsecs.FilterRunningTasks(svcDesc.Tasks))
+	task, err := o.selectTask(awsecs.FilterRunningTasks(svcDesc.Tasks))
+	if err != nil {
+		return err
+	}
+	taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+	if err != nil {
+		return err
+	}
+	container, err := o.selectContainer(task, taskID)
 	if err != nil {
 		return err
 	}
-	container := o.selectContainer()
 	log.Infof("Execute %s in container %s in task %s.\n", color.HighlightCode(o.command),
 		color.HighlightUserInput(container), color.HighlightResource(taskID))
 	if err = o.newCommandExecutor(sess).ExecuteCommand(awsecs.ExecuteCommandInput{
@@ -184,35 +194,48 @@ func (o *svcExecOpts) envSession() (*session.Session, error) {
 	return sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
 }
 
-func (o *svcExecOpts) selectTask(tasks []*awsecs.Task) (string, error) {
+func (o *svcExecOpts) selectTask(tasks []*awsecs.Task) (*awsecs.Task, error) {
 	if len(tasks) == 0 {
-		return "", fmt.Errorf("found no running task for service %s in environment %s", o.name, o.envName)
+		return nil, fmt.Errorf("found no running task for service %s in environment %s", o.name, o.envName)
 	}
 	if o.taskID != "" {
 		for _, task := range tasks {
 			taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
 			if err != nil {
-				return "", err
+				return nil, err
 			}
 			if strings.HasPrefix(taskID, o.taskID) {
-				return taskID, nil
+				return task, nil
 			}
 		}
-		return "", fmt.Errorf("found no running task whose ID is prefixed with %s", o.taskID)
+		return nil, fmt.Errorf("found no running task whose ID is prefixed with %s", o.taskID)
 	}
-	taskID, err := awsecs.TaskID(aws.StringValue(tasks[o.randInt(len(tasks))].TaskArn))
-	if err != nil {
-		return "", err
-	}
-	return taskID, nil
+	return tasks[o.randInt(len(tasks))], nil
 }
 
-func (o *svcExecOpts) selectContainer() string {
+// selectContainer returns the container to execute into. If the task runs more than one container and
+// none was passed in with a flag, the user is prompted to choose one.
+func (o *svcExecOpts) selectContainer(task *awsecs.Task, taskID string) (string, error) {
 	if o.containerName != "" {
-		return o.containerName
+		return o.containerName, nil
+	}
+	// The first essential container is named with the workload name, so it's listed first.
+	containers := []string{o.name}
+	for _, container := range task.Containers {
+		name := aws.StringValue(container.Name)
+		if name == "" || name == o.name || aws.StringValue(container.LastStatus) != awsecs.TaskStatusRunning {
+			continue
+		}
+		containers = append(containers, name)
+	}
+	if len(containers) == 1 {
+		return o.name, nil
+	}
+	container, err := o.prompter.SelectOne(fmt.Sprintf(svcExecContainerPrompt, color.HighlightResource(taskID)), svcExecContainerHelpPrompt, containers)
+	if err != nil {
+		return "", fmt.Errorf("select container for task %s: %w", taskID, err)
 	}
-	// The first essential container is named with the workload name.
-	return o.name
+	return container, nil
 }
 
 func validateSSMBinary(prompt prompter, manager ssmPluginManager, skipConfirmation *bool) error {
@@ -307,5 +330,6 @@ func buildSvcExecCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
 
 	cmd.SetUsageTemplate(template.Usage)
+	markPromptedFlags(cmd, appFlag, nameFlag, envFlag)
 	return cmd
 }
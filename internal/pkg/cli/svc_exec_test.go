@@ -10,6 +10,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -457,6 +458,20 @@ func TestSvcExec_Execute(t *testing.T) {
 		Name: "mockSvc",
 		Type: "Request-Driven Web Service",
 	}
+	mockContainers := []*sdkecs.Container{
+		{
+			Name:       aws.String("mockSvc"),
+			LastStatus: aws.String("RUNNING"),
+		},
+		{
+			Name:       aws.String("nginx"),
+			LastStatus: aws.String("RUNNING"),
+		},
+		{
+			Name:       aws.String("firelens_log_router"),
+			LastStatus: aws.String("STOPPED"),
+		},
+	}
 	mockError := errors.New("some error")
 	testCases := map[string]struct {
 		containerName string
@@ -563,6 +578,55 @@ func TestSvcExec_Execute(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("execute command mockCommand in container hello: some error"),
 		},
+		"return error if fail to select a container": {
+			setupMocks: func(m execSvcMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil),
+					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
+						Name: "my-env",
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+						ClusterName: "mockCluster",
+						Tasks: []*awsecs.Task{
+							{
+								TaskArn:    aws.String(mockTaskARN),
+								LastStatus: aws.String("RUNNING"),
+								Containers: mockContainers,
+							},
+						},
+					}, nil),
+					m.prompter.EXPECT().SelectOne(gomock.Any(), svcExecContainerHelpPrompt, []string{"mockSvc", "nginx"}).Return("", mockError),
+				)
+			},
+			wantedError: fmt.Errorf("select container for task mockTaskID: some error"),
+		},
+		"prompt for the container if the task runs more than one": {
+			setupMocks: func(m execSvcMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil),
+					m.storeSvc.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{
+						Name: "my-env",
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+						ClusterName: "mockCluster",
+						Tasks: []*awsecs.Task{
+							{
+								TaskArn:    aws.String(mockTaskARN),
+								LastStatus: aws.String("RUNNING"),
+								Containers: mockContainers,
+							},
+						},
+					}, nil),
+					m.prompter.EXPECT().SelectOne(gomock.Any(), svcExecContainerHelpPrompt, []string{"mockSvc", "nginx"}).Return("nginx", nil),
+					m.ecsCommandExecutor.EXPECT().ExecuteCommand(awsecs.ExecuteCommandInput{
+						Cluster:   "mockCluster",
+						Container: "nginx",
+						Task:      "mockTaskID",
+						Command:   "mockCommand",
+					}).Return(nil),
+				)
+			},
+		},
 		"success": {
 			setupMocks: func(m execSvcMocks) {
 				gomock.InOrder(
@@ -602,6 +666,7 @@ func TestSvcExec_Execute(t *testing.T) {
 			mockStoreReader := mocks.NewMockstore(ctrl)
 			mockSvcDescriber := mocks.NewMockserviceDescriber(ctrl)
 			mockCommandExecutor := mocks.NewMockecsCommandExecutor(ctrl)
+			mockPrompter := mocks.NewMockprompter(ctrl)
 			mockNewSvcDescriber := func(_ *session.Session) serviceDescriber {
 				return mockSvcDescriber
 			}
@@ -613,6 +678,7 @@ func TestSvcExec_Execute(t *testing.T) {
 				storeSvc:           mockStoreReader,
 				ecsCommandExecutor: mockCommandExecutor,
 				ecsSvcDescriber:    mockSvcDescriber,
+				prompter:           mockPrompter,
 			}
 
 			tc.setupMocks(mocks)
@@ -629,6 +695,7 @@ func TestSvcExec_Execute(t *testing.T) {
 				store:              mockStoreReader,
 				newSvcDescriber:    mockNewSvcDescriber,
 				newCommandExecutor: mockNewCommandExecutor,
+				prompter:           mockPrompter,
 				randInt:            func(i int) int { return 0 },
 			}
 
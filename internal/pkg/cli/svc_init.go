@@ -6,12 +6,19 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
+	"path/filepath"
 	"runtime"
+	"sort"
 	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
-	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/initialize"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
@@ -35,6 +42,21 @@ Other repositories are specified with either repository-url/image:tag or reposit
 const (
 	defaultSvcPortString = "80"
 	service              = "service"
+
+	fluentbitLogRouter = "fluentbit"
+
+	efsStorage          = "efs"
+	efsVolumeName       = "data"     // Name of the volume under "storage.volumes" in the manifest.
+	defaultEFSMountPath = "/var/efs" // Default path where the EFS volume is mounted in the container.
+
+	defaultCountRange               = "1-10" // Default range of tasks of an autoscaling service.
+	defaultAutoscalingCPUPercentage = 70     // Default average CPU utilization targeted by the autoscaling policy.
+
+	// Bounds of the load balancer's target group health check.
+	minHealthyThreshold    = 2
+	maxHealthyThreshold    = 10
+	minHealthCheckInterval = 5 * time.Second
+	maxHealthCheckInterval = 300 * time.Second
 )
 
 var (
@@ -60,6 +82,46 @@ Deployed resources (such as your ECR repository, logs) will contain this %[1]s's
 	svcInitSvcPortPrompt     = "Which %s do you want customer traffic sent to?"
 	svcInitSvcPortHelpPrompt = `The port will be used by the load balancer to route incoming traffic to this service.
 You should set this to the port which your Dockerfile uses to communicate with the internet.`
+	fmtSvcInitNoExposedPortsNote = `No EXPOSE instruction found in %s.
+Make sure the port you enter matches the port your application listens on.
+`
+	svcInitAdditionalPortsPrompt     = "Which other exposed %s should your container publish?"
+	svcInitAdditionalPortsHelpPrompt = `Your Dockerfile exposes more ports than the one receiving traffic from the load balancer, for example a metrics port.
+The selected ports are published by your container but don't receive any traffic from the load balancer.`
+
+	svcInitLogConfigFilePrompt     = "What is the path to the " + color.Emphasize("Fluent Bit configuration file") + " in your log router image?"
+	svcInitLogConfigFileHelpPrompt = `The full path to a custom Fluent Bit configuration file in the log router's image.
+Leave it empty to route your logs to a destination configured in your manifest instead.`
+
+	svcInitMountPathPrompt     = "Where do you want to mount the " + color.Emphasize("EFS volume") + " in your container?"
+	svcInitMountPathHelpPrompt = `The absolute path in your container of a directory in the EFS filesystem managed by your environment.
+Files written under this path persist across deployments and are shared by all the tasks of your service.`
+
+	svcInitCountRangePrompt     = "What is the " + color.Emphasize("range of tasks") + " that your service can scale between?"
+	svcInitCountRangeHelpPrompt = `The minimum and maximum number of tasks of your service, of the format "${min}-${max}".
+Tasks are added or removed to keep the average CPU utilization of your service around 70%.`
+
+	fmtSvcInitDockerignorePrompt  = "No " + color.Emphasize(".dockerignore") + " file found next to %s. Would you like to generate one?"
+	svcInitDockerignoreHelpPrompt = `A .dockerignore file excludes files such as .git and node_modules from the context sent to Docker,
+which keeps your builds fast and your images small.`
+)
+
+const (
+	dockerignoreFileName = ".dockerignore"
+
+	// defaultDockerignore is the content of a generated .dockerignore file.
+	defaultDockerignore = `.git
+.gitignore
+.dockerignore
+copilot
+node_modules
+npm-debug.log
+__pycache__
+*.pyc
+.venv
+.env
+.DS_Store
+`
 )
 
 var serviceTypeHints = map[string]string{
@@ -68,18 +130,65 @@ var serviceTypeHints = map[string]string{
 	manifest.BackendServiceType:          "ECS on Fargate",
 }
 
+// svcTypeDescription compares a service type against the others.
+type svcTypeDescription struct {
+	useCases   string
+	networking string
+	cost       string
+}
+
+var svcTypeDescriptions = map[string]svcTypeDescription{
+	manifest.RequestDrivenWebServiceType: {
+		useCases:   "Web apps and APIs with spiky or low traffic",
+		networking: "Public HTTPS endpoint managed by App Runner",
+		cost:       "Memory while idle, CPU per request",
+	},
+	manifest.LoadBalancedWebServiceType: {
+		useCases:   "Web apps and APIs with steady traffic",
+		networking: "Public load balancer to tasks in a VPC",
+		cost:       "Running tasks and load balancer",
+	},
+	manifest.BackendServiceType: {
+		useCases:   "APIs called by your other services",
+		networking: "Private, via service discovery",
+		cost:       "Running tasks",
+	},
+}
+
 type initWkldVars struct {
-	appName        string
-	wkldType       string
-	name           string
-	dockerfilePath string
-	image          string
+	appName         string
+	wkldType        string
+	name            string
+	dockerfilePath  string
+	image           string
+	dockerfileDepth int // Number of directory levels searched for Dockerfiles to choose from.
 }
 
 type initSvcVars struct {
 	initWkldVars
 
-	port uint16
+	port          uint16
+	logRouter     string
+	logConfigFile string // Path of the custom FireLens configuration file in the log router image.
+	storage       string // Type of the volume to mount in the main container, must be "efs".
+	autoscaling   bool   // True if the number of tasks should scale with target-tracking policies.
+	addonsDir     string // Directory of addon templates to copy under the service's "addons/" directory.
+	envFile       string // Dotenv file of environment variables to write in the manifest.
+	edit          bool
+	typeHelp      bool
+
+	// Load balancer health check of a Load Balanced Web Service.
+	healthCheckPath             string
+	healthCheckHealthyThreshold int64
+	healthCheckInterval         time.Duration
+}
+
+// addonTemplate is the content of an addon template file.
+type addonTemplate []byte
+
+// MarshalBinary returns the content of the addon template as is.
+func (t addonTemplate) MarshalBinary() ([]byte, error) {
+	return t, nil
 }
 
 type initSvcOpts struct {
@@ -91,11 +200,22 @@ type initSvcOpts struct {
 	prompt       prompter
 	dockerEngine dockerEngine
 	sel          dockerfileSelector
+	ws           wsAddonManager
+
+	// Absolute path to the root of the workspace. Relative Dockerfile paths are resolved from it.
+	// If empty, they are resolved from the current directory where the workspace will be created.
+	wsRoot string
 
 	// Outputs stored on successful actions.
-	manifestPath string
-	os           string
-	arch         string
+	manifestPath      string
+	os                string
+	arch              string
+	mountPath         string                   // Path of the EFS volume in the main container.
+	countRange        string                   // Range of tasks of an autoscaling service, such as "1-10".
+	additionalPorts   []uint16                 // Exposed ports of the main container that don't receive traffic from the load balancer.
+	writeDockerignore bool                     // True if a default .dockerignore should be written next to the Dockerfile.
+	addonTemplates    map[string]addonTemplate // Validated addon templates under addonsDir keyed by file name.
+	variables         map[string]string        // Environment variables read from envFile.
 
 	// Cache variables
 	df dockerfileParser
@@ -120,14 +240,21 @@ func newInitSvcOpts(vars initSvcVars) (*initSvcOpts, error) {
 	if err != nil {
 		return nil, err
 	}
+	deployer, err := newAppCloudFormation(sess)
+	if err != nil {
+		return nil, err
+	}
 	prompter := prompt.New()
-	sel := selector.NewWorkspaceSelect(prompter, store, ws)
+	sel := selector.NewWorkspaceSelect(prompter, store, ws, selector.WithDockerfileSearchDepth(vars.dockerfileDepth))
 
 	initSvc := &initialize.WorkloadInitializer{
 		Store:    store,
 		Ws:       ws,
 		Prog:     termprogress.NewSpinner(log.DiagnosticWriter),
-		Deployer: cloudformation.New(sess),
+		Deployer: deployer,
+	}
+	if vars.edit {
+		initSvc.Editor = exec.NewEditor()
 	}
 	fs := &afero.Afero{Fs: afero.NewOsFs()}
 	opts := &initSvcOpts{
@@ -138,12 +265,14 @@ func newInitSvcOpts(vars initSvcVars) (*initSvcOpts, error) {
 		prompt:       prompter,
 		sel:          sel,
 		dockerEngine: exec.NewDockerCommand(),
+		ws:           ws,
+		wsRoot:       workspaceRoot(ws),
 	}
 	opts.dockerfile = func(path string) dockerfileParser {
 		if opts.df != nil {
 			return opts.df
 		}
-		opts.df = exec.NewDockerfile(opts.fs, opts.dockerfilePath)
+		opts.df = exec.NewDockerfile(opts.fs, resolveDockerfilePath(opts.wsRoot, opts.dockerfilePath))
 		return opts.df
 	}
 	return opts, nil
@@ -168,10 +297,13 @@ func (o *initSvcOpts) Validate() error {
 		return fmt.Errorf("--%s and --%s cannot be specified together", dockerFileFlag, imageFlag)
 	}
 	if o.dockerfilePath != "" {
-		if _, err := o.fs.Stat(o.dockerfilePath); err != nil {
+		if _, err := o.fs.Stat(resolveDockerfilePath(o.wsRoot, o.dockerfilePath)); err != nil {
 			return err
 		}
 	}
+	if err := validateDockerfileDepth(o.dockerfileDepth); err != nil {
+		return err
+	}
 	if o.port != 0 {
 		if err := validateSvcPort(o.port); err != nil {
 			return err
@@ -182,6 +314,142 @@ func (o *initSvcOpts) Validate() error {
 			return err
 		}
 	}
+	if o.logRouter != "" {
+		if err := validateLogRouter(o.logRouter, o.wkldType); err != nil {
+			return err
+		}
+	}
+	if o.logConfigFile != "" && o.logRouter == "" {
+		return fmt.Errorf("--%s cannot be specified without --%s", logConfigFileFlag, logRouterFlag)
+	}
+	if o.storage != "" {
+		if err := validateSvcStorage(o.storage, o.wkldType); err != nil {
+			return err
+		}
+	}
+	if o.autoscaling {
+		if err := validateAutoscaling(o.wkldType); err != nil {
+			return err
+		}
+	}
+	if err := o.validateHTTPHealthCheckFlags(); err != nil {
+		return err
+	}
+	if o.addonsDir != "" {
+		templates, err := o.readAddonTemplates()
+		if err != nil {
+			return err
+		}
+		o.addonTemplates = templates
+	}
+	if o.envFile != "" {
+		content, err := afero.ReadFile(o.fs, o.envFile)
+		if err != nil {
+			return fmt.Errorf("read env file %s: %w", o.envFile, err)
+		}
+		variables, err := parseEnvFile(content)
+		if err != nil {
+			return fmt.Errorf("parse env file %s: %w", o.envFile, err)
+		}
+		o.variables = variables
+	}
+	return nil
+}
+
+// readAddonTemplates returns the YAML files under the addons directory
+// after validating that each one is a CloudFormation template.
+func (o *initSvcOpts) readAddonTemplates() (map[string]addonTemplate, error) {
+	files, err := afero.ReadDir(o.fs, o.addonsDir)
+	if err != nil {
+		return nil, fmt.Errorf("read addons directory %s: %w", o.addonsDir, err)
+	}
+	templates := make(map[string]addonTemplate)
+	for _, f := range files {
+		if f.IsDir() || !isYAMLFile(f.Name()) {
+			continue
+		}
+		path := filepath.Join(o.addonsDir, f.Name())
+		content, err := afero.ReadFile(o.fs, path)
+		if err != nil {
+			return nil, fmt.Errorf("read addon %s: %w", path, err)
+		}
+		if err := addon.ValidateTemplate(f.Name(), content); err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", addonsDirFlag, err)
+		}
+		templates[f.Name()] = content
+	}
+	if len(templates) == 0 {
+		return nil, fmt.Errorf("no YAML addon templates found in %s", o.addonsDir)
+	}
+	return templates, nil
+}
+
+// parseEnvFile returns the environment variables of a dotenv file.
+// Each line is a KEY=VALUE pair, blank lines and lines starting with "#" are skipped.
+// Values can contain "=" and can be wrapped in single or double quotes.
+func parseEnvFile(content []byte) (map[string]string, error) {
+	variables := make(map[string]string)
+	lineOf := make(map[string]int)
+	for i, line := range strings.Split(string(content), "\n") {
+		lineNum := i + 1
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("line %d: %q is not in the KEY=VALUE format", lineNum, line)
+		}
+		key, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		if !envVarNameRegexp.MatchString(key) {
+			return nil, fmt.Errorf("line %d: %q is not a valid environment variable name", lineNum, key)
+		}
+		if prev, ok := lineOf[key]; ok {
+			return nil, fmt.Errorf("duplicate key %s on lines %d and %d", key, prev, lineNum)
+		}
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		variables[key] = value
+		lineOf[key] = lineNum
+	}
+	return variables, nil
+}
+
+// validateDockerfileDepth returns an error if the number of directory levels to search for Dockerfiles is out of bounds.
+func validateDockerfileDepth(depth int) error {
+	if depth < 0 || depth > workspace.MaxDockerfileSearchDepth {
+		return fmt.Errorf("invalid --%s %d: must be between 0 and %d", dockerfileDepthFlag, depth, workspace.MaxDockerfileSearchDepth)
+	}
+	return nil
+}
+
+// validateHTTPHealthCheckFlags returns an error if the flags of the load balancer health check are invalid.
+func (o *initSvcOpts) validateHTTPHealthCheckFlags() error {
+	flags := []struct {
+		name  string
+		isSet bool
+	}{
+		{name: healthCheckPathFlag, isSet: o.healthCheckPath != ""},
+		{name: healthCheckHealthyThresholdFlag, isSet: o.healthCheckHealthyThreshold != 0},
+		{name: healthCheckIntervalFlag, isSet: o.healthCheckInterval != 0},
+	}
+	if o.wkldType != "" && o.wkldType != manifest.LoadBalancedWebServiceType {
+		for _, flag := range flags {
+			if flag.isSet {
+				return fmt.Errorf("--%s is only supported for %s", flag.name, manifest.LoadBalancedWebServiceType)
+			}
+		}
+	}
+	if o.healthCheckPath != "" && !strings.HasPrefix(o.healthCheckPath, "/") {
+		return fmt.Errorf("invalid --%s %s: must start with /", healthCheckPathFlag, o.healthCheckPath)
+	}
+	if o.healthCheckHealthyThreshold != 0 && (o.healthCheckHealthyThreshold < minHealthyThreshold || o.healthCheckHealthyThreshold > maxHealthyThreshold) {
+		return fmt.Errorf("invalid --%s %d: must be between %d and %d", healthCheckHealthyThresholdFlag, o.healthCheckHealthyThreshold, minHealthyThreshold, maxHealthyThreshold)
+	}
+	if o.healthCheckInterval != 0 && (o.healthCheckInterval < minHealthCheckInterval || o.healthCheckInterval > maxHealthCheckInterval) {
+		return fmt.Errorf("invalid --%s %s: must be between %s and %s", healthCheckIntervalFlag, o.healthCheckInterval, minHealthCheckInterval, maxHealthCheckInterval)
+	}
 	return nil
 }
 
@@ -190,6 +458,10 @@ func (o *initSvcOpts) Ask() error {
 	if err := o.askSvcType(); err != nil {
 		return err
 	}
+	// The service type might have been selected after the flags were validated.
+	if err := o.validateHTTPHealthCheckFlags(); err != nil {
+		return err
+	}
 	if err := o.askSvcName(); err != nil {
 		return err
 	}
@@ -208,6 +480,22 @@ func (o *initSvcOpts) Ask() error {
 		return err
 	}
 
+	if err := o.askLogConfigFile(); err != nil {
+		return err
+	}
+
+	if err := o.askMountPath(); err != nil {
+		return err
+	}
+
+	if err := o.askCountRange(); err != nil {
+		return err
+	}
+
+	if err := o.askDockerignore(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -228,26 +516,38 @@ func (o *initSvcOpts) Execute() error {
 		return err
 	}
 
+	if o.writeDockerignore {
+		if err := o.writeDefaultDockerignore(); err != nil {
+			return err
+		}
+	}
+
 	manifestPath, err := o.init.Service(&initialize.ServiceProps{
 		WorkloadProps: initialize.WorkloadProps{
 			App:            o.appName,
 			Name:           o.name,
 			Type:           o.wkldType,
-			DockerfilePath: o.dockerfilePath,
+			DockerfilePath: resolveDockerfilePath(o.wsRoot, o.dockerfilePath),
 			Image:          o.image,
 			Platform: &manifest.PlatformConfig{
 				OS:   o.os,
 				Arch: o.arch,
 			},
+			Variables: o.variables,
 		},
-		Port:        o.port,
-		HealthCheck: hc,
+		Port:            o.port,
+		AdditionalPorts: o.additionalPorts,
+		HealthCheck:     hc,
+		Logging:         o.logging(),
+		Storage:         o.volumes(),
+		Count:           o.count(),
+		HTTPHealthCheck: o.httpHealthCheck(),
 	})
 	if err != nil {
 		return err
 	}
 	o.manifestPath = manifestPath
-	return nil
+	return o.writeAddonTemplates()
 }
 
 // RecommendedActions returns follow-up actions the user can take after successfully executing the command.
@@ -357,7 +657,10 @@ func (o *initSvcOpts) askDockerfile() (isDfSelected bool, err error) {
 	if df == selector.DockerfilePromptUseImage {
 		return false, nil
 	}
-	o.dockerfilePath = df
+	o.dockerfilePath, err = dockerfilePathFromRoot(o.wsRoot, df)
+	if err != nil {
+		return false, err
+	}
 	return true, nil
 }
 
@@ -378,10 +681,13 @@ func (o *initSvcOpts) askSvcPort() (err error) {
 	}
 
 	defaultPort := defaultSvcPortString
+	var noExposedPorts bool
 	if o.dockerfilePath != "" {
 		switch len(ports) {
 		case 0:
 			// There were no ports detected, keep the default port prompt.
+			// Only explain why if the Dockerfile was parsed successfully.
+			noExposedPorts = err == nil
 		case 1:
 			o.port = ports[0]
 			return nil
@@ -393,6 +699,9 @@ func (o *initSvcOpts) askSvcPort() (err error) {
 	if o.wkldType == manifest.BackendServiceType {
 		return nil
 	}
+	if noExposedPorts {
+		log.Infof(fmtSvcInitNoExposedPortsNote, color.HighlightUserInput(o.dockerfilePath))
+	}
 
 	port, err := o.prompt.Get(
 		fmt.Sprintf(svcInitSvcPortPrompt, color.Emphasize("port")),
@@ -412,6 +721,285 @@ func (o *initSvcOpts) askSvcPort() (err error) {
 
 	o.port = uint16(portUint)
 
+	return o.askAdditionalPorts(ports)
+}
+
+// askAdditionalPorts asks which of the other ports exposed in the Dockerfile should be published by
+// the container of a Load Balanced Web Service, when the Dockerfile exposes more than one port.
+func (o *initSvcOpts) askAdditionalPorts(exposedPorts []uint16) error {
+	if o.wkldType != manifest.LoadBalancedWebServiceType {
+		return nil
+	}
+	var options []string
+	seen := map[uint16]bool{o.port: true}
+	for _, port := range exposedPorts {
+		if seen[port] {
+			continue
+		}
+		seen[port] = true
+		options = append(options, strconv.Itoa(int(port)))
+	}
+	if len(options) == 0 {
+		return nil
+	}
+	selected, err := o.prompt.MultiSelect(
+		fmt.Sprintf(svcInitAdditionalPortsPrompt, color.Emphasize("ports")),
+		svcInitAdditionalPortsHelpPrompt,
+		options,
+		prompt.WithFinalMessage("Additional ports:"),
+	)
+	if err != nil {
+		return fmt.Errorf("select additional ports: %w", err)
+	}
+	for _, port := range selected {
+		portUint, err := strconv.ParseUint(port, 10, 16)
+		if err != nil {
+			return fmt.Errorf("parse port string: %w", err)
+		}
+		o.additionalPorts = append(o.additionalPorts, uint16(portUint))
+	}
+	return nil
+}
+
+func (o *initSvcOpts) askLogConfigFile() error {
+	if o.logRouter == "" {
+		return nil
+	}
+	// The service type might have been selected after the flags were validated.
+	if err := validateLogRouter(o.logRouter, o.wkldType); err != nil {
+		return err
+	}
+	if o.logConfigFile != "" {
+		return nil
+	}
+	configFile, err := o.prompt.Get(
+		svcInitLogConfigFilePrompt,
+		svcInitLogConfigFileHelpPrompt,
+		nil,
+		prompt.WithFinalMessage("Log config file:"),
+	)
+	if err != nil {
+		return fmt.Errorf("get log configuration file path: %w", err)
+	}
+	o.logConfigFile = configFile
+	return nil
+}
+
+func (o *initSvcOpts) askMountPath() error {
+	if o.storage == "" {
+		return nil
+	}
+	// The service type might have been selected after the flags were validated.
+	if err := validateSvcStorage(o.storage, o.wkldType); err != nil {
+		return err
+	}
+	path, err := o.prompt.Get(
+		svcInitMountPathPrompt,
+		svcInitMountPathHelpPrompt,
+		validateContainerPath,
+		prompt.WithDefaultInput(defaultEFSMountPath),
+		prompt.WithFinalMessage("Mount path:"),
+	)
+	if err != nil {
+		return fmt.Errorf("get mount path of EFS volume: %w", err)
+	}
+	o.mountPath = path
+	return nil
+}
+
+// askCountRange prompts for the range of tasks to autoscale between if autoscaling is enabled.
+func (o *initSvcOpts) askCountRange() error {
+	if !o.autoscaling {
+		return nil
+	}
+	// The service type might have been selected after the flags were validated.
+	if err := validateAutoscaling(o.wkldType); err != nil {
+		return err
+	}
+	countRange, err := o.prompt.Get(
+		svcInitCountRangePrompt,
+		svcInitCountRangeHelpPrompt,
+		validateCountRange,
+		prompt.WithDefaultInput(defaultCountRange),
+		prompt.WithFinalMessage("Range of tasks:"),
+	)
+	if err != nil {
+		return fmt.Errorf("get range of tasks: %w", err)
+	}
+	o.countRange = countRange
+	return nil
+}
+
+// askDockerignore offers to generate a .dockerignore file if the Dockerfile doesn't have one next to it.
+func (o *initSvcOpts) askDockerignore() error {
+	if o.dockerfilePath == "" {
+		return nil
+	}
+	dfPath := resolveDockerfilePath(o.wsRoot, o.dockerfilePath)
+	if exists, _ := afero.Exists(o.fs, dfPath); !exists {
+		return nil
+	}
+	exists, err := afero.Exists(o.fs, filepath.Join(filepath.Dir(dfPath), dockerignoreFileName))
+	if err != nil {
+		return fmt.Errorf("check if %s exists next to %s: %w", dockerignoreFileName, o.dockerfilePath, err)
+	}
+	if exists {
+		return nil
+	}
+	ok, err := o.prompt.Confirm(
+		fmt.Sprintf(fmtSvcInitDockerignorePrompt, color.HighlightUserInput(o.dockerfilePath)),
+		svcInitDockerignoreHelpPrompt,
+		prompt.WithFinalMessage("Generate .dockerignore:"),
+	)
+	if err != nil {
+		var errNoPrompt *prompt.ErrNoPrompt
+		if errors.As(err, &errNoPrompt) {
+			// Generating a .dockerignore file is optional, so don't require it when prompts are disabled.
+			return nil
+		}
+		return fmt.Errorf("confirm generating %s: %w", dockerignoreFileName, err)
+	}
+	o.writeDockerignore = ok
+	return nil
+}
+
+// writeDefaultDockerignore writes a default .dockerignore file next to the Dockerfile.
+func (o *initSvcOpts) writeDefaultDockerignore() error {
+	path := filepath.Join(filepath.Dir(resolveDockerfilePath(o.wsRoot, o.dockerfilePath)), dockerignoreFileName)
+	if err := afero.WriteFile(o.fs, path, []byte(defaultDockerignore), 0644); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	log.Successf("Wrote a %s file at %s\n", dockerignoreFileName, color.HighlightResource(path))
+	return nil
+}
+
+// writeAddonTemplates copies the addon templates under the "addons/" directory of the service.
+func (o *initSvcOpts) writeAddonTemplates() error {
+	fnames := make([]string, 0, len(o.addonTemplates))
+	for fname := range o.addonTemplates {
+		fnames = append(fnames, fname)
+	}
+	sort.Strings(fnames)
+	for _, fname := range fnames {
+		name := strings.TrimSuffix(fname, filepath.Ext(fname))
+		path, err := o.ws.WriteAddon(o.addonTemplates[fname], o.name, name)
+		if err != nil {
+			var errFileExists *workspace.ErrFileExists
+			if errors.As(err, &errFileExists) {
+				return fmt.Errorf("addon already exists: %w", err)
+			}
+			return fmt.Errorf("write addon %s: %w", fname, err)
+		}
+		path, err = relPath(path)
+		if err != nil {
+			return err
+		}
+		log.Successf("Wrote the addon template %s at %s\n", color.HighlightUserInput(fname), color.HighlightResource(path))
+	}
+	return nil
+}
+
+// isYAMLFile returns true if the file name has a YAML extension.
+func isYAMLFile(fname string) bool {
+	ext := filepath.Ext(fname)
+	return ext == ".yml" || ext == ".yaml"
+}
+
+// logging returns the FireLens configuration for the manifest, or nil if the service doesn't need a log router.
+func (o *initSvcOpts) logging() *manifest.Logging {
+	if o.logRouter == "" {
+		return nil
+	}
+	logging := &manifest.Logging{}
+	if o.logConfigFile != "" {
+		logging.ConfigFile = aws.String(o.logConfigFile)
+	}
+	return logging
+}
+
+// volumes returns the storage configuration for the manifest, or nil if the service doesn't mount a volume.
+func (o *initSvcOpts) volumes() *manifest.Storage {
+	if o.storage == "" {
+		return nil
+	}
+	return &manifest.Storage{
+		Volumes: map[string]manifest.Volume{
+			efsVolumeName: {
+				EFS: &manifest.EFSConfigOrBool{
+					Enabled: aws.Bool(true),
+				},
+				MountPointOpts: manifest.MountPointOpts{
+					ContainerPath: aws.String(o.mountPath),
+					ReadOnly:      aws.Bool(false),
+				},
+			},
+		},
+	}
+}
+
+// count returns the autoscaling configuration of the service, or nil to keep the default count.
+func (o *initSvcOpts) count() *manifest.Count {
+	if o.countRange == "" {
+		return nil
+	}
+	countRange := manifest.IntRangeBand(o.countRange)
+	return &manifest.Count{
+		AdvancedCount: manifest.AdvancedCount{
+			Range: &manifest.Range{
+				Value: &countRange,
+			},
+			CPU: aws.Int(defaultAutoscalingCPUPercentage),
+		},
+	}
+}
+
+// httpHealthCheck returns the load balancer health check of the service, or nil if none of its flags are set.
+// The path defaults to "/" when only the other flags are set.
+func (o *initSvcOpts) httpHealthCheck() *manifest.HTTPHealthCheckArgs {
+	if o.healthCheckPath == "" && o.healthCheckHealthyThreshold == 0 && o.healthCheckInterval == 0 {
+		return nil
+	}
+	hc := &manifest.HTTPHealthCheckArgs{
+		Path: aws.String(manifest.DefaultHealthCheckPath),
+	}
+	if o.healthCheckPath != "" {
+		hc.Path = aws.String(o.healthCheckPath)
+	}
+	if o.healthCheckHealthyThreshold != 0 {
+		hc.HealthyThreshold = aws.Int64(o.healthCheckHealthyThreshold)
+	}
+	if o.healthCheckInterval != 0 {
+		interval := o.healthCheckInterval
+		hc.Interval = &interval
+	}
+	return hc
+}
+
+// validateAutoscaling returns an error if the service type doesn't support configuring autoscaling at init time.
+func validateAutoscaling(svcType string) error {
+	if svcType != "" && svcType != manifest.LoadBalancedWebServiceType {
+		return fmt.Errorf("--%s is not supported for %s", autoscalingFlag, svcType)
+	}
+	return nil
+}
+
+func validateSvcStorage(storage, svcType string) error {
+	if storage != efsStorage {
+		return fmt.Errorf("invalid --%s %s: must be %s", svcStorageFlag, storage, efsStorage)
+	}
+	if svcType != "" && svcType != manifest.LoadBalancedWebServiceType && svcType != manifest.BackendServiceType {
+		return fmt.Errorf("--%s is not supported for %s", svcStorageFlag, svcType)
+	}
+	return nil
+}
+
+func validateLogRouter(router, svcType string) error {
+	if router != fluentbitLogRouter {
+		return fmt.Errorf("invalid --%s %s: must be %s", logRouterFlag, router, fluentbitLogRouter)
+	}
+	if svcType == manifest.RequestDrivenWebServiceType {
+		return fmt.Errorf("--%s is not supported for %s", logRouterFlag, manifest.RequestDrivenWebServiceType)
+	}
 	return nil
 }
 
@@ -432,6 +1020,40 @@ func parseHealthCheck(df dockerfileParser) (*manifest.ContainerHealthCheck, erro
 	}, nil
 }
 
+// workspaceRoot returns the absolute path to the root of the workspace, or an empty string if there is no workspace yet.
+func workspaceRoot(ws copilotDirGetter) string {
+	copilotDir, err := ws.CopilotDirPath()
+	if err != nil {
+		return ""
+	}
+	return filepath.Dir(copilotDir)
+}
+
+// resolveDockerfilePath returns the path to a Dockerfile given relative to the workspace root.
+func resolveDockerfilePath(wsRoot, path string) string {
+	if wsRoot == "" || path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(wsRoot, path)
+}
+
+// dockerfilePathFromRoot converts the path to a Dockerfile given relative to the current directory,
+// such as a selected Dockerfile, into a path relative to the workspace root.
+func dockerfilePathFromRoot(wsRoot, path string) (string, error) {
+	if wsRoot == "" || filepath.IsAbs(path) {
+		return path, nil
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", fmt.Errorf("get absolute path of %s: %w", path, err)
+	}
+	relPath, err := filepath.Rel(wsRoot, absPath)
+	if err != nil {
+		return "", fmt.Errorf("get path of %s relative to workspace root: %w", path, err)
+	}
+	return relPath, nil
+}
+
 func dockerPlatform(engine dockerEngine, image string) (os, arch string, err error) {
 	os, arch = runtime.GOOS, runtime.GOARCH
 	if image == "" {
@@ -447,6 +1069,23 @@ func dockerPlatform(engine dockerEngine, image string) (os, arch string, err err
 	return os, arch, nil
 }
 
+// writeSvcTypeHelp writes a table comparing the use cases, networking, and cost of each service type.
+func writeSvcTypeHelp(w io.Writer) error {
+	writer := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	headers := []string{"Type", "Use cases", "Networking", "Cost"}
+	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
+	var underlines []string
+	for _, header := range headers {
+		underlines = append(underlines, strings.Repeat("-", len(header)))
+	}
+	fmt.Fprintf(writer, "%s\n", strings.Join(underlines, "\t"))
+	for _, svcType := range manifest.ServiceTypes {
+		desc := svcTypeDescriptions[svcType]
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", svcType, desc.useCases, desc.networking, desc.cost)
+	}
+	return writer.Flush()
+}
+
 func svcTypePromptOpts() []prompt.Option {
 	var options []prompt.Option
 	for _, svcType := range manifest.ServiceTypes {
@@ -471,8 +1110,20 @@ This command is also run as part of "copilot init".`,
   /code $ copilot svc init --name frontend --svc-type "Load Balanced Web Service" --dockerfile ./frontend/Dockerfile
 
   Create a "subscribers" backend service.
-  /code $ copilot svc init --name subscribers --svc-type "Backend Service"`,
+  /code $ copilot svc init --name subscribers --svc-type "Backend Service"
+
+  Create an "api" load balanced web service that routes its logs through a Fluent Bit sidecar.
+  /code $ copilot svc init --name api --svc-type "Load Balanced Web Service" --dockerfile ./api/Dockerfile --log-router fluentbit
+
+  Edit the manifest of a "frontend" load balanced web service before it's written.
+  /code $ copilot svc init --name frontend --svc-type "Load Balanced Web Service" --dockerfile ./frontend/Dockerfile --edit
+
+  Compare the service types before choosing one.
+  /code $ copilot svc init --type-help`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if vars.typeHelp {
+				return writeSvcTypeHelp(log.OutputWriter)
+			}
 			opts, err := newInitSvcOpts(vars)
 			if err != nil {
 				return err
@@ -499,6 +1150,21 @@ This command is also run as part of "copilot init".`,
 	cmd.Flags().StringVarP(&vars.wkldType, svcTypeFlag, typeFlagShort, "", svcTypeFlagDescription)
 	cmd.Flags().StringVarP(&vars.dockerfilePath, dockerFileFlag, dockerFileFlagShort, "", dockerFileFlagDescription)
 	cmd.Flags().StringVarP(&vars.image, imageFlag, imageFlagShort, "", imageFlagDescription)
+	cmd.Flags().IntVar(&vars.dockerfileDepth, dockerfileDepthFlag, workspace.DefaultDockerfileSearchDepth, dockerfileDepthFlagDescription)
 	cmd.Flags().Uint16Var(&vars.port, svcPortFlag, 0, svcPortFlagDescription)
+	cmd.Flags().StringVar(&vars.logRouter, logRouterFlag, "", logRouterFlagDescription)
+	cmd.Flags().StringVar(&vars.logConfigFile, logConfigFileFlag, "", logConfigFileFlagDescription)
+	cmd.Flags().StringVar(&vars.storage, svcStorageFlag, "", svcStorageFlagDescription)
+	cmd.Flags().BoolVar(&vars.autoscaling, autoscalingFlag, false, autoscalingFlagDescription)
+	cmd.Flags().StringVar(&vars.healthCheckPath, healthCheckPathFlag, "", healthCheckPathFlagDescription)
+	cmd.Flags().Int64Var(&vars.healthCheckHealthyThreshold, healthCheckHealthyThresholdFlag, 0, healthCheckHealthyThresholdFlagDescription)
+	cmd.Flags().DurationVar(&vars.healthCheckInterval, healthCheckIntervalFlag, 0, healthCheckIntervalFlagDescription)
+	cmd.Flags().StringVar(&vars.addonsDir, addonsDirFlag, "", addonsDirFlagDescription)
+	cmd.Flags().StringVar(&vars.envFile, envFileFlag, "", envFileFlagDescription)
+	cmd.Flags().BoolVar(&vars.edit, editFlag, false, editFlagDescription)
+	cmd.Flags().BoolVar(&vars.typeHelp, typeHelpFlag, false, typeHelpFlagDescription)
+	markPromptedFlags(cmd, svcTypeFlag, nameFlag)
+	markPromptedFlag(cmd, dockerFileFlag, imageFlag)
+	markPromptedFlag(cmd, logConfigFileFlag)
 	return cmd
 }
@@ -4,17 +4,25 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"runtime"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/initialize"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
@@ -22,16 +30,85 @@ import (
 
 func TestSvcInitOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inSvcType        string
-		inSvcName        string
-		inDockerfilePath string
-		inImage          string
-		inAppName        string
-		inSvcPort        uint16
+		inSvcType         string
+		inSvcName         string
+		inDockerfilePath  string
+		inImage           string
+		inDockerfileDepth int
+		inAppName         string
+		inSvcPort         uint16
+		inLogRouter       string
+		inLogConfigFile   string
+		inStorage         string
+		inAutoscaling     bool
+		inHealthCheck     initSvcVars // Only the health check fields are read.
+		inWsRoot          string
+		inAddonsDir       string
+		inEnvFile         string
 
-		mockFileSystem func(mockFS afero.Fs)
-		wantedErr      error
+		mockFileSystem  func(mockFS afero.Fs)
+		wantedVariables map[string]string
+		wantedErr       error
 	}{
+		"env file doesn't exist": {
+			inAppName: "phonetool",
+			inEnvFile: ".env",
+
+			wantedErr: errors.New("read env file .env: open .env: file does not exist"),
+		},
+		"env file with an invalid line": {
+			inAppName: "phonetool",
+			inEnvFile: ".env",
+
+			mockFileSystem: func(mockFS afero.Fs) {
+				afero.WriteFile(mockFS, ".env", []byte("LOG_LEVEL=info\nDEBUG\n"), 0644)
+			},
+			wantedErr: errors.New(`parse env file .env: line 2: "DEBUG" is not in the KEY=VALUE format`),
+		},
+		"valid env file": {
+			inAppName: "phonetool",
+			inEnvFile: ".env",
+
+			mockFileSystem: func(mockFS afero.Fs) {
+				afero.WriteFile(mockFS, ".env", []byte("LOG_LEVEL=info\n"), 0644)
+			},
+			wantedVariables: map[string]string{
+				"LOG_LEVEL": "info",
+			},
+		},
+		"addons directory doesn't exist": {
+			inAppName:   "phonetool",
+			inAddonsDir: "shared",
+
+			wantedErr: errors.New("read addons directory shared: open shared: file does not exist"),
+		},
+		"addons directory without YAML files": {
+			inAppName:   "phonetool",
+			inAddonsDir: "shared",
+
+			mockFileSystem: func(mockFS afero.Fs) {
+				afero.WriteFile(mockFS, "shared/README.md", []byte("# Shared addons"), 0644)
+			},
+			wantedErr: errors.New("no YAML addon templates found in shared"),
+		},
+		"addons directory with an invalid template": {
+			inAppName:   "phonetool",
+			inAddonsDir: "shared",
+
+			mockFileSystem: func(mockFS afero.Fs) {
+				afero.WriteFile(mockFS, "shared/bucket.yml", []byte("Parameters:\n  App:\n    Type: String\n"), 0644)
+			},
+			wantedErr: errors.New(`invalid --addons-dir: addon bucket.yml must declare at least one resource under "Resources"`),
+		},
+		"addons directory with valid templates": {
+			inAppName:   "phonetool",
+			inAddonsDir: "shared",
+
+			mockFileSystem: func(mockFS afero.Fs) {
+				afero.WriteFile(mockFS, "shared/bucket.yml", []byte("Resources:\n  Bucket:\n    Type: AWS::S3::Bucket\n"), 0644)
+				afero.WriteFile(mockFS, "shared/README.md", []byte("# Shared addons"), 0644)
+			},
+		},
 		"invalid service type": {
 			inAppName: "phonetool",
 			inSvcType: "TestSvcType",
@@ -48,6 +125,11 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 			inImage:          "mockImage",
 			wantedErr:        fmt.Errorf("--dockerfile and --image cannot be specified together"),
 		},
+		"fail if the Dockerfile search depth is negative": {
+			inAppName:         "phonetool",
+			inDockerfileDepth: -1,
+			wantedErr:         errors.New("invalid --dockerfile-search-depth -1: must be between 0 and 5"),
+		},
 		"fail if image not supported by App Runner": {
 			inAppName: "phonetool",
 			inImage:   "amazon/amazon-ecs-sample",
@@ -63,6 +145,72 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 			inAppName: "",
 			wantedErr: errNoAppInWorkspace,
 		},
+		"invalid log router": {
+			inAppName:   "phonetool",
+			inLogRouter: "fluentd",
+			wantedErr:   errors.New("invalid --log-router fluentd: must be fluentbit"),
+		},
+		"fail if log router is used with a Request-Driven Web Service": {
+			inAppName:   "phonetool",
+			inSvcType:   manifest.RequestDrivenWebServiceType,
+			inLogRouter: "fluentbit",
+			wantedErr:   errors.New("--log-router is not supported for Request-Driven Web Service"),
+		},
+		"fail if log config file is used without a log router": {
+			inAppName:       "phonetool",
+			inLogConfigFile: "/fluent-bit/etc/extra.conf",
+			wantedErr:       errors.New("--log-config-file cannot be specified without --log-router"),
+		},
+		"invalid storage": {
+			inAppName: "phonetool",
+			inStorage: "ebs",
+			wantedErr: errors.New("invalid --storage ebs: must be efs"),
+		},
+		"fail if storage is used with a Request-Driven Web Service": {
+			inAppName: "phonetool",
+			inSvcType: manifest.RequestDrivenWebServiceType,
+			inStorage: "efs",
+			wantedErr: errors.New("--storage is not supported for Request-Driven Web Service"),
+		},
+		"fail if a load balancer health check flag is used with a Backend Service": {
+			inAppName:     "phonetool",
+			inSvcType:     manifest.BackendServiceType,
+			inHealthCheck: initSvcVars{healthCheckInterval: 10 * time.Second},
+			wantedErr:     errors.New("--healthcheck-interval is only supported for Load Balanced Web Service"),
+		},
+		"fail if the health check path doesn't start with a slash": {
+			inAppName:     "phonetool",
+			inSvcType:     manifest.LoadBalancedWebServiceType,
+			inHealthCheck: initSvcVars{healthCheckPath: "healthz"},
+			wantedErr:     errors.New("invalid --health-check-path healthz: must start with /"),
+		},
+		"fail if the healthy threshold is out of range": {
+			inAppName:     "phonetool",
+			inSvcType:     manifest.LoadBalancedWebServiceType,
+			inHealthCheck: initSvcVars{healthCheckHealthyThreshold: 11},
+			wantedErr:     errors.New("invalid --healthcheck-healthy-threshold 11: must be between 2 and 10"),
+		},
+		"fail if the health check interval is out of range": {
+			inAppName:     "phonetool",
+			inSvcType:     manifest.LoadBalancedWebServiceType,
+			inHealthCheck: initSvcVars{healthCheckInterval: 2 * time.Second},
+			wantedErr:     errors.New("invalid --healthcheck-interval 2s: must be between 5s and 5m0s"),
+		},
+		"valid load balancer health check flags": {
+			inAppName: "phonetool",
+			inSvcType: manifest.LoadBalancedWebServiceType,
+			inHealthCheck: initSvcVars{
+				healthCheckPath:             "/healthz",
+				healthCheckHealthyThreshold: 3,
+				healthCheckInterval:         30 * time.Second,
+			},
+		},
+		"fail if autoscaling is used with a Backend Service": {
+			inAppName:     "phonetool",
+			inSvcType:     manifest.BackendServiceType,
+			inAutoscaling: true,
+			wantedErr:     errors.New("--autoscaling is not supported for Backend Service"),
+		},
 		"valid flags": {
 			inSvcName:        "frontend",
 			inSvcType:        "Load Balanced Web Service",
@@ -75,6 +223,30 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 			},
 			wantedErr: nil,
 		},
+		"dockerfile path is relative to the workspace root": {
+			inSvcName:        "frontend",
+			inSvcType:        "Load Balanced Web Service",
+			inDockerfilePath: "./hello/Dockerfile",
+			inAppName:        "phonetool",
+			inWsRoot:         "/ws",
+
+			mockFileSystem: func(mockFS afero.Fs) {
+				mockFS.MkdirAll("/ws/hello", 0755)
+				afero.WriteFile(mockFS, "/ws/hello/Dockerfile", []byte("FROM nginx"), 0644)
+			},
+			wantedErr: nil,
+		},
+		"dockerfile path doesn't exist relative to the workspace root": {
+			inAppName:        "phonetool",
+			inDockerfilePath: "./hello/Dockerfile",
+			inWsRoot:         "/ws",
+
+			mockFileSystem: func(mockFS afero.Fs) {
+				mockFS.MkdirAll("hello", 0755)
+				afero.WriteFile(mockFS, "hello/Dockerfile", []byte("FROM nginx"), 0644)
+			},
+			wantedErr: errors.New("open /ws/hello/Dockerfile: file does not exist"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -82,15 +254,27 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 			opts := initSvcOpts{
 				initSvcVars: initSvcVars{
 					initWkldVars: initWkldVars{
-						wkldType:       tc.inSvcType,
-						name:           tc.inSvcName,
-						dockerfilePath: tc.inDockerfilePath,
-						image:          tc.inImage,
-						appName:        tc.inAppName,
+						wkldType:        tc.inSvcType,
+						name:            tc.inSvcName,
+						dockerfilePath:  tc.inDockerfilePath,
+						image:           tc.inImage,
+						appName:         tc.inAppName,
+						dockerfileDepth: tc.inDockerfileDepth,
 					},
-					port: tc.inSvcPort,
+					port:          tc.inSvcPort,
+					logRouter:     tc.inLogRouter,
+					logConfigFile: tc.inLogConfigFile,
+					storage:       tc.inStorage,
+					autoscaling:   tc.inAutoscaling,
+					addonsDir:     tc.inAddonsDir,
+					envFile:       tc.inEnvFile,
+
+					healthCheckPath:             tc.inHealthCheck.healthCheckPath,
+					healthCheckHealthyThreshold: tc.inHealthCheck.healthCheckHealthyThreshold,
+					healthCheckInterval:         tc.inHealthCheck.healthCheckInterval,
 				},
-				fs: &afero.Afero{Fs: afero.NewMemMapFs()},
+				fs:     &afero.Afero{Fs: afero.NewMemMapFs()},
+				wsRoot: tc.inWsRoot,
 			}
 			if tc.mockFileSystem != nil {
 				tc.mockFileSystem(opts.fs)
@@ -104,6 +288,66 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 				require.EqualError(t, err, tc.wantedErr.Error())
 			} else {
 				require.NoError(t, err)
+				require.Equal(t, tc.wantedVariables, opts.variables)
+			}
+		})
+	}
+}
+
+func TestParseEnvFile(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedVariables map[string]string
+		wantedErr       error
+	}{
+		"skips comments and blank lines": {
+			inContent: "# Logging\nLOG_LEVEL=info\n\n  # Database\nDB_NAME = orders\n",
+			wantedVariables: map[string]string{
+				"LOG_LEVEL": "info",
+				"DB_NAME":   "orders",
+			},
+		},
+		"preserves equal signs in values": {
+			inContent: "DB_URL=postgres://db?sslmode=require&user=admin\nEMPTY=\n",
+			wantedVariables: map[string]string{
+				"DB_URL": "postgres://db?sslmode=require&user=admin",
+				"EMPTY":  "",
+			},
+		},
+		"removes surrounding quotes": {
+			inContent: "GREETING=\"hello world\"\nQUOTE='\"hi\"'\nUNBALANCED=\"hi\n",
+			wantedVariables: map[string]string{
+				"GREETING":   "hello world",
+				"QUOTE":      `"hi"`,
+				"UNBALANCED": `"hi`,
+			},
+		},
+		"error if a line is not a key value pair": {
+			inContent: "LOG_LEVEL=info\nDEBUG\n",
+			wantedErr: errors.New(`line 2: "DEBUG" is not in the KEY=VALUE format`),
+		},
+		"error if a key is not a valid environment variable name": {
+			inContent: "1ST_PLACE=gold\n",
+			wantedErr: errors.New(`line 1: "1ST_PLACE" is not a valid environment variable name`),
+		},
+		"error on duplicate keys": {
+			inContent: "LOG_LEVEL=info\n# Override\nLOG_LEVEL=debug\n",
+			wantedErr: errors.New("duplicate key LOG_LEVEL on lines 1 and 3"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			variables, err := parseEnvFile([]byte(tc.inContent))
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedVariables, variables)
 			}
 		})
 	}
@@ -123,13 +367,22 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 		inDockerfilePath string
 		inImage          string
 		inSvcPort        uint16
+		inLogRouter      string
+		inLogConfigFile  string
+		inStorage        string
+		inAutoscaling    bool
 
 		mockPrompt       func(m *mocks.Mockprompter)
 		mockSel          func(m *mocks.MockdockerfileSelector)
 		mockDockerfile   func(m *mocks.MockdockerfileParser)
 		mockDockerEngine func(m *mocks.MockdockerEngine)
+		mockFileSystem   func(mockFS afero.Fs)
 
-		wantedErr error
+		wantedErr             error
+		wantedDockerignore    bool
+		wantedMountPath       string
+		wantedCountRange      string
+		wantedAdditionalPorts []uint16
 	}{
 		"prompt for service type": {
 			inSvcType:        "",
@@ -431,6 +684,46 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 			mockSel:          func(m *mocks.MockdockerfileSelector) {},
 			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
 		},
+		"select additional ports if dockerfile has multiple ports": {
+			inSvcType:        wantedSvcType,
+			inSvcName:        wantedSvcName,
+			inDockerfilePath: wantedDockerfilePath,
+			inSvcPort:        0,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Eq(fmt.Sprintf(svcInitSvcPortPrompt, "port")), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("8080", nil)
+				m.EXPECT().MultiSelect(gomock.Eq(fmt.Sprintf(svcInitAdditionalPortsPrompt, "ports")), gomock.Any(), []string{"9090", "9100"}, gomock.Any()).
+					Return([]string{"9090"}, nil)
+			},
+			mockDockerfile: func(m *mocks.MockdockerfileParser) {
+				m.EXPECT().GetExposedPorts().Return([]uint16{8080, 9090, 8080, 9100}, nil)
+			},
+			mockSel:          func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+
+			wantedAdditionalPorts: []uint16{9090},
+		},
+		"errors if additional ports can't be selected": {
+			inSvcType:        wantedSvcType,
+			inSvcName:        wantedSvcName,
+			inDockerfilePath: wantedDockerfilePath,
+			inSvcPort:        0,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Eq(fmt.Sprintf(svcInitSvcPortPrompt, "port")), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("8080", nil)
+				m.EXPECT().MultiSelect(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			mockDockerfile: func(m *mocks.MockdockerfileParser) {
+				m.EXPECT().GetExposedPorts().Return([]uint16{8080, 9090}, nil)
+			},
+			mockSel:          func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+
+			wantedErr: errors.New("select additional ports: some error"),
+		},
 		"don't use dockerfile port if flag specified": {
 			inSvcType:        wantedSvcType,
 			inSvcName:        wantedSvcName,
@@ -443,6 +736,213 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 			mockSel:          func(m *mocks.MockdockerfileSelector) {},
 			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
 		},
+		"prompt for log configuration file if log router is set": {
+			inSvcType:        wantedSvcType,
+			inSvcName:        wantedSvcName,
+			inDockerfilePath: wantedDockerfilePath,
+			inSvcPort:        wantedSvcPort,
+			inLogRouter:      fluentbitLogRouter,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Eq(svcInitLogConfigFilePrompt), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("/fluent-bit/etc/extra.conf", nil)
+			},
+			mockDockerfile:   func(m *mocks.MockdockerfileParser) {},
+			mockSel:          func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+		},
+		"skip asking for log configuration file if the flag is set": {
+			inSvcType:        wantedSvcType,
+			inSvcName:        wantedSvcName,
+			inDockerfilePath: wantedDockerfilePath,
+			inSvcPort:        wantedSvcPort,
+			inLogRouter:      fluentbitLogRouter,
+			inLogConfigFile:  "/fluent-bit/etc/extra.conf",
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Eq(svcInitLogConfigFilePrompt), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			mockDockerfile:   func(m *mocks.MockdockerfileParser) {},
+			mockSel:          func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+		},
+		"returns an error if fail to get log configuration file": {
+			inSvcType:        wantedSvcType,
+			inSvcName:        wantedSvcName,
+			inDockerfilePath: wantedDockerfilePath,
+			inSvcPort:        wantedSvcPort,
+			inLogRouter:      fluentbitLogRouter,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Eq(svcInitLogConfigFilePrompt), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("", errors.New("some error"))
+			},
+			mockDockerfile:   func(m *mocks.MockdockerfileParser) {},
+			mockSel:          func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+			wantedErr:        errors.New("get log configuration file path: some error"),
+		},
+		"prompt for the mount path if storage is set": {
+			inSvcType:        wantedSvcType,
+			inSvcName:        wantedSvcName,
+			inDockerfilePath: wantedDockerfilePath,
+			inSvcPort:        wantedSvcPort,
+			inStorage:        efsStorage,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Eq(svcInitMountPathPrompt), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("/var/efs", nil)
+			},
+			mockDockerfile:   func(m *mocks.MockdockerfileParser) {},
+			mockSel:          func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+
+			wantedMountPath: "/var/efs",
+		},
+		"returns an error if storage is set for a selected Request-Driven Web Service": {
+			inSvcType:        manifest.RequestDrivenWebServiceType,
+			inSvcName:        wantedSvcName,
+			inDockerfilePath: wantedDockerfilePath,
+			inSvcPort:        wantedSvcPort,
+			inStorage:        efsStorage,
+
+			mockPrompt:       func(m *mocks.Mockprompter) {},
+			mockDockerfile:   func(m *mocks.MockdockerfileParser) {},
+			mockSel:          func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+			wantedErr:        errors.New("--storage is not supported for Request-Driven Web Service"),
+		},
+		"returns an error if fail to get the mount path": {
+			inSvcType:        wantedSvcType,
+			inSvcName:        wantedSvcName,
+			inDockerfilePath: wantedDockerfilePath,
+			inSvcPort:        wantedSvcPort,
+			inStorage:        efsStorage,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Eq(svcInitMountPathPrompt), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("", errors.New("some error"))
+			},
+			mockDockerfile:   func(m *mocks.MockdockerfileParser) {},
+			mockSel:          func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+			wantedErr:        errors.New("get mount path of EFS volume: some error"),
+		},
+		"prompt for the range of tasks if autoscaling is set": {
+			inSvcType:        wantedSvcType,
+			inSvcName:        wantedSvcName,
+			inDockerfilePath: wantedDockerfilePath,
+			inSvcPort:        wantedSvcPort,
+			inAutoscaling:    true,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Eq(svcInitCountRangePrompt), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("2-20", nil)
+			},
+			mockDockerfile:   func(m *mocks.MockdockerfileParser) {},
+			mockSel:          func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+
+			wantedCountRange: "2-20",
+		},
+		"returns an error if autoscaling is set for a selected Backend Service": {
+			inSvcType:     manifest.BackendServiceType,
+			inSvcName:     wantedSvcName,
+			inImage:       wantedImage,
+			inSvcPort:     wantedSvcPort,
+			inAutoscaling: true,
+
+			mockPrompt:       func(m *mocks.Mockprompter) {},
+			mockDockerfile:   func(m *mocks.MockdockerfileParser) {},
+			mockSel:          func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+			wantedErr:        errors.New("--autoscaling is not supported for Backend Service"),
+		},
+		"returns an error if fail to get the range of tasks": {
+			inSvcType:        wantedSvcType,
+			inSvcName:        wantedSvcName,
+			inDockerfilePath: wantedDockerfilePath,
+			inSvcPort:        wantedSvcPort,
+			inAutoscaling:    true,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Eq(svcInitCountRangePrompt), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("", errors.New("some error"))
+			},
+			mockDockerfile:   func(m *mocks.MockdockerfileParser) {},
+			mockSel:          func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+			wantedErr:        errors.New("get range of tasks: some error"),
+		},
+		"offers to generate a .dockerignore file if the Dockerfile doesn't have one": {
+			inSvcType:        wantedSvcType,
+			inSvcName:        wantedSvcName,
+			inDockerfilePath: wantedDockerfilePath,
+			inSvcPort:        wantedSvcPort,
+
+			mockFileSystem: func(mockFS afero.Fs) {
+				afero.WriteFile(mockFS, "frontend/Dockerfile", []byte("FROM nginx"), 0644)
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(fmt.Sprintf(fmtSvcInitDockerignorePrompt, "frontend/Dockerfile"), svcInitDockerignoreHelpPrompt, gomock.Any()).
+					Return(true, nil)
+			},
+			mockDockerfile:   func(m *mocks.MockdockerfileParser) {},
+			mockSel:          func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+
+			wantedDockerignore: true,
+		},
+		"does not offer to generate a .dockerignore file if one already exists": {
+			inSvcType:        wantedSvcType,
+			inSvcName:        wantedSvcName,
+			inDockerfilePath: wantedDockerfilePath,
+			inSvcPort:        wantedSvcPort,
+
+			mockFileSystem: func(mockFS afero.Fs) {
+				afero.WriteFile(mockFS, "frontend/Dockerfile", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "frontend/.dockerignore", []byte(".git"), 0644)
+			},
+			mockPrompt:       func(m *mocks.Mockprompter) {},
+			mockDockerfile:   func(m *mocks.MockdockerfileParser) {},
+			mockSel:          func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+		},
+		"skips generating a .dockerignore file if prompts are disabled": {
+			inSvcType:        wantedSvcType,
+			inSvcName:        wantedSvcName,
+			inDockerfilePath: wantedDockerfilePath,
+			inSvcPort:        wantedSvcPort,
+
+			mockFileSystem: func(mockFS afero.Fs) {
+				afero.WriteFile(mockFS, "frontend/Dockerfile", []byte("FROM nginx"), 0644)
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(false, &prompt.ErrNoPrompt{Message: "some prompt"})
+			},
+			mockDockerfile:   func(m *mocks.MockdockerfileParser) {},
+			mockSel:          func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+		},
+		"returns an error if fail to confirm generating a .dockerignore file": {
+			inSvcType:        wantedSvcType,
+			inSvcName:        wantedSvcName,
+			inDockerfilePath: wantedDockerfilePath,
+			inSvcPort:        wantedSvcPort,
+
+			mockFileSystem: func(mockFS afero.Fs) {
+				afero.WriteFile(mockFS, "frontend/Dockerfile", []byte("FROM nginx"), 0644)
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(false, errors.New("some error"))
+			},
+			mockDockerfile:   func(m *mocks.MockdockerfileParser) {},
+			mockSel:          func(m *mocks.MockdockerfileSelector) {},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+			wantedErr:        errors.New("confirm generating .dockerignore: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -463,7 +963,11 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 						image:          tc.inImage,
 						dockerfilePath: tc.inDockerfilePath,
 					},
-					port: tc.inSvcPort,
+					port:          tc.inSvcPort,
+					logRouter:     tc.inLogRouter,
+					logConfigFile: tc.inLogConfigFile,
+					storage:       tc.inStorage,
+					autoscaling:   tc.inAutoscaling,
 				},
 				fs: &afero.Afero{Fs: afero.NewMemMapFs()},
 				dockerfile: func(s string) dockerfileParser {
@@ -478,6 +982,9 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 			tc.mockPrompt(mockPrompt)
 			tc.mockDockerfile(mockDockerfile)
 			tc.mockDockerEngine(mockDockerEngine)
+			if tc.mockFileSystem != nil {
+				tc.mockFileSystem(opts.fs)
+			}
 
 			// WHEN
 			err := opts.Ask()
@@ -494,6 +1001,10 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 				if opts.image != "" {
 					require.Equal(t, wantedImage, opts.image)
 				}
+				require.Equal(t, tc.wantedDockerignore, opts.writeDockerignore)
+				require.Equal(t, tc.wantedMountPath, opts.mountPath)
+				require.Equal(t, tc.wantedCountRange, opts.countRange)
+				require.Equal(t, tc.wantedAdditionalPorts, opts.additionalPorts)
 			}
 		})
 	}
@@ -504,16 +1015,66 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 		mockSvcInit      func(m *mocks.MocksvcInitializer)
 		mockDockerfile   func(m *mocks.MockdockerfileParser)
 		mockDockerEngine func(m *mocks.MockdockerEngine)
+		mockWs           func(m *mocks.MockwsAddonManager)
 		inSvcPort        uint16
 		inSvcType        string
 		inSvcName        string
 		inDockerfilePath string
 		inImage          string
 		inAppName        string
+		inLogRouter      string
+		inLogConfigFile  string
+		inStorage        string
+		inMountPath      string
+		inCountRange     string
+		inHealthCheckInt time.Duration
+		inDockerignore   bool
+		inAddons         map[string]addonTemplate
 
 		wantedErr          error
 		wantedManifestPath string
+		wantedDockerignore string
 	}{
+		"writes the addon templates after initializing the service": {
+			inAppName: "sample",
+			inSvcName: "frontend",
+			inImage:   "nginx:latest",
+			inSvcType: manifest.BackendServiceType,
+			inAddons: map[string]addonTemplate{
+				"queue.yaml": addonTemplate("queue"),
+				"bucket.yml": addonTemplate("bucket"),
+			},
+
+			mockSvcInit: func(m *mocks.MocksvcInitializer) {
+				m.EXPECT().Service(gomock.Any()).Return("manifest/path", nil)
+			},
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				gomock.InOrder(
+					m.EXPECT().WriteAddon(addonTemplate("bucket"), "frontend", "bucket").Return("/ws/copilot/frontend/addons/bucket.yml", nil),
+					m.EXPECT().WriteAddon(addonTemplate("queue"), "frontend", "queue").Return("/ws/copilot/frontend/addons/queue.yml", nil),
+				)
+			},
+
+			wantedManifestPath: "manifest/path",
+		},
+		"fails to write an addon template that already exists": {
+			inAppName: "sample",
+			inSvcName: "frontend",
+			inImage:   "nginx:latest",
+			inSvcType: manifest.BackendServiceType,
+			inAddons: map[string]addonTemplate{
+				"bucket.yml": addonTemplate("bucket"),
+			},
+
+			mockSvcInit: func(m *mocks.MocksvcInitializer) {
+				m.EXPECT().Service(gomock.Any()).Return("manifest/path", nil)
+			},
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().WriteAddon(addonTemplate("bucket"), "frontend", "bucket").Return("", &workspace.ErrFileExists{FileName: "bucket.yml"})
+			},
+
+			wantedErr: errors.New("addon already exists: file bucket.yml already exists"),
+		},
 		"success on typical svc props": {
 			inAppName:        "sample",
 			inSvcName:        "frontend",
@@ -546,6 +1107,26 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 
 			wantedManifestPath: "manifest/path",
 		},
+		"writes a .dockerignore file next to the Dockerfile": {
+			inAppName:        "sample",
+			inSvcName:        "frontend",
+			inDockerfilePath: "frontend/Dockerfile",
+			inSvcType:        manifest.BackendServiceType,
+			inDockerignore:   true,
+
+			mockSvcInit: func(m *mocks.MocksvcInitializer) {
+				m.EXPECT().Service(gomock.Any()).Return("manifest/path", nil)
+			},
+			mockDockerfile: func(m *mocks.MockdockerfileParser) {
+				m.EXPECT().GetHealthCheck().Return(nil, nil)
+			},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {
+				m.EXPECT().GetPlatform().Return("linux", "amd64", nil)
+			},
+
+			wantedManifestPath: "manifest/path",
+			wantedDockerignore: defaultDockerignore,
+		},
 		"backend service": {
 			inAppName:        "sample",
 			inSvcName:        "frontend",
@@ -575,6 +1156,134 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 
 			wantedManifestPath: "manifest/path",
 		},
+		"backend service with a log router": {
+			inAppName:       "sample",
+			inSvcName:       "backend",
+			inImage:         "nginx:latest",
+			inSvcType:       manifest.BackendServiceType,
+			inLogRouter:     fluentbitLogRouter,
+			inLogConfigFile: "/fluent-bit/etc/extra.conf",
+
+			mockSvcInit: func(m *mocks.MocksvcInitializer) {
+				m.EXPECT().Service(&initialize.ServiceProps{
+					WorkloadProps: initialize.WorkloadProps{
+						App:   "sample",
+						Name:  "backend",
+						Type:  "Backend Service",
+						Image: "nginx:latest",
+						Platform: &manifest.PlatformConfig{
+							OS:   runtime.GOOS,
+							Arch: runtime.GOARCH,
+						},
+					},
+					Logging: &manifest.Logging{
+						ConfigFile: aws.String("/fluent-bit/etc/extra.conf"),
+					},
+				}).Return("manifest/path", nil)
+			},
+
+			wantedManifestPath: "manifest/path",
+		},
+		"backend service with an EFS volume": {
+			inAppName:   "sample",
+			inSvcName:   "backend",
+			inImage:     "nginx:latest",
+			inSvcType:   manifest.BackendServiceType,
+			inStorage:   efsStorage,
+			inMountPath: "/var/efs",
+
+			mockSvcInit: func(m *mocks.MocksvcInitializer) {
+				m.EXPECT().Service(&initialize.ServiceProps{
+					WorkloadProps: initialize.WorkloadProps{
+						App:   "sample",
+						Name:  "backend",
+						Type:  "Backend Service",
+						Image: "nginx:latest",
+						Platform: &manifest.PlatformConfig{
+							OS:   runtime.GOOS,
+							Arch: runtime.GOARCH,
+						},
+					},
+					Storage: &manifest.Storage{
+						Volumes: map[string]manifest.Volume{
+							"data": {
+								EFS: &manifest.EFSConfigOrBool{
+									Enabled: aws.Bool(true),
+								},
+								MountPointOpts: manifest.MountPointOpts{
+									ContainerPath: aws.String("/var/efs"),
+									ReadOnly:      aws.Bool(false),
+								},
+							},
+						},
+					},
+				}).Return("manifest/path", nil)
+			},
+
+			wantedManifestPath: "manifest/path",
+		},
+		"load balanced web service with autoscaling": {
+			inAppName:    "sample",
+			inSvcName:    "frontend",
+			inImage:      "nginx:latest",
+			inSvcType:    manifest.LoadBalancedWebServiceType,
+			inCountRange: "2-20",
+
+			mockSvcInit: func(m *mocks.MocksvcInitializer) {
+				countRange := manifest.IntRangeBand("2-20")
+				m.EXPECT().Service(&initialize.ServiceProps{
+					WorkloadProps: initialize.WorkloadProps{
+						App:   "sample",
+						Name:  "frontend",
+						Type:  "Load Balanced Web Service",
+						Image: "nginx:latest",
+						Platform: &manifest.PlatformConfig{
+							OS:   runtime.GOOS,
+							Arch: runtime.GOARCH,
+						},
+					},
+					Count: &manifest.Count{
+						AdvancedCount: manifest.AdvancedCount{
+							Range: &manifest.Range{
+								Value: &countRange,
+							},
+							CPU: aws.Int(70),
+						},
+					},
+				}).Return("manifest/path", nil)
+			},
+
+			wantedManifestPath: "manifest/path",
+		},
+		"load balanced web service with a load balancer health check": {
+			inAppName:        "sample",
+			inSvcName:        "frontend",
+			inImage:          "nginx:latest",
+			inSvcType:        manifest.LoadBalancedWebServiceType,
+			inHealthCheckInt: 30 * time.Second,
+
+			mockSvcInit: func(m *mocks.MocksvcInitializer) {
+				interval := 30 * time.Second
+				m.EXPECT().Service(&initialize.ServiceProps{
+					WorkloadProps: initialize.WorkloadProps{
+						App:   "sample",
+						Name:  "frontend",
+						Type:  "Load Balanced Web Service",
+						Image: "nginx:latest",
+						Platform: &manifest.PlatformConfig{
+							OS:   runtime.GOOS,
+							Arch: runtime.GOARCH,
+						},
+					},
+					HTTPHealthCheck: &manifest.HTTPHealthCheckArgs{
+						Path:     aws.String("/"),
+						Interval: &interval,
+					},
+				}).Return("manifest/path", nil)
+			},
+
+			wantedManifestPath: "manifest/path",
+		},
 		"doesn't parse dockerfile if image specified (backend)": {
 			inAppName:        "sample",
 			inSvcName:        "backend",
@@ -651,6 +1360,7 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 			mockSvcInitializer := mocks.NewMocksvcInitializer(ctrl)
 			mockDockerfile := mocks.NewMockdockerfileParser(ctrl)
 			mockDockerEngine := mocks.NewMockdockerEngine(ctrl)
+			mockWs := mocks.NewMockwsAddonManager(ctrl)
 
 			if tc.mockSvcInit != nil {
 				tc.mockSvcInit(mockSvcInitializer)
@@ -661,6 +1371,9 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 			if tc.mockDockerEngine != nil {
 				tc.mockDockerEngine(mockDockerEngine)
 			}
+			if tc.mockWs != nil {
+				tc.mockWs(mockWs)
+			}
 			opts := initSvcOpts{
 				initSvcVars: initSvcVars{
 					initWkldVars: initWkldVars{
@@ -670,14 +1383,25 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 						dockerfilePath: tc.inDockerfilePath,
 						image:          tc.inImage,
 					},
-					port: tc.inSvcPort,
+					port:      tc.inSvcPort,
+					logRouter: tc.inLogRouter,
+					storage:   tc.inStorage,
+
+					healthCheckInterval: tc.inHealthCheckInt,
+					logConfigFile:       tc.inLogConfigFile,
 				},
-				init: mockSvcInitializer,
+				mountPath:         tc.inMountPath,
+				countRange:        tc.inCountRange,
+				writeDockerignore: tc.inDockerignore,
+				addonTemplates:    tc.inAddons,
+				fs:                &afero.Afero{Fs: afero.NewMemMapFs()},
+				init:              mockSvcInitializer,
 				dockerfile: func(s string) dockerfileParser {
 					return mockDockerfile
 				},
 				df:           mockDockerfile,
 				dockerEngine: mockDockerEngine,
+				ws:           mockWs,
 			}
 
 			// WHEN
@@ -687,9 +1411,195 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 			if tc.wantedErr == nil {
 				require.NoError(t, err)
 				require.Equal(t, tc.wantedManifestPath, opts.manifestPath)
+				if tc.wantedDockerignore != "" {
+					content, err := afero.ReadFile(opts.fs, "frontend/.dockerignore")
+					require.NoError(t, err)
+					require.Equal(t, tc.wantedDockerignore, string(content))
+				}
 			} else {
 				require.EqualError(t, err, tc.wantedErr.Error())
 			}
 		})
 	}
 }
+
+func TestSvcInitOpts_askSvcPort(t *testing.T) {
+	const wantedDockerfilePath = "frontend/Dockerfile"
+	testCases := map[string]struct {
+		inSvcType string
+
+		mockPrompt     func(m *mocks.Mockprompter)
+		mockDockerfile func(m *mocks.MockdockerfileParser)
+
+		wantedPort uint16
+		wantedNote bool
+	}{
+		"explains the default port if the Dockerfile has no EXPOSE instruction": {
+			inSvcType: manifest.LoadBalancedWebServiceType,
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Eq(fmt.Sprintf(svcInitSvcPortPrompt, "port")), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("8080", nil)
+			},
+			mockDockerfile: func(m *mocks.MockdockerfileParser) {
+				m.EXPECT().GetExposedPorts().Return(nil, nil)
+			},
+			wantedPort: 8080,
+			wantedNote: true,
+		},
+		"doesn't explain the default port if the Dockerfile can't be parsed": {
+			inSvcType: manifest.LoadBalancedWebServiceType,
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Eq(fmt.Sprintf(svcInitSvcPortPrompt, "port")), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(defaultSvcPortString, nil)
+			},
+			mockDockerfile: func(m *mocks.MockdockerfileParser) {
+				m.EXPECT().GetExposedPorts().Return(nil, errors.New("some error"))
+			},
+			wantedPort: 80,
+		},
+		"doesn't explain the default port for a backend service": {
+			inSvcType:  manifest.BackendServiceType,
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			mockDockerfile: func(m *mocks.MockdockerfileParser) {
+				m.EXPECT().GetExposedPorts().Return(nil, nil)
+			},
+		},
+		"doesn't explain the default port if the Dockerfile exposes ports": {
+			inSvcType: manifest.LoadBalancedWebServiceType,
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Eq(fmt.Sprintf(svcInitSvcPortPrompt, "port")), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("8080", nil)
+				m.EXPECT().MultiSelect(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil)
+			},
+			mockDockerfile: func(m *mocks.MockdockerfileParser) {
+				m.EXPECT().GetExposedPorts().Return([]uint16{8080, 9090}, nil)
+			},
+			wantedPort: 8080,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			defer func(w io.Writer) { log.DiagnosticWriter = w }(log.DiagnosticWriter)
+			log.DiagnosticWriter = b
+
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			mockDockerfile := mocks.NewMockdockerfileParser(ctrl)
+			tc.mockPrompt(mockPrompt)
+			tc.mockDockerfile(mockDockerfile)
+			opts := &initSvcOpts{
+				initSvcVars: initSvcVars{
+					initWkldVars: initWkldVars{
+						wkldType:       tc.inSvcType,
+						dockerfilePath: wantedDockerfilePath,
+					},
+				},
+				dockerfile: func(s string) dockerfileParser {
+					return mockDockerfile
+				},
+				prompt: mockPrompt,
+			}
+
+			// WHEN
+			err := opts.askSvcPort()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPort, opts.port)
+			note := fmt.Sprintf(fmtSvcInitNoExposedPortsNote, wantedDockerfilePath)
+			if tc.wantedNote {
+				require.Contains(t, b.String(), note)
+			} else {
+				require.NotContains(t, b.String(), note)
+			}
+		})
+	}
+}
+
+func TestWriteSvcTypeHelp(t *testing.T) {
+	// GIVEN
+	b := &bytes.Buffer{}
+
+	// WHEN
+	err := writeSvcTypeHelp(b)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, `Type                        Use cases                                    Networking                                   Cost
+----                        ---------                                    ----------                                   ----
+Request-Driven Web Service  Web apps and APIs with spiky or low traffic  Public HTTPS endpoint managed by App Runner  Memory while idle, CPU per request
+Load Balanced Web Service   Web apps and APIs with steady traffic        Public load balancer to tasks in a VPC       Running tasks and load balancer
+Backend Service             APIs called by your other services           Private, via service discovery               Running tasks
+`, b.String())
+}
+
+func TestResolveDockerfilePath(t *testing.T) {
+	testCases := map[string]struct {
+		inWsRoot string
+		inPath   string
+
+		wantedPath string
+	}{
+		"resolves relative paths from the workspace root": {
+			inWsRoot: "/ws",
+			inPath:   "./frontend/Dockerfile",
+
+			wantedPath: "/ws/frontend/Dockerfile",
+		},
+		"keeps absolute paths": {
+			inWsRoot: "/ws",
+			inPath:   "/other/Dockerfile",
+
+			wantedPath: "/other/Dockerfile",
+		},
+		"keeps relative paths if there is no workspace yet": {
+			inPath: "frontend/Dockerfile",
+
+			wantedPath: "frontend/Dockerfile",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedPath, resolveDockerfilePath(tc.inWsRoot, tc.inPath))
+		})
+	}
+}
+
+func TestDockerfilePathFromRoot(t *testing.T) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	testCases := map[string]struct {
+		inWsRoot string
+		inPath   string
+
+		wantedPath string
+	}{
+		"converts paths relative to the current directory": {
+			inWsRoot: filepath.Dir(wd),
+			inPath:   "frontend/Dockerfile",
+
+			wantedPath: filepath.Join(filepath.Base(wd), "frontend", "Dockerfile"),
+		},
+		"keeps relative paths if there is no workspace yet": {
+			inPath: "frontend/Dockerfile",
+
+			wantedPath: "frontend/Dockerfile",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			path, err := dockerfilePathFromRoot(tc.inWsRoot, tc.inPath)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPath, path)
+		})
+	}
+}
@@ -19,6 +19,7 @@ type listWkldVars struct {
 	appName                  string
 	shouldOutputJSON         bool
 	shouldShowLocalWorkloads bool
+	outputFormat             string
 }
 
 type listSvcOpts struct {
@@ -45,6 +46,7 @@ func newListSvcOpts(vars listWkldVars) (*listSvcOpts, error) {
 
 		ShowLocalSvcs: vars.shouldShowLocalWorkloads,
 		OutputJSON:    vars.shouldOutputJSON,
+		OutputCSV:     vars.outputFormat == outputFormatCSV,
 	}
 
 	return &listSvcOpts{
@@ -55,6 +57,11 @@ func newListSvcOpts(vars listWkldVars) (*listSvcOpts, error) {
 	}, nil
 }
 
+// Validate returns an error if the values provided by the user are invalid.
+func (o *listSvcOpts) Validate() error {
+	return validateListOutputFormat(o.shouldOutputJSON, o.outputFormat)
+}
+
 // Ask asks for fields that are required but not passed in.
 func (o *listSvcOpts) Ask() error {
 	if o.appName != "" {
@@ -87,12 +94,18 @@ func buildSvcListCmd() *cobra.Command {
 		Short: "Lists all the services in an application.",
 		Example: `
   Lists all the services for the "myapp" application.
-  /code $ copilot svc ls --app myapp`,
+  /code $ copilot svc ls --app myapp
+
+  Lists all the services for the "myapp" application as CSV.
+  /code $ copilot svc ls --app myapp --output csv`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newListSvcOpts(vars)
 			if err != nil {
 				return err
 			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
 			if err := opts.Ask(); err != nil {
 				return err
 			}
@@ -102,5 +115,7 @@ func buildSvcListCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldShowLocalWorkloads, localFlag, false, localSvcFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFlag, "", listOutputFlagDescription)
+	markPromptedFlags(cmd, appFlag)
 	return cmd
 }
@@ -66,6 +66,52 @@ func TestListSvcOpts_Execute(t *testing.T) {
 	}
 }
 
+func TestListSvcOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		vars        listWkldVars
+		expectedErr error
+	}{
+		"valid with json output": {
+			vars: listWkldVars{
+				shouldOutputJSON: true,
+			},
+		},
+		"valid with csv output": {
+			vars: listWkldVars{
+				outputFormat: "csv",
+			},
+		},
+		"error if both --json and --output are specified": {
+			vars: listWkldVars{
+				shouldOutputJSON: true,
+				outputFormat:     "csv",
+			},
+			expectedErr: fmt.Errorf("--json and --output cannot be specified together"),
+		},
+		"error if the output format is not supported": {
+			vars: listWkldVars{
+				outputFormat: "xml",
+			},
+			expectedErr: fmt.Errorf("invalid output format xml: must be one of \"csv\""),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := listSvcOpts{
+				listWkldVars: tc.vars,
+			}
+
+			err := opts.Validate()
+
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestListSvcOpts_Ask(t *testing.T) {
 	testCases := map[string]struct {
 		inApp string
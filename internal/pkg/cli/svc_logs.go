@@ -10,10 +10,14 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
@@ -40,11 +44,14 @@ type wkldLogsVars struct {
 	taskIDs          []string
 	since            time.Duration
 	logGroup         string
+	previous         bool
 }
 
 type svcLogsOpts struct {
 	wkldLogsVars
 	wkldLogOpts
+
+	svcDescriber serviceDescriber // Only set if logs of the previous task are requested.
 }
 
 type wkldLogOpts struct {
@@ -95,6 +102,12 @@ func newSvcLogOpts(vars wkldLogsVars) (*svcLogsOpts, error) {
 		if err != nil {
 			return err
 		}
+		if opts.previous {
+			if workload.Type == manifest.RequestDrivenWebServiceType {
+				return fmt.Errorf("cannot use --%s for services with type: '%s'", previousFlag, manifest.RequestDrivenWebServiceType)
+			}
+			opts.svcDescriber = ecs.New(sess)
+		}
 		opts.logsSvc, err = logging.NewServiceClient(&logging.NewServiceLogsConfig{
 			App:         opts.appName,
 			Env:         opts.envName,
@@ -130,6 +143,14 @@ func (o *svcLogsOpts) Validate() error {
 		return errors.New("only one of --follow or --end-time may be used")
 	}
 
+	if o.previous && o.follow {
+		return errors.New("only one of --follow or --previous may be used")
+	}
+
+	if o.previous && len(o.taskIDs) != 0 {
+		return errors.New("only one of --tasks or --previous may be used")
+	}
+
 	if o.since != 0 {
 		if o.since < 0 {
 			return fmt.Errorf("--since must be greater than 0")
@@ -174,6 +195,13 @@ func (o *svcLogsOpts) Execute() error {
 	if err := o.initLogsSvc(); err != nil {
 		return err
 	}
+	if o.previous {
+		taskID, err := o.latestStoppedTaskID()
+		if err != nil {
+			return err
+		}
+		o.taskIDs = []string{taskID}
+	}
 	eventsWriter := logging.WriteHumanLogs
 	if o.shouldOutputJSON {
 		eventsWriter = logging.WriteJSONLogs
@@ -196,6 +224,30 @@ func (o *svcLogsOpts) Execute() error {
 	return nil
 }
 
+// latestStoppedTaskID returns the ID of the task of the service that stopped last.
+func (o *svcLogsOpts) latestStoppedTaskID() (string, error) {
+	svcDesc, err := o.svcDescriber.DescribeService(o.appName, o.envName, o.name)
+	if err != nil {
+		return "", fmt.Errorf("describe ECS service for %s in environment %s: %w", o.name, o.envName, err)
+	}
+	if len(svcDesc.StoppedTasks) == 0 {
+		return "", fmt.Errorf("found no stopped task for service %s in environment %s", o.name, o.envName)
+	}
+	latest := svcDesc.StoppedTasks[0]
+	for _, task := range svcDesc.StoppedTasks[1:] {
+		if aws.TimeValue(task.StoppedAt).After(aws.TimeValue(latest.StoppedAt)) {
+			latest = task
+		}
+	}
+	taskID, err := awsecs.TaskID(aws.StringValue(latest.TaskArn))
+	if err != nil {
+		return "", err
+	}
+	log.Infof("Showing logs of task %s, which stopped at %s: %s\n", color.HighlightResource(taskID),
+		aws.TimeValue(latest.StoppedAt).Format(time.RFC3339), aws.StringValue(latest.StoppedReason))
+	return taskID, nil
+}
+
 func (o *svcLogsOpts) askApp() error {
 	if o.appName != "" {
 		return nil
@@ -250,8 +302,12 @@ func buildSvcLogsCmd() *cobra.Command {
   /code $ copilot svc logs --tasks 709c7eae05f947f6861b150372ddc443,1de57fd63c6a4920ac416d02add891b9
   Displays logs in real time.
   /code $ copilot svc logs --follow
+  Displays logs in the last hour, then streams new logs until you press Ctrl-C.
+  /code $ copilot svc logs --follow --since 1h
   Display logs from specific log group.
-  /code $ copilot svc logs --log-group system`,
+  /code $ copilot svc logs --log-group system
+  Displays logs of the most recently stopped task, such as a task that crashed.
+  /code $ copilot svc logs --previous`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcLogOpts(vars)
 			if err != nil {
@@ -277,5 +333,7 @@ func buildSvcLogsCmd() *cobra.Command {
 	cmd.Flags().IntVar(&vars.limit, limitFlag, 0, limitFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.taskIDs, tasksFlag, nil, tasksLogsFlagDescription)
 	cmd.Flags().StringVar(&vars.logGroup, logGroupFlag, "", logGroupFlagDescription)
+	cmd.Flags().BoolVar(&vars.previous, previousFlag, false, previousFlagDescription)
+	markPromptedFlags(cmd, appFlag, nameFlag, envFlag)
 	return cmd
 }
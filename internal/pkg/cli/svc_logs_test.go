@@ -9,7 +9,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/logging"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 
@@ -39,6 +42,8 @@ func TestSvcLogs_Validate(t *testing.T) {
 		inputStartTime string
 		inputEndTime   string
 		inputSince     time.Duration
+		inputTaskIDs   []string
+		inputPrevious  bool
 
 		mockstore func(m *mocks.Mockstore)
 
@@ -74,6 +79,22 @@ func TestSvcLogs_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("only one of --follow or --end-time may be used"),
 		},
+		"returns error if follow and previous flags are set together": {
+			inputFollow:   true,
+			inputPrevious: true,
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --follow or --previous may be used"),
+		},
+		"returns error if tasks and previous flags are set together": {
+			inputTaskIDs:  []string{"mockTaskID"},
+			inputPrevious: true,
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --tasks or --previous may be used"),
+		},
 		"returns error if invalid start time flag value": {
 			inputStartTime: mockBadStartTime,
 
@@ -129,6 +150,8 @@ func TestSvcLogs_Validate(t *testing.T) {
 					since:          tc.inputSince,
 					name:           tc.inputSvc,
 					appName:        tc.inputApp,
+					taskIDs:        tc.inputTaskIDs,
+					previous:       tc.inputPrevious,
 				},
 				wkldLogOpts: wkldLogOpts{
 					configStore: mockstore,
@@ -266,8 +289,10 @@ func TestSvcLogs_Execute(t *testing.T) {
 		endTime   int64
 		startTime int64
 		taskIDs   []string
+		previous  bool
 
-		mocklogsSvc func(ctrl *gomock.Controller) logEventsWriter
+		mocklogsSvc      func(ctrl *gomock.Controller) logEventsWriter
+		mockSvcDescriber func(m *mocks.MockserviceDescriber)
 
 		wantedError error
 	}{
@@ -329,6 +354,59 @@ func TestSvcLogs_Execute(t *testing.T) {
 
 			wantedError: fmt.Errorf("write log events for service mockSvc: some error"),
 		},
+		"returns error if fail to describe the service for the previous task": {
+			inputSvc: "mockSvc",
+			previous: true,
+
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				return mocks.NewMocklogEventsWriter(ctrl)
+			},
+			mockSvcDescriber: func(m *mocks.MockserviceDescriber) {
+				m.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("describe ECS service for mockSvc in environment mockEnv: some error"),
+		},
+		"returns error if there is no stopped task": {
+			inputSvc: "mockSvc",
+			previous: true,
+
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				return mocks.NewMocklogEventsWriter(ctrl)
+			},
+			mockSvcDescriber: func(m *mocks.MockserviceDescriber) {
+				m.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{}, nil)
+			},
+
+			wantedError: fmt.Errorf("found no stopped task for service mockSvc in environment mockEnv"),
+		},
+		"writes logs of the most recently stopped task": {
+			inputSvc: "mockSvc",
+			previous: true,
+
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteLogEvents(gomock.Any()).Do(func(param logging.WriteLogEventsOpts) {
+					require.Equal(t, []string{"latestTaskID"}, param.TaskIDs)
+				}).Return(nil)
+				return m
+			},
+			mockSvcDescriber: func(m *mocks.MockserviceDescriber) {
+				m.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+					StoppedTasks: []*awsecs.Task{
+						{
+							TaskArn:   aws.String("arn:aws:ecs:us-west-2:123456789:task/mockCluster/olderTaskID"),
+							StoppedAt: aws.Time(time.Unix(1000, 0)),
+						},
+						{
+							TaskArn:       aws.String("arn:aws:ecs:us-west-2:123456789:task/mockCluster/latestTaskID"),
+							StoppedAt:     aws.Time(time.Unix(2000, 0)),
+							StoppedReason: aws.String("Essential container in task exited"),
+						},
+					},
+				}, nil)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -336,12 +414,20 @@ func TestSvcLogs_Execute(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
+			mockSvcDescriber := mocks.NewMockserviceDescriber(ctrl)
+			if tc.mockSvcDescriber != nil {
+				tc.mockSvcDescriber(mockSvcDescriber)
+			}
+
 			svcLogs := &svcLogsOpts{
 				wkldLogsVars: wkldLogsVars{
-					name:    tc.inputSvc,
-					follow:  tc.follow,
-					limit:   tc.limit,
-					taskIDs: tc.taskIDs,
+					name:     tc.inputSvc,
+					appName:  "mockApp",
+					envName:  "mockEnv",
+					follow:   tc.follow,
+					limit:    tc.limit,
+					taskIDs:  tc.taskIDs,
+					previous: tc.previous,
 				},
 				wkldLogOpts: wkldLogOpts{
 					startTime:   &tc.startTime,
@@ -349,6 +435,7 @@ func TestSvcLogs_Execute(t *testing.T) {
 					initLogsSvc: func() error { return nil },
 					logsSvc:     tc.mocklogsSvc(ctrl),
 				},
+				svcDescriber: mockSvcDescriber,
 			}
 
 			// WHEN
@@ -57,20 +57,20 @@ type packageSvcOpts struct {
 	packageSvcVars
 
 	// Interfaces to interact with dependencies.
-	addonsClient      templater
-	initAddonsClient  func(*packageSvcOpts) error // Overridden in tests.
-	ws                wsSvcReader
-	store             store
-	appCFN            appResourcesGetter
-	stackWriter       io.Writer
-	paramsWriter      io.Writer
-	addonsWriter      io.Writer
-	fs                afero.Fs
-	runner            runner
-	sel               wsSelector
-	prompt            prompter
-	stackSerializer   func(mft interface{}, env *config.Environment, app *config.Application, rc stack.RuntimeConfig) (stackSerializer, error)
-	newEndpointGetter func(app, env string) (endpointGetter, error)
+	addonsClient     templater
+	initAddonsClient func(*packageSvcOpts) error // Overridden in tests.
+	ws               wsSvcReader
+	store            store
+	appCFN           appResourcesGetter
+	stackWriter      io.Writer
+	paramsWriter     io.Writer
+	addonsWriter     io.Writer
+	fs               afero.Fs
+	runner           runner
+	sel              wsSelector
+	prompt           prompter
+	stackSerializer  func(mft interface{}, env *config.Environment, app *config.Application, rc stack.RuntimeConfig) (stackSerializer, error)
+	newEnvDescriber  func(app, env string) (workloadEnvDescriber, error)
 }
 
 func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
@@ -118,6 +118,11 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 				if err != nil {
 					return nil, fmt.Errorf("init https load balanced web service stack serializer: %w", err)
 				}
+			} else if env.HasImportedCerts() {
+				serializer, err = stack.NewImportedCertsLoadBalancedWebService(t, env.Name, app.Name, rc)
+				if err != nil {
+					return nil, fmt.Errorf("init https load balanced web service stack serializer: %w", err)
+				}
 			} else {
 				serializer, err = stack.NewLoadBalancedWebService(t, env.Name, app.Name, rc)
 				if err != nil {
@@ -139,7 +144,7 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 		}
 		return serializer, nil
 	}
-	opts.newEndpointGetter = func(app, env string) (endpointGetter, error) {
+	opts.newEnvDescriber = func(app, env string) (workloadEnvDescriber, error) {
 		d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 			App:         app,
 			Env:         env,
@@ -292,17 +297,31 @@ func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTempla
 	if err != nil {
 		return nil, err
 	}
-	endpointGetter, err := o.newEndpointGetter(o.appName, o.envName)
+	envDescriber, err := o.newEnvDescriber(o.appName, o.envName)
 	if err != nil {
 		return nil, err
 	}
-	endpoint, err := endpointGetter.ServiceDiscoveryEndpoint()
+	endpoint, err := envDescriber.ServiceDiscoveryEndpoint()
 	if err != nil {
 		return nil, err
 	}
 	rc := stack.RuntimeConfig{
 		AdditionalTags:           app.Tags,
 		ServiceDiscoveryEndpoint: endpoint,
+		EnvCapacityProvider:      envCapacityProvider(env),
+		EnvPublicSubnetCount:     env.PublicSubnetCount(),
+	}
+	switch t := envMft.(type) {
+	case *manifest.LoadBalancedWebService:
+		if rc.RulePriority, err = rulePriority(envDescriber, o.envName, o.name, aws.StringValue(t.Path), publicListeners); err != nil {
+			return nil, err
+		}
+	case *manifest.BackendService:
+		if t.HTTP != nil {
+			if rc.RulePriority, err = rulePriority(envDescriber, o.envName, o.name, aws.StringValue(t.HTTP.Path), internalListeners); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	if imgNeedsBuild {
@@ -444,5 +463,6 @@ func buildSvcPackageCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVar(&vars.tag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringVar(&vars.outputDir, stackOutputDirFlag, "", stackOutputDirFlagDescription)
+	markPromptedFlags(cmd, nameFlag, envFlag)
 	return cmd
 }
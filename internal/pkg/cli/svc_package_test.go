@@ -289,10 +289,11 @@ count: 1`), nil)
 					mockStackSerializer.EXPECT().SerializedParameters().Return("myparams", nil)
 					return mockStackSerializer, nil
 				}
-				opts.newEndpointGetter = func(app, env string) (endpointGetter, error) {
-					mockendpointGetter := mocks.NewMockendpointGetter(ctrl)
-					mockendpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return(fmt.Sprintf("%s.%s.local", env, app), nil)
-					return mockendpointGetter, nil
+				opts.newEnvDescriber = func(app, env string) (workloadEnvDescriber, error) {
+					mockEnvDescriber := mocks.NewMockworkloadEnvDescriber(ctrl)
+					mockEnvDescriber.EXPECT().ServiceDiscoveryEndpoint().Return(fmt.Sprintf("%s.%s.local", env, app), nil)
+					mockEnvDescriber.EXPECT().LoadBalancerRules().Return(nil, nil)
+					return mockEnvDescriber, nil
 				}
 			},
 
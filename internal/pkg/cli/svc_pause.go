@@ -30,6 +30,7 @@ const (
 	fmtsvcPauseFailed        = "Failed to pause service %s in environment %s.\n"
 	fmtSvcPauseSucceed       = "Paused service %s in environment %s.\n"
 	fmtSvcPauseConfirmPrompt = "Are you sure you want to stop processing requests for service %s?"
+	fmtSvcAlreadyPaused      = "Service %s in environment %s is already paused, no changes made.\n"
 )
 
 type svcPauseVars struct {
@@ -183,16 +184,29 @@ func (o *svcPauseOpts) Execute() error {
 	if err := o.initSvcPause(); err != nil {
 		return err
 	}
+	svc, err := o.client.DescribeService(o.svcARN)
+	if err != nil {
+		return fmt.Errorf("get status of service %s: %w", o.svcName, err)
+	}
+	if svc.Status == apprunner.ServiceStatusPaused {
+		log.Infof(fmtSvcAlreadyPaused, o.svcName, o.envName)
+		return nil
+	}
 
 	log.Warningln("Your service will be unavailable while paused. You can resume the service once the pause operation is complete.")
 	o.prog.Start(fmt.Sprintf(fmtSvcPauseStart, o.svcName, o.envName))
 
-	err := o.client.PauseService(o.svcARN)
+	err = o.client.PauseService(o.svcARN)
 	if err != nil {
 		o.prog.Stop(log.Serrorf(fmtsvcPauseFailed, o.svcName, o.envName))
 		return err
 	}
 	o.prog.Stop(log.Ssuccessf(fmtSvcPauseSucceed, o.svcName, o.envName))
+	svc, err = o.client.DescribeService(o.svcARN)
+	if err != nil {
+		return fmt.Errorf("get status of service %s: %w", o.svcName, err)
+	}
+	log.Infof(fmtAppRunnerSvcStatus, o.svcName, o.envName, svc.Status)
 	return nil
 }
 
@@ -239,5 +253,6 @@ func buildSvcPauseCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	markPromptedFlags(cmd, appFlag, nameFlag, envFlag, yesFlag)
 	return cmd
 }
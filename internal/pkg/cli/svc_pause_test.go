@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -249,8 +250,21 @@ func TestSvcPause_Execute(t *testing.T) {
 		mocking     func(t *testing.T, mockPauser *mocks.MockservicePauser, mockProgress *mocks.Mockprogress)
 		wantedError error
 	}{
+		"errors if failed to get the status of the service": {
+			mocking: func(t *testing.T, mockPauser *mocks.MockservicePauser, mockProgress *mocks.Mockprogress) {
+				mockPauser.EXPECT().DescribeService("mock-svc-arn").Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("get status of service mock-svc: some error"),
+		},
+		"no-op if the service is already paused": {
+			mocking: func(t *testing.T, mockPauser *mocks.MockservicePauser, mockProgress *mocks.Mockprogress) {
+				mockPauser.EXPECT().DescribeService("mock-svc-arn").Return(&apprunner.Service{Status: "PAUSED"}, nil)
+				mockPauser.EXPECT().PauseService(gomock.Any()).Times(0)
+			},
+		},
 		"errors if failed to pause the service": {
 			mocking: func(t *testing.T, mockPauser *mocks.MockservicePauser, mockProgress *mocks.Mockprogress) {
+				mockPauser.EXPECT().DescribeService("mock-svc-arn").Return(&apprunner.Service{Status: "RUNNING"}, nil)
 				mockProgress.EXPECT().Start("Pausing service mock-svc in environment mock-env.")
 				mockPauser.EXPECT().PauseService("mock-svc-arn").Return(mockError)
 				mockProgress.EXPECT().Stop(log.Serrorf("Failed to pause service mock-svc in environment mock-env.\n"))
@@ -259,9 +273,13 @@ func TestSvcPause_Execute(t *testing.T) {
 		},
 		"success": {
 			mocking: func(t *testing.T, mockPauser *mocks.MockservicePauser, mockProgress *mocks.Mockprogress) {
-				mockProgress.EXPECT().Start("Pausing service mock-svc in environment mock-env.")
-				mockPauser.EXPECT().PauseService("mock-svc-arn").Return(nil)
-				mockProgress.EXPECT().Stop(log.Ssuccessf("Paused service mock-svc in environment mock-env.\n"))
+				gomock.InOrder(
+					mockPauser.EXPECT().DescribeService("mock-svc-arn").Return(&apprunner.Service{Status: "RUNNING"}, nil),
+					mockProgress.EXPECT().Start("Pausing service mock-svc in environment mock-env."),
+					mockPauser.EXPECT().PauseService("mock-svc-arn").Return(nil),
+					mockProgress.EXPECT().Stop(log.Ssuccessf("Paused service mock-svc in environment mock-env.\n")),
+					mockPauser.EXPECT().DescribeService("mock-svc-arn").Return(&apprunner.Service{Status: "PAUSED"}, nil),
+				)
 			},
 		},
 	}
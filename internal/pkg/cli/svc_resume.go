@@ -24,9 +24,10 @@ const (
 	svcResumeSvcNamePrompt     = "Which service of %s would you like to resume?"
 	svcResumeSvcNameHelpPrompt = "The selected service will be resumed."
 
-	fmtSvcResumeStarted = "Resuming service %s in environment %s."
-	fmtSvcResumeFailed  = "Failed to resume service %s in environment %s: %v\n"
-	fmtSvcResumeSuccess = "Resumed service %s in environment %s.\n"
+	fmtSvcResumeStarted  = "Resuming service %s in environment %s."
+	fmtSvcResumeFailed   = "Failed to resume service %s in environment %s: %v\n"
+	fmtSvcResumeSuccess  = "Resumed service %s in environment %s.\n"
+	fmtSvcAlreadyRunning = "Service %s in environment %s is already running, no changes made.\n"
 )
 
 type resumeSvcVars struct {
@@ -87,6 +88,14 @@ func (o *resumeSvcOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	svc, err := o.serviceResumer.DescribeService(svcARN)
+	if err != nil {
+		return fmt.Errorf("get status of service %s: %w", o.svcName, err)
+	}
+	if svc.Status == apprunner.ServiceStatusRunning {
+		log.Infof(fmtSvcAlreadyRunning, o.svcName, o.envName)
+		return nil
+	}
 
 	o.spinner.Start(fmt.Sprintf(fmtSvcResumeStarted, o.svcName, o.envName))
 	if err := o.serviceResumer.ResumeService(svcARN); err != nil {
@@ -94,6 +103,11 @@ func (o *resumeSvcOpts) Execute() error {
 		return err
 	}
 	o.spinner.Stop(log.Ssuccessf(fmtSvcResumeSuccess, o.svcName, o.envName))
+	svc, err = o.serviceResumer.DescribeService(svcARN)
+	if err != nil {
+		return fmt.Errorf("get status of service %s: %w", o.svcName, err)
+	}
+	log.Infof(fmtAppRunnerSvcStatus, o.svcName, o.envName, svc.Status)
 	return nil
 }
 
@@ -236,5 +250,6 @@ func buildSvcResumeCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	markPromptedFlags(cmd, appFlag, nameFlag, envFlag)
 	return cmd
 }
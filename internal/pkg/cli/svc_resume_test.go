@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -272,13 +273,35 @@ func TestResumeSvcOpts_Execute(t *testing.T) {
 			setupMocks: func(m *resumeSvcMocks) {
 				m.apprunnerDescriber.EXPECT().ServiceARN().Return(testSvcARN, nil)
 				gomock.InOrder(
+					m.serviceResumer.EXPECT().DescribeService(testSvcARN).Return(&apprunner.Service{Status: "PAUSED"}, nil),
 					m.spinner.EXPECT().Start("Resuming service phonetool in environment test."),
 					m.serviceResumer.EXPECT().ResumeService(testSvcARN).Return(nil),
 					m.spinner.EXPECT().Stop(log.Ssuccessf("Resumed service phonetool in environment test.\n")),
+					m.serviceResumer.EXPECT().DescribeService(testSvcARN).Return(&apprunner.Service{Status: "RUNNING"}, nil),
 				)
 			},
 			wantedError: nil,
 		},
+		"no-op if the service is already running": {
+			appName: testAppName,
+			envName: testEnvName,
+			svcName: testSvcName,
+			setupMocks: func(m *resumeSvcMocks) {
+				m.apprunnerDescriber.EXPECT().ServiceARN().Return(testSvcARN, nil)
+				m.serviceResumer.EXPECT().DescribeService(testSvcARN).Return(&apprunner.Service{Status: "RUNNING"}, nil)
+				m.serviceResumer.EXPECT().ResumeService(gomock.Any()).Times(0)
+			},
+		},
+		"return error if fails to get the status of the service": {
+			appName: testAppName,
+			envName: testEnvName,
+			svcName: testSvcName,
+			setupMocks: func(m *resumeSvcMocks) {
+				m.apprunnerDescriber.EXPECT().ServiceARN().Return(testSvcARN, nil)
+				m.serviceResumer.EXPECT().DescribeService(testSvcARN).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("get status of service phonetool: mockError"),
+		},
 		"return error if fails to retrieve service ARN": {
 			appName: testAppName,
 			envName: testEnvName,
@@ -295,6 +318,7 @@ func TestResumeSvcOpts_Execute(t *testing.T) {
 			setupMocks: func(m *resumeSvcMocks) {
 				m.apprunnerDescriber.EXPECT().ServiceARN().Return(testSvcARN, nil)
 				gomock.InOrder(
+					m.serviceResumer.EXPECT().DescribeService(testSvcARN).Return(&apprunner.Service{Status: "PAUSED"}, nil),
 					m.spinner.EXPECT().Start("Resuming service phonetool in environment test."),
 					m.serviceResumer.EXPECT().ResumeService(testSvcARN).Return(mockError),
 					m.spinner.EXPECT().Stop(log.Serrorf("Failed to resume service phonetool in environment test: mockError\n")),
@@ -23,9 +23,22 @@ const (
 	svcShowSvcNameHelpPrompt = "The details of a service will be shown (e.g., endpoint URL, CPU, Memory)."
 )
 
+const (
+	svcShowEventsLimitMin     = 1
+	svcShowEventsLimitMax     = 100
+	svcShowEventsLimitDefault = 10
+)
+
 type showSvcVars struct {
 	shouldOutputJSON      bool
 	shouldOutputResources bool
+	shouldOutputMetrics   bool
+	shouldOutputTasks     bool
+	shouldOutputSGs       bool
+	shouldOutputDeps      bool
+	shouldOutputOutputs   bool
+	eventsLimit           int
+	format                string
 	appName               string
 	svcName               string
 }
@@ -38,13 +51,17 @@ type showSvcOpts struct {
 	describer     describer
 	sel           configSelector
 	initDescriber func() error // Overridden in tests.
+
+	deployStore         deployedEnvironmentLister
+	newOutputsDescriber func(env string) (svcOutputsDescriber, error)
 }
 
 func newShowSvcOpts(vars showSvcVars) (*showSvcOpts, error) {
-	ssmStore, err := config.NewStore()
+	store, err := config.NewStore()
 	if err != nil {
 		return nil, fmt.Errorf("connect to config store: %w", err)
 	}
+	ssmStore := config.NewCachedStore(store)
 	deployStore, err := deploy.NewStore(ssmStore)
 	if err != nil {
 		return nil, fmt.Errorf("connect to deploy store: %w", err)
@@ -55,48 +72,26 @@ func newShowSvcOpts(vars showSvcVars) (*showSvcOpts, error) {
 		store:       ssmStore,
 		w:           log.OutputWriter,
 		sel:         selector.NewConfigSelect(prompt.New(), ssmStore),
+		deployStore: deployStore,
+	}
+	opts.newOutputsDescriber = func(env string) (svcOutputsDescriber, error) {
+		d, err := describe.NewServiceDescriber(describe.NewServiceConfig{
+			App:         opts.appName,
+			Env:         env,
+			Svc:         opts.svcName,
+			ConfigStore: ssmStore,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("creating describer for service %s in environment %s: %w", opts.svcName, env, err)
+		}
+		return d, nil
 	}
 	opts.initDescriber = func() error {
-		var d describer
 		svc, err := opts.store.GetService(opts.appName, opts.svcName)
 		if err != nil {
 			return err
 		}
-		switch svc.Type {
-		case manifest.LoadBalancedWebServiceType:
-			d, err = describe.NewLBWebServiceDescriber(describe.NewLBWebServiceConfig{
-				NewServiceConfig: describe.NewServiceConfig{
-					App:         opts.appName,
-					Svc:         opts.svcName,
-					ConfigStore: ssmStore,
-				},
-				DeployStore:     deployStore,
-				EnableResources: opts.shouldOutputResources,
-			})
-		case manifest.RequestDrivenWebServiceType:
-			d, err = describe.NewRDWebServiceDescriber(describe.NewRDWebServiceConfig{
-				NewServiceConfig: describe.NewServiceConfig{
-					App:         opts.appName,
-					Svc:         opts.svcName,
-					ConfigStore: ssmStore,
-				},
-				DeployStore:     deployStore,
-				EnableResources: opts.shouldOutputResources,
-			})
-		case manifest.BackendServiceType:
-			d, err = describe.NewBackendServiceDescriber(describe.NewBackendServiceConfig{
-				NewServiceConfig: describe.NewServiceConfig{
-					App:         opts.appName,
-					Svc:         opts.svcName,
-					ConfigStore: ssmStore,
-				},
-				DeployStore:     deployStore,
-				EnableResources: opts.shouldOutputResources,
-			})
-		default:
-			return fmt.Errorf("invalid service type %s", svc.Type)
-		}
-
+		d, err := newServiceDescriber(svc, ssmStore, deployStore, opts.showSvcVars)
 		if err != nil {
 			return fmt.Errorf("creating describer for service %s in application %s: %w", opts.svcName, opts.appName, err)
 		}
@@ -106,6 +101,57 @@ func newShowSvcOpts(vars showSvcVars) (*showSvcOpts, error) {
 	return opts, nil
 }
 
+// newServiceDescriber returns a describer for the service based on its type.
+// The sections to describe are selected by the fields of vars.
+func newServiceDescriber(svc *config.Workload, configStore describe.ConfigStoreSvc, deployStore describe.DeployedEnvServicesLister, vars showSvcVars) (describer, error) {
+	cfg := describe.NewServiceConfig{
+		App:         svc.App,
+		Svc:         svc.Name,
+		ConfigStore: configStore,
+	}
+	log.Verbosef("Using the %s describer for service %s\n", svc.Type, svc.Name)
+	switch svc.Type {
+	case manifest.LoadBalancedWebServiceType:
+		return describe.NewLBWebServiceDescriber(describe.NewLBWebServiceConfig{
+			NewServiceConfig: cfg,
+			DeployStore:      deployStore,
+			EnableResources:  vars.shouldOutputResources,
+			EnableMetrics:    vars.shouldOutputMetrics,
+			EnableTasks:      vars.shouldOutputTasks,
+			EnableSGs:        vars.shouldOutputSGs,
+			EnableDeps:       vars.shouldOutputDeps,
+			EventsLimit:      vars.eventsLimit,
+		})
+	case manifest.RequestDrivenWebServiceType:
+		if vars.shouldOutputTasks {
+			return nil, fmt.Errorf("--%s is not supported for a %s because it doesn't run ECS tasks", tasksFlag, manifest.RequestDrivenWebServiceType)
+		}
+		if vars.shouldOutputSGs {
+			return nil, fmt.Errorf("--%s is not supported for a %s because it doesn't run ECS tasks", securityGroupsFlag, manifest.RequestDrivenWebServiceType)
+		}
+		return describe.NewRDWebServiceDescriber(describe.NewRDWebServiceConfig{
+			NewServiceConfig: cfg,
+			DeployStore:      deployStore,
+			EnableResources:  vars.shouldOutputResources,
+			EnableDeps:       vars.shouldOutputDeps,
+			EventsLimit:      vars.eventsLimit,
+		})
+	case manifest.BackendServiceType:
+		return describe.NewBackendServiceDescriber(describe.NewBackendServiceConfig{
+			NewServiceConfig: cfg,
+			DeployStore:      deployStore,
+			EnableResources:  vars.shouldOutputResources,
+			EnableMetrics:    vars.shouldOutputMetrics,
+			EnableTasks:      vars.shouldOutputTasks,
+			EnableSGs:        vars.shouldOutputSGs,
+			EnableDeps:       vars.shouldOutputDeps,
+			EventsLimit:      vars.eventsLimit,
+		})
+	default:
+		return nil, fmt.Errorf("invalid service type %s", svc.Type)
+	}
+}
+
 // Validate returns an error if the values provided by the user are invalid.
 func (o *showSvcOpts) Validate() error {
 	if o.appName != "" {
@@ -118,6 +164,36 @@ func (o *showSvcOpts) Validate() error {
 			return err
 		}
 	}
+	if o.eventsLimit < svcShowEventsLimitMin || o.eventsLimit > svcShowEventsLimitMax {
+		return fmt.Errorf("--%s %d is out-of-bounds, value must be between %d and %d", eventsLimitFlag, o.eventsLimit, svcShowEventsLimitMin, svcShowEventsLimitMax)
+	}
+	if o.format != "" {
+		if o.shouldOutputJSON {
+			return fmt.Errorf("--%s and --%s cannot be specified together", jsonFlag, formatFlag)
+		}
+		if _, err := parseFormatTemplate(o.format); err != nil {
+			return err
+		}
+	}
+	if o.shouldOutputOutputs {
+		// The stack outputs are printed on their own as JSON, without the description of the service.
+		others := []struct {
+			flag string
+			set  bool
+		}{
+			{formatFlag, o.format != ""},
+			{resourcesFlag, o.shouldOutputResources},
+			{includeMetricsFlag, o.shouldOutputMetrics},
+			{tasksFlag, o.shouldOutputTasks},
+			{securityGroupsFlag, o.shouldOutputSGs},
+			{dependenciesFlag, o.shouldOutputDeps},
+		}
+		for _, other := range others {
+			if other.set {
+				return fmt.Errorf("--%s and --%s cannot be specified together", outputsFlag, other.flag)
+			}
+		}
+	}
 
 	return nil
 }
@@ -135,6 +211,9 @@ func (o *showSvcOpts) Execute() error {
 	if o.svcName == "" {
 		return nil
 	}
+	if o.shouldOutputOutputs {
+		return o.showStackOutputs()
+	}
 	if err := o.initDescriber(); err != nil {
 		return err
 	}
@@ -143,6 +222,9 @@ func (o *showSvcOpts) Execute() error {
 		return fmt.Errorf("describe service %s: %w", o.svcName, err)
 	}
 
+	if o.format != "" {
+		return writeFormatted(o.w, o.format, svc)
+	}
 	if o.shouldOutputJSON {
 		data, err := svc.JSONString()
 		if err != nil {
@@ -156,6 +238,33 @@ func (o *showSvcOpts) Execute() error {
 	return nil
 }
 
+// showStackOutputs writes the outputs of the service stack in each environment where the service is deployed
+// as a JSON map keyed by environment name.
+func (o *showSvcOpts) showStackOutputs() error {
+	envs, err := o.deployStore.ListEnvironmentsDeployedTo(o.appName, o.svcName)
+	if err != nil {
+		return fmt.Errorf("list environments where service %s is deployed: %w", o.svcName, err)
+	}
+	outputs := make(map[string]map[string]string, len(envs))
+	for _, env := range envs {
+		d, err := o.newOutputsDescriber(env)
+		if err != nil {
+			return err
+		}
+		out, err := d.Outputs()
+		if err != nil {
+			return fmt.Errorf("get the stack outputs of service %s in environment %s: %w", o.svcName, env, err)
+		}
+		outputs[env] = out
+	}
+	data, err := marshalStackOutputs(outputs)
+	if err != nil {
+		return fmt.Errorf("marshal the stack outputs of service %s: %w", o.svcName, err)
+	}
+	fmt.Fprintf(o.w, "%s\n", data)
+	return nil
+}
+
 func (o *showSvcOpts) askApp() error {
 	if o.appName != "" {
 		return nil
@@ -193,7 +302,28 @@ func buildSvcShowCmd() *cobra.Command {
 
 		Example: `
   Shows info about the service "my-svc"
-  /code $ copilot svc show -n my-svc`,
+  /code $ copilot svc show -n my-svc
+
+  Shows info about the service "my-svc" with its 25 most recent stack events per environment
+  /code $ copilot svc show -n my-svc --events-limit 25
+
+  Shows info about the service "my-svc" with links to its CloudWatch metrics per environment
+  /code $ copilot svc show -n my-svc --include-metrics
+
+  Shows the private IPs and network interfaces of the running tasks of the service "my-svc" per environment
+  /code $ copilot svc show -n my-svc --tasks
+
+  Shows the security group rules of the service "my-svc" and whether they allow traffic from the load balancer and other services
+  /code $ copilot svc show -n my-svc --security-groups
+
+  Shows the tables, buckets, and database clusters created by the addons of the service "my-svc" per environment
+  /code $ copilot svc show -n my-svc --dependencies
+
+  Shows the outputs of the CloudFormation stacks of the service "my-svc", such as its ARN, per environment as JSON
+  /code $ copilot svc show -n my-svc --outputs
+
+  Shows the environments where the service "my-svc" is deployed
+  /code $ copilot svc show -n my-svc --format '{{range .Configurations}}{{.Environment}}{{"\n"}}{{end}}'`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newShowSvcOpts(vars)
 			if err != nil {
@@ -212,5 +342,13 @@ func buildSvcShowCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputResources, resourcesFlag, false, svcResourcesFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputMetrics, includeMetricsFlag, false, svcIncludeMetricsFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputTasks, tasksFlag, false, svcShowTasksFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputSGs, securityGroupsFlag, false, svcShowSGsFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputDeps, dependenciesFlag, false, svcShowDepsFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputOutputs, outputsFlag, false, svcOutputsFlagDescription)
+	cmd.Flags().IntVar(&vars.eventsLimit, eventsLimitFlag, svcShowEventsLimitDefault, svcEventsLimitFlagDescription)
+	cmd.Flags().StringVar(&vars.format, formatFlag, "", svcFormatFlagDescription)
+	markPromptedFlags(cmd, appFlag, nameFlag)
 	return cmd
 }
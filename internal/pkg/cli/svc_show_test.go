@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/golang/mock/gomock"
@@ -16,10 +17,12 @@ import (
 )
 
 type showSvcMocks struct {
-	storeSvc  *mocks.Mockstore
-	describer *mocks.Mockdescriber
-	ws        *mocks.MockwsSvcReader
-	sel       *mocks.MockconfigSelector
+	storeSvc    *mocks.Mockstore
+	describer   *mocks.Mockdescriber
+	ws          *mocks.MockwsSvcReader
+	sel         *mocks.MockconfigSelector
+	deployStore *mocks.MockdeployedEnvironmentLister
+	outputs     *mocks.MocksvcOutputsDescriber
 }
 
 type mockDescribeData struct {
@@ -37,9 +40,14 @@ func (m *mockDescribeData) JSONString() (string, error) {
 
 func TestSvcShow_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inputApp   string
-		inputSvc   string
-		setupMocks func(mocks showSvcMocks)
+		inputApp         string
+		inputSvc         string
+		inputEventsLimit *int
+		inputJSON        bool
+		inputFormat      string
+		inputOutputs     bool
+		inputResources   bool
+		setupMocks       func(mocks showSvcMocks)
 
 		wantedError error
 	}{
@@ -85,6 +93,49 @@ func TestSvcShow_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("some error"),
 		},
+		"events limit out of bounds": {
+			inputEventsLimit: aws.Int(101),
+
+			setupMocks: func(m showSvcMocks) {},
+
+			wantedError: fmt.Errorf("--events-limit 101 is out-of-bounds, value must be between 1 and 100"),
+		},
+		"events limit of zero": {
+			inputEventsLimit: aws.Int(0),
+
+			setupMocks: func(m showSvcMocks) {},
+
+			wantedError: fmt.Errorf("--events-limit 0 is out-of-bounds, value must be between 1 and 100"),
+		},
+		"format with json": {
+			inputJSON:   true,
+			inputFormat: "{{.Service}}",
+
+			setupMocks: func(m showSvcMocks) {},
+
+			wantedError: fmt.Errorf("--json and --format cannot be specified together"),
+		},
+		"format template does not compile": {
+			inputFormat: "{{.Service",
+
+			setupMocks: func(m showSvcMocks) {},
+
+			wantedError: fmt.Errorf("parse --format template: template: format:1: unclosed action"),
+		},
+		"outputs with json": {
+			inputOutputs: true,
+			inputJSON:    true,
+
+			setupMocks: func(m showSvcMocks) {},
+		},
+		"outputs with resources": {
+			inputOutputs:   true,
+			inputResources: true,
+
+			setupMocks: func(m showSvcMocks) {},
+
+			wantedError: fmt.Errorf("--outputs and --resources cannot be specified together"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -100,10 +151,19 @@ func TestSvcShow_Validate(t *testing.T) {
 
 			tc.setupMocks(mocks)
 
+			eventsLimit := svcShowEventsLimitDefault
+			if tc.inputEventsLimit != nil {
+				eventsLimit = *tc.inputEventsLimit
+			}
 			showSvcs := &showSvcOpts{
 				showSvcVars: showSvcVars{
-					svcName: tc.inputSvc,
-					appName: tc.inputApp,
+					svcName:               tc.inputSvc,
+					appName:               tc.inputApp,
+					eventsLimit:           eventsLimit,
+					shouldOutputJSON:      tc.inputJSON,
+					format:                tc.inputFormat,
+					shouldOutputOutputs:   tc.inputOutputs,
+					shouldOutputResources: tc.inputResources,
 				},
 				store: mockStoreReader,
 			}
@@ -229,8 +289,10 @@ func TestSvcShow_Execute(t *testing.T) {
 		err:  errors.New("some error"),
 	}
 	testCases := map[string]struct {
-		inputSvc         string
-		shouldOutputJSON bool
+		inputSvc            string
+		shouldOutputJSON    bool
+		shouldOutputOutputs bool
+		format              string
 
 		setupMocks func(mocks showSvcMocks)
 
@@ -265,6 +327,18 @@ func TestSvcShow_Execute(t *testing.T) {
 
 			wantedError: fmt.Errorf("some error"),
 		},
+		"success with format": {
+			inputSvc: "my-svc",
+			format:   "{{.HumanString}}",
+
+			setupMocks: func(m showSvcMocks) {
+				gomock.InOrder(
+					m.describer.EXPECT().Describe().Return(&webSvc, nil),
+				)
+			},
+
+			wantedContent: "mockData\n",
+		},
 		"return error if fail to describe service": {
 			inputSvc: "my-svc",
 
@@ -276,6 +350,42 @@ func TestSvcShow_Execute(t *testing.T) {
 
 			wantedError: fmt.Errorf("describe service my-svc: some error"),
 		},
+		"success with stack outputs": {
+			inputSvc:            "my-svc",
+			shouldOutputOutputs: true,
+
+			setupMocks: func(m showSvcMocks) {
+				m.describer.EXPECT().Describe().Times(0)
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("my-app", "my-svc").Return([]string{"test", "prod"}, nil)
+				gomock.InOrder(
+					m.outputs.EXPECT().Outputs().Return(map[string]string{"ServiceArn": "arn:aws:ecs:us-west-2:123456789012:service/test/my-svc"}, nil),
+					m.outputs.EXPECT().Outputs().Return(map[string]string{"ServiceArn": "arn:aws:ecs:us-west-2:123456789012:service/prod/my-svc"}, nil),
+				)
+			},
+
+			wantedContent: `{"schemaVersion":1,"outputs":{"prod":{"ServiceArn":"arn:aws:ecs:us-west-2:123456789012:service/prod/my-svc"},"test":{"ServiceArn":"arn:aws:ecs:us-west-2:123456789012:service/test/my-svc"}}}` + "\n",
+		},
+		"return error if fail to list the environments of the service": {
+			inputSvc:            "my-svc",
+			shouldOutputOutputs: true,
+
+			setupMocks: func(m showSvcMocks) {
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("my-app", "my-svc").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("list environments where service my-svc is deployed: some error"),
+		},
+		"return error if fail to get the stack outputs": {
+			inputSvc:            "my-svc",
+			shouldOutputOutputs: true,
+
+			setupMocks: func(m showSvcMocks) {
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("my-app", "my-svc").Return([]string{"test"}, nil)
+				m.outputs.EXPECT().Outputs().Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("get the stack outputs of service my-svc in environment test: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -285,22 +395,32 @@ func TestSvcShow_Execute(t *testing.T) {
 
 			b := &bytes.Buffer{}
 			mockSvcDescriber := mocks.NewMockdescriber(ctrl)
+			mockDeployStore := mocks.NewMockdeployedEnvironmentLister(ctrl)
+			mockOutputsDescriber := mocks.NewMocksvcOutputsDescriber(ctrl)
 
 			mocks := showSvcMocks{
-				describer: mockSvcDescriber,
+				describer:   mockSvcDescriber,
+				deployStore: mockDeployStore,
+				outputs:     mockOutputsDescriber,
 			}
 
 			tc.setupMocks(mocks)
 
 			showSvcs := &showSvcOpts{
 				showSvcVars: showSvcVars{
-					svcName:          tc.inputSvc,
-					shouldOutputJSON: tc.shouldOutputJSON,
-					appName:          appName,
+					svcName:             tc.inputSvc,
+					shouldOutputJSON:    tc.shouldOutputJSON,
+					shouldOutputOutputs: tc.shouldOutputOutputs,
+					format:              tc.format,
+					appName:             appName,
 				},
 				describer:     mockSvcDescriber,
 				initDescriber: func() error { return nil },
-				w:             b,
+				deployStore:   mockDeployStore,
+				newOutputsDescriber: func(env string) (svcOutputsDescriber, error) {
+					return mockOutputsDescriber, nil
+				},
+				w: b,
 			}
 
 			// WHEN
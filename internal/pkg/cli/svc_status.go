@@ -6,6 +6,9 @@ package cli
 import (
 	"fmt"
 	"io"
+	"os"
+	"os/signal"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -17,6 +20,14 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	svcStatusAlarmHistoryMin = 1
+	svcStatusAlarmHistoryMax = 100
+
+	svcStatusWatchInterval = 5 * time.Second
+	clearScreen            = "\033[H\033[2J" // Moves the cursor to the top left corner and clears the terminal.
+)
+
 const (
 	svcStatusNamePrompt     = "Which service's status would you like to show?"
 	svcStatusNameHelpPrompt = "Displays the service's task status, most recent deployment and alarm statuses."
@@ -24,9 +35,14 @@ const (
 
 type svcStatusVars struct {
 	shouldOutputJSON bool
+	alarmsOnly       bool
+	alarmHistory     int
+	format           string
 	svcName          string
 	envName          string
 	appName          string
+	watch            bool
+	drift            bool
 }
 
 type svcStatusOpts struct {
@@ -35,8 +51,18 @@ type svcStatusOpts struct {
 	w                   io.Writer
 	store               store
 	statusDescriber     statusDescriber
+	alarmDescriber      alarmStatusDescriber
 	sel                 deploySelector
 	initStatusDescriber func(*svcStatusOpts) error
+
+	isTerminal    bool          // True if w is a terminal that can be cleared between the snapshots of a watched status.
+	watchInterval time.Duration // Time to wait before refreshing a watched status.
+	now           func() time.Time
+}
+
+// stableStatus is a service status that knows whether the service converged.
+type stableStatus interface {
+	Stable() (bool, error)
 }
 
 func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
@@ -52,6 +78,9 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 		svcStatusVars: vars,
 		store:         configStore,
 		w:             log.OutputWriter,
+		isTerminal:    isTerminal(os.Stdout),
+		watchInterval: svcStatusWatchInterval,
+		now:           time.Now,
 		sel:           selector.NewDeploySelect(prompt.New(), configStore, deployStore),
 		initStatusDescriber: func(o *svcStatusOpts) error {
 			wkld, err := configStore.GetWorkload(o.appName, o.svcName)
@@ -59,6 +88,16 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 				return fmt.Errorf("retrieve %s from application %s: %w", o.appName, o.svcName, err)
 			}
 			if wkld.Type == manifest.RequestDrivenWebServiceType {
+				if o.alarmsOnly {
+					return fmt.Errorf("--%s is not supported for %s", alarmsOnlyFlag, manifest.RequestDrivenWebServiceType)
+				}
+				if o.alarmHistory != 0 {
+					return fmt.Errorf("--%s is not supported for %s", alarmHistoryFlag, manifest.RequestDrivenWebServiceType)
+				}
+				if o.drift {
+					return fmt.Errorf("--%s is not supported for %s", driftFlag, manifest.RequestDrivenWebServiceType)
+				}
+				log.Verbosef("Using the App Runner status describer for service %s in environment %s\n", o.svcName, o.envName)
 				d, err := describe.NewAppRunnerStatusDescriber(&describe.NewServiceStatusConfig{
 					App:         o.appName,
 					Env:         o.envName,
@@ -70,16 +109,19 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 				}
 				o.statusDescriber = d
 			} else {
+				log.Verbosef("Using the ECS status describer for service %s in environment %s\n", o.svcName, o.envName)
 				d, err := describe.NewECSStatusDescriber(&describe.NewServiceStatusConfig{
 					App:         o.appName,
 					Env:         o.envName,
 					Svc:         o.svcName,
 					ConfigStore: configStore,
+					EnableDrift: o.drift,
 				})
 				if err != nil {
 					return fmt.Errorf("creating status describer for service %s in application %s: %w", o.svcName, o.appName, err)
 				}
 				o.statusDescriber = d
+				o.alarmDescriber = d
 			}
 			return nil
 		},
@@ -88,6 +130,47 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 
 // Validate returns an error if the values provided by the user are invalid.
 func (o *svcStatusOpts) Validate() error {
+	if o.format != "" {
+		if o.shouldOutputJSON {
+			return fmt.Errorf("--%s and --%s cannot be specified together", jsonFlag, formatFlag)
+		}
+		if _, err := parseFormatTemplate(o.format); err != nil {
+			return err
+		}
+	}
+	if o.watch {
+		exclusive := []struct {
+			flag string
+			set  bool
+		}{
+			{jsonFlag, o.shouldOutputJSON},
+			{formatFlag, o.format != ""},
+			{alarmsOnlyFlag, o.alarmsOnly},
+			{alarmHistoryFlag, o.alarmHistory != 0},
+			{driftFlag, o.drift},
+		}
+		for _, f := range exclusive {
+			if f.set {
+				return fmt.Errorf("--%s and --%s cannot be specified together", watchFlag, f.flag)
+			}
+		}
+	}
+	if o.drift {
+		if o.alarmsOnly {
+			return fmt.Errorf("--%s and --%s cannot be specified together", driftFlag, alarmsOnlyFlag)
+		}
+		if o.alarmHistory != 0 {
+			return fmt.Errorf("--%s and --%s cannot be specified together", driftFlag, alarmHistoryFlag)
+		}
+	}
+	if o.alarmHistory != 0 {
+		if o.alarmsOnly {
+			return fmt.Errorf("--%s and --%s cannot be specified together", alarmsOnlyFlag, alarmHistoryFlag)
+		}
+		if o.alarmHistory < svcStatusAlarmHistoryMin || o.alarmHistory > svcStatusAlarmHistoryMax {
+			return fmt.Errorf("--%s %d is out-of-bounds, value must be between %d and %d", alarmHistoryFlag, o.alarmHistory, svcStatusAlarmHistoryMin, svcStatusAlarmHistoryMax)
+		}
+	}
 	if o.appName != "" {
 		if _, err := o.store.GetApplication(o.appName); err != nil {
 			return err
@@ -120,9 +203,28 @@ func (o *svcStatusOpts) Execute() error {
 	if err != nil {
 		return err
 	}
-	svcStatus, err := o.statusDescriber.Describe()
-	if err != nil {
-		return fmt.Errorf("describe status of service %s: %w", o.svcName, err)
+	if o.watch {
+		return o.watchStatus()
+	}
+	var svcStatus describe.HumanJSONStringer
+	if o.alarmHistory != 0 {
+		svcStatus, err = o.alarmDescriber.DescribeAlarmHistory(o.alarmHistory)
+		if err != nil {
+			return fmt.Errorf("describe alarm history of service %s: %w", o.svcName, err)
+		}
+	} else if o.alarmsOnly {
+		svcStatus, err = o.alarmDescriber.DescribeAlarms()
+		if err != nil {
+			return fmt.Errorf("describe alarms of service %s: %w", o.svcName, err)
+		}
+	} else {
+		svcStatus, err = o.statusDescriber.Describe()
+		if err != nil {
+			return fmt.Errorf("describe status of service %s: %w", o.svcName, err)
+		}
+	}
+	if o.format != "" {
+		return writeFormatted(o.w, o.format, svcStatus)
 	}
 	if o.shouldOutputJSON {
 		data, err := svcStatus.JSONString()
@@ -137,6 +239,47 @@ func (o *svcStatusOpts) Execute() error {
 	return nil
 }
 
+// watchStatus renders the status of the service until the service is stable or the user interrupts the command.
+// On a terminal, the screen is cleared before each snapshot. Otherwise, the snapshots are appended to the output.
+// It only returns an error if the service reached a state that it can't recover from.
+func (o *svcStatusOpts) watchStatus() error {
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+	for {
+		svcStatus, err := o.statusDescriber.Describe()
+		if err != nil {
+			return fmt.Errorf("describe status of service %s: %w", o.svcName, err)
+		}
+		if o.isTerminal {
+			fmt.Fprint(o.w, clearScreen)
+		} else {
+			fmt.Fprintf(o.w, "Status at %s\n\n", o.now().Format(time.RFC3339))
+		}
+		fmt.Fprint(o.w, svcStatus.HumanString())
+		status, ok := svcStatus.(stableStatus)
+		if !ok {
+			return nil
+		}
+		stable, err := status.Stable()
+		if err != nil {
+			return fmt.Errorf("service %s in environment %s: %w", o.svcName, o.envName, err)
+		}
+		if stable {
+			log.Successf("Service %s is stable in environment %s.\n", o.svcName, o.envName)
+			return nil
+		}
+		if !o.isTerminal {
+			fmt.Fprintln(o.w)
+		}
+		select {
+		case <-interrupt:
+			return nil
+		case <-time.After(o.watchInterval):
+		}
+	}
+}
+
 func (o *svcStatusOpts) askApp() error {
 	if o.appName != "" {
 		return nil
@@ -169,7 +312,22 @@ func buildSvcStatusCmd() *cobra.Command {
 
 		Example: `
   Shows status of the deployed service "my-svc"
-  /code $ copilot svc status -n my-svc`,
+  /code $ copilot svc status -n my-svc
+
+  Shows only the alarm statuses of the deployed service "my-svc" in the "test" environment
+  /code $ copilot svc status -n my-svc -e test --alarms-only
+
+  Shows the 5 most recent state transitions of each alarm of the service "my-svc"
+  /code $ copilot svc status -n my-svc --alarm-history 5
+
+  Refreshes the status of the service "my-svc" until its deployment is stable
+  /code $ copilot svc status -n my-svc -e test --watch
+
+  Shows the status of the service "my-svc" and the resources that drifted from its stack
+  /code $ copilot svc status -n my-svc -e test --drift
+
+  Shows the ID and status of each running task of the service "my-svc"
+  /code $ copilot svc status -n my-svc --format '{{range .DesiredRunningTasks}}{{.ID}} {{.LastStatus}}{{"\n"}}{{end}}'`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcStatusOpts(vars)
 			if err != nil {
@@ -188,5 +346,11 @@ func buildSvcStatusCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.alarmsOnly, alarmsOnlyFlag, false, svcAlarmsOnlyFlagDescription)
+	cmd.Flags().IntVar(&vars.alarmHistory, alarmHistoryFlag, 0, svcAlarmHistoryFlagDescription)
+	cmd.Flags().StringVar(&vars.format, formatFlag, "", svcFormatFlagDescription)
+	cmd.Flags().BoolVar(&vars.watch, watchFlag, false, svcWatchFlagDescription)
+	cmd.Flags().BoolVar(&vars.drift, driftFlag, false, svcDriftFlagDescription)
+	markPromptedFlags(cmd, appFlag, nameFlag, envFlag)
 	return cmd
 }
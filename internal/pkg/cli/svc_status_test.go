@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -21,10 +22,86 @@ func TestSvcStatus_Validate(t *testing.T) {
 		inputApp         string
 		inputSvc         string
 		inputEnvironment string
+		alarmsOnly       bool
+		alarmHistory     int
+		inputJSON        bool
+		inputFormat      string
+		inputWatch       bool
+		inputDrift       bool
 		mockStoreReader  func(m *mocks.Mockstore)
 
 		wantedError error
 	}{
+		"errors if --alarms-only and --alarm-history are both specified": {
+			alarmsOnly:   true,
+			alarmHistory: 5,
+
+			mockStoreReader: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("--alarms-only and --alarm-history cannot be specified together"),
+		},
+		"errors if --json and --format are both specified": {
+			inputJSON:   true,
+			inputFormat: "{{.Service}}",
+
+			mockStoreReader: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("--json and --format cannot be specified together"),
+		},
+		"errors if --watch and --json are both specified": {
+			inputJSON:  true,
+			inputWatch: true,
+
+			mockStoreReader: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("--watch and --json cannot be specified together"),
+		},
+		"errors if --watch and --alarms-only are both specified": {
+			alarmsOnly: true,
+			inputWatch: true,
+
+			mockStoreReader: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("--watch and --alarms-only cannot be specified together"),
+		},
+		"errors if --watch and --drift are both specified": {
+			inputWatch: true,
+			inputDrift: true,
+
+			mockStoreReader: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("--watch and --drift cannot be specified together"),
+		},
+		"errors if --drift and --alarms-only are both specified": {
+			alarmsOnly: true,
+			inputDrift: true,
+
+			mockStoreReader: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("--drift and --alarms-only cannot be specified together"),
+		},
+		"errors if --drift and --alarm-history are both specified": {
+			alarmHistory: 5,
+			inputDrift:   true,
+
+			mockStoreReader: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("--drift and --alarm-history cannot be specified together"),
+		},
+		"errors if the --format template does not compile": {
+			inputFormat: "{{range .DesiredRunningTasks}}",
+
+			mockStoreReader: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("parse --format template: template: format:1: unexpected EOF"),
+		},
+		"errors if --alarm-history is out of bounds": {
+			alarmHistory: 101,
+
+			mockStoreReader: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("--alarm-history 101 is out-of-bounds, value must be between 1 and 100"),
+		},
 		"invalid app name": {
 			inputApp: "my-app",
 
@@ -89,9 +166,15 @@ func TestSvcStatus_Validate(t *testing.T) {
 
 			svcStatus := &svcStatusOpts{
 				svcStatusVars: svcStatusVars{
-					svcName: tc.inputSvc,
-					envName: tc.inputEnvironment,
-					appName: tc.inputApp,
+					svcName:          tc.inputSvc,
+					envName:          tc.inputEnvironment,
+					appName:          tc.inputApp,
+					alarmsOnly:       tc.alarmsOnly,
+					alarmHistory:     tc.alarmHistory,
+					shouldOutputJSON: tc.inputJSON,
+					format:           tc.inputFormat,
+					watch:            tc.inputWatch,
+					drift:            tc.inputDrift,
 				},
 				store: mockStoreReader,
 			}
@@ -185,14 +268,56 @@ func TestSvcStatus_Execute(t *testing.T) {
 	mockError := errors.New("some error")
 	testCases := map[string]struct {
 		shouldOutputJSON    bool
+		alarmsOnly          bool
+		alarmHistory        int
+		format              string
 		mockStatusDescriber func(m *mocks.MockstatusDescriber)
+		mockAlarmDescriber  func(m *mocks.MockalarmStatusDescriber)
 		wantedError         error
 	}{
 		"errors if failed to describe the status of the service": {
 			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
 				m.EXPECT().Describe().Return(nil, mockError)
 			},
-			wantedError: fmt.Errorf("describe status of service mockSvc: some error"),
+			mockAlarmDescriber: func(m *mocks.MockalarmStatusDescriber) {},
+			wantedError:        fmt.Errorf("describe status of service mockSvc: some error"),
+		},
+		"errors if failed to describe the alarms of the service": {
+			alarmsOnly:          true,
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {},
+			mockAlarmDescriber: func(m *mocks.MockalarmStatusDescriber) {
+				m.EXPECT().DescribeAlarms().Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("describe alarms of service mockSvc: some error"),
+		},
+		"errors if failed to describe the alarm history of the service": {
+			alarmHistory:        5,
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {},
+			mockAlarmDescriber: func(m *mocks.MockalarmStatusDescriber) {
+				m.EXPECT().DescribeAlarmHistory(5).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("describe alarm history of service mockSvc: some error"),
+		},
+		"only describes alarm history with --alarm-history": {
+			alarmHistory:        5,
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {},
+			mockAlarmDescriber: func(m *mocks.MockalarmStatusDescriber) {
+				m.EXPECT().DescribeAlarmHistory(5).Return(&mockDescribeData{data: "mockAlarmHistory"}, nil)
+			},
+		},
+		"writes the output with the --format template": {
+			format: "{{.HumanString}}",
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(&mockDescribeData{data: "mockStatus"}, nil)
+			},
+			mockAlarmDescriber: func(m *mocks.MockalarmStatusDescriber) {},
+		},
+		"only describes alarms with --alarms-only": {
+			alarmsOnly:          true,
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {},
+			mockAlarmDescriber: func(m *mocks.MockalarmStatusDescriber) {
+				m.EXPECT().DescribeAlarms().Return(&mockDescribeData{data: "mockAlarms"}, nil)
+			},
 		},
 	}
 	for name, tc := range testCases {
@@ -203,15 +328,21 @@ func TestSvcStatus_Execute(t *testing.T) {
 			b := &bytes.Buffer{}
 			mockStatusDescriber := mocks.NewMockstatusDescriber(ctrl)
 			tc.mockStatusDescriber(mockStatusDescriber)
+			mockAlarmDescriber := mocks.NewMockalarmStatusDescriber(ctrl)
+			tc.mockAlarmDescriber(mockAlarmDescriber)
 
 			svcStatus := &svcStatusOpts{
 				svcStatusVars: svcStatusVars{
 					svcName:          "mockSvc",
 					envName:          "mockEnv",
 					shouldOutputJSON: tc.shouldOutputJSON,
+					alarmsOnly:       tc.alarmsOnly,
+					alarmHistory:     tc.alarmHistory,
+					format:           tc.format,
 					appName:          "mockApp",
 				},
 				statusDescriber:     mockStatusDescriber,
+				alarmDescriber:      mockAlarmDescriber,
 				initStatusDescriber: func(*svcStatusOpts) error { return nil },
 				w:                   b,
 			}
@@ -229,3 +360,106 @@ func TestSvcStatus_Execute(t *testing.T) {
 		})
 	}
 }
+
+type mockStableStatus struct {
+	data   string
+	stable bool
+	err    error
+}
+
+func (m *mockStableStatus) HumanString() string {
+	return m.data
+}
+
+func (m *mockStableStatus) JSONString() (string, error) {
+	return m.data, nil
+}
+
+func (m *mockStableStatus) Stable() (bool, error) {
+	return m.stable, m.err
+}
+
+func TestSvcStatus_ExecuteWatch(t *testing.T) {
+	testCases := map[string]struct {
+		isTerminal          bool
+		mockStatusDescriber func(m *mocks.MockstatusDescriber)
+
+		wantedOutput string
+		wantedError  error
+	}{
+		"errors if the service fails to converge": {
+			isTerminal: true,
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+				gomock.InOrder(
+					m.EXPECT().Describe().Return(&mockStableStatus{data: "deploying\n"}, nil),
+					m.EXPECT().Describe().Return(&mockStableStatus{data: "failed\n", err: errors.New("deployment ecs-svc/1 failed")}, nil),
+				)
+			},
+			wantedOutput: clearScreen + "deploying\n" + clearScreen + "failed\n",
+			wantedError:  errors.New("service mockSvc in environment mockEnv: deployment ecs-svc/1 failed"),
+		},
+		"clears the terminal between snapshots until the service is stable": {
+			isTerminal: true,
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+				gomock.InOrder(
+					m.EXPECT().Describe().Return(&mockStableStatus{data: "deploying\n"}, nil),
+					m.EXPECT().Describe().Return(&mockStableStatus{data: "stable\n", stable: true}, nil),
+				)
+			},
+			wantedOutput: clearScreen + "deploying\n" + clearScreen + "stable\n",
+		},
+		"appends the snapshots if the output is not a terminal": {
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+				gomock.InOrder(
+					m.EXPECT().Describe().Return(&mockStableStatus{data: "deploying\n"}, nil),
+					m.EXPECT().Describe().Return(&mockStableStatus{data: "stable\n", stable: true}, nil),
+				)
+			},
+			wantedOutput: "Status at 2021-09-01T00:00:00Z\n\ndeploying\n\nStatus at 2021-09-01T00:00:00Z\n\nstable\n",
+		},
+		"stops after one snapshot if the status can't tell whether the service is stable": {
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(&mockDescribeData{data: "status"}, nil)
+			},
+			wantedOutput: "Status at 2021-09-01T00:00:00Z\n\nstatus",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockStatusDescriber := mocks.NewMockstatusDescriber(ctrl)
+			tc.mockStatusDescriber(mockStatusDescriber)
+
+			svcStatus := &svcStatusOpts{
+				svcStatusVars: svcStatusVars{
+					svcName: "mockSvc",
+					envName: "mockEnv",
+					appName: "mockApp",
+					watch:   true,
+				},
+				statusDescriber:     mockStatusDescriber,
+				initStatusDescriber: func(*svcStatusOpts) error { return nil },
+				w:                   b,
+				isTerminal:          tc.isTerminal,
+				now: func() time.Time {
+					return time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+				},
+			}
+
+			// WHEN
+			err := svcStatus.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcValidateNamePrompt     = "Which service would you like to validate?"
+	svcValidateNameHelpPrompt = "The manifest and the addon templates of the service will be checked for errors."
+)
+
+type validateSvcVars struct {
+	name string
+}
+
+type validateSvcOpts struct {
+	validateSvcVars
+
+	ws                  wsSvcReader
+	sel                 wsSelector
+	addonsValidator     addonsValidator
+	initAddonsValidator func() error // Overridden in tests.
+}
+
+func newValidateSvcOpts(vars validateSvcVars) (*validateSvcOpts, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	opts := &validateSvcOpts{
+		validateSvcVars: vars,
+		ws:              ws,
+		sel:             selector.NewWorkspaceSelect(prompt.New(), store, ws),
+	}
+	opts.initAddonsValidator = func() error {
+		addons, err := addon.New(opts.name)
+		if err != nil {
+			return fmt.Errorf("new addons client: %w", err)
+		}
+		opts.addonsValidator = addons
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *validateSvcOpts) Validate() error {
+	if o.name == "" {
+		return nil
+	}
+	names, err := o.ws.ServiceNames()
+	if err != nil {
+		return fmt.Errorf("list services in the workspace: %w", err)
+	}
+	if !contains(o.name, names) {
+		return fmt.Errorf("service '%s' does not exist in the workspace", o.name)
+	}
+	return nil
+}
+
+// Ask prompts the user for any missing required fields.
+func (o *validateSvcOpts) Ask() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.sel.Service(svcValidateNamePrompt, svcValidateNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select service: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute checks that the manifest and the addon templates of the service can be deployed.
+func (o *validateSvcOpts) Execute() error {
+	raw, err := o.ws.ReadServiceManifest(o.name)
+	if err != nil {
+		return fmt.Errorf("read manifest of service %s: %w", o.name, err)
+	}
+	if _, err := manifest.UnmarshalWorkload(raw); err != nil {
+		return fmt.Errorf("unmarshal manifest of service %s: %w", o.name, err)
+	}
+	if err := o.initAddonsValidator(); err != nil {
+		return err
+	}
+	if err := o.addonsValidator.Validate(); err != nil {
+		return fmt.Errorf("validate addons of service %s: %w", o.name, err)
+	}
+	log.Successf("The manifest and addons of service %s are valid.\n", color.HighlightUserInput(o.name))
+	return nil
+}
+
+// buildSvcValidateCmd builds the command for validating the manifest and addons of a service.
+func buildSvcValidateCmd() *cobra.Command {
+	vars := validateSvcVars{}
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Checks the manifest and addon templates of a service for errors.",
+		Long: `Checks the manifest and addon templates of a service for errors.
+Addon templates must be valid YAML, must not conflict with each other,
+and must declare the App, Env, and Name parameters.`,
+		Example: `
+  Validate the manifest and addons of the "frontend" service.
+  /code $ copilot svc validate -n frontend`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newValidateSvcOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	return cmd
+}
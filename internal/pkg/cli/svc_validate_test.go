@@ -0,0 +1,210 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type validateSvcMocks struct {
+	ws     *mocks.MockwsSvcReader
+	sel    *mocks.MockwsSelector
+	addons *mocks.MockaddonsValidator
+}
+
+func TestValidateSvcOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		setupMocks func(m validateSvcMocks)
+
+		wantedErr error
+	}{
+		"skip validation if no name is provided": {
+			setupMocks: func(m validateSvcMocks) {},
+		},
+		"error if the service cannot be listed": {
+			inName: "frontend",
+			setupMocks: func(m validateSvcMocks) {
+				m.ws.EXPECT().ServiceNames().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list services in the workspace: some error"),
+		},
+		"error if the service is not in the workspace": {
+			inName: "frontend",
+			setupMocks: func(m validateSvcMocks) {
+				m.ws.EXPECT().ServiceNames().Return([]string{"backend"}, nil)
+			},
+			wantedErr: errors.New("service 'frontend' does not exist in the workspace"),
+		},
+		"success": {
+			inName: "frontend",
+			setupMocks: func(m validateSvcMocks) {
+				m.ws.EXPECT().ServiceNames().Return([]string{"frontend", "backend"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := validateSvcMocks{
+				ws: mocks.NewMockwsSvcReader(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &validateSvcOpts{
+				validateSvcVars: validateSvcVars{
+					name: tc.inName,
+				},
+				ws: m.ws,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSvcOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		setupMocks func(m validateSvcMocks)
+
+		wantedName string
+		wantedErr  error
+	}{
+		"skip prompting if the name is provided": {
+			inName:     "frontend",
+			setupMocks: func(m validateSvcMocks) {},
+			wantedName: "frontend",
+		},
+		"error if fail to select a service": {
+			setupMocks: func(m validateSvcMocks) {
+				m.sel.EXPECT().Service(svcValidateNamePrompt, svcValidateNameHelpPrompt).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select service: some error"),
+		},
+		"prompt for the service name": {
+			setupMocks: func(m validateSvcMocks) {
+				m.sel.EXPECT().Service(svcValidateNamePrompt, svcValidateNameHelpPrompt).Return("frontend", nil)
+			},
+			wantedName: "frontend",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := validateSvcMocks{
+				sel: mocks.NewMockwsSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &validateSvcOpts{
+				validateSvcVars: validateSvcVars{
+					name: tc.inName,
+				},
+				sel: m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedName, opts.name)
+			}
+		})
+	}
+}
+
+func TestValidateSvcOpts_Execute(t *testing.T) {
+	const mockManifest = `name: frontend
+type: Backend Service
+image:
+  build: ./frontend/Dockerfile
+  port: 80
+`
+	testCases := map[string]struct {
+		setupMocks func(m validateSvcMocks)
+
+		wantedErr error
+	}{
+		"error if fail to read the manifest": {
+			setupMocks: func(m validateSvcMocks) {
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("read manifest of service frontend: some error"),
+		},
+		"error if the manifest cannot be unmarshaled": {
+			setupMocks: func(m validateSvcMocks) {
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte("name: frontend\ntype: Unknown Service"), nil)
+			},
+			wantedErr: errors.New("unmarshal manifest of service frontend: invalid manifest type: Unknown Service"),
+		},
+		"error if the addons are invalid": {
+			setupMocks: func(m validateSvcMocks) {
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(mockManifest), nil)
+				m.addons.EXPECT().Validate().Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("validate addons of service frontend: some error"),
+		},
+		"success": {
+			setupMocks: func(m validateSvcMocks) {
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(mockManifest), nil)
+				m.addons.EXPECT().Validate().Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := validateSvcMocks{
+				ws:     mocks.NewMockwsSvcReader(ctrl),
+				addons: mocks.NewMockaddonsValidator(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &validateSvcOpts{
+				validateSvcVars: validateSvcVars{
+					name: "frontend",
+				},
+				ws: m.ws,
+			}
+			opts.initAddonsValidator = func() error {
+				opts.addonsValidator = m.addons
+				return nil
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
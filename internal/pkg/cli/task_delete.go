@@ -493,5 +493,8 @@ func BuildTaskDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.env, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
 	cmd.Flags().BoolVar(&vars.defaultCluster, taskDefaultFlag, false, taskDeleteDefaultFlagDescription)
+	markPromptedFlag(cmd, appFlag, taskDefaultFlag)
+	markPromptedFlag(cmd, envFlag, taskDefaultFlag)
+	markPromptedFlags(cmd, nameFlag, yesFlag)
 	return cmd
 }
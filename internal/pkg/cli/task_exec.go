@@ -233,5 +233,7 @@ func buildTaskExecCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
 
 	cmd.SetUsageTemplate(template.Usage)
+	markPromptedFlag(cmd, appFlag, taskDefaultFlag)
+	markPromptedFlag(cmd, envFlag, taskDefaultFlag)
 	return cmd
 }
@@ -73,6 +73,32 @@ Select %s to run the task in your default VPC instead of any existing applicatio
 Select %s to run the task in your default VPC instead of any existing environment.`, color.Emphasize(appEnvOptionNone))
 )
 
+// ErrorWithExitCode is an error returned by a command that should exit with a specific code instead of 1.
+// Only the errors of this package implement it, so that the errors of child processes such as
+// *exec.ExitError, which also have an ExitCode method, don't change the exit code of the command.
+type ErrorWithExitCode interface {
+	error
+	ExitCode() int
+	isErrorWithExitCode()
+}
+
+// errTaskExitCode means the tasks run by "task run" stopped with a non-zero exit code.
+type errTaskExitCode struct {
+	groupName string
+	exitCode  int
+}
+
+func (e *errTaskExitCode) Error() string {
+	return fmt.Sprintf("task %s exited with code %d", e.groupName, e.exitCode)
+}
+
+// ExitCode returns the exit code of the task, to be used as the exit code of the command.
+func (e *errTaskExitCode) ExitCode() int {
+	return e.exitCode
+}
+
+func (e *errTaskExitCode) isErrorWithExitCode() {}
+
 type runTaskVars struct {
 	count  int
 	cpu    int
@@ -101,6 +127,7 @@ type runTaskVars struct {
 	resourceTags map[string]string
 
 	follow                bool
+	keep                  bool // True if the task's resources shouldn't be deleted once the followed tasks stop.
 	generateCommandTarget string
 }
 
@@ -122,6 +149,8 @@ type runTaskOpts struct {
 	eventsWriter         eventsWriter
 	defaultClusterGetter defaultClusterGetter
 	publicIPGetter       publicIPGetter
+	stackManager         taskStackManager
+	imageRemover         imageRemover
 
 	sess              *session.Session
 	targetEnvironment *config.Environment
@@ -161,6 +190,8 @@ func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
 		opts.deployer = cloudformation.New(opts.sess)
 		opts.defaultClusterGetter = awsecs.New(opts.sess)
 		opts.publicIPGetter = ec2.New(opts.sess)
+		opts.stackManager = cloudformation.New(opts.sess)
+		opts.imageRemover = ecr.New(opts.sess)
 		return nil
 	}
 
@@ -276,6 +307,10 @@ func (o *runTaskOpts) Validate() error {
 		return errNumNotPositive
 	}
 
+	if o.keep && !o.follow {
+		return errors.New("cannot specify `--keep` without `--follow`")
+	}
+
 	if o.cpu <= 0 {
 		return errCPUNotPositive
 	}
@@ -509,6 +544,21 @@ func (o *runTaskOpts) Execute() error {
 		if err := o.displayLogStream(); err != nil {
 			return err
 		}
+		exitCode, exitCodeErr := o.eventsWriter.ExitCode()
+		if !o.keep {
+			if err := o.deleteTaskResources(); err != nil {
+				return err
+			}
+		}
+		if exitCodeErr != nil {
+			return fmt.Errorf("get exit code of task %s: %w", o.groupName, exitCodeErr)
+		}
+		if exitCode != 0 {
+			return &errTaskExitCode{
+				groupName: o.groupName,
+				exitCode:  exitCode,
+			}
+		}
 	}
 	return nil
 }
@@ -640,6 +690,26 @@ func (o *runTaskOpts) displayLogStream() error {
 	return nil
 }
 
+// deleteTaskResources empties the ECR repository of the task and deletes its CloudFormation stack once the tasks have stopped.
+func (o *runTaskOpts) deleteTaskResources() error {
+	o.spinner.Start(fmt.Sprintf("Deleting resources of task %s.", color.HighlightUserInput(o.groupName)))
+	if err := o.imageRemover.ClearRepository(fmt.Sprintf(deploy.FmtTaskECRRepoName, o.groupName)); err != nil {
+		o.spinner.Stop(log.Serrorln("Error emptying ECR repository."))
+		return fmt.Errorf("clear ECR repository for task %s: %w", o.groupName, err)
+	}
+	info, err := o.stackManager.GetTaskStack(o.groupName)
+	if err != nil {
+		o.spinner.Stop(log.Serrorln("Error getting CloudFormation stack."))
+		return fmt.Errorf("get stack for task %s: %w", o.groupName, err)
+	}
+	if err := o.stackManager.DeleteTask(*info); err != nil {
+		o.spinner.Stop(log.Serrorln("Error deleting CloudFormation stack."))
+		return fmt.Errorf("delete stack for task %s: %w", o.groupName, err)
+	}
+	o.spinner.Stop(log.Ssuccessf("Deleted resources of task %s.\n", color.HighlightUserInput(o.groupName)))
+	return nil
+}
+
 func (o *runTaskOpts) runTask() ([]*task.Task, error) {
 	o.spinner.Start(fmt.Sprintf("Waiting for %s to be running for %s.", english.Plural(o.count, "task", ""), o.groupName))
 	tasks, err := o.runner.Run()
@@ -831,6 +901,8 @@ Run a task with environment variables.
 /code $ copilot task run --env-vars name=myName,user=myUser
 Run a task using the current workspace with specific subnets and security groups.
 /code $ copilot task run --subnets subnet-123,subnet-456 --security-groups sg-123,sg-456
+Run a task until it exits with its exit code, and keep its logs and resources afterwards.
+/code $ copilot task run -n db-migrate --env test --follow --keep
 Run a task with a command.
 /code $ copilot task run --command "python migrate-script.py"`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
@@ -885,7 +957,10 @@ Run a task with a command.
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 
 	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
+	cmd.Flags().BoolVar(&vars.keep, keepFlag, false, keepFlagDescription)
 	cmd.Flags().StringVar(&vars.generateCommandTarget, generateCommandFlag, "", generateCommandFlagDescription)
 
+	markPromptedFlag(cmd, appFlag, taskDefaultFlag, subnetsFlag, clusterFlag)
+	markPromptedFlag(cmd, envFlag, taskDefaultFlag, subnetsFlag, clusterFlag)
 	return cmd
 }
@@ -64,6 +64,8 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 
 		inDefault               bool
 		inGenerateCommandTarget string
+		inFollow                bool
+		inKeep                  bool
 
 		appName         string
 		isDockerfileSet bool
@@ -158,6 +160,16 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 			},
 			wantedError: errMemNotPositive,
 		},
+		"valid to keep the resources of followed tasks": {
+			basicOpts: defaultOpts,
+			inFollow:  true,
+			inKeep:    true,
+		},
+		"invalid to keep the resources without following the tasks": {
+			basicOpts:   defaultOpts,
+			inKeep:      true,
+			wantedError: errors.New("cannot specify `--keep` without `--follow`"),
+		},
 		"both dockerfile and image name specified": {
 			basicOpts: defaultOpts,
 
@@ -341,6 +353,8 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 					entrypoint:                  tc.inEntryPoint,
 					useDefaultSubnetsAndCluster: tc.inDefault,
 					generateCommandTarget:       tc.inGenerateCommandTarget,
+					follow:                      tc.inFollow,
+					keep:                        tc.inKeep,
 				},
 				isDockerfileSet: tc.isDockerfileSet,
 				nFlag:           2,
@@ -621,6 +635,8 @@ type runTaskMocks struct {
 	eventsWriter         *mocks.MockeventsWriter
 	defaultClusterGetter *mocks.MockdefaultClusterGetter
 	publicIPGetter       *mocks.MockpublicIPGetter
+	stackManager         *mocks.MocktaskStackManager
+	imageRemover         *mocks.MockimageRemover
 }
 
 func mockHasDefaultCluster(m runTaskMocks) {
@@ -648,6 +664,7 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 		inImage      string
 		inTag        string
 		inFollow     bool
+		inKeep       bool
 		inCommand    string
 		inEntryPoint string
 
@@ -847,6 +864,86 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 			},
 			wantedError: errors.New("write events: error writing events"),
 		},
+		"delete the task resources once the followed tasks stop": {
+			inFollow: true,
+			inImage:  "image",
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN: "task-1",
+					},
+				}, nil)
+				m.eventsWriter.EXPECT().WriteEventsUntilStopped().Return(nil)
+				m.eventsWriter.EXPECT().ExitCode().Return(0, nil)
+				gomock.InOrder(
+					m.imageRemover.EXPECT().ClearRepository("copilot-my-task").Return(nil),
+					m.stackManager.EXPECT().GetTaskStack(inGroupName).Return(&deploy.TaskStackInfo{StackName: "task-my-task"}, nil),
+					m.stackManager.EXPECT().DeleteTask(deploy.TaskStackInfo{StackName: "task-my-task"}).Return(nil),
+				)
+				mockHasDefaultCluster(m)
+			},
+		},
+		"keep the task resources and return the exit code of the followed tasks": {
+			inFollow: true,
+			inKeep:   true,
+			inImage:  "image",
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN: "task-1",
+					},
+				}, nil)
+				m.eventsWriter.EXPECT().WriteEventsUntilStopped().Return(nil)
+				m.eventsWriter.EXPECT().ExitCode().Return(3, nil)
+				m.imageRemover.EXPECT().ClearRepository(gomock.Any()).Times(0)
+				m.stackManager.EXPECT().DeleteTask(gomock.Any()).Times(0)
+				mockHasDefaultCluster(m)
+			},
+			wantedError: &errTaskExitCode{
+				groupName: inGroupName,
+				exitCode:  3,
+			},
+		},
+		"delete the task resources even if the exit code can't be retrieved": {
+			inFollow: true,
+			inImage:  "image",
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN: "task-1",
+					},
+				}, nil)
+				m.eventsWriter.EXPECT().WriteEventsUntilStopped().Return(nil)
+				m.eventsWriter.EXPECT().ExitCode().Return(0, errors.New("some error"))
+				m.imageRemover.EXPECT().ClearRepository("copilot-my-task").Return(nil)
+				m.stackManager.EXPECT().GetTaskStack(inGroupName).Return(&deploy.TaskStackInfo{StackName: "task-my-task"}, nil)
+				m.stackManager.EXPECT().DeleteTask(gomock.Any()).Return(nil)
+				mockHasDefaultCluster(m)
+			},
+			wantedError: errors.New("get exit code of task my-task: some error"),
+		},
+		"fail to delete the task stack": {
+			inFollow: true,
+			inImage:  "image",
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN: "task-1",
+					},
+				}, nil)
+				m.eventsWriter.EXPECT().WriteEventsUntilStopped().Return(nil)
+				m.eventsWriter.EXPECT().ExitCode().Return(0, nil)
+				m.imageRemover.EXPECT().ClearRepository("copilot-my-task").Return(nil)
+				m.stackManager.EXPECT().GetTaskStack(inGroupName).Return(&deploy.TaskStackInfo{StackName: "task-my-task"}, nil)
+				m.stackManager.EXPECT().DeleteTask(gomock.Any()).Return(errors.New("some error"))
+				mockHasDefaultCluster(m)
+			},
+			wantedError: errors.New("delete stack for task my-task: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -862,6 +959,8 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				eventsWriter:         mocks.NewMockeventsWriter(ctrl),
 				defaultClusterGetter: mocks.NewMockdefaultClusterGetter(ctrl),
 				publicIPGetter:       mocks.NewMockpublicIPGetter(ctrl),
+				stackManager:         mocks.NewMocktaskStackManager(ctrl),
+				imageRemover:         mocks.NewMockimageRemover(ctrl),
 			}
 			tc.setupMocks(mocks)
 
@@ -873,6 +972,7 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 					imageTag:   tc.inTag,
 					env:        tc.inEnv,
 					follow:     tc.inFollow,
+					keep:       tc.inKeep,
 					secrets:    tc.inSecrets,
 					command:    tc.inCommand,
 					entrypoint: tc.inEntryPoint,
@@ -885,6 +985,8 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				opts.deployer = mocks.deployer
 				opts.defaultClusterGetter = mocks.defaultClusterGetter
 				opts.publicIPGetter = mocks.publicIPGetter
+				opts.stackManager = mocks.stackManager
+				opts.imageRemover = mocks.imageRemover
 				return nil
 			}
 			opts.configureRepository = func() error {
@@ -1067,3 +1169,15 @@ func TestTaskRunOpts_runTaskCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestErrTaskExitCode(t *testing.T) {
+	err := fmt.Errorf("run task: %w", &errTaskExitCode{
+		groupName: "my-task",
+		exitCode:  3,
+	})
+
+	var errWithCode ErrorWithExitCode
+	require.True(t, errors.As(err, &errWithCode))
+	require.Equal(t, 3, errWithCode.ExitCode())
+	require.EqualError(t, err, "run task: task my-task exited with code 3")
+}
@@ -29,6 +29,7 @@ var (
 	errValueNotAString      = errors.New("value must be a string")
 	errValueNotAStringSlice = errors.New("value must be a string slice")
 	errValueNotAValidPath   = errors.New("value must be a valid path")
+	errValueNotAbsolutePath = errors.New("value must be an absolute path")
 	errValueNotAnIPNet      = errors.New("value must be a valid IP address range (example: 10.0.0.0/16)")
 	errValueNotIPNetSlice   = errors.New("value must be a valid slice of IP address range (example: 10.0.0.0/16,10.0.1.0/16)")
 	errPortInvalid          = errors.New("value must be in range 1-65535")
@@ -106,6 +107,8 @@ var (
 	domainNameRegexp = regexp.MustCompile(`\.`) // Check for at least one dot in domain name.
 
 	awsScheduleRegexp = regexp.MustCompile(`(?:rate|cron)\(.*\)`) // Check for strings of the form rate(*) or cron(*).
+
+	envVarNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`) // Check for a letter or underscore followed by alphanumerics and underscores.
 )
 
 // RDS Aurora Serverless validation expressions.
@@ -264,6 +267,36 @@ func validatePath(fs afero.Fs, val interface{}) error {
 	return nil
 }
 
+func validateContainerPath(val interface{}) error {
+	path, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if path == "" {
+		return errValueEmpty
+	}
+	if !strings.HasPrefix(path, "/") {
+		return errValueNotAbsolutePath
+	}
+	return nil
+}
+
+// validateCountRange returns an error if the value isn't a range of task counts of the format "${min}-${max}", such as "1-10".
+func validateCountRange(val interface{}) error {
+	countRange, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	min, max, err := manifest.IntRangeBand(countRange).Parse()
+	if err != nil {
+		return err
+	}
+	if min > max {
+		return fmt.Errorf("minimum count %d must be less than or equal to the maximum count %d", min, max)
+	}
+	return nil
+}
+
 func validateStorageType(val interface{}) error {
 	storageType, ok := val.(string)
 	if !ok {
@@ -626,6 +659,26 @@ func validateLSIs(val interface{}) error {
 	return nil
 }
 
+// validateListOutputFormat validates the --output flag of list commands, which can't be used together with --json.
+func validateListOutputFormat(shouldOutputJSON bool, format string) error {
+	if format == "" {
+		return nil
+	}
+	if shouldOutputJSON {
+		return fmt.Errorf("--%s and --%s cannot be specified together", jsonFlag, outputFlag)
+	}
+	return validateOutputFormat(format, listOutputFormats)
+}
+
+func validateOutputFormat(format string, validFormats []string) error {
+	for _, valid := range validFormats {
+		if format == valid {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid output format %s: must be one of %s", format, prettify(validFormats))
+}
+
 func prettify(inputStrings []string) string {
 	prettyTypes := template.QuoteSliceFunc(inputStrings)
 	return strings.Join(prettyTypes, ", ")
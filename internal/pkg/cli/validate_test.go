@@ -343,6 +343,72 @@ func TestValidateStorageType(t *testing.T) {
 	}
 }
 
+func TestValidateContainerPath(t *testing.T) {
+	testCases := map[string]struct {
+		input interface{}
+		want  error
+	}{
+		"not a string": {
+			input: 123,
+			want:  errValueNotAString,
+		},
+		"empty": {
+			input: "",
+			want:  errValueEmpty,
+		},
+		"relative path": {
+			input: "var/efs",
+			want:  errValueNotAbsolutePath,
+		},
+		"absolute path": {
+			input: "/var/efs",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateContainerPath(tc.input)
+			if tc.want == nil {
+				require.NoError(t, got)
+			} else {
+				require.EqualError(t, got, tc.want.Error())
+			}
+		})
+	}
+}
+
+func TestValidateCountRange(t *testing.T) {
+	testCases := map[string]struct {
+		input interface{}
+		want  error
+	}{
+		"not a string": {
+			input: 123,
+			want:  errValueNotAString,
+		},
+		"not a range": {
+			input: "10",
+			want:  errors.New("invalid range value 10. Should be in format of ${min}-${max}"),
+		},
+		"minimum greater than maximum": {
+			input: "10-2",
+			want:  errors.New("minimum count 10 must be less than or equal to the maximum count 2"),
+		},
+		"valid range": {
+			input: "1-10",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateCountRange(tc.input)
+			if tc.want == nil {
+				require.NoError(t, got)
+			} else {
+				require.EqualError(t, got, tc.want.Error())
+			}
+		})
+	}
+}
+
 func TestValidateKey(t *testing.T) {
 	testCases := map[string]struct {
 		input string
@@ -5,7 +5,10 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"runtime"
 
 	"github.com/aws/copilot-cli/cmd/copilot/template"
@@ -15,19 +18,75 @@ import (
 	"github.com/spf13/cobra"
 )
 
+type versionVars struct {
+	outputFormat string
+}
+
+type versionOpts struct {
+	versionVars
+
+	w io.Writer
+}
+
+// versionInfo is the machine-readable build information of the CLI.
+type versionInfo struct {
+	Version   string `json:"version"`
+	GitCommit string `json:"gitCommit"`
+	BuildDate string `json:"buildDate"`
+	GoVersion string `json:"goVersion"`
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *versionOpts) Validate() error {
+	if o.outputFormat != "" {
+		return validateOutputFormat(o.outputFormat, versionOutputFormats)
+	}
+	return nil
+}
+
+// Execute writes the version of the CLI.
+func (o *versionOpts) Execute() error {
+	if o.outputFormat != outputFormatJSON {
+		fmt.Fprintf(o.w, "version: %s, built for %s\n", version.Version, runtime.GOOS)
+		return nil
+	}
+	b, err := json.Marshal(versionInfo{
+		Version:   version.Version,
+		GitCommit: version.GitCommit,
+		BuildDate: version.BuildDate,
+		GoVersion: runtime.Version(),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal version information: %w", err)
+	}
+	fmt.Fprintf(o.w, "%s\n", b)
+	return nil
+}
+
 // BuildVersionCmd builds the command for displaying the version
 func BuildVersionCmd() *cobra.Command {
+	vars := versionVars{}
 	cmd := &cobra.Command{
 		Use:   "version",
 		Short: "Print the version number.",
+		Example: `
+  Print the version of the CLI as JSON.
+  /code $ copilot version --output json`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			fmt.Printf("version: %s, built for %s\n", version.Version, runtime.GOOS)
-			return nil
+			opts := &versionOpts{
+				versionVars: vars,
+				w:           os.Stdout,
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Execute()
 		}),
 		Annotations: map[string]string{
 			"group": group.Settings,
 		},
 	}
+	cmd.Flags().StringVar(&vars.outputFormat, outputFlag, "", versionOutputFlagDescription)
 	cmd.SetUsageTemplate(template.Usage)
 	return cmd
 }
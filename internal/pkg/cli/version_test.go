@@ -0,0 +1,88 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		outputFormat string
+		wantedErr    error
+	}{
+		"valid without an output format": {},
+		"valid with json output format": {
+			outputFormat: "json",
+		},
+		"invalid output format": {
+			outputFormat: "csv",
+			wantedErr:    errors.New(`invalid output format csv: must be one of "json"`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &versionOpts{
+				versionVars: versionVars{
+					outputFormat: tc.outputFormat,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVersionOpts_Execute(t *testing.T) {
+	defer func(v, c, d string) {
+		version.Version, version.GitCommit, version.BuildDate = v, c, d
+	}(version.Version, version.GitCommit, version.BuildDate)
+	version.Version = "v1.2.3"
+	version.GitCommit = "abc123"
+	version.BuildDate = "2021-01-01T00:00:00Z"
+
+	testCases := map[string]struct {
+		outputFormat  string
+		wantedContent string
+	}{
+		"writes plain text by default": {
+			wantedContent: fmt.Sprintf("version: v1.2.3, built for %s\n", runtime.GOOS),
+		},
+		"writes json": {
+			outputFormat:  "json",
+			wantedContent: fmt.Sprintf(`{"version":"v1.2.3","gitCommit":"abc123","buildDate":"2021-01-01T00:00:00Z","goVersion":"%s"}`+"\n", runtime.Version()),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			b := &bytes.Buffer{}
+			opts := &versionOpts{
+				versionVars: versionVars{
+					outputFormat: tc.outputFormat,
+				},
+				w: b,
+			}
+
+			err := opts.Execute()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
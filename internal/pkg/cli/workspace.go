@@ -0,0 +1,27 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/spf13/cobra"
+)
+
+// BuildWorkspaceCmd is the top level command for workspace.
+func BuildWorkspaceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "workspace",
+		Short: `Commands for the local workspace.
+A workspace is the directory that contains your "copilot" directory.`,
+	}
+
+	cmd.AddCommand(buildWorkspaceRepairCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Settings,
+	}
+	return cmd
+}
@@ -0,0 +1,155 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	wsRepairAppNamePrompt     = "Which application should this workspace belong to?"
+	wsRepairAppNameHelpPrompt = "The workspace summary will be rewritten to reference the selected application."
+
+	fmtWsRepairHealthy        = "Workspace belongs to application %s, nothing to repair.\n"
+	fmtWsRepairDeletedApp     = "Workspace references application %s, which doesn't exist anymore.\n"
+	fmtWsRepairInvalidSummary = "Couldn't read the workspace summary: %v.\n"
+	fmtWsRepairSucceeded      = "Workspace now belongs to application %s.\n"
+
+	wsRepairAppFlagDescription = "Name of the application to associate the workspace with if its application is gone."
+)
+
+type repairWsVars struct {
+	appName string
+}
+
+type repairWsOpts struct {
+	repairWsVars
+
+	store store
+	ws    wsSummaryRepairer
+	sel   appSelector
+
+	// Outputs stored on successful actions.
+	summaryApp  string // Application referenced by a healthy workspace summary.
+	needsRepair bool
+}
+
+func newRepairWsOpts(vars repairWsVars) (*repairWsOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	return &repairWsOpts{
+		repairWsVars: vars,
+		store:        store,
+		ws:           ws,
+		sel:          selector.NewSelect(prompt.New(), store),
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *repairWsOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask checks the workspace summary against the config store,
+// and prompts for the application to associate the workspace with if the summary needs to be repaired.
+func (o *repairWsOpts) Ask() error {
+	needsRepair, err := o.checkSummary()
+	if err != nil {
+		return err
+	}
+	o.needsRepair = needsRepair
+	if !needsRepair || o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(wsRepairAppNamePrompt, wsRepairAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+// checkSummary returns true if the workspace summary can't be read or references an application that doesn't exist.
+func (o *repairWsOpts) checkSummary() (bool, error) {
+	summary, err := o.ws.Summary()
+	if err != nil {
+		log.Warningf(fmtWsRepairInvalidSummary, err)
+		return true, nil
+	}
+	if _, err := o.store.GetApplication(summary.Application); err != nil {
+		var errNoSuchApp *config.ErrNoSuchApplication
+		if errors.As(err, &errNoSuchApp) {
+			log.Warningf(fmtWsRepairDeletedApp, summary.Application)
+			return true, nil
+		}
+		return false, fmt.Errorf("get application %s: %w", summary.Application, err)
+	}
+	o.summaryApp = summary.Application
+	return false, nil
+}
+
+// Execute rewrites the workspace summary if it needs to be repaired.
+func (o *repairWsOpts) Execute() error {
+	if !o.needsRepair {
+		log.Infof(fmtWsRepairHealthy, color.HighlightUserInput(o.summaryApp))
+		return nil
+	}
+	if err := o.ws.OverwriteSummary(o.appName); err != nil {
+		return fmt.Errorf("rewrite workspace summary: %w", err)
+	}
+	log.Successf(fmtWsRepairSucceeded, color.HighlightUserInput(o.appName))
+	return nil
+}
+
+// buildWorkspaceRepairCmd builds the command to repair the workspace summary.
+func buildWorkspaceRepairCmd() *cobra.Command {
+	vars := repairWsVars{}
+	cmd := &cobra.Command{
+		Use:   "repair",
+		Short: "Repairs the workspace summary if it references an application that doesn't exist.",
+		Long: `Repairs the workspace summary if it references an application that doesn't exist.
+If the summary can't be read or its application was deleted, the workspace is associated with an existing application.`,
+
+		Example: `
+  Associate the workspace with an existing application if its application was deleted.
+  /code $ copilot workspace repair
+  Associate the workspace with the application "my-app" if its summary needs to be repaired.
+  /code $ copilot workspace repair -a my-app`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRepairWsOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", wsRepairAppFlagDescription)
+	return cmd
+}
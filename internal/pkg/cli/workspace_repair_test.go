@@ -0,0 +1,225 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type repairWsMocks struct {
+	store *mocks.Mockstore
+	ws    *mocks.MockwsSummaryRepairer
+	sel   *mocks.MockappSelector
+}
+
+func TestRepairWsOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		setupMocks func(m *repairWsMocks)
+
+		wantedErr error
+	}{
+		"skip validation if no application is passed": {
+			setupMocks: func(m *repairWsMocks) {},
+		},
+		"valid application": {
+			inAppName: "phonetool",
+			setupMocks: func(m *repairWsMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+		},
+		"invalid application": {
+			inAppName: "phonetool",
+			setupMocks: func(m *repairWsMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &repairWsMocks{
+				store: mocks.NewMockstore(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &repairWsOpts{
+				repairWsVars: repairWsVars{
+					appName: tc.inAppName,
+				},
+				store: m.store,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRepairWsOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		setupMocks func(m *repairWsMocks)
+
+		wantedAppName     string
+		wantedNeedsRepair bool
+		wantedErr         error
+	}{
+		"healthy summary doesn't need to be repaired": {
+			setupMocks: func(m *repairWsMocks) {
+				m.ws.EXPECT().Summary().Return(&workspace.Summary{Application: "phonetool"}, nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.sel.EXPECT().Application(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"prompts for an application if the summary references a deleted application": {
+			setupMocks: func(m *repairWsMocks) {
+				m.ws.EXPECT().Summary().Return(&workspace.Summary{Application: "deleted"}, nil)
+				m.store.EXPECT().GetApplication("deleted").Return(nil, &config.ErrNoSuchApplication{ApplicationName: "deleted"})
+				m.sel.EXPECT().Application(wsRepairAppNamePrompt, wsRepairAppNameHelpPrompt).Return("phonetool", nil)
+			},
+			wantedAppName:     "phonetool",
+			wantedNeedsRepair: true,
+		},
+		"prompts for an application if the summary can't be read": {
+			setupMocks: func(m *repairWsMocks) {
+				m.ws.EXPECT().Summary().Return(nil, errors.New("yaml: did not find expected node content"))
+				m.sel.EXPECT().Application(wsRepairAppNamePrompt, wsRepairAppNameHelpPrompt).Return("phonetool", nil)
+			},
+			wantedAppName:     "phonetool",
+			wantedNeedsRepair: true,
+		},
+		"doesn't prompt if an application is passed": {
+			inAppName: "phonetool",
+			setupMocks: func(m *repairWsMocks) {
+				m.ws.EXPECT().Summary().Return(&workspace.Summary{Application: "deleted"}, nil)
+				m.store.EXPECT().GetApplication("deleted").Return(nil, &config.ErrNoSuchApplication{ApplicationName: "deleted"})
+				m.sel.EXPECT().Application(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedAppName:     "phonetool",
+			wantedNeedsRepair: true,
+		},
+		"errors if fail to get the application of the summary": {
+			setupMocks: func(m *repairWsMocks) {
+				m.ws.EXPECT().Summary().Return(&workspace.Summary{Application: "phonetool"}, nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get application phonetool: some error"),
+		},
+		"errors if fail to select an application": {
+			setupMocks: func(m *repairWsMocks) {
+				m.ws.EXPECT().Summary().Return(&workspace.Summary{Application: "deleted"}, nil)
+				m.store.EXPECT().GetApplication("deleted").Return(nil, &config.ErrNoSuchApplication{ApplicationName: "deleted"})
+				m.sel.EXPECT().Application(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select application: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &repairWsMocks{
+				store: mocks.NewMockstore(ctrl),
+				ws:    mocks.NewMockwsSummaryRepairer(ctrl),
+				sel:   mocks.NewMockappSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &repairWsOpts{
+				repairWsVars: repairWsVars{
+					appName: tc.inAppName,
+				},
+				store: m.store,
+				ws:    m.ws,
+				sel:   m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedAppName, opts.appName)
+				require.Equal(t, tc.wantedNeedsRepair, opts.needsRepair)
+			}
+		})
+	}
+}
+
+func TestRepairWsOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inNeedsRepair bool
+		setupMocks    func(m *repairWsMocks)
+
+		wantedErr error
+	}{
+		"doesn't rewrite a healthy summary": {
+			setupMocks: func(m *repairWsMocks) {
+				m.ws.EXPECT().OverwriteSummary(gomock.Any()).Times(0)
+			},
+		},
+		"rewrites the summary with the selected application": {
+			inNeedsRepair: true,
+			setupMocks: func(m *repairWsMocks) {
+				m.ws.EXPECT().OverwriteSummary("phonetool").Return(nil)
+			},
+		},
+		"errors if fail to rewrite the summary": {
+			inNeedsRepair: true,
+			setupMocks: func(m *repairWsMocks) {
+				m.ws.EXPECT().OverwriteSummary("phonetool").Return(errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("rewrite workspace summary: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &repairWsMocks{
+				ws: mocks.NewMockwsSummaryRepairer(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &repairWsOpts{
+				repairWsVars: repairWsVars{
+					appName: "phonetool",
+				},
+				ws:          m.ws,
+				needsRepair: tc.inNeedsRepair,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+// Names of the cached read operations.
+const (
+	cacheListApplications = "ListApplications"
+	cacheGetApplication   = "GetApplication"
+	cacheListEnvironments = "ListEnvironments"
+	cacheGetEnvironment   = "GetEnvironment"
+	cacheListServices     = "ListServices"
+	cacheGetService       = "GetService"
+	cacheListJobs         = "ListJobs"
+	cacheGetJob           = "GetJob"
+	cacheListWorkloads    = "ListWorkloads"
+	cacheGetWorkload      = "GetWorkload"
+)
+
+type cacheKey struct {
+	method string
+	app    string
+	name   string // Name of the environment or workload, if any.
+}
+
+// CachedStore is a Store that remembers the results of its reads, so that reading the same
+// configuration more than once only calls SSM the first time.
+//
+// A CachedStore is meant to live as long as a single command, so it's never shared between commands and
+// never returns stale data written by another command. Writes through the CachedStore drop the cached
+// reads of the application that they modify. Errors are never cached.
+type CachedStore struct {
+	*Store
+	cache map[cacheKey]interface{}
+}
+
+// NewCachedStore returns a CachedStore that reads from and writes to store.
+func NewCachedStore(store *Store) *CachedStore {
+	return &CachedStore{
+		Store: store,
+		cache: make(map[cacheKey]interface{}),
+	}
+}
+
+// CreateApplication instantiates a new application and drops the cached reads of the application.
+func (s *CachedStore) CreateApplication(application *Application) error {
+	s.invalidateApp(application.Name)
+	return s.Store.CreateApplication(application)
+}
+
+// UpdateApplication updates the data of an application and drops the cached reads of the application.
+func (s *CachedStore) UpdateApplication(application *Application) error {
+	s.invalidateApp(application.Name)
+	return s.Store.UpdateApplication(application)
+}
+
+// GetApplication fetches an application by name, or returns the result of a previous call.
+func (s *CachedStore) GetApplication(applicationName string) (*Application, error) {
+	key := cacheKey{method: cacheGetApplication, app: applicationName}
+	if app, ok := s.cache[key]; ok {
+		return app.(*Application), nil
+	}
+	app, err := s.Store.GetApplication(applicationName)
+	if err != nil {
+		return nil, err
+	}
+	s.cache[key] = app
+	return app, nil
+}
+
+// ListApplications returns the list of existing applications, or returns the result of a previous call.
+func (s *CachedStore) ListApplications() ([]*Application, error) {
+	key := cacheKey{method: cacheListApplications}
+	if apps, ok := s.cache[key]; ok {
+		return apps.([]*Application), nil
+	}
+	apps, err := s.Store.ListApplications()
+	if err != nil {
+		return nil, err
+	}
+	s.cache[key] = apps
+	return apps, nil
+}
+
+// DeleteApplication deletes the application and drops the cached reads of the application.
+func (s *CachedStore) DeleteApplication(name string) error {
+	s.invalidateApp(name)
+	return s.Store.DeleteApplication(name)
+}
+
+// CreateEnvironment instantiates a new environment and drops the cached reads of its application.
+func (s *CachedStore) CreateEnvironment(environment *Environment) error {
+	s.invalidateApp(environment.App)
+	return s.Store.CreateEnvironment(environment)
+}
+
+// GetEnvironment gets an environment belonging to a particular application by name,
+// or returns the result of a previous call.
+func (s *CachedStore) GetEnvironment(appName string, environmentName string) (*Environment, error) {
+	key := cacheKey{method: cacheGetEnvironment, app: appName, name: environmentName}
+	if env, ok := s.cache[key]; ok {
+		return env.(*Environment), nil
+	}
+	env, err := s.Store.GetEnvironment(appName, environmentName)
+	if err != nil {
+		return nil, err
+	}
+	s.cache[key] = env
+	return env, nil
+}
+
+// ListEnvironments returns all environments belonging to a particular application,
+// or returns the result of a previous call.
+func (s *CachedStore) ListEnvironments(appName string) ([]*Environment, error) {
+	key := cacheKey{method: cacheListEnvironments, app: appName}
+	if envs, ok := s.cache[key]; ok {
+		return envs.([]*Environment), nil
+	}
+	envs, err := s.Store.ListEnvironments(appName)
+	if err != nil {
+		return nil, err
+	}
+	s.cache[key] = envs
+	return envs, nil
+}
+
+// DeleteEnvironment removes an environment and drops the cached reads of its application.
+func (s *CachedStore) DeleteEnvironment(appName, environmentName string) error {
+	s.invalidateApp(appName)
+	return s.Store.DeleteEnvironment(appName, environmentName)
+}
+
+// CreateService instantiates a new service and drops the cached reads of its application.
+func (s *CachedStore) CreateService(svc *Workload) error {
+	s.invalidateApp(svc.App)
+	return s.Store.CreateService(svc)
+}
+
+// CreateJob instantiates a new job and drops the cached reads of its application.
+func (s *CachedStore) CreateJob(job *Workload) error {
+	s.invalidateApp(job.App)
+	return s.Store.CreateJob(job)
+}
+
+// GetService gets a service belonging to a particular application by name,
+// or returns the result of a previous call.
+func (s *CachedStore) GetService(appName, svcName string) (*Workload, error) {
+	return s.getWorkload(cacheKey{method: cacheGetService, app: appName, name: svcName}, s.Store.GetService)
+}
+
+// GetJob gets a job belonging to a particular application by name,
+// or returns the result of a previous call.
+func (s *CachedStore) GetJob(appName, jobName string) (*Workload, error) {
+	return s.getWorkload(cacheKey{method: cacheGetJob, app: appName, name: jobName}, s.Store.GetJob)
+}
+
+// GetWorkload gets a workload belonging to an application by name,
+// or returns the result of a previous call.
+func (s *CachedStore) GetWorkload(appName, name string) (*Workload, error) {
+	return s.getWorkload(cacheKey{method: cacheGetWorkload, app: appName, name: name}, s.Store.GetWorkload)
+}
+
+// ListServices returns all services belonging to a particular application,
+// or returns the result of a previous call.
+func (s *CachedStore) ListServices(appName string) ([]*Workload, error) {
+	return s.listWorkloads(cacheKey{method: cacheListServices, app: appName}, s.Store.ListServices)
+}
+
+// ListJobs returns all jobs belonging to a particular application,
+// or returns the result of a previous call.
+func (s *CachedStore) ListJobs(appName string) ([]*Workload, error) {
+	return s.listWorkloads(cacheKey{method: cacheListJobs, app: appName}, s.Store.ListJobs)
+}
+
+// ListWorkloads returns all workloads belonging to a particular application,
+// or returns the result of a previous call.
+func (s *CachedStore) ListWorkloads(appName string) ([]*Workload, error) {
+	return s.listWorkloads(cacheKey{method: cacheListWorkloads, app: appName}, s.Store.ListWorkloads)
+}
+
+// DeleteService removes a service and drops the cached reads of its application.
+func (s *CachedStore) DeleteService(appName, svcName string) error {
+	s.invalidateApp(appName)
+	return s.Store.DeleteService(appName, svcName)
+}
+
+// DeleteJob removes a job and drops the cached reads of its application.
+func (s *CachedStore) DeleteJob(appName, jobName string) error {
+	s.invalidateApp(appName)
+	return s.Store.DeleteJob(appName, jobName)
+}
+
+func (s *CachedStore) getWorkload(key cacheKey, get func(appName, name string) (*Workload, error)) (*Workload, error) {
+	if wkld, ok := s.cache[key]; ok {
+		return wkld.(*Workload), nil
+	}
+	wkld, err := get(key.app, key.name)
+	if err != nil {
+		return nil, err
+	}
+	s.cache[key] = wkld
+	return wkld, nil
+}
+
+func (s *CachedStore) listWorkloads(key cacheKey, list func(appName string) ([]*Workload, error)) ([]*Workload, error) {
+	if wklds, ok := s.cache[key]; ok {
+		return wklds.([]*Workload), nil
+	}
+	wklds, err := list(key.app)
+	if err != nil {
+		return nil, err
+	}
+	s.cache[key] = wklds
+	return wklds, nil
+}
+
+// invalidateApp drops the cached reads of an application and of everything that belongs to it,
+// as well as the cached list of applications.
+func (s *CachedStore) invalidateApp(appName string) {
+	for key := range s.cache {
+		if key.app == appName || key.method == cacheListApplications {
+			delete(s.cache, key)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedStore_GetApplication(t *testing.T) {
+	// GIVEN
+	app, err := marshal(Application{Name: "chicken", Version: "1.0"})
+	require.NoError(t, err)
+	var calls int
+	store := NewCachedStore(&Store{
+		ssmClient: &mockSSM{
+			t: t,
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				calls++
+				if calls == 1 {
+					return nil, errors.New("some error")
+				}
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Name:  param.Name,
+						Value: aws.String(app),
+					},
+				}, nil
+			},
+		},
+	})
+
+	// WHEN
+	_, err = store.GetApplication("chicken")
+	require.EqualError(t, err, "get application chicken: some error")
+	first, err := store.GetApplication("chicken")
+	require.NoError(t, err)
+	second, err := store.GetApplication("chicken")
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, 2, calls, "errors should not be cached")
+	require.Equal(t, "chicken", second.Name)
+	require.Same(t, first, second)
+}
+
+func TestCachedStore_ListEnvironments(t *testing.T) {
+	// GIVEN
+	env, err := marshal(Environment{Name: "test", App: "chicken"})
+	require.NoError(t, err)
+	calls := make(map[string]int)
+	store := NewCachedStore(&Store{
+		ssmClient: &mockSSM{
+			t: t,
+			mockGetParametersByPath: func(t *testing.T, param *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+				calls[aws.StringValue(param.Path)]++
+				return &ssm.GetParametersByPathOutput{
+					Parameters: []*ssm.Parameter{
+						{
+							Name:  aws.String("/copilot/applications/chicken/environments/test"),
+							Value: aws.String(env),
+						},
+					},
+				}, nil
+			},
+		},
+	})
+
+	// WHEN
+	for i := 0; i < 3; i++ {
+		envs, err := store.ListEnvironments("chicken")
+		require.NoError(t, err)
+		require.Len(t, envs, 1)
+	}
+	_, err = store.ListEnvironments("cow")
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, map[string]int{
+		"/copilot/applications/chicken/environments/": 1,
+		"/copilot/applications/cow/environments/":     1,
+	}, calls)
+}
+
+func TestCachedStore_WritesInvalidateTheApplication(t *testing.T) {
+	// GIVEN
+	app, err := marshal(Application{Name: "chicken", Version: "1.0"})
+	require.NoError(t, err)
+	listCalls := make(map[string]int)
+	store := NewCachedStore(&Store{
+		ssmClient: &mockSSM{
+			t: t,
+			mockGetParametersByPath: func(t *testing.T, param *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+				listCalls[aws.StringValue(param.Path)]++
+				return &ssm.GetParametersByPathOutput{}, nil
+			},
+			mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+				return &ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Name:  param.Name,
+						Value: aws.String(app),
+					},
+				}, nil
+			},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return &ssm.PutParameterOutput{
+					Version: aws.Int64(1),
+				}, nil
+			},
+		},
+	})
+	_, err = store.ListServices("chicken")
+	require.NoError(t, err)
+	_, err = store.ListServices("cow")
+	require.NoError(t, err)
+
+	// WHEN
+	err = store.CreateService(&Workload{
+		App:  "chicken",
+		Name: "api",
+		Type: "Load Balanced Web Service",
+	})
+	require.NoError(t, err)
+	_, err = store.ListServices("chicken")
+	require.NoError(t, err)
+	_, err = store.ListServices("cow")
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, map[string]int{
+		"/copilot/applications/chicken/components/": 2,
+		"/copilot/applications/cow/components/":     1,
+	}, listCalls)
+}
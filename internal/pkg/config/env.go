@@ -13,6 +13,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/ssm"
 )
 
+// defaultPublicSubnetCount is the number of public subnets in the VPC that Copilot creates by default.
+const defaultPublicSubnetCount = 2
+
 // Environment represents a deployment environment in an application.
 type Environment struct {
 	App              string        `json:"app"`                    // Name of the app this environment belongs to.
@@ -26,10 +29,34 @@ type Environment struct {
 	CustomConfig     *CustomizeEnv `json:"customConfig,omitempty"` // Custom environment configuration by users.
 }
 
+// HasImportedCerts returns true if the environment's load balancer serves HTTPS with imported certificates.
+func (e *Environment) HasImportedCerts() bool {
+	return e.CustomConfig != nil && len(e.CustomConfig.ImportCertARNs) != 0
+}
+
+// PublicSubnetCount returns the number of public subnets in the environment's VPC.
+func (e *Environment) PublicSubnetCount() int {
+	if e.CustomConfig != nil && e.CustomConfig.ImportVPC != nil {
+		return len(e.CustomConfig.ImportVPC.PublicSubnetIDs)
+	}
+	if e.CustomConfig != nil && e.CustomConfig.VPCConfig != nil {
+		return len(e.CustomConfig.VPCConfig.PublicSubnetCIDRs)
+	}
+	return defaultPublicSubnetCount
+}
+
 // CustomizeEnv represents the custom environment config.
 type CustomizeEnv struct {
-	ImportVPC *ImportVPC `json:"importVPC,omitempty"`
-	VPCConfig *AdjustVPC `json:"adjustVPC,omitempty"`
+	ImportVPC           *ImportVPC `json:"importVPC,omitempty"`
+	VPCConfig           *AdjustVPC `json:"adjustVPC,omitempty"`
+	WebACLARN           string     `json:"webACLARN,omitempty"`           // ARN of the AWS WAF web ACL associated with the load balancer.
+	ImportCertARNs      []string   `json:"importCertARNs,omitempty"`      // ARNs of the ACM certificates used by the load balancer's HTTPS listener.
+	HTTPToHTTPSRedirect bool       `json:"httpToHTTPSRedirect,omitempty"` // Whether the load balancer's HTTP listener redirects to HTTPS.
+	IdleTimeout         int64      `json:"idleTimeout,omitempty"`         // Idle timeout of the load balancers in seconds.
+	NATGateways         string     `json:"natGateways,omitempty"`         // Number of NAT gateways in the Copilot-managed VPC: "per-az", "single", or "none".
+	VPCEndpoints        bool       `json:"vpcEndpoints,omitempty"`        // Whether the Copilot-managed VPC has endpoints for ECR, S3, and CloudWatch Logs.
+	ImportClusterARN    string     `json:"importClusterARN,omitempty"`    // ARN of an existing ECS cluster that services in the environment deploy to.
+	CapacityProvider    string     `json:"capacityProvider,omitempty"`    // Default capacity provider of services in the environment: "FARGATE" or "FARGATE_SPOT".
 }
 
 // NewCustomizeEnv returns a new CustomizeEnv struct.
@@ -14,6 +14,80 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestEnvironment_HasImportedCerts(t *testing.T) {
+	testCases := map[string]struct {
+		in     Environment
+		wanted bool
+	}{
+		"no custom config": {
+			in:     Environment{Name: "test"},
+			wanted: false,
+		},
+		"custom config without imported certificates": {
+			in: Environment{
+				Name: "test",
+				CustomConfig: &CustomizeEnv{
+					WebACLARN: "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/mock/1234",
+				},
+			},
+			wanted: false,
+		},
+		"imported certificates": {
+			in: Environment{
+				Name: "test",
+				CustomConfig: &CustomizeEnv{
+					ImportCertARNs: []string{"arn:aws:acm:us-west-2:123456789012:certificate/1234"},
+				},
+			},
+			wanted: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.HasImportedCerts())
+		})
+	}
+}
+
+func TestEnvironment_PublicSubnetCount(t *testing.T) {
+	testCases := map[string]struct {
+		in     Environment
+		wanted int
+	}{
+		"default vpc": {
+			in:     Environment{Name: "test"},
+			wanted: 2,
+		},
+		"adjusted vpc": {
+			in: Environment{
+				Name: "test",
+				CustomConfig: &CustomizeEnv{
+					VPCConfig: &AdjustVPC{
+						PublicSubnetCIDRs: []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/24"},
+					},
+				},
+			},
+			wanted: 3,
+		},
+		"imported vpc": {
+			in: Environment{
+				Name: "test",
+				CustomConfig: &CustomizeEnv{
+					ImportVPC: &ImportVPC{
+						PublicSubnetIDs: []string{"subnet-1"},
+					},
+				},
+			},
+			wanted: 1,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.PublicSubnetCount())
+		})
+	}
+}
+
 func TestStore_ListEnvironments(t *testing.T) {
 	testEnvironment := Environment{Name: "test", AccountID: "12345", App: "chicken", Region: "us-west-2s", Prod: false}
 	testEnvironmentString, err := marshal(testEnvironment)
@@ -18,6 +18,32 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 )
 
+const (
+	// defaultAppStackSetMaxConcurrentPercentage is the default maximum percentage of accounts to update at once
+	// when the application StackSet is updated.
+	defaultAppStackSetMaxConcurrentPercentage = 100
+	// defaultAppStackSetFailureTolerancePercentage is the default percentage of accounts per region that can fail
+	// before CloudFormation stops the application StackSet update in that region.
+	defaultAppStackSetFailureTolerancePercentage = 0
+
+	// maxAppStackSetUpdateAttempts is the number of times we try to update the application StackSet
+	// when the update races with another update.
+	maxAppStackSetUpdateAttempts = 3
+)
+
+// errAppStackSetVersionChanged occurs when the application StackSet was updated by someone else
+// after we read its configuration but before we started our own update.
+type errAppStackSetVersionChanged struct {
+	app           string
+	readVersion   int
+	latestVersion int
+}
+
+func (e *errAppStackSetVersionChanged) Error() string {
+	return fmt.Sprintf("application %s resources were updated to version %d by another operation while preparing an update from version %d",
+		e.app, e.latestVersion, e.readVersion)
+}
+
 // DeployApp sets up everything required for our application-wide resources.
 // These resources include things that are regional, rather than scoped to a particular
 // environment, such as ECR Repos, CodePipeline KMS keys & S3 buckets.
@@ -87,8 +113,7 @@ func (cf CloudFormation) upgradeAppStackSet(config *stack.AppStackConfig) error
 		if err == nil {
 			return nil
 		}
-		var stackSetOutOfDateErr *stackset.ErrStackSetOutOfDate
-		if errors.As(err, &stackSetOutOfDateErr) {
+		if isRetryableAppStackSetUpdateError(err) {
 			continue
 		}
 		return err
@@ -247,40 +272,32 @@ func (cf CloudFormation) addWorkloadToApp(app *config.Application, wlName string
 		AdditionalTags: app.Tags,
 		Version:        deploy.LatestAppTemplateVersion,
 	})
-	previouslyDeployedConfig, err := cf.getLastDeployedAppConfig(appConfig)
-	if err != nil {
-		return err
-	}
-
-	// We'll generate a new list of Accounts to add to our application
-	// infrastructure by appending the environment's account if it
-	// doesn't already exist.
-	var wlList []string
-	shouldAddNewWl := true
-	// For now, AppResourcesConfig.Services refers to workloads, including both services and jobs.
-	for _, wl := range previouslyDeployedConfig.Services {
-		wlList = append(wlList, wl)
-		if wl == wlName {
-			shouldAddNewWl = false
+	return cf.updateAppStackSet(appConfig, func(previouslyDeployedConfig *stack.AppResourcesConfig) *stack.AppResourcesConfig {
+		// We'll generate a new list of Accounts to add to our application
+		// infrastructure by appending the environment's account if it
+		// doesn't already exist.
+		var wlList []string
+		shouldAddNewWl := true
+		// For now, AppResourcesConfig.Services refers to workloads, including both services and jobs.
+		for _, wl := range previouslyDeployedConfig.Services {
+			wlList = append(wlList, wl)
+			if wl == wlName {
+				shouldAddNewWl = false
+			}
+		}
+		if !shouldAddNewWl {
+			return nil
 		}
-	}
-	if !shouldAddNewWl {
-		return nil
-	}
-
-	wlList = append(wlList, wlName)
 
-	newDeploymentConfig := stack.AppResourcesConfig{
-		Version:  previouslyDeployedConfig.Version + 1,
-		Services: wlList,
-		Accounts: previouslyDeployedConfig.Accounts,
-		App:      appConfig.Name,
-	}
-	if err := cf.deployAppConfig(appConfig, &newDeploymentConfig); err != nil {
-		return err
-	}
+		wlList = append(wlList, wlName)
 
-	return nil
+		return &stack.AppResourcesConfig{
+			Version:  previouslyDeployedConfig.Version + 1,
+			Services: wlList,
+			Accounts: previouslyDeployedConfig.Accounts,
+			App:      appConfig.Name,
+		}
+	})
 }
 
 // RemoveServiceFromApp attempts to remove service-specific resources (ECR repositories) from the application resource stack.
@@ -305,39 +322,31 @@ func (cf CloudFormation) removeWorkloadFromApp(app *config.Application, wlName s
 		AccountID: app.AccountID,
 		Version:   deploy.LatestAppTemplateVersion,
 	})
-	previouslyDeployedConfig, err := cf.getLastDeployedAppConfig(appConfig)
-	if err != nil {
-		return fmt.Errorf("get previous application %s config: %w", app.Name, err)
-	}
-
-	// We'll generate a new list of Accounts to remove the account associated
-	// with the input workload to be removed.
-	var wlList []string
-	shouldRemoveWl := false
-	// For now, AppResourcesConfig.Services refers to workloads, including both services and jobs.
-	for _, wl := range previouslyDeployedConfig.Services {
-		if wl == wlName {
-			shouldRemoveWl = true
-			continue
+	return cf.updateAppStackSet(appConfig, func(previouslyDeployedConfig *stack.AppResourcesConfig) *stack.AppResourcesConfig {
+		// We'll generate a new list of Accounts to remove the account associated
+		// with the input workload to be removed.
+		var wlList []string
+		shouldRemoveWl := false
+		// For now, AppResourcesConfig.Services refers to workloads, including both services and jobs.
+		for _, wl := range previouslyDeployedConfig.Services {
+			if wl == wlName {
+				shouldRemoveWl = true
+				continue
+			}
+			wlList = append(wlList, wl)
 		}
-		wlList = append(wlList, wl)
-	}
 
-	if !shouldRemoveWl {
-		return nil
-	}
-
-	newDeploymentConfig := stack.AppResourcesConfig{
-		Version:  previouslyDeployedConfig.Version + 1,
-		Services: wlList,
-		Accounts: previouslyDeployedConfig.Accounts,
-		App:      appConfig.Name,
-	}
-	if err := cf.deployAppConfig(appConfig, &newDeploymentConfig); err != nil {
-		return err
-	}
+		if !shouldRemoveWl {
+			return nil
+		}
 
-	return nil
+		return &stack.AppResourcesConfig{
+			Version:  previouslyDeployedConfig.Version + 1,
+			Services: wlList,
+			Accounts: previouslyDeployedConfig.Accounts,
+			App:      appConfig.Name,
+		}
+	})
 }
 
 // AddEnvToAppOpts contains the parameters to call AddEnvToApp.
@@ -358,35 +367,31 @@ func (cf CloudFormation) AddEnvToApp(opts *AddEnvToAppOpts) error {
 		AdditionalTags: opts.App.Tags,
 		Version:        deploy.LatestAppTemplateVersion,
 	})
-	previouslyDeployedConfig, err := cf.getLastDeployedAppConfig(appConfig)
-	if err != nil {
-		return fmt.Errorf("getting previous deployed stackset %w", err)
-	}
-
-	// We'll generate a new list of Accounts to add to our application
-	// infrastructure by appending the environment's account if it
-	// doesn't already exist.
-	var accountList []string
-	shouldAddNewAccountID := true
-	for _, accountID := range previouslyDeployedConfig.Accounts {
-		accountList = append(accountList, accountID)
-		if accountID == opts.EnvAccountID {
-			shouldAddNewAccountID = false
+	err := cf.updateAppStackSet(appConfig, func(previouslyDeployedConfig *stack.AppResourcesConfig) *stack.AppResourcesConfig {
+		// We'll generate a new list of Accounts to add to our application
+		// infrastructure by appending the environment's account if it
+		// doesn't already exist.
+		var accountList []string
+		shouldAddNewAccountID := true
+		for _, accountID := range previouslyDeployedConfig.Accounts {
+			accountList = append(accountList, accountID)
+			if accountID == opts.EnvAccountID {
+				shouldAddNewAccountID = false
+			}
 		}
-	}
 
-	if shouldAddNewAccountID {
-		accountList = append(accountList, opts.EnvAccountID)
-	}
-
-	newDeploymentConfig := stack.AppResourcesConfig{
-		Version:  previouslyDeployedConfig.Version + 1,
-		Services: previouslyDeployedConfig.Services,
-		Accounts: accountList,
-		App:      appConfig.Name,
-	}
+		if shouldAddNewAccountID {
+			accountList = append(accountList, opts.EnvAccountID)
+		}
 
-	if err := cf.deployAppConfig(appConfig, &newDeploymentConfig); err != nil {
+		return &stack.AppResourcesConfig{
+			Version:  previouslyDeployedConfig.Version + 1,
+			Services: previouslyDeployedConfig.Services,
+			Accounts: accountList,
+			App:      appConfig.Name,
+		}
+	})
+	if err != nil {
 		return fmt.Errorf("adding %s environment resources to application: %w", opts.EnvName, err)
 	}
 
@@ -426,6 +431,51 @@ func (cf CloudFormation) AddPipelineResourcesToApp(
 	return nil
 }
 
+// updateAppStackSet reads the last deployed application configuration, applies the changes returned by
+// the update function, and deploys the new configuration to the application StackSet.
+// The update function returns nil if there is nothing new to deploy.
+//
+// If someone else updated the StackSet between us reading and updating it, we wait for their operation to complete,
+// re-read the latest configuration and re-apply the changes, up to maxAppStackSetUpdateAttempts times.
+func (cf CloudFormation) updateAppStackSet(appConfig *stack.AppStackConfig, update func(prev *stack.AppResourcesConfig) *stack.AppResourcesConfig) error {
+	var err error
+	for attempt := 0; attempt < maxAppStackSetUpdateAttempts; attempt++ {
+		var previouslyDeployedConfig *stack.AppResourcesConfig
+		previouslyDeployedConfig, err = cf.getLastDeployedAppConfig(appConfig)
+		if err != nil {
+			return fmt.Errorf("get previous application %s config: %w", appConfig.Name, err)
+		}
+		newDeploymentConfig := update(previouslyDeployedConfig)
+		if newDeploymentConfig == nil {
+			return nil
+		}
+		err = cf.deployAppConfig(appConfig, newDeploymentConfig)
+		if err == nil {
+			return nil
+		}
+		if !isRetryableAppStackSetUpdateError(err) {
+			return err
+		}
+		if attempt == maxAppStackSetUpdateAttempts-1 {
+			break
+		}
+		// The competing update might still be in progress, retrying right away would fail the same way.
+		ssName := appConfig.StackSetName()
+		if waitErr := cf.appStackSet.WaitForStackSetLastOperationComplete(ssName); waitErr != nil {
+			return fmt.Errorf("wait for stack set %s last operation complete: %w", ssName, waitErr)
+		}
+	}
+	return err
+}
+
+// isRetryableAppStackSetUpdateError returns true if the update failed because it raced with another update
+// of the application StackSet.
+func isRetryableAppStackSetUpdateError(err error) bool {
+	var stackSetOutOfDateErr *stackset.ErrStackSetOutOfDate
+	var versionChangedErr *errAppStackSetVersionChanged
+	return errors.As(err, &stackSetOutOfDateErr) || errors.As(err, &versionChangedErr)
+}
+
 func (cf CloudFormation) deployAppConfig(appConfig *stack.AppStackConfig, resources *stack.AppResourcesConfig) error {
 	newTemplateToDeploy, err := appConfig.ResourceTemplate(resources)
 	if err != nil {
@@ -441,16 +491,39 @@ func (cf CloudFormation) deployAppConfig(appConfig *stack.AppStackConfig, resour
 	//  * We update the StackSet with Version 2, the update completes.
 	//  * Someone else tries to update the StackSet with their stale version 2.
 	//  * "2" has already been used as an operation ID, and the stale write fails.
+	// Before updating, we also read the version one more time so that we can fail fast without starting
+	// an operation if someone else has already updated the StackSet.
+	latestConfig, err := cf.getLastDeployedAppConfig(appConfig)
+	if err != nil {
+		return err
+	}
+	if readVersion := resources.Version - 1; latestConfig.Version != readVersion {
+		return &errAppStackSetVersionChanged{
+			app:           appConfig.Name,
+			readVersion:   readVersion,
+			latestVersion: latestConfig.Version,
+		}
+	}
 	stackSetAdminRoleARN, err := appConfig.StackSetAdminRoleARN(cf.region)
 	if err != nil {
 		return fmt.Errorf("get stack set administrator role arn: %w", err)
 	}
-	return cf.appStackSet.UpdateAndWait(appConfig.StackSetName(), newTemplateToDeploy,
+	opts := append([]stackset.CreateOrUpdateOption{
 		stackset.WithOperationID(fmt.Sprintf("%d", resources.Version)),
 		stackset.WithDescription(appConfig.StackSetDescription()),
 		stackset.WithExecutionRoleName(appConfig.StackSetExecutionRoleName()),
 		stackset.WithAdministrationRoleARN(stackSetAdminRoleARN),
-		stackset.WithTags(toMap(appConfig.Tags())))
+		stackset.WithTags(toMap(appConfig.Tags())),
+	}, cf.appStackSetOperationPreferences()...)
+	return cf.appStackSet.UpdateAndWait(appConfig.StackSetName(), newTemplateToDeploy, opts...)
+}
+
+// appStackSetOperationPreferences returns the options that set the preferences of every application StackSet operation.
+func (cf CloudFormation) appStackSetOperationPreferences() []stackset.CreateOrUpdateOption {
+	return []stackset.CreateOrUpdateOption{
+		stackset.WithMaxConcurrentPercentage(cf.appStackSetMaxConcurrentPercentage),
+		stackset.WithFailureTolerancePercentage(cf.appStackSetFailureTolerancePercentage),
+	}
 }
 
 // addNewAppStackInstances takes an environment and determines if we need to create a new
@@ -475,7 +548,8 @@ func (cf CloudFormation) addNewAppStackInstances(appConfig *stack.AppStackConfig
 	}
 
 	// Set up a new Stack Instance for the new region. The Stack Instance will inherit the latest StackSet template.
-	return cf.appStackSet.CreateInstancesAndWait(appConfig.StackSetName(), []string{appConfig.AccountID}, []string{region})
+	return cf.appStackSet.CreateInstancesAndWait(appConfig.StackSetName(), []string{appConfig.AccountID}, []string{region},
+		cf.appStackSetOperationPreferences()...)
 }
 
 func (cf CloudFormation) getLastDeployedAppConfig(appConfig *stack.AppStackConfig) (*stack.AppResourcesConfig, error) {
@@ -495,7 +569,7 @@ func (cf CloudFormation) getLastDeployedAppConfig(appConfig *stack.AppStackConfi
 
 // DeleteApp deletes all application specific StackSet and Stack resources.
 func (cf CloudFormation) DeleteApp(appName string) error {
-	if err := cf.appStackSet.Delete(fmt.Sprintf("%s-infrastructure", appName)); err != nil {
+	if err := cf.appStackSet.Delete(fmt.Sprintf("%s-infrastructure", appName), cf.appStackSetOperationPreferences()...); err != nil {
 		return err
 	}
 	return cf.cfnClient.DeleteAndWait(fmt.Sprintf("%s-infrastructure-roles", appName))
@@ -182,8 +182,8 @@ func TestCloudFormation_UpgradeApplication(t *testing.T) {
 
 				mockAppStackSet := mocks.NewMockstackSetClient(ctrl)
 				mockAppStackSet.EXPECT().WaitForStackSetLastOperationComplete("phonetool-infrastructure").Return(nil)
-				mockAppStackSet.EXPECT().Describe("phonetool-infrastructure").Return(stackset.Description{}, nil)
-				mockAppStackSet.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				mockAppStackSet.EXPECT().Describe("phonetool-infrastructure").Return(stackset.Description{}, nil).Times(2)
+				mockAppStackSet.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil)
 
 				return &CloudFormation{
@@ -205,12 +205,12 @@ func TestCloudFormation_UpgradeApplication(t *testing.T) {
 
 				mockAppStackSet := mocks.NewMockstackSetClient(ctrl)
 				mockAppStackSet.EXPECT().WaitForStackSetLastOperationComplete("phonetool-infrastructure").Return(nil)
-				mockAppStackSet.EXPECT().Describe("phonetool-infrastructure").Return(stackset.Description{}, nil)
-				mockAppStackSet.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				mockAppStackSet.EXPECT().Describe("phonetool-infrastructure").Return(stackset.Description{}, nil).Times(2)
+				mockAppStackSet.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&stackset.ErrStackSetOutOfDate{})
 				mockAppStackSet.EXPECT().WaitForStackSetLastOperationComplete("phonetool-infrastructure").Return(nil)
-				mockAppStackSet.EXPECT().Describe("phonetool-infrastructure").Return(stackset.Description{}, nil)
-				mockAppStackSet.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				mockAppStackSet.EXPECT().Describe("phonetool-infrastructure").Return(stackset.Description{}, nil).Times(2)
+				mockAppStackSet.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil)
 
 				return &CloudFormation{
@@ -264,8 +264,8 @@ func TestCloudFormation_AddEnvToApp(t *testing.T) {
 				require.NoError(t, err)
 				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
 					Template: string(body),
-				}, nil)
-				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				}, nil).Times(2)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil).
 					Do(func(_, _ string, ops ...stackset.CreateOrUpdateOption) {
 						actual := &awscfn.UpdateStackSetInput{}
@@ -273,9 +273,28 @@ func TestCloudFormation_AddEnvToApp(t *testing.T) {
 						wanted := &awscfn.UpdateStackSetInput{}
 						stackset.WithOperationID("1")(wanted)
 						require.Equal(t, actual, wanted)
+
+						actualPrefs := &awscfn.UpdateStackSetInput{}
+						for _, op := range ops {
+							op(actualPrefs)
+						}
+						require.Equal(t, &awscfn.StackSetOperationPreferences{
+							MaxConcurrentPercentage:    aws.Int64(50),
+							FailureTolerancePercentage: aws.Int64(10),
+						}, actualPrefs.OperationPreferences)
 					})
 				m.EXPECT().InstanceSummaries(gomock.Any()).Return([]stackset.InstanceSummary{}, nil)
-				m.EXPECT().CreateInstancesAndWait(gomock.Any(), []string{"1234"}, []string{"us-west-2"})
+				m.EXPECT().CreateInstancesAndWait(gomock.Any(), []string{"1234"}, []string{"us-west-2"}, gomock.Any(), gomock.Any()).
+					Do(func(_ string, _, _ []string, ops ...stackset.CreateOrUpdateOption) {
+						actual := &awscfn.CreateStackInstancesInput{}
+						for _, op := range ops {
+							op(actual)
+						}
+						require.Equal(t, &awscfn.StackSetOperationPreferences{
+							MaxConcurrentPercentage:    aws.Int64(50),
+							FailureTolerancePercentage: aws.Int64(10),
+						}, actual.OperationPreferences)
+					})
 				return m
 			},
 		},
@@ -292,11 +311,11 @@ func TestCloudFormation_AddEnvToApp(t *testing.T) {
 				require.NoError(t, err)
 				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
 					Template: string(body),
-				}, nil)
-				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				}, nil).Times(2)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil)
 				m.EXPECT().InstanceSummaries(gomock.Any()).Return([]stackset.InstanceSummary{}, nil)
-				m.EXPECT().CreateInstancesAndWait(gomock.Any(), []string{"1234"}, []string{"us-west-2"}).Return(nil)
+				m.EXPECT().CreateInstancesAndWait(gomock.Any(), []string{"1234"}, []string{"us-west-2"}, gomock.Any(), gomock.Any()).Return(nil)
 				return m
 			},
 		},
@@ -314,8 +333,8 @@ func TestCloudFormation_AddEnvToApp(t *testing.T) {
 				require.NoError(t, err)
 				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
 					Template: string(body),
-				}, nil)
-				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				}, nil).Times(2)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil).
 					Do(func(_, _ string, ops ...stackset.CreateOrUpdateOption) {
 						actual := &awscfn.UpdateStackSetInput{}
@@ -330,7 +349,7 @@ func TestCloudFormation_AddEnvToApp(t *testing.T) {
 						Account: "1234",
 					},
 				}, nil)
-				m.EXPECT().CreateInstancesAndWait(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.EXPECT().CreateInstancesAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 				return m
 			},
 		},
@@ -345,6 +364,9 @@ func TestCloudFormation_AddEnvToApp(t *testing.T) {
 				appStackSet: tc.mockStackSet(t, ctrl),
 				box:         templates.Box(),
 				region:      "us-west-2",
+
+				appStackSetMaxConcurrentPercentage:    50,
+				appStackSetFailureTolerancePercentage: 10,
 			}
 			got := cf.AddEnvToApp(&AddEnvToAppOpts{
 				App:          tc.app,
@@ -378,7 +400,7 @@ func TestCloudFormation_AddPipelineResourcesToApp(t *testing.T) {
 			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
 				m := mocks.NewMockstackSetClient(ctrl)
 				m.EXPECT().InstanceSummaries(gomock.Any()).Return([]stackset.InstanceSummary{}, nil)
-				m.EXPECT().CreateInstancesAndWait(gomock.Any(), []string{"1234"}, []string{"us-west-2"}).Return(nil)
+				m.EXPECT().CreateInstancesAndWait(gomock.Any(), []string{"1234"}, []string{"us-west-2"}, gomock.Any(), gomock.Any()).Return(nil)
 				return m
 			},
 			getRegionFromClient: func(client cloudformationiface.CloudFormationAPI) (string, error) {
@@ -395,7 +417,7 @@ func TestCloudFormation_AddPipelineResourcesToApp(t *testing.T) {
 						Account: mockApp.AccountID,
 					},
 				}, nil)
-				m.EXPECT().CreateInstancesAndWait(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.EXPECT().CreateInstancesAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 				return m
 			},
 			getRegionFromClient: func(client cloudformationiface.CloudFormationAPI) (string, error) {
@@ -447,8 +469,8 @@ func TestCloudFormation_AddServiceToApp(t *testing.T) {
 				require.NoError(t, err)
 				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
 					Template: string(body),
-				}, nil)
-				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				}, nil).Times(2)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil).
 					Do(func(_, template string, _ ...stackset.CreateOrUpdateOption) {
 						configToDeploy, err := stack.AppConfigFrom(&template)
@@ -472,8 +494,8 @@ func TestCloudFormation_AddServiceToApp(t *testing.T) {
 				require.NoError(t, err)
 				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
 					Template: string(body),
-				}, nil)
-				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				}, nil).Times(2)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil).
 					Do(func(_, template string, _ ...stackset.CreateOrUpdateOption) {
 						configToDeploy, err := stack.AppConfigFrom(&template)
@@ -486,6 +508,147 @@ func TestCloudFormation_AddServiceToApp(t *testing.T) {
 				return m
 			},
 		},
+		"re-reads the stack set and retries if the update is out of date": {
+			app:     &mockApp,
+			svcName: "test",
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				staleBody, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+					Services: []string{"firsttest"},
+					Version:  1,
+				}})
+				require.NoError(t, err)
+				latestBody, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+					Services: []string{"firsttest", "secondtest"},
+					Version:  2,
+				}})
+				require.NoError(t, err)
+				gomock.InOrder(
+					m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+						Template: string(staleBody),
+					}, nil).Times(2),
+					m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(&stackset.ErrStackSetOutOfDate{}),
+					m.EXPECT().WaitForStackSetLastOperationComplete("testapp-infrastructure").Return(nil),
+					m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+						Template: string(latestBody),
+					}, nil).Times(2),
+					m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(nil).
+						Do(func(_, template string, _ ...stackset.CreateOrUpdateOption) {
+							configToDeploy, err := stack.AppConfigFrom(&template)
+							require.NoError(t, err)
+							require.ElementsMatch(t, []string{"test", "firsttest", "secondtest"}, configToDeploy.Services)
+							require.Equal(t, 3, configToDeploy.Version)
+						}),
+				)
+				return m
+			},
+		},
+		"re-reads the stack set and retries if the version changed before the update": {
+			app:     &mockApp,
+			svcName: "test",
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				staleBody, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+					Services: []string{"firsttest"},
+					Version:  1,
+				}})
+				require.NoError(t, err)
+				latestBody, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+					Services: []string{"firsttest", "secondtest"},
+					Version:  2,
+				}})
+				require.NoError(t, err)
+				gomock.InOrder(
+					m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+						Template: string(staleBody),
+					}, nil),
+					m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+						Template: string(latestBody),
+					}, nil),
+					m.EXPECT().WaitForStackSetLastOperationComplete("testapp-infrastructure").Return(nil),
+					m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+						Template: string(latestBody),
+					}, nil).Times(2),
+					m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(nil).
+						Do(func(_, template string, _ ...stackset.CreateOrUpdateOption) {
+							configToDeploy, err := stack.AppConfigFrom(&template)
+							require.NoError(t, err)
+							require.ElementsMatch(t, []string{"test", "firsttest", "secondtest"}, configToDeploy.Services)
+							require.Equal(t, 3, configToDeploy.Version)
+						}),
+				)
+				return m
+			},
+		},
+		"gives up if the version keeps changing before the update": {
+			app:     &mockApp,
+			svcName: "test",
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				staleBody, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+					Version: 1,
+				}})
+				require.NoError(t, err)
+				latestBody, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+					Version: 2,
+				}})
+				require.NoError(t, err)
+				for i := 0; i < maxAppStackSetUpdateAttempts; i++ {
+					gomock.InOrder(
+						m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+							Template: string(staleBody),
+						}, nil),
+						m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+							Template: string(latestBody),
+						}, nil),
+					)
+				}
+				m.EXPECT().WaitForStackSetLastOperationComplete("testapp-infrastructure").Return(nil).Times(maxAppStackSetUpdateAttempts - 1)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+				return m
+			},
+			want: errors.New("adding service test resources to application testapp: application testapp resources were updated to version 2 by another operation while preparing an update from version 1"),
+		},
+		"gives up after too many out of date updates": {
+			app:     &mockApp,
+			svcName: "test",
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				body, err := yaml.Marshal(stack.DeployedAppMetadata{})
+				require.NoError(t, err)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: string(body),
+				}, nil).Times(2 * maxAppStackSetUpdateAttempts)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&stackset.ErrStackSetOutOfDate{}).Times(maxAppStackSetUpdateAttempts)
+				m.EXPECT().WaitForStackSetLastOperationComplete("testapp-infrastructure").Return(nil).Times(maxAppStackSetUpdateAttempts - 1)
+				return m
+			},
+			want: fmt.Errorf("adding service test resources to application testapp: %w", &stackset.ErrStackSetOutOfDate{}),
+		},
+		"returns an error if waiting for the competing operation fails": {
+			app:     &mockApp,
+			svcName: "test",
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				body, err := yaml.Marshal(stack.DeployedAppMetadata{})
+				require.NoError(t, err)
+				gomock.InOrder(
+					m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+						Template: string(body),
+					}, nil).Times(2),
+					m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(&stackset.ErrStackSetOutOfDate{}),
+					m.EXPECT().WaitForStackSetLastOperationComplete("testapp-infrastructure").Return(errors.New("some error")),
+				)
+				return m
+			},
+			want: errors.New("adding service test resources to application testapp: wait for stack set testapp-infrastructure last operation complete: some error"),
+		},
 		"with existing service to existing app with existing services": {
 			app:     &mockApp,
 			svcName: "test",
@@ -499,7 +662,7 @@ func TestCloudFormation_AddServiceToApp(t *testing.T) {
 				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
 					Template: string(body),
 				}, nil)
-				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 				return m
 			},
@@ -550,8 +713,8 @@ func TestCloudFormation_RemoveServiceFromApp(t *testing.T) {
 				require.NoError(t, err)
 				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
 					Template: string(body),
-				}, nil)
-				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				}, nil).Times(2)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil).
 					Do(func(_, template string, _ ...stackset.CreateOrUpdateOption) {
 						configToDeploy, err := stack.AppConfigFrom(&template)
@@ -915,7 +1078,18 @@ func TestCloudFormation_DeleteApp(t *testing.T) {
 			},
 			mockStackSet: func(ctrl *gomock.Controller) stackSetClient {
 				m := mocks.NewMockstackSetClient(ctrl)
-				m.EXPECT().Delete(gomock.Any()).Return(nil)
+				m.EXPECT().Delete("testApp-infrastructure", gomock.Any(), gomock.Any()).
+					Return(nil).
+					Do(func(_ string, ops ...stackset.CreateOrUpdateOption) {
+						actual := &awscfn.DeleteStackInstancesInput{}
+						for _, op := range ops {
+							op(actual)
+						}
+						require.Equal(t, &awscfn.StackSetOperationPreferences{
+							MaxConcurrentPercentage:    aws.Int64(50),
+							FailureTolerancePercentage: aws.Int64(10),
+						}, actual.OperationPreferences)
+					})
 				return m
 			},
 		},
@@ -930,6 +1104,9 @@ func TestCloudFormation_DeleteApp(t *testing.T) {
 			cf := CloudFormation{
 				cfnClient:   tc.createMock(ctrl),
 				appStackSet: tc.mockStackSet(ctrl),
+
+				appStackSetMaxConcurrentPercentage:    50,
+				appStackSetFailureTolerancePercentage: 10,
 			}
 
 			// WHEN
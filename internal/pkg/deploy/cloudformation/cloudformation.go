@@ -92,11 +92,11 @@ type s3Client interface {
 
 type stackSetClient interface {
 	Create(name, template string, opts ...stackset.CreateOrUpdateOption) error
-	CreateInstancesAndWait(name string, accounts, regions []string) error
+	CreateInstancesAndWait(name string, accounts, regions []string, opts ...stackset.CreateOrUpdateOption) error
 	UpdateAndWait(name, template string, opts ...stackset.CreateOrUpdateOption) error
 	Describe(name string) (stackset.Description, error)
 	InstanceSummaries(name string, opts ...stackset.InstanceSummariesOption) ([]stackset.InstanceSummary, error)
-	Delete(name string) error
+	Delete(name string, opts ...stackset.CreateOrUpdateOption) error
 	WaitForStackSetLastOperationComplete(name string) error
 }
 
@@ -111,10 +111,32 @@ type CloudFormation struct {
 	box            packd.Box
 	s3Client       s3Client
 	region         string
+
+	appStackSetMaxConcurrentPercentage    int
+	appStackSetFailureTolerancePercentage int
+}
+
+// Option is a functional option to configure the CloudFormation client.
+type Option func(cf *CloudFormation)
+
+// WithAppStackSetMaxConcurrentPercentage sets the maximum percentage of accounts in which to perform
+// an application StackSet operation at once. Defaults to 100.
+func WithAppStackSetMaxConcurrentPercentage(percentage int) Option {
+	return func(cf *CloudFormation) {
+		cf.appStackSetMaxConcurrentPercentage = percentage
+	}
+}
+
+// WithAppStackSetFailureTolerancePercentage sets the percentage of accounts per region that can fail
+// before CloudFormation stops an application StackSet operation in that region. Defaults to 0.
+func WithAppStackSetFailureTolerancePercentage(percentage int) Option {
+	return func(cf *CloudFormation) {
+		cf.appStackSetFailureTolerancePercentage = percentage
+	}
 }
 
 // New returns a configured CloudFormation client.
-func New(sess *session.Session) CloudFormation {
+func New(sess *session.Session, opts ...Option) CloudFormation {
 	client := CloudFormation{
 		cfnClient:      cloudformation.New(sess),
 		codeStarClient: codestar.New(sess),
@@ -129,6 +151,12 @@ func New(sess *session.Session) CloudFormation {
 		box:         templates.Box(),
 		s3Client:    s3.New(sess),
 		region:      aws.StringValue(sess.Config.Region),
+
+		appStackSetMaxConcurrentPercentage:    defaultAppStackSetMaxConcurrentPercentage,
+		appStackSetFailureTolerancePercentage: defaultAppStackSetFailureTolerancePercentage,
+	}
+	for _, opt := range opts {
+		opt(&client)
 	}
 	return client
 }
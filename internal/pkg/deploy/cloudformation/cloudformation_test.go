@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
 	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	awsecs "github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
@@ -22,6 +23,46 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestNew(t *testing.T) {
+	testCases := map[string]struct {
+		opts []Option
+
+		wantedMaxConcurrentPercentage    int
+		wantedFailureTolerancePercentage int
+	}{
+		"uses default application StackSet operation preferences": {
+			wantedMaxConcurrentPercentage:    100,
+			wantedFailureTolerancePercentage: 0,
+		},
+		"overrides application StackSet operation preferences": {
+			opts: []Option{
+				WithAppStackSetMaxConcurrentPercentage(25),
+				WithAppStackSetFailureTolerancePercentage(10),
+			},
+			wantedMaxConcurrentPercentage:    25,
+			wantedFailureTolerancePercentage: 10,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			sess, err := session.NewSession(&aws.Config{
+				Region: aws.String("us-west-2"),
+			})
+			require.NoError(t, err)
+
+			// WHEN
+			cf := New(sess, tc.opts...)
+
+			// THEN
+			require.Equal(t, "us-west-2", cf.region)
+			require.Equal(t, tc.wantedMaxConcurrentPercentage, cf.appStackSetMaxConcurrentPercentage)
+			require.Equal(t, tc.wantedFailureTolerancePercentage, cf.appStackSetFailureTolerancePercentage)
+		})
+	}
+}
+
 type mockFileWriter struct {
 	io.Writer
 }
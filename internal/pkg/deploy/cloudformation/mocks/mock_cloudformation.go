@@ -576,31 +576,41 @@ func (mr *MockstackSetClientMockRecorder) Create(name, template interface{}, opt
 }
 
 // CreateInstancesAndWait mocks base method.
-func (m *MockstackSetClient) CreateInstancesAndWait(name string, accounts, regions []string) error {
+func (m *MockstackSetClient) CreateInstancesAndWait(name string, accounts, regions []string, opts ...stackset.CreateOrUpdateOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateInstancesAndWait", name, accounts, regions)
+	varargs := []interface{}{name, accounts, regions}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateInstancesAndWait", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateInstancesAndWait indicates an expected call of CreateInstancesAndWait.
-func (mr *MockstackSetClientMockRecorder) CreateInstancesAndWait(name, accounts, regions interface{}) *gomock.Call {
+func (mr *MockstackSetClientMockRecorder) CreateInstancesAndWait(name, accounts, regions interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstancesAndWait", reflect.TypeOf((*MockstackSetClient)(nil).CreateInstancesAndWait), name, accounts, regions)
+	varargs := append([]interface{}{name, accounts, regions}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstancesAndWait", reflect.TypeOf((*MockstackSetClient)(nil).CreateInstancesAndWait), varargs...)
 }
 
 // Delete mocks base method.
-func (m *MockstackSetClient) Delete(name string) error {
+func (m *MockstackSetClient) Delete(name string, opts ...stackset.CreateOrUpdateOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", name)
+	varargs := []interface{}{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Delete", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockstackSetClientMockRecorder) Delete(name interface{}) *gomock.Call {
+func (mr *MockstackSetClientMockRecorder) Delete(name interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockstackSetClient)(nil).Delete), name)
+	varargs := append([]interface{}{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockstackSetClient)(nil).Delete), varargs...)
 }
 
 // Describe mocks base method.
@@ -9,9 +9,9 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/partition"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"gopkg.in/yaml.v3"
@@ -186,11 +186,11 @@ func (c *AppStackConfig) stackSetAdminRoleName() string {
 // StackSetAdminRoleARN returns the role ARN of the role used to administer the Application
 // StackSet.
 func (c *AppStackConfig) StackSetAdminRoleARN(region string) (string, error) {
-	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
-	if !ok {
-		return "", fmt.Errorf("find the partition for region %s", region)
+	partitionID, err := partition.FromRegion(region)
+	if err != nil {
+		return "", err
 	}
-	return fmt.Sprintf(fmtStackSetAdminRoleARN, partition.ID(), c.AccountID, c.stackSetAdminRoleName()), nil
+	return fmt.Sprintf(fmtStackSetAdminRoleARN, partitionID, c.AccountID, c.stackSetAdminRoleName()), nil
 }
 
 // StackSetExecutionRoleName returns the role name of the role used to actually create
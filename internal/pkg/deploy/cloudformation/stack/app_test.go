@@ -379,6 +379,48 @@ func TestAppStackSetName(t *testing.T) {
 	require.Equal(t, fmt.Sprintf("%s-infrastructure", app.Name), app.StackSetName())
 }
 
+func TestAppStackSetAdminRoleARN(t *testing.T) {
+	testCases := map[string]struct {
+		inRegion string
+
+		wantedARN string
+		wantedErr error
+	}{
+		"commercial region": {
+			inRegion:  "us-west-2",
+			wantedARN: "arn:aws:iam::1234:role/testapp-adminrole",
+		},
+		"GovCloud region": {
+			inRegion:  "us-gov-west-1",
+			wantedARN: "arn:aws-us-gov:iam::1234:role/testapp-adminrole",
+		},
+		"China region": {
+			inRegion:  "cn-north-1",
+			wantedARN: "arn:aws-cn:iam::1234:role/testapp-adminrole",
+		},
+		"unknown region": {
+			inRegion:  "mars-west-1",
+			wantedErr: errors.New("find the partition for region mars-west-1"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			app := &AppStackConfig{
+				CreateAppInput: &deploy.CreateAppInput{Name: "testapp", AccountID: "1234"},
+			}
+
+			arn, err := app.StackSetAdminRoleARN(tc.inRegion)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedARN, arn)
+		})
+	}
+}
+
 func TestTemplateToAppConfig(t *testing.T) {
 	given := `AWSTemplateFormatVersion: '2010-09-09'
 Description: Cross-regional resources to support the CodePipeline for a workspace
@@ -47,15 +47,17 @@ func NewBackendService(mft *manifest.BackendService, env, app string, rc Runtime
 	return &BackendService{
 		ecsWkld: &ecsWkld{
 			wkld: &wkld{
-				name:   aws.StringValue(mft.Name),
-				env:    env,
-				app:    app,
-				rc:     rc,
-				image:  mft.ImageConfig,
-				parser: parser,
-				addons: addons,
+				name:         aws.StringValue(mft.Name),
+				env:          env,
+				app:          app,
+				rc:           rc,
+				image:        mft.ImageConfig,
+				parser:       parser,
+				addons:       addons,
+				addonsParams: addonsParameters(mft.Addons),
 			},
-			tc: mft.TaskConfig,
+			tc:      mft.TaskConfig,
+			logging: mft.Logging,
 		},
 		manifest: mft,
 
@@ -105,6 +107,13 @@ func (s *BackendService) Template() (string, error) {
 		desiredCountOnSpot = advancedCount.Spot
 		capacityProviders = advancedCount.Cps
 	}
+	capacityProviders, err = convertCapacityProvider(capacityProviders, s.manifest.CapacityProvider, s.rc.EnvCapacityProvider)
+	if err != nil {
+		return "", fmt.Errorf("convert the capacity provider for service %s: %w", s.name, err)
+	}
+	if err := validateClusterCapacityProviders(capacityProviders, s.rc.ImportedCluster); err != nil {
+		return "", fmt.Errorf("validate the capacity providers for service %s: %w", s.name, err)
+	}
 	storage, err := convertStorageOpts(s.manifest.Name, s.manifest.Storage)
 	if err != nil {
 		return "", fmt.Errorf("convert storage options for service %s: %w", s.name, err)
@@ -117,6 +126,35 @@ func (s *BackendService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if len(s.manifest.ImageConfig.AdditionalPorts) != 0 {
+		return "", fmt.Errorf("validate the image configuration for service %s: %w", s.name, errAdditionalPortsNotSupported)
+	}
+	var httpHealthCheck template.HTTPHealthCheckOpts
+	var hostHeader, rulePath string
+	var rulePriority int
+	if httpConfig := s.manifest.HTTP; httpConfig != nil {
+		if err := validateBackendHTTPConfig(*httpConfig, s.manifest.ImageConfig.Port, s.manifest.Network); err != nil {
+			return "", fmt.Errorf("validate the http configuration for service %s: %w", s.name, err)
+		}
+		httpHealthCheck, err = convertHTTPHealthCheck(&httpConfig.HealthCheck)
+		if err != nil {
+			return "", fmt.Errorf("convert the health check configuration for service %s: %w", s.name, err)
+		}
+		hostHeader = aws.StringValue(httpConfig.Host)
+		rulePath = aws.StringValue(httpConfig.Path)
+		if rulePath == "" {
+			// Route all requests for the host if the service only has a host condition.
+			rulePath = "/"
+		}
+		rulePriority = s.rc.RulePriority
+		if rulePriority == 0 {
+			rulePriority = RulePriority(s.name, aws.StringValue(httpConfig.Path))
+		}
+	}
+	alarmNotifications, err := convertAlarmNotifications(s.manifest.AlarmNotifications)
+	if err != nil {
+		return "", fmt.Errorf("convert the alarm notifications configuration for service %s: %w", s.name, err)
+	}
 	content, err := s.parser.ParseBackendService(template.WorkloadOpts{
 		Variables:                s.manifest.BackendServiceConfig.Variables,
 		Secrets:                  s.manifest.BackendServiceConfig.Secrets,
@@ -128,6 +166,11 @@ func (s *BackendService) Template() (string, error) {
 		ExecuteCommand:           convertExecuteCommand(&s.manifest.ExecuteCommand),
 		WorkloadType:             manifest.BackendServiceType,
 		HealthCheck:              s.manifest.BackendServiceConfig.ImageConfig.HealthCheckOpts(),
+		InternalALB:              s.manifest.HTTP != nil,
+		HTTPHealthCheck:          httpHealthCheck,
+		HostHeader:               hostHeader,
+		RulePath:                 rulePath,
+		RulePriority:             rulePriority,
 		LogConfig:                convertLogging(s.manifest.Logging),
 		DockerLabels:             s.manifest.ImageConfig.DockerLabels,
 		DesiredCountLambda:       desiredCountLambda.String(),
@@ -138,6 +181,8 @@ func (s *BackendService) Template() (string, error) {
 		Command:                  command,
 		DependsOn:                dependencies,
 		ServiceDiscoveryEndpoint: s.rc.ServiceDiscoveryEndpoint,
+		Dashboard:                s.manifest.Observability.HasDashboard(),
+		AlarmNotifications:       alarmNotifications,
 	})
 	if err != nil {
 		return "", fmt.Errorf("parse backend service template: %w", err)
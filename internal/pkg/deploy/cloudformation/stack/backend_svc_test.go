@@ -153,6 +153,209 @@ Outputs:
 			},
 			wantedErr: fmt.Errorf("parse backend service template: %w", errors.New("some error")),
 		},
+		"failed validating http configuration": {
+			setUpManifest: func(svc *BackendService) {
+				svc.manifest = manifest.NewBackendService(baseProps)
+				svc.manifest.HTTP = &manifest.BackendServiceHTTPConfig{}
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrAddonsNotFound{}}
+			},
+			wantedErr: fmt.Errorf("validate the http configuration for service frontend: %w", errNoRoutingRuleCondition),
+		},
+		"failed validating the capacity providers of the imported cluster": {
+			setUpManifest: func(svc *BackendService) {
+				svc.manifest = manifest.NewBackendService(baseProps)
+				svc.manifest.CapacityProvider = aws.String(capacityProviderFargateSpot)
+				svc.rc.ImportedCluster = &ImportedCluster{
+					ARN:               "arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster",
+					CapacityProviders: []string{capacityProviderFargate},
+				}
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrAddonsNotFound{}}
+			},
+			wantedErr: fmt.Errorf("validate the capacity providers for service frontend: capacity provider FARGATE_SPOT is not associated with the imported cluster arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster"),
+		},
+		"render template with internal load balancer": {
+			setUpManifest: func(svc *BackendService) {
+				svc.manifest = manifest.NewBackendService(baseProps)
+				svc.manifest.HTTP = &manifest.BackendServiceHTTPConfig{
+					Path: aws.String("api"),
+					Host: aws.String("api.internal"),
+					HealthCheck: manifest.HealthCheckArgsOrString{
+						HealthCheckPath: aws.String("/healthz"),
+					},
+				}
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseBackendService(gomock.Any()).DoAndReturn(func(actual template.WorkloadOpts) (*template.Content, error) {
+					require.True(t, actual.InternalALB)
+					require.Equal(t, template.HTTPHealthCheckOpts{HealthCheckPath: "/healthz"}, actual.HTTPHealthCheck)
+					require.Equal(t, "api.internal", actual.HostHeader)
+					require.Equal(t, "api", actual.RulePath)
+					require.Equal(t, 29457, actual.RulePriority)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrAddonsNotFound{}}
+			},
+			wantedTemplate: "template",
+		},
+		"render template with the rule priority of the runtime config": {
+			setUpManifest: func(svc *BackendService) {
+				svc.manifest = manifest.NewBackendService(baseProps)
+				svc.manifest.HTTP = &manifest.BackendServiceHTTPConfig{
+					Path: aws.String("api"),
+				}
+				svc.rc.RulePriority = 29458
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseBackendService(gomock.Any()).DoAndReturn(func(actual template.WorkloadOpts) (*template.Content, error) {
+					require.Equal(t, 29458, actual.RulePriority)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrAddonsNotFound{}}
+			},
+			wantedTemplate: "template",
+		},
+		"render template with addons parameters": {
+			setUpManifest: func(svc *BackendService) {
+				svc.manifest = manifest.NewBackendService(baseProps)
+				svc.addonsParams = map[string]string{
+					"BucketRetentionDays": "30",
+				}
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseBackendService(gomock.Any()).DoAndReturn(func(actual template.WorkloadOpts) (*template.Content, error) {
+					require.Equal(t, map[string]string{
+						"BucketRetentionDays": "30",
+					}, actual.NestedStack.Parameters)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+				svc.parser = m
+				svc.addons = mockTemplater{
+					tpl: `
+Parameters:
+  App:
+    Type: String
+  Env:
+    Type: String
+  Name:
+    Type: String
+  BucketRetentionDays:
+    Type: Number
+    Default: 7
+Resources:
+  MyBucket:
+    Type: AWS::S3::Bucket`,
+				}
+			},
+			wantedTemplate: "template",
+		},
+		"error if addons parameters are set without addons": {
+			setUpManifest: func(svc *BackendService) {
+				svc.manifest = manifest.NewBackendService(baseProps)
+				svc.addonsParams = map[string]string{
+					"BucketRetentionDays": "30",
+					"BucketName":          "my-bucket",
+				}
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrAddonsNotFound{}}
+			},
+			wantedErr: errors.New("addons parameters BucketName, BucketRetentionDays are set in the manifest of frontend but it has no addons"),
+		},
+		"error if an addons parameter is not declared by the addons": {
+			setUpManifest: func(svc *BackendService) {
+				svc.manifest = manifest.NewBackendService(baseProps)
+				svc.addonsParams = map[string]string{
+					"BucketRetentionDay": "30",
+				}
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{
+					tpl: `
+Parameters:
+  BucketRetentionDays:
+    Type: Number
+Resources:
+  MyBucket:
+    Type: AWS::S3::Bucket`,
+				}
+			},
+			wantedErr: errors.New(`addons parameter BucketRetentionDay of frontend is not declared under "Parameters" in its addons`),
+		},
+		"error if an addons parameter is passed by Copilot": {
+			setUpManifest: func(svc *BackendService) {
+				svc.manifest = manifest.NewBackendService(baseProps)
+				svc.addonsParams = map[string]string{
+					"Env": "prod",
+				}
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{
+					tpl: `
+Parameters:
+  Env:
+    Type: String
+Resources:
+  MyBucket:
+    Type: AWS::S3::Bucket`,
+				}
+			},
+			wantedErr: errors.New("addons parameter Env of frontend cannot be set in the manifest as Copilot passes it to the addons stack"),
+		},
+		"render template with a dashboard": {
+			setUpManifest: func(svc *BackendService) {
+				svc.manifest = manifest.NewBackendService(baseProps)
+				svc.manifest.Observability = &manifest.ObservabilityConfig{
+					Dashboard: aws.Bool(true),
+				}
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseBackendService(gomock.Any()).DoAndReturn(func(actual template.WorkloadOpts) (*template.Content, error) {
+					require.True(t, actual.Dashboard)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrAddonsNotFound{}}
+			},
+			wantedTemplate: "template",
+		},
 		"render template": {
 			setUpManifest: func(svc *BackendService) {
 				svc.manifest = manifest.NewBackendService(manifest.BackendServiceProps{
@@ -39,8 +39,12 @@ const (
 
 	// Output keys.
 	EnvOutputVPCID                   = "VpcId"
+	EnvOutputClusterID               = "ClusterId"
 	EnvOutputPublicSubnets           = "PublicSubnets"
 	EnvOutputPrivateSubnets          = "PrivateSubnets"
+	EnvOutputHTTPListenerARN         = "HTTPListenerArn"
+	EnvOutputHTTPSListenerARN        = "HTTPSListenerArn"
+	EnvOutputInternalHTTPListenerARN = "InternalHTTPListenerArn"
 	envOutputCFNExecutionRoleARN     = "CFNExecutionRoleARN"
 	envOutputManagerRoleKey          = "EnvironmentManagerRoleARN"
 	EnvParamServiceDiscoveryEndpoint = "ServiceDiscoveryEndpoint"
@@ -49,6 +53,11 @@ const (
 	DefaultVPCCIDR            = "10.0.0.0/16"
 	DefaultPublicSubnetCIDRs  = "10.0.0.0/24,10.0.1.0/24"
 	DefaultPrivateSubnetCIDRs = "10.0.2.0/24,10.0.3.0/24"
+
+	// Default subnet CIDRs for a VPC spread across three availability zones.
+	// The first two zones keep the same subnets as a VPC spread across two availability zones.
+	DefaultThreeAZPublicSubnetCIDRs  = "10.0.0.0/24,10.0.1.0/24,10.0.4.0/24"
+	DefaultThreeAZPrivateSubnetCIDRs = "10.0.2.0/24,10.0.3.0/24,10.0.5.0/24"
 )
 
 var (
@@ -101,6 +110,13 @@ func (e *EnvStackConfig) Template() (string, error) {
 		ScriptBucketName:          bucket,
 		ImportVPC:                 e.in.ImportVPCConfig,
 		VPCConfig:                 vpcConf,
+		WebACLARN:                 e.in.WebACLARN,
+		ImportCertARNs:            e.in.ImportCertARNs,
+		HTTPToHTTPSRedirect:       e.in.HTTPToHTTPSRedirect,
+		IdleTimeout:               e.in.IdleTimeout,
+		NATGateways:               e.in.NATGateways,
+		VPCEndpoints:              e.in.VPCEndpoints,
+		ImportClusterARN:          e.in.ImportClusterARN,
 		Version:                   e.in.Version,
 	}, template.WithFuncs(map[string]interface{}{
 		"inc": template.IncFunc,
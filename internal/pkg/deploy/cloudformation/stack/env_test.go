@@ -22,6 +22,8 @@ import (
 func TestEnv_Template(t *testing.T) {
 	testCases := map[string]struct {
 		mockDependencies func(ctrl *gomock.Controller, e *EnvStackConfig)
+		inNATGateways    string
+		inVPCEndpoints   bool
 		expectedOutput   string
 		want             error
 	}{
@@ -46,6 +48,31 @@ func TestEnv_Template(t *testing.T) {
 			},
 			expectedOutput: mockTemplate,
 		},
+		"should pass the NAT gateways and VPC endpoints configuration to the template": {
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				m := mocks.NewMockenvReadParser(ctrl)
+				m.EXPECT().ParseEnv(&template.EnvOpts{
+					AppName:                   "project",
+					ScriptBucketName:          "mockbucket",
+					DNSCertValidatorLambda:    "mockkey1",
+					DNSDelegationLambda:       "mockkey2",
+					EnableLongARNFormatLambda: "mockkey3",
+					CustomDomainLambda:        "mockkey4",
+					ImportVPC:                 nil,
+					VPCConfig: &config.AdjustVPC{
+						CIDR:               DefaultVPCCIDR,
+						PrivateSubnetCIDRs: strings.Split(DefaultPrivateSubnetCIDRs, ","),
+						PublicSubnetCIDRs:  strings.Split(DefaultPublicSubnetCIDRs, ","),
+					},
+					NATGateways:  template.NATGatewaysNone,
+					VPCEndpoints: true,
+				}, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil)
+				e.parser = m
+			},
+			inNATGateways:  template.NATGatewaysNone,
+			inVPCEndpoints: true,
+			expectedOutput: mockTemplate,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -53,8 +80,11 @@ func TestEnv_Template(t *testing.T) {
 			// GIVEN
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
+			in := mockDeployEnvironmentInput()
+			in.NATGateways = tc.inNATGateways
+			in.VPCEndpoints = tc.inVPCEndpoints
 			envStack := &EnvStackConfig{
-				in: mockDeployEnvironmentInput(),
+				in: in,
 			}
 			tc.mockDependencies(ctrl, envStack)
 
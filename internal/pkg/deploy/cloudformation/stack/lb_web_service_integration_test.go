@@ -27,7 +27,6 @@ const (
 	svcManifestPath = "svc-manifest.yml"
 
 	dynamicDesiredCountPath = "custom-resources/desired-count-delegation.js"
-	rulePriorityPath        = "custom-resources/alb-rule-priority-generator.js"
 )
 
 func TestLoadBalancedWebService_Template(t *testing.T) {
@@ -77,9 +76,6 @@ func TestLoadBalancedWebService_Template(t *testing.T) {
 		dynamicDesiredCount, err := parser.Read(dynamicDesiredCountPath)
 		require.NoError(t, err)
 		dynamicDesiredCountZipFile := dynamicDesiredCount.String()
-		rulePriority, err := parser.Read(rulePriorityPath)
-		require.NoError(t, err)
-		rulePriorityZipFile := rulePriority.String()
 
 		t.Run(testName, func(t *testing.T) {
 			actualBytes := []byte(tpl)
@@ -89,7 +85,6 @@ func TestLoadBalancedWebService_Template(t *testing.T) {
 			// Cut out zip file for more readable output
 			actualString = strings.ReplaceAll(actualString, envControllerZipFile, "mockEnvControllerZipFile")
 			actualString = strings.ReplaceAll(actualString, dynamicDesiredCountZipFile, "mockDynamicDesiredCountZipFile")
-			actualString = strings.ReplaceAll(actualString, rulePriorityZipFile, "mockRulePriorityZipFile")
 			actualBytes = []byte(actualString)
 			mActual := make(map[interface{}]interface{})
 			require.NoError(t, yaml.Unmarshal(actualBytes, mActual))
@@ -115,3 +110,90 @@ func TestLoadBalancedWebService_Template(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadBalancedWebService_TemplateWithImportedCerts(t *testing.T) {
+	// GIVEN
+	path := filepath.Join("testdata", "workloads", svcManifestPath)
+	manifestBytes, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	mft, err := manifest.UnmarshalWorkload(manifestBytes)
+	require.NoError(t, err)
+	envMft, err := mft.ApplyEnv("test")
+	require.NoError(t, err)
+	v, ok := envMft.(*manifest.LoadBalancedWebService)
+	require.True(t, ok)
+	serializer, err := stack.NewImportedCertsLoadBalancedWebService(v, "test", appName, stack.RuntimeConfig{
+		ServiceDiscoveryEndpoint: fmt.Sprintf("test.%s.local", appName),
+	})
+	require.NoError(t, err)
+
+	// WHEN
+	tpl, err := serializer.Template()
+	require.NoError(t, err, "template should render")
+	params, err := serializer.Parameters()
+	require.NoError(t, err)
+
+	// THEN
+	for _, param := range params {
+		if *param.ParameterKey == stack.LBWebServiceHTTPSParamKey {
+			require.Equal(t, "true", *param.ParameterValue, "service should use the HTTPS listener")
+		}
+	}
+	var actual struct {
+		Resources map[string]struct {
+			Condition  string                 `yaml:"Condition"`
+			Properties map[string]interface{} `yaml:"Properties"`
+		} `yaml:"Resources"`
+	}
+	parser := template.New()
+	envController, err := parser.Read(envControllerPath)
+	require.NoError(t, err)
+	dynamicDesiredCount, err := parser.Read(dynamicDesiredCountPath)
+	require.NoError(t, err)
+	// Cut out zip files so that the template can be unmarshaled.
+	tpl = strings.ReplaceAll(tpl, envController.String(), "mockEnvControllerZipFile")
+	tpl = strings.ReplaceAll(tpl, dynamicDesiredCount.String(), "mockDynamicDesiredCountZipFile")
+	require.NoError(t, yaml.Unmarshal([]byte(tpl), &actual))
+	require.NotContains(t, actual.Resources, "LoadBalancerDNSAlias", "there is no hosted zone to create an alias record in")
+	require.NotContains(t, tpl, "-SubDomain", "the environment doesn't have a Copilot-managed subdomain")
+
+	httpsRule, ok := actual.Resources["HTTPSListenerRule"]
+	require.True(t, ok)
+	require.Equal(t, "HTTPSLoadBalancer", httpsRule.Condition)
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			"TargetGroupArn": "TargetGroup",
+			"Type":           "forward",
+		},
+	}, httpsRule.Properties["Actions"])
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			"Field": "path-pattern",
+			"PathPatternConfig": map[string]interface{}{
+				"Values": []interface{}{
+					"IsDefaultRootPath",
+					[]interface{}{"/*"},
+					[]interface{}{"/${RulePath}", "/${RulePath}/*"},
+				},
+			},
+		},
+	}, httpsRule.Properties["Conditions"])
+
+	httpRule, ok := actual.Resources["HTTPListenerRuleWithDomain"]
+	require.True(t, ok)
+	require.Equal(t, "HTTPSLoadBalancer", httpRule.Condition)
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			"Type": "redirect",
+			"RedirectConfig": map[string]interface{}{
+				"Protocol":   "HTTPS",
+				"Port":       443,
+				"Host":       "#{host}",
+				"Path":       "/#{path}",
+				"Query":      "#{query}",
+				"StatusCode": "HTTP_301",
+			},
+		},
+	}, httpRule.Properties["Actions"], "HTTP requests to the service should be redirected to HTTPS")
+	require.Equal(t, httpsRule.Properties["Priority"], httpRule.Properties["Priority"])
+}
@@ -5,6 +5,7 @@ package stack
 
 import (
 	"fmt"
+	"hash/fnv"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -16,9 +17,15 @@ import (
 
 // Template rendering configuration.
 const (
-	lbWebSvcRulePriorityGeneratorPath = "custom-resources/alb-rule-priority-generator.js"
-	desiredCountGeneratorPath         = "custom-resources/desired-count-delegation.js"
-	envControllerPath                 = "custom-resources/env-controller.js"
+	desiredCountGeneratorPath = "custom-resources/desired-count-delegation.js"
+	envControllerPath         = "custom-resources/env-controller.js"
+)
+
+// Listener rule priorities for a load balanced web service.
+const (
+	minRulePriority = 1
+	// maxRulePriority is one less than the max priority allowed by ALB, which is reserved for the root path rule on the HTTP listener.
+	maxRulePriority = 49999
 )
 
 // Parameter logical IDs for a load balanced web service.
@@ -39,8 +46,9 @@ type loadBalancedWebSvcReadParser interface {
 // LoadBalancedWebService represents the configuration needed to create a CloudFormation stack from a load balanced web service manifest.
 type LoadBalancedWebService struct {
 	*ecsWkld
-	manifest     *manifest.LoadBalancedWebService
-	httpsEnabled bool
+	manifest          *manifest.LoadBalancedWebService
+	httpsEnabled      bool
+	importedCertsOnly bool
 
 	parser loadBalancedWebSvcReadParser
 }
@@ -55,15 +63,17 @@ func NewLoadBalancedWebService(mft *manifest.LoadBalancedWebService, env, app st
 	return &LoadBalancedWebService{
 		ecsWkld: &ecsWkld{
 			wkld: &wkld{
-				name:   aws.StringValue(mft.Name),
-				env:    env,
-				app:    app,
-				rc:     rc,
-				image:  mft.ImageConfig,
-				parser: parser,
-				addons: addons,
+				name:         aws.StringValue(mft.Name),
+				env:          env,
+				app:          app,
+				rc:           rc,
+				image:        mft.ImageConfig,
+				parser:       parser,
+				addons:       addons,
+				addonsParams: addonsParameters(mft.Addons),
 			},
-			tc: mft.TaskConfig,
+			tc:      mft.TaskConfig,
+			logging: mft.Logging,
 		},
 		manifest:     mft,
 		httpsEnabled: false,
@@ -84,12 +94,20 @@ func NewHTTPSLoadBalancedWebService(mft *manifest.LoadBalancedWebService, env, a
 	return webSvc, nil
 }
 
-// Template returns the CloudFormation template for the service parametrized for the environment.
-func (s *LoadBalancedWebService) Template() (string, error) {
-	rulePriorityLambda, err := s.parser.Read(lbWebSvcRulePriorityGeneratorPath)
+// NewImportedCertsLoadBalancedWebService creates a new LoadBalancedWebService stack from its manifest that needs to be deployed to
+// an environment whose HTTPS listener serves imported certificates, in an application without a domain.
+// The service is routed on its path and host over HTTPS, and HTTP requests are redirected to HTTPS.
+func NewImportedCertsLoadBalancedWebService(mft *manifest.LoadBalancedWebService, env, app string, rc RuntimeConfig) (*LoadBalancedWebService, error) {
+	webSvc, err := NewHTTPSLoadBalancedWebService(mft, env, app, rc)
 	if err != nil {
-		return "", fmt.Errorf("read rule priority lambda: %w", err)
+		return nil, err
 	}
+	webSvc.importedCertsOnly = true
+	return webSvc, nil
+}
+
+// Template returns the CloudFormation template for the service parametrized for the environment.
+func (s *LoadBalancedWebService) Template() (string, error) {
 	desiredCountLambda, err := s.parser.Read(desiredCountGeneratorPath)
 	if err != nil {
 		return "", fmt.Errorf("read desired count lambda: %w", err)
@@ -130,11 +148,33 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		desiredCountOnSpot = advancedCount.Spot
 		capacityProviders = advancedCount.Cps
 	}
+	capacityProviders, err = convertCapacityProvider(capacityProviders, s.manifest.CapacityProvider, s.rc.EnvCapacityProvider)
+	if err != nil {
+		return "", fmt.Errorf("convert the capacity provider for service %s: %w", s.name, err)
+	}
+	if err := validateClusterCapacityProviders(capacityProviders, s.rc.ImportedCluster); err != nil {
+		return "", fmt.Errorf("validate the capacity providers for service %s: %w", s.name, err)
+	}
 
 	storage, err := convertStorageOpts(s.manifest.Name, s.manifest.Storage)
 	if err != nil {
 		return "", fmt.Errorf("convert storage options for service %s: %w", s.name, err)
 	}
+	if err := validateRoutingRule(s.manifest.RoutingRule); err != nil {
+		return "", fmt.Errorf("validate the routing rule for service %s: %w", s.name, err)
+	}
+	httpHealthCheck, err := convertHTTPHealthCheck(&s.manifest.HealthCheck)
+	if err != nil {
+		return "", fmt.Errorf("convert the health check configuration for service %s: %w", s.name, err)
+	}
+	deregistrationDelay, err := convertDeregistrationDelay(s.manifest.Deployment)
+	if err != nil {
+		return "", fmt.Errorf("convert the deployment configuration for service %s: %w", s.name, err)
+	}
+	stickinessDuration, err := convertStickinessDuration(s.manifest.StickinessDuration)
+	if err != nil {
+		return "", fmt.Errorf("convert the stickiness configuration for service %s: %w", s.name, err)
+	}
 	entrypoint, err := convertEntryPoint(s.manifest.EntryPoint)
 	if err != nil {
 		return "", err
@@ -144,14 +184,41 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		return "", err
 	}
 
+	alarmNotifications, err := convertAlarmNotifications(s.manifest.AlarmNotifications)
+	if err != nil {
+		return "", fmt.Errorf("convert the alarm notifications configuration for service %s: %w", s.name, err)
+	}
+
+	additionalPorts, err := convertAdditionalPorts(s.manifest.ImageConfig.AdditionalPorts, s.manifest.ImageConfig.Port)
+	if err != nil {
+		return "", fmt.Errorf("convert the additional ports of service %s: %w", s.name, err)
+	}
+
+	var mainPorts []*template.PortMapping
+	if s.manifest.ImageConfig.Port != nil {
+		mainPorts = append(mainPorts, &template.PortMapping{
+			Port:     strconv.Itoa(int(aws.Uint16Value(s.manifest.ImageConfig.Port))),
+			Protocol: containerProtocolTCP,
+		})
+	}
+	nlb, err := convertNetworkLoadBalancer(s.manifest.NLBConfig, s.name, append(mainPorts, additionalPorts...), s.manifest.Sidecars, s.rc.EnvPublicSubnetCount)
+	if err != nil {
+		return "", fmt.Errorf("convert the network load balancer configuration for service %s: %w", s.name, err)
+	}
+
 	var aliases []string
-	if s.httpsEnabled {
+	if s.httpsEnabled && !s.importedCertsOnly {
 		albAlias := aws.StringValue(s.manifest.Alias)
 		if albAlias != "" {
 			aliases = append(aliases, albAlias)
 		}
 	}
 
+	rulePriority := s.rc.RulePriority
+	if rulePriority == 0 {
+		rulePriority = RulePriority(s.name, aws.StringValue(s.manifest.Path))
+	}
+
 	var allowedSourceIPs []string
 	if s.manifest.AllowedSourceIps != nil {
 		allowedSourceIPs = *s.manifest.AllowedSourceIps
@@ -170,9 +237,14 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		ExecuteCommand:           convertExecuteCommand(&s.manifest.ExecuteCommand),
 		WorkloadType:             manifest.LoadBalancedWebServiceType,
 		HealthCheck:              s.manifest.ImageConfig.HealthCheckOpts(),
-		HTTPHealthCheck:          convertHTTPHealthCheck(&s.manifest.HealthCheck),
+		HTTPHealthCheck:          httpHealthCheck,
+		AdditionalPorts:          additionalPorts,
+		HostHeader:               aws.StringValue(s.manifest.Host),
+		ImportedCertsOnly:        s.importedCertsOnly,
+		DeregistrationDelay:      deregistrationDelay,
+		StickinessDuration:       stickinessDuration,
 		AllowedSourceIps:         allowedSourceIPs,
-		RulePriorityLambda:       rulePriorityLambda.String(),
+		RulePriority:             rulePriority,
 		DesiredCountLambda:       desiredCountLambda.String(),
 		EnvControllerLambda:      envControllerLambda.String(),
 		Storage:                  storage,
@@ -181,6 +253,9 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		Command:                  command,
 		DependsOn:                dependencies,
 		ServiceDiscoveryEndpoint: s.rc.ServiceDiscoveryEndpoint,
+		Dashboard:                s.manifest.Observability.HasDashboard(),
+		AlarmNotifications:       alarmNotifications,
+		NLB:                      nlb,
 	})
 	if err != nil {
 		return "", err
@@ -188,6 +263,30 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	return content.String(), nil
 }
 
+// RulePriority returns the listener rule priority of a service routed on path.
+// The priority is derived from a stable hash of the service name and path so that it stays the same across deployments.
+func RulePriority(svcName, path string) int {
+	h := fnv.New32a()
+	h.Write([]byte(fmt.Sprintf("%s:%s", svcName, path)))
+	return minRulePriority + int(h.Sum32()%uint32(maxRulePriority-minRulePriority+1))
+}
+
+// AvailableRulePriority returns the first listener rule priority of a service routed on path that isn't taken by another rule.
+// It starts from the priority returned by RulePriority and probes the next priorities, wrapping around, until it finds a free one.
+func AvailableRulePriority(svcName, path string, taken map[int]bool) (int, error) {
+	priority := RulePriority(svcName, path)
+	for i := 0; i < maxRulePriority-minRulePriority+1; i++ {
+		if !taken[priority] {
+			return priority, nil
+		}
+		priority++
+		if priority > maxRulePriority {
+			priority = minRulePriority
+		}
+	}
+	return 0, fmt.Errorf("no listener rule priority between %d and %d is available for service %s", minRulePriority, maxRulePriority, svcName)
+}
+
 func (s *LoadBalancedWebService) loadBalancerTarget() (targetContainer *string, targetPort *string, err error) {
 	containerName := s.name
 	containerPort := strconv.FormatUint(uint64(aws.Uint16Value(s.manifest.ImageConfig.Port)), 10)
@@ -223,6 +322,11 @@ func (s *LoadBalancedWebService) Parameters() ([]*cloudformation.Parameter, erro
 	if err != nil {
 		return nil, err
 	}
+	rulePath := s.manifest.Path
+	if rulePath == nil {
+		// Route all requests for the host if the service only has a host condition.
+		rulePath = aws.String("/")
+	}
 	return append(wkldParams, []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(LBWebServiceContainerPortParamKey),
@@ -230,7 +334,7 @@ func (s *LoadBalancedWebService) Parameters() ([]*cloudformation.Parameter, erro
 		},
 		{
 			ParameterKey:   aws.String(LBWebServiceRulePathParamKey),
-			ParameterValue: s.manifest.Path,
+			ParameterValue: rulePath,
 		},
 		{
 			ParameterKey:   aws.String(LBWebServiceHTTPSParamKey),
@@ -120,19 +120,9 @@ func TestLoadBalancedWebService_Template(t *testing.T) {
 		wantedTemplate   string
 		wantedError      error
 	}{
-		"unavailable rule priority lambda template": {
-			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
-				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
-				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(nil, errors.New("some error"))
-				c.parser = m
-			},
-			wantedTemplate: "",
-			wantedError:    fmt.Errorf("read rule priority lambda: some error"),
-		},
 		"unavailable desired count lambda template": {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
 				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
-				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
 				m.EXPECT().Read(desiredCountGeneratorPath).Return(nil, errors.New("some error"))
 				c.parser = m
 			},
@@ -142,7 +132,6 @@ func TestLoadBalancedWebService_Template(t *testing.T) {
 		"unavailable env controller lambda template": {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
 				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
-				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
 				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
 				m.EXPECT().Read(envControllerPath).Return(nil, errors.New("some error"))
 				c.parser = m
@@ -153,7 +142,6 @@ func TestLoadBalancedWebService_Template(t *testing.T) {
 		"unexpected addons parsing error": {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
 				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
-				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
 				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
 				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
 				addons := mockTemplater{err: errors.New("some error")}
@@ -166,7 +154,6 @@ func TestLoadBalancedWebService_Template(t *testing.T) {
 		"failed parsing svc template": {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
 				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
-				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
 				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
 				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
 				m.EXPECT().ParseLoadBalancedWebService(gomock.Any()).Return(nil, errors.New("some error"))
@@ -189,7 +176,6 @@ Outputs:
 		"render template without addons": {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
 				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
-				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
 				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
 				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
 				m.EXPECT().ParseLoadBalancedWebService(template.WorkloadOpts{
@@ -198,7 +184,7 @@ Outputs:
 						HealthCheckPath: "/",
 					},
 					HealthCheck:         &overridenContainerHealthCheck,
-					RulePriorityLambda:  "lambda",
+					RulePriority:        36028,
 					DesiredCountLambda:  "something",
 					EnvControllerLambda: "something",
 					Network: &template.NetworkOpts{
@@ -216,10 +202,43 @@ Outputs:
 
 			wantedTemplate: "template",
 		},
+		"capacity provider not associated with the imported cluster": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				addons := mockTemplater{err: &addon.ErrAddonsNotFound{}}
+				c.parser = m
+				c.wkld.addons = addons
+				c.rc.EnvCapacityProvider = capacityProviderFargateSpot
+				c.rc.ImportedCluster = &ImportedCluster{
+					ARN:               "arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster",
+					CapacityProviders: []string{capacityProviderFargate},
+				}
+			},
+			wantedError: fmt.Errorf("validate the capacity providers for service frontend: capacity provider FARGATE_SPOT is not associated with the imported cluster arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster"),
+		},
+		"render template with the rule priority of the runtime config": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(gomock.Any()).DoAndReturn(func(opts template.WorkloadOpts) (*template.Content, error) {
+					require.Equal(t, 36029, opts.RulePriority)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+
+				addons := mockTemplater{err: &addon.ErrAddonsNotFound{}}
+				c.parser = m
+				c.wkld.addons = addons
+				c.rc.RulePriority = 36029
+			},
+
+			wantedTemplate: "template",
+		},
 		"render template with addons": {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
 				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
-				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
 				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
 				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
 				m.EXPECT().ParseLoadBalancedWebService(template.WorkloadOpts{
@@ -234,7 +253,7 @@ Outputs:
 						HealthCheckPath: "/",
 					},
 					HealthCheck:         &overridenContainerHealthCheck,
-					RulePriorityLambda:  "lambda",
+					RulePriority:        36028,
 					DesiredCountLambda:  "something",
 					EnvControllerLambda: "something",
 					Network: &template.NetworkOpts{
@@ -733,3 +752,99 @@ func TestLoadBalancedWebService_Tags(t *testing.T) {
 		},
 	}, tags)
 }
+
+func TestAvailableRulePriority(t *testing.T) {
+	testCases := map[string]struct {
+		inTaken map[int]bool
+
+		wantedPriority int
+		wantedErr      error
+	}{
+		"returns the derived priority if it's free": {
+			inTaken: map[int]bool{
+				100: true,
+			},
+
+			wantedPriority: 27701,
+		},
+		"probes the next free priority if the derived priority is taken": {
+			inTaken: map[int]bool{
+				27701: true,
+				27702: true,
+			},
+
+			wantedPriority: 27703,
+		},
+		"wraps around to the lowest priority": {
+			inTaken: func() map[int]bool {
+				taken := make(map[int]bool)
+				for p := 27701; p <= maxRulePriority; p++ {
+					taken[p] = true
+				}
+				return taken
+			}(),
+
+			wantedPriority: minRulePriority,
+		},
+		"errors if all priorities are taken": {
+			inTaken: func() map[int]bool {
+				taken := make(map[int]bool)
+				for p := minRulePriority; p <= maxRulePriority; p++ {
+					taken[p] = true
+				}
+				return taken
+			}(),
+
+			wantedErr: errors.New("no listener rule priority between 1 and 49999 is available for service frontend"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := AvailableRulePriority("frontend", "/", tc.inTaken)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedPriority, got)
+			}
+		})
+	}
+}
+
+func TestRulePriority(t *testing.T) {
+	testCases := map[string]struct {
+		inSvcName string
+		inPath    string
+
+		wantedPriority int
+	}{
+		"root path": {
+			inSvcName: "frontend",
+			inPath:    "/",
+
+			wantedPriority: 27701,
+		},
+		"non-root path": {
+			inSvcName: "frontend",
+			inPath:    "api",
+
+			wantedPriority: 29457,
+		},
+		"service name and path are not interchangeable": {
+			inSvcName: "api",
+			inPath:    "frontend",
+
+			wantedPriority: 439,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := RulePriority(tc.inSvcName, tc.inPath)
+
+			require.Equal(t, tc.wantedPriority, got)
+			require.GreaterOrEqual(t, got, minRulePriority)
+			require.LessOrEqual(t, got, maxRulePriority)
+		})
+	}
+}
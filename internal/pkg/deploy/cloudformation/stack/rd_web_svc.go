@@ -35,13 +35,14 @@ func NewRequestDrivenWebService(mft *manifest.RequestDrivenWebService, env, app
 	return &RequestDrivenWebService{
 		appRunnerWkld: &appRunnerWkld{
 			wkld: &wkld{
-				name:   aws.StringValue(mft.Name),
-				env:    env,
-				app:    app,
-				rc:     rc,
-				image:  mft.ImageConfig,
-				addons: addons,
-				parser: parser,
+				name:         aws.StringValue(mft.Name),
+				env:          env,
+				app:          app,
+				rc:           rc,
+				image:        mft.ImageConfig,
+				addons:       addons,
+				addonsParams: addonsParameters(mft.Addons),
+				parser:       parser,
 			},
 			instanceConfig:    mft.InstanceConfig,
 			imageConfig:       mft.ImageConfig,
@@ -58,11 +59,16 @@ func (s *RequestDrivenWebService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	autoScalingARN := aws.StringValue(s.manifest.AutoScalingConfigurationARN)
+	if err := validateAutoScalingConfigurationARN(autoScalingARN); err != nil {
+		return "", fmt.Errorf("validate the auto scaling configuration of service %s: %w", s.name, err)
+	}
 	content, err := s.parser.ParseRequestDrivenWebService(template.ParseRequestDrivenWebServiceInput{
-		Variables:         s.manifest.Variables,
-		Tags:              s.manifest.Tags,
-		NestedStack:       outputs,
-		EnableHealthCheck: !s.healthCheckConfig.IsEmpty(),
+		Variables:                   s.manifest.Variables,
+		Tags:                        s.manifest.Tags,
+		NestedStack:                 outputs,
+		EnableHealthCheck:           !s.healthCheckConfig.IsEmpty(),
+		AutoScalingConfigurationARN: autoScalingARN,
 	})
 	if err != nil {
 		return "", err
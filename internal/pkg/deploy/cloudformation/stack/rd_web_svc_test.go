@@ -183,6 +183,32 @@ Outputs:
 			},
 			wantedError: errors.New("parsing error"),
 		},
+		"should return error if the auto scaling configuration is not an App Runner ARN": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *RequestDrivenWebService) {
+				mft := *c.manifest
+				mft.AutoScalingConfigurationARN = aws.String("arn:aws:ecs:us-west-2:123456789012:cluster/demo")
+				c.manifest = &mft
+				c.addons = mockTemplater{err: &addon.ErrAddonsNotFound{}}
+			},
+			wantedError: fmt.Errorf("validate the auto scaling configuration of service %s: %w", testServiceName, errInvalidAutoScalingConfigurationARN),
+		},
+		"should parse template with an auto scaling configuration": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *RequestDrivenWebService) {
+				mft := *c.manifest
+				mft.AutoScalingConfigurationARN = aws.String("arn:aws:apprunner:us-west-2:123456789012:autoscalingconfiguration/high-availability/1/abcd")
+				c.manifest = &mft
+				mockParser := mocks.NewMockrequestDrivenWebSvcReadParser(ctrl)
+				mockParser.EXPECT().ParseRequestDrivenWebService(template.ParseRequestDrivenWebServiceInput{
+					Variables:                   c.manifest.Variables,
+					Tags:                        c.manifest.Tags,
+					EnableHealthCheck:           true,
+					AutoScalingConfigurationARN: "arn:aws:apprunner:us-west-2:123456789012:autoscalingconfiguration/high-availability/1/abcd",
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				c.parser = mockParser
+				c.addons = mockTemplater{err: &addon.ErrAddonsNotFound{}}
+			},
+			wantedTemplate: "template",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -98,15 +98,17 @@ func NewScheduledJob(mft *manifest.ScheduledJob, env, app string, rc RuntimeConf
 	return &ScheduledJob{
 		ecsWkld: &ecsWkld{
 			wkld: &wkld{
-				name:   aws.StringValue(mft.Name),
-				env:    env,
-				app:    app,
-				rc:     rc,
-				image:  mft.ImageConfig,
-				parser: parser,
-				addons: addons,
+				name:         aws.StringValue(mft.Name),
+				env:          env,
+				app:          app,
+				rc:           rc,
+				image:        mft.ImageConfig,
+				parser:       parser,
+				addons:       addons,
+				addonsParams: addonsParameters(mft.Addons),
 			},
-			tc: mft.TaskConfig,
+			tc:      mft.TaskConfig,
+			logging: mft.Logging,
 		},
 		manifest: mft,
 
@@ -7,10 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"hash/crc32"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 )
@@ -43,6 +45,8 @@ const (
 var (
 	errEphemeralBadSize  = errors.New("ephemeral storage must be between 20 GiB and 200 GiB")
 	errInvalidSpotConfig = errors.New(`"count.spot" and "count.range" cannot be specified together`)
+
+	errCapacityProviderWithSpotCount = errors.New(`"capacity_provider" cannot be specified with "count.spot" or "count.range.spot_from"`)
 )
 
 type convertSidecarOpts struct {
@@ -216,6 +220,35 @@ func convertCapacityProviders(a *manifest.AdvancedCount) ([]*template.CapacityPr
 	return cps, nil
 }
 
+// convertCapacityProvider returns the capacity provider strategy of a service given the strategy derived from its count.
+// If the count doesn't place tasks on Spot, the capacity provider in the manifest takes precedence over the environment's default.
+func convertCapacityProvider(countCps []*template.CapacityProviderStrategy, mftProvider *string, envProvider string) ([]*template.CapacityProviderStrategy, error) {
+	if countCps != nil {
+		if mftProvider != nil {
+			return nil, errCapacityProviderWithSpotCount
+		}
+		return countCps, nil
+	}
+	provider := envProvider
+	if mftProvider != nil {
+		provider = aws.StringValue(mftProvider)
+	}
+	switch provider {
+	case "", capacityProviderFargate:
+		// Services use the FARGATE launch type by default.
+		return nil, nil
+	case capacityProviderFargateSpot:
+		return []*template.CapacityProviderStrategy{
+			{
+				Weight:           aws.Int(1),
+				CapacityProvider: capacityProviderFargateSpot,
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf(`invalid "capacity_provider" %s: must be one of %s or %s`, provider, capacityProviderFargate, capacityProviderFargateSpot)
+	}
+}
+
 // convertAutoscaling converts the service's Auto Scaling configuration into a format parsable
 // by the templates pkg.
 func convertAutoscaling(a *manifest.AdvancedCount) (*template.AutoscalingOpts, error) {
@@ -251,7 +284,7 @@ func convertAutoscaling(a *manifest.AdvancedCount) (*template.AutoscalingOpts, e
 }
 
 // convertHTTPHealthCheck converts the ALB health check configuration into a format parsable by the templates pkg.
-func convertHTTPHealthCheck(hc *manifest.HealthCheckArgsOrString) template.HTTPHealthCheckOpts {
+func convertHTTPHealthCheck(hc *manifest.HealthCheckArgsOrString) (template.HTTPHealthCheckOpts, error) {
 	opts := template.HTTPHealthCheckOpts{
 		HealthCheckPath:    manifest.DefaultHealthCheckPath,
 		HealthyThreshold:   hc.HealthCheckArgs.HealthyThreshold,
@@ -271,7 +304,212 @@ func convertHTTPHealthCheck(hc *manifest.HealthCheckArgsOrString) template.HTTPH
 	if hc.HealthCheckArgs.Timeout != nil {
 		opts.Timeout = aws.Int64(int64(hc.HealthCheckArgs.Timeout.Seconds()))
 	}
-	return opts
+	if hc.HealthCheckArgs.GracePeriod != nil {
+		if *hc.HealthCheckArgs.GracePeriod < 0 {
+			return template.HTTPHealthCheckOpts{}, errNegativeHealthCheckGracePeriod
+		}
+		opts.GracePeriod = aws.Int64(int64(hc.HealthCheckArgs.GracePeriod.Seconds()))
+	}
+	return opts, nil
+}
+
+// convertDeregistrationDelay converts the target group deregistration delay of a deployment configuration
+// into seconds, or returns nil if it isn't specified.
+func convertDeregistrationDelay(d *manifest.DeploymentConfig) (*int64, error) {
+	if d == nil || d.DeregistrationDelay == nil {
+		return nil, nil
+	}
+	delay := *d.DeregistrationDelay
+	if delay < minDeregistrationDelay || delay > maxDeregistrationDelay {
+		return nil, errInvalidDeregistrationDelay
+	}
+	return aws.Int64(int64(delay.Seconds())), nil
+}
+
+// convertStickinessDuration converts the lifetime of the load balancer stickiness cookie into seconds,
+// or returns nil if it isn't specified.
+func convertStickinessDuration(d *time.Duration) (*int64, error) {
+	if d == nil {
+		return nil, nil
+	}
+	if *d < minStickinessDuration || *d > maxStickinessDuration {
+		return nil, errInvalidStickinessDuration
+	}
+	return aws.Int64(int64(d.Seconds())), nil
+}
+
+// convertNetworkLoadBalancer converts the network load balancer configuration of a service into a format parsable
+// by the templates pkg, or returns nil if it isn't specified.
+// Traffic is routed to the main container by default, on the same port as the listener.
+// The target port must be a TCP port exposed by the target container, either the main container's
+// image.port and additional ports, or the port of a sidecar.
+// If publicSubnetCount isn't 0, the load balancer can't have more Elastic IPs than there are public subnets.
+func convertNetworkLoadBalancer(nlb *manifest.NetworkLoadBalancerConfiguration, mainContainer string, mainPorts []*template.PortMapping,
+	sidecars map[string]*manifest.SidecarConfig, publicSubnetCount int) (*template.NetworkLoadBalancerOpts, error) {
+	if nlb == nil {
+		return nil, nil
+	}
+	if nlb.Port == nil {
+		return nil, errNLBWithoutPort
+	}
+	port, protocol, err := parsePortMapping(nlb.Port)
+	if err != nil {
+		return nil, err
+	}
+	if !isValidPortNumber(aws.StringValue(port)) {
+		return nil, errInvalidNLBPort
+	}
+	listenerProtocol := nlbProtocolTCP
+	if protocol != nil {
+		listenerProtocol = strings.ToUpper(aws.StringValue(protocol))
+	}
+	if listenerProtocol != nlbProtocolTCP {
+		return nil, errInvalidNLBProtocol
+	}
+	targetContainer := mainContainer
+	targetContainerPorts := mainPorts
+	if nlb.TargetContainer != nil && aws.StringValue(nlb.TargetContainer) != mainContainer {
+		targetContainer = aws.StringValue(nlb.TargetContainer)
+		sidecar, ok := sidecars[targetContainer]
+		if !ok {
+			return nil, fmt.Errorf("target container %s doesn't exist", targetContainer)
+		}
+		sidecarPorts, err := sidecarPortMappings(sidecar)
+		if err != nil {
+			return nil, err
+		}
+		targetContainerPorts = sidecarPorts
+	}
+	targetPort := aws.StringValue(port)
+	if nlb.TargetPort != nil {
+		targetPort = strconv.Itoa(aws.IntValue(nlb.TargetPort))
+		if !isValidPortNumber(targetPort) {
+			return nil, errInvalidNLBTargetPort
+		}
+	}
+	if !exposesPort(targetContainerPorts, targetPort, containerProtocolTCP) {
+		return nil, fmt.Errorf("target port %s must be a TCP port exposed by container %s", targetPort, targetContainer)
+	}
+	for _, id := range nlb.EIPAllocationIDs {
+		if !strings.HasPrefix(id, eipAllocationIDPrefix) {
+			return nil, fmt.Errorf("`nlb.eip_allocation_ids` value %s must be an Elastic IP allocation ID starting with %s", id, eipAllocationIDPrefix)
+		}
+	}
+	if publicSubnetCount != 0 && len(nlb.EIPAllocationIDs) > publicSubnetCount {
+		return nil, fmt.Errorf("`nlb.eip_allocation_ids` has %d Elastic IPs but the environment has %d public subnets, at most one Elastic IP per public subnet is allowed",
+			len(nlb.EIPAllocationIDs), publicSubnetCount)
+	}
+	return &template.NetworkLoadBalancerOpts{
+		Port:             aws.StringValue(port),
+		Protocol:         listenerProtocol,
+		TargetContainer:  targetContainer,
+		TargetPort:       targetPort,
+		EIPAllocationIDs: nlb.EIPAllocationIDs,
+	}, nil
+}
+
+// sidecarPortMappings returns the port exposed by a sidecar, if any.
+func sidecarPortMappings(sidecar *manifest.SidecarConfig) ([]*template.PortMapping, error) {
+	if sidecar.Port == nil {
+		return nil, nil
+	}
+	port, protocol, err := parsePortMapping(sidecar.Port)
+	if err != nil {
+		return nil, err
+	}
+	mapping := &template.PortMapping{
+		Port:     aws.StringValue(port),
+		Protocol: containerProtocolTCP,
+	}
+	if protocol != nil {
+		mapping.Protocol = strings.ToLower(aws.StringValue(protocol))
+	}
+	return []*template.PortMapping{mapping}, nil
+}
+
+// exposesPort returns true if one of the port mappings exposes the port with the protocol.
+func exposesPort(mappings []*template.PortMapping, port, protocol string) bool {
+	for _, m := range mappings {
+		if m.Port == port && m.Protocol == protocol {
+			return true
+		}
+	}
+	return false
+}
+
+// convertAlarmNotifications converts the alarm notifications configuration of a service into a format parsable
+// by the templates pkg, or returns nil if it isn't specified.
+func convertAlarmNotifications(in *manifest.AlarmNotificationsConfig) (*template.AlarmNotificationsOpts, error) {
+	if in == nil {
+		return nil, nil
+	}
+	createTopic := aws.BoolValue(in.CreateTopic)
+	if in.TopicARN == nil && !createTopic {
+		return nil, errAlarmNotificationsWithoutTopic
+	}
+	if in.TopicARN != nil && createTopic {
+		return nil, errAlarmNotificationsBothTopics
+	}
+	if createTopic {
+		return &template.AlarmNotificationsOpts{}, nil
+	}
+	topicARN := aws.StringValue(in.TopicARN)
+	parsed, err := arn.Parse(topicARN)
+	if err != nil {
+		return nil, fmt.Errorf("parse `alarm_notifications.topic_arn` %s: %w", topicARN, err)
+	}
+	if parsed.Service != "sns" {
+		return nil, fmt.Errorf("`alarm_notifications.topic_arn` %s must be the ARN of an SNS topic", topicARN)
+	}
+	return &template.AlarmNotificationsOpts{
+		TopicARN: topicARN,
+	}, nil
+}
+
+// convertAdditionalPorts converts the additional ports of the main container into port mappings.
+// The ports default to the tcp protocol and can't repeat the port that receives traffic from the load balancer.
+func convertAdditionalPorts(ports []string, mainPort *uint16) ([]*template.PortMapping, error) {
+	seen := make(map[string]bool)
+	if mainPort != nil {
+		seen[fmt.Sprintf("%d/%s", aws.Uint16Value(mainPort), containerProtocolTCP)] = true
+	}
+	var mappings []*template.PortMapping
+	for _, p := range ports {
+		port, protocol, err := parsePortMapping(aws.String(p))
+		if err != nil {
+			return nil, err
+		}
+		if !isValidPortNumber(aws.StringValue(port)) {
+			return nil, errInvalidAdditionalPort
+		}
+		mapping := &template.PortMapping{
+			Port:     aws.StringValue(port),
+			Protocol: containerProtocolTCP,
+		}
+		if protocol != nil {
+			mapping.Protocol = strings.ToLower(aws.StringValue(protocol))
+		}
+		switch mapping.Protocol {
+		case containerProtocolTCP, containerProtocolUDP:
+		default:
+			return nil, errInvalidAdditionalPortProtocol
+		}
+		key := fmt.Sprintf("%s/%s", mapping.Port, mapping.Protocol)
+		if seen[key] {
+			return nil, fmt.Errorf("`image.additional_ports` port %s is specified more than once", key)
+		}
+		seen[key] = true
+		mappings = append(mappings, mapping)
+	}
+	return mappings, nil
+}
+
+func isValidPortNumber(port string) bool {
+	n, err := strconv.Atoi(port)
+	if err != nil {
+		return false
+	}
+	return n >= minPortNumber && n <= maxPortNumber
 }
 
 func convertExecuteCommand(e *manifest.ExecuteCommand) *template.ExecuteCommandOpts {
@@ -282,7 +520,7 @@ func convertExecuteCommand(e *manifest.ExecuteCommand) *template.ExecuteCommandO
 }
 
 func convertLogging(lc *manifest.Logging) *template.LogConfigOpts {
-	if lc == nil {
+	if lc == nil || lc.IsRetentionOnly() {
 		return nil
 	}
 	return logConfigOpts(lc)
@@ -4,6 +4,7 @@
 package stack
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -382,6 +383,67 @@ func Test_convertCapacityProviders(t *testing.T) {
 	}
 }
 
+func Test_convertCapacityProvider(t *testing.T) {
+	spotCps := []*template.CapacityProviderStrategy{
+		{
+			Weight:           aws.Int(1),
+			CapacityProvider: capacityProviderFargateSpot,
+		},
+	}
+	testCases := map[string]struct {
+		inCountCps    []*template.CapacityProviderStrategy
+		inMftProvider *string
+		inEnvProvider string
+
+		expected    []*template.CapacityProviderStrategy
+		expectedErr string
+	}{
+		"returns the count's strategy if it places tasks on spot": {
+			inCountCps:    spotCps,
+			inEnvProvider: capacityProviderFargate,
+			expected:      spotCps,
+		},
+		"errors if capacity provider is specified with spot count": {
+			inCountCps:    spotCps,
+			inMftProvider: aws.String(capacityProviderFargate),
+			expectedErr:   `"capacity_provider" cannot be specified with "count.spot" or "count.range.spot_from"`,
+		},
+		"returns nil if neither the manifest nor the environment set a capacity provider": {
+			expected: nil,
+		},
+		"inherits spot from the environment": {
+			inEnvProvider: capacityProviderFargateSpot,
+			expected:      spotCps,
+		},
+		"manifest overrides the environment's spot default": {
+			inMftProvider: aws.String(capacityProviderFargate),
+			inEnvProvider: capacityProviderFargateSpot,
+			expected:      nil,
+		},
+		"manifest opts into spot": {
+			inMftProvider: aws.String(capacityProviderFargateSpot),
+			expected:      spotCps,
+		},
+		"errors if capacity provider is invalid": {
+			inMftProvider: aws.String("EC2"),
+			expectedErr:   `invalid "capacity_provider" EC2: must be one of FARGATE or FARGATE_SPOT`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := convertCapacityProvider(tc.inCountCps, tc.inMftProvider, tc.inEnvProvider)
+
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, actual)
+			}
+		})
+	}
+}
+
 func Test_convertAutoscaling(t *testing.T) {
 	mockRange := manifest.IntRangeBand("1-100")
 	badRange := manifest.IntRangeBand("badRange")
@@ -471,6 +533,7 @@ func Test_convertHTTPHealthCheck(t *testing.T) {
 	// These are used by reference to represent the output of the manifest.durationp function.
 	duration15Seconds := time.Duration(15 * time.Second)
 	duration60Seconds := time.Duration(60 * time.Second)
+	negativeDuration := time.Duration(-1 * time.Second)
 	testCases := map[string]struct {
 		inputPath               *string
 		inputSuccessCodes       *string
@@ -478,8 +541,10 @@ func Test_convertHTTPHealthCheck(t *testing.T) {
 		inputUnhealthyThreshold *int64
 		inputInterval           *time.Duration
 		inputTimeout            *time.Duration
+		inputGracePeriod        *time.Duration
 
 		wantedOpts template.HTTPHealthCheckOpts
+		wantedErr  error
 	}{
 		"no fields indicated in manifest": {
 			inputPath:               nil,
@@ -558,6 +623,19 @@ func Test_convertHTTPHealthCheck(t *testing.T) {
 				SuccessCodes:    "200,301",
 			},
 		},
+		"just GracePeriod": {
+			inputGracePeriod: &duration60Seconds,
+
+			wantedOpts: template.HTTPHealthCheckOpts{
+				HealthCheckPath: "/",
+				GracePeriod:     aws.Int64(60),
+			},
+		},
+		"error if GracePeriod is negative": {
+			inputGracePeriod: &negativeDuration,
+
+			wantedErr: errNegativeHealthCheckGracePeriod,
+		},
 		"all values changed in manifest": {
 			inputPath:               aws.String("/road/to/nowhere"),
 			inputSuccessCodes:       aws.String("200-299"),
@@ -565,6 +643,7 @@ func Test_convertHTTPHealthCheck(t *testing.T) {
 			inputUnhealthyThreshold: aws.Int64(3),
 			inputInterval:           &duration60Seconds,
 			inputTimeout:            &duration60Seconds,
+			inputGracePeriod:        &duration15Seconds,
 
 			wantedOpts: template.HTTPHealthCheckOpts{
 				HealthCheckPath:    "/road/to/nowhere",
@@ -573,6 +652,7 @@ func Test_convertHTTPHealthCheck(t *testing.T) {
 				UnhealthyThreshold: aws.Int64(3),
 				Interval:           aws.Int64(60),
 				Timeout:            aws.Int64(60),
+				GracePeriod:        aws.Int64(15),
 			},
 		},
 	}
@@ -588,12 +668,18 @@ func Test_convertHTTPHealthCheck(t *testing.T) {
 					UnhealthyThreshold: tc.inputUnhealthyThreshold,
 					Timeout:            tc.inputTimeout,
 					Interval:           tc.inputInterval,
+					GracePeriod:        tc.inputGracePeriod,
 				},
 			}
 			// WHEN
-			actualOpts := convertHTTPHealthCheck(&hc)
+			actualOpts, err := convertHTTPHealthCheck(&hc)
 
 			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
 			require.Equal(t, tc.wantedOpts, actualOpts)
 		})
 	}
@@ -774,6 +860,21 @@ func Test_convertStorageOpts(t *testing.T) {
 			},
 			wantErr: fmt.Sprintf("validate container configuration for volume wordpress: %s", errNoContainerPath.Error()),
 		},
+		"container path is relative": {
+			inVolumes: map[string]manifest.Volume{
+				"wordpress": {
+					EFS: &manifest.EFSConfigOrBool{
+						Advanced: manifest.EFSVolumeConfiguration{
+							FileSystemID: aws.String("fs-1234"),
+						},
+					},
+					MountPointOpts: manifest.MountPointOpts{
+						ContainerPath: aws.String("var/www"),
+					},
+				},
+			},
+			wantErr: fmt.Sprintf("validate container configuration for volume wordpress: %s", errRelativeContainerPath.Error()),
+		},
 		"full specification with access point renders correctly": {
 			inVolumes: map[string]manifest.Volume{
 				"wordpress": {
@@ -1087,6 +1188,360 @@ func Test_convertStorageOpts(t *testing.T) {
 	}
 }
 
+func Test_convertDeregistrationDelay(t *testing.T) {
+	durationp := func(d time.Duration) *time.Duration { return &d }
+	testCases := map[string]struct {
+		in *manifest.DeploymentConfig
+
+		wanted    *int64
+		wantedErr error
+	}{
+		"no deployment configuration": {
+			in:     nil,
+			wanted: nil,
+		},
+		"no deregistration delay": {
+			in:     &manifest.DeploymentConfig{},
+			wanted: nil,
+		},
+		"valid deregistration delay": {
+			in: &manifest.DeploymentConfig{
+				DeregistrationDelay: durationp(5 * time.Minute),
+			},
+			wanted: aws.Int64(300),
+		},
+		"zero deregistration delay": {
+			in: &manifest.DeploymentConfig{
+				DeregistrationDelay: durationp(0),
+			},
+			wanted: aws.Int64(0),
+		},
+		"deregistration delay is too long": {
+			in: &manifest.DeploymentConfig{
+				DeregistrationDelay: durationp(2 * time.Hour),
+			},
+			wantedErr: errors.New("`deployment.deregistration_delay` must be between 0s and 3600s"),
+		},
+		"deregistration delay is negative": {
+			in: &manifest.DeploymentConfig{
+				DeregistrationDelay: durationp(-1 * time.Second),
+			},
+			wantedErr: errors.New("`deployment.deregistration_delay` must be between 0s and 3600s"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertDeregistrationDelay(tc.in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_convertStickinessDuration(t *testing.T) {
+	durationp := func(d time.Duration) *time.Duration { return &d }
+	testCases := map[string]struct {
+		in *time.Duration
+
+		wanted    *int64
+		wantedErr error
+	}{
+		"no stickiness duration": {
+			in:     nil,
+			wanted: nil,
+		},
+		"valid stickiness duration": {
+			in:     durationp(24 * time.Hour),
+			wanted: aws.Int64(86400),
+		},
+		"stickiness duration is too short": {
+			in:        durationp(0),
+			wantedErr: errors.New("`http.stickiness_duration` must be between 1s and 604800s"),
+		},
+		"stickiness duration is too long": {
+			in:        durationp(8 * 24 * time.Hour),
+			wantedErr: errors.New("`http.stickiness_duration` must be between 1s and 604800s"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertStickinessDuration(tc.in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_convertNetworkLoadBalancer(t *testing.T) {
+	mainPorts := []*template.PortMapping{
+		{
+			Port:     "443",
+			Protocol: "tcp",
+		},
+		{
+			Port:     "53",
+			Protocol: "udp",
+		},
+	}
+	sidecars := map[string]*manifest.SidecarConfig{
+		"envoy": {
+			Port: aws.String("9090"),
+		},
+	}
+	testCases := map[string]struct {
+		in *manifest.NetworkLoadBalancerConfiguration
+
+		wanted    *template.NetworkLoadBalancerOpts
+		wantedErr error
+	}{
+		"no network load balancer": {
+			in:     nil,
+			wanted: nil,
+		},
+		"port is not specified": {
+			in:        &manifest.NetworkLoadBalancerConfiguration{},
+			wantedErr: errors.New("`nlb.port` must be specified when `nlb` is configured"),
+		},
+		"port is out of range": {
+			in: &manifest.NetworkLoadBalancerConfiguration{
+				Port: aws.String("70000/tcp"),
+			},
+			wantedErr: errors.New("`nlb.port` must be a port number between 1 and 65535"),
+		},
+		"protocol is not supported": {
+			in: &manifest.NetworkLoadBalancerConfiguration{
+				Port: aws.String("443/tls"),
+			},
+			wantedErr: errors.New("`nlb.port` protocol must be tcp, UDP traffic is not supported"),
+		},
+		"udp is not supported": {
+			in: &manifest.NetworkLoadBalancerConfiguration{
+				Port: aws.String("53/udp"),
+			},
+			wantedErr: errors.New("`nlb.port` protocol must be tcp, UDP traffic is not supported"),
+		},
+		"target container does not exist": {
+			in: &manifest.NetworkLoadBalancerConfiguration{
+				Port:            aws.String("443"),
+				TargetContainer: aws.String("nginx"),
+			},
+			wantedErr: errors.New("target container nginx doesn't exist"),
+		},
+		"target port is out of range": {
+			in: &manifest.NetworkLoadBalancerConfiguration{
+				Port:       aws.String("443"),
+				TargetPort: aws.Int(0),
+			},
+			wantedErr: errors.New("`nlb.target_port` must be a port number between 1 and 65535"),
+		},
+		"invalid elastic IP allocation ID": {
+			in: &manifest.NetworkLoadBalancerConfiguration{
+				Port:             aws.String("443"),
+				EIPAllocationIDs: []string{"eipalloc-1234", "1.2.3.4"},
+			},
+			wantedErr: errors.New("`nlb.eip_allocation_ids` value 1.2.3.4 must be an Elastic IP allocation ID starting with eipalloc-"),
+		},
+		"target port is not exposed by the main container": {
+			in: &manifest.NetworkLoadBalancerConfiguration{
+				Port: aws.String("8080"),
+			},
+			wantedErr: errors.New("target port 8080 must be a TCP port exposed by container frontend"),
+		},
+		"target port is only exposed over udp by the main container": {
+			in: &manifest.NetworkLoadBalancerConfiguration{
+				Port: aws.String("53"),
+			},
+			wantedErr: errors.New("target port 53 must be a TCP port exposed by container frontend"),
+		},
+		"target port is not exposed by the sidecar": {
+			in: &manifest.NetworkLoadBalancerConfiguration{
+				Port:            aws.String("443"),
+				TargetContainer: aws.String("envoy"),
+			},
+			wantedErr: errors.New("target port 443 must be a TCP port exposed by container envoy"),
+		},
+		"more elastic IPs than public subnets": {
+			in: &manifest.NetworkLoadBalancerConfiguration{
+				Port:             aws.String("443"),
+				EIPAllocationIDs: []string{"eipalloc-1234", "eipalloc-5678", "eipalloc-9012"},
+			},
+			wantedErr: errors.New("`nlb.eip_allocation_ids` has 3 Elastic IPs but the environment has 2 public subnets, at most one Elastic IP per public subnet is allowed"),
+		},
+		"defaults to the main container on the listener port with tcp": {
+			in: &manifest.NetworkLoadBalancerConfiguration{
+				Port: aws.String("443"),
+			},
+			wanted: &template.NetworkLoadBalancerOpts{
+				Port:            "443",
+				Protocol:        "TCP",
+				TargetContainer: "frontend",
+				TargetPort:      "443",
+			},
+		},
+		"routes to a sidecar with elastic IPs": {
+			in: &manifest.NetworkLoadBalancerConfiguration{
+				Port:             aws.String("53/tcp"),
+				TargetContainer:  aws.String("envoy"),
+				TargetPort:       aws.Int(9090),
+				EIPAllocationIDs: []string{"eipalloc-1234", "eipalloc-5678"},
+			},
+			wanted: &template.NetworkLoadBalancerOpts{
+				Port:             "53",
+				Protocol:         "TCP",
+				TargetContainer:  "envoy",
+				TargetPort:       "9090",
+				EIPAllocationIDs: []string{"eipalloc-1234", "eipalloc-5678"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertNetworkLoadBalancer(tc.in, "frontend", mainPorts, sidecars, 2)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_convertAdditionalPorts(t *testing.T) {
+	testCases := map[string]struct {
+		inPorts    []string
+		inMainPort *uint16
+
+		wanted    []*template.PortMapping
+		wantedErr error
+	}{
+		"no additional ports": {
+			inMainPort: aws.Uint16(80),
+		},
+		"port mapping can't be parsed": {
+			inPorts:   []string{"9090/tcp/udp"},
+			wantedErr: errors.New("cannot parse port mapping from 9090/tcp/udp"),
+		},
+		"port is out of range": {
+			inPorts:   []string{"70000"},
+			wantedErr: errors.New("`image.additional_ports` must be port numbers between 1 and 65535"),
+		},
+		"protocol is not supported": {
+			inPorts:   []string{"9090/http"},
+			wantedErr: errors.New("`image.additional_ports` protocol must be one of < tcp | udp >"),
+		},
+		"port is the main port": {
+			inPorts:    []string{"80/TCP"},
+			inMainPort: aws.Uint16(80),
+			wantedErr:  errors.New("`image.additional_ports` port 80/tcp is specified more than once"),
+		},
+		"port is repeated": {
+			inPorts:   []string{"9090", "9090/tcp"},
+			wantedErr: errors.New("`image.additional_ports` port 9090/tcp is specified more than once"),
+		},
+		"defaults to tcp and accepts the main port with another protocol": {
+			inPorts:    []string{"9090", "80/udp"},
+			inMainPort: aws.Uint16(80),
+			wanted: []*template.PortMapping{
+				{
+					Port:     "9090",
+					Protocol: "tcp",
+				},
+				{
+					Port:     "80",
+					Protocol: "udp",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertAdditionalPorts(tc.inPorts, tc.inMainPort)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_convertAlarmNotifications(t *testing.T) {
+	testCases := map[string]struct {
+		in *manifest.AlarmNotificationsConfig
+
+		wanted    *template.AlarmNotificationsOpts
+		wantedErr error
+	}{
+		"no alarm notifications": {
+			in:     nil,
+			wanted: nil,
+		},
+		"neither topic is specified": {
+			in:        &manifest.AlarmNotificationsConfig{},
+			wantedErr: errors.New("`alarm_notifications.topic_arn` or `alarm_notifications.create_topic` must be specified"),
+		},
+		"both topics are specified": {
+			in: &manifest.AlarmNotificationsConfig{
+				TopicARN:    aws.String("arn:aws:sns:us-west-2:123456789012:oncall"),
+				CreateTopic: aws.Bool(true),
+			},
+			wantedErr: errors.New("`alarm_notifications.topic_arn` and `alarm_notifications.create_topic` cannot be specified together"),
+		},
+		"topic ARN cannot be parsed": {
+			in: &manifest.AlarmNotificationsConfig{
+				TopicARN: aws.String("oncall"),
+			},
+			wantedErr: errors.New("parse `alarm_notifications.topic_arn` oncall: arn: invalid prefix"),
+		},
+		"topic ARN is not an SNS topic": {
+			in: &manifest.AlarmNotificationsConfig{
+				TopicARN: aws.String("arn:aws:sqs:us-west-2:123456789012:oncall"),
+			},
+			wantedErr: errors.New("`alarm_notifications.topic_arn` arn:aws:sqs:us-west-2:123456789012:oncall must be the ARN of an SNS topic"),
+		},
+		"existing topic": {
+			in: &manifest.AlarmNotificationsConfig{
+				TopicARN: aws.String("arn:aws:sns:us-west-2:123456789012:oncall"),
+			},
+			wanted: &template.AlarmNotificationsOpts{
+				TopicARN: "arn:aws:sns:us-west-2:123456789012:oncall",
+			},
+		},
+		"new topic": {
+			in: &manifest.AlarmNotificationsConfig{
+				CreateTopic: aws.Bool(true),
+			},
+			wanted: &template.AlarmNotificationsOpts{},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertAlarmNotifications(tc.in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func Test_convertExecuteCommand(t *testing.T) {
 	testCases := map[string]struct {
 		inConfig manifest.ExecuteCommand
@@ -1185,6 +1640,17 @@ func Test_convertSidecarMountPoints(t *testing.T) {
 			},
 			wantErr: errNoContainerPath.Error(),
 		},
+		"error when path is relative": {
+			inMountPoints: []manifest.SidecarMountPoint{
+				{
+					SourceVolume: aws.String("wordpress"),
+					MountPointOpts: manifest.MountPointOpts{
+						ContainerPath: aws.String("var/www/wp-content"),
+					},
+				},
+			},
+			wantErr: errRelativeContainerPath.Error(),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -6,9 +6,15 @@ package stack
 import (
 	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 )
 
 // Container dependency status constants.
@@ -42,6 +48,90 @@ var (
 	errEssentialSidecarStatus        = fmt.Errorf("essential sidecar container dependencies can only have status < %s >", dependsOnStart)
 )
 
+var errRelativeContainerPath = errors.New("`path` must be an absolute path")
+
+var errNegativeHealthCheckGracePeriod = errors.New("`healthcheck.grace_period` must be a non-negative duration")
+
+var errNoRoutingRuleCondition = errors.New("`http.path` or `http.host` must be specified")
+
+// maxHostHeaderLength is the maximum length of a host condition value of a listener rule.
+const maxHostHeaderLength = 128
+
+// hostHeaderRegexp matches the characters allowed in a host condition value of a listener rule, including the wildcards "*" and "?".
+var hostHeaderRegexp = regexp.MustCompile(`^[a-zA-Z0-9\-\.\*\?]+$`)
+
+// Internal load balancer errors.
+var (
+	errInternalALBWithoutPort     = errors.New("`image.port` must be specified when `http` is configured")
+	errInternalALBInPublicSubnets = errors.New("`network.vpc.placement` must be \"private\" when `http` is configured")
+)
+
+// Network load balancer configuration.
+const (
+	nlbProtocolTCP        = "TCP"
+	eipAllocationIDPrefix = "eipalloc-"
+
+	minPortNumber = 1
+	maxPortNumber = 65535
+)
+
+// Network load balancer errors.
+var (
+	errNLBWithoutPort       = errors.New("`nlb.port` must be specified when `nlb` is configured")
+	errInvalidNLBPort       = fmt.Errorf("`nlb.port` must be a port number between %d and %d", minPortNumber, maxPortNumber)
+	errInvalidNLBTargetPort = fmt.Errorf("`nlb.target_port` must be a port number between %d and %d", minPortNumber, maxPortNumber)
+	errInvalidNLBProtocol   = fmt.Errorf("`nlb.port` protocol must be %s, UDP traffic is not supported", strings.ToLower(nlbProtocolTCP))
+)
+
+// Container port protocols.
+const (
+	containerProtocolTCP = "tcp"
+	containerProtocolUDP = "udp"
+)
+
+// Additional port errors.
+var (
+	errInvalidAdditionalPort         = fmt.Errorf("`image.additional_ports` must be port numbers between %d and %d", minPortNumber, maxPortNumber)
+	errInvalidAdditionalPortProtocol = fmt.Errorf("`image.additional_ports` protocol must be one of < %s | %s >", containerProtocolTCP, containerProtocolUDP)
+	errAdditionalPortsNotSupported   = errors.New("`image.additional_ports` is only supported by Load Balanced Web Services")
+)
+
+// Alarm notification errors.
+var (
+	errAlarmNotificationsWithoutTopic = errors.New("`alarm_notifications.topic_arn` or `alarm_notifications.create_topic` must be specified")
+	errAlarmNotificationsBothTopics   = errors.New("`alarm_notifications.topic_arn` and `alarm_notifications.create_topic` cannot be specified together")
+)
+
+// App Runner auto scaling configuration ARN format.
+const (
+	appRunnerServiceName                    = "apprunner"
+	appRunnerAutoScalingConfigurationPrefix = "autoscalingconfiguration/"
+)
+
+var errInvalidAutoScalingConfigurationARN = fmt.Errorf("`auto_scaling_configuration_arn` must be the ARN of an App Runner auto scaling configuration, e.g. arn:aws:%s:us-west-2:123456789012:%sname/1/id", appRunnerServiceName, appRunnerAutoScalingConfigurationPrefix)
+
+// Bounds for the deregistration delay of a target group.
+// See https://docs.aws.amazon.com/elasticloadbalancing/latest/application/load-balancer-target-groups.html#deregistration-delay
+const (
+	minDeregistrationDelay = 0
+	maxDeregistrationDelay = 3600 * time.Second
+)
+
+var errInvalidDeregistrationDelay = fmt.Errorf("`deployment.deregistration_delay` must be between %ds and %ds", minDeregistrationDelay, int(maxDeregistrationDelay.Seconds()))
+
+// Bounds for the duration of load balancer generated stickiness cookies.
+// See https://docs.aws.amazon.com/elasticloadbalancing/latest/application/sticky-sessions.html
+const (
+	minStickinessDuration = 1 * time.Second
+	maxStickinessDuration = 7 * 24 * time.Hour
+)
+
+var errInvalidStickinessDuration = fmt.Errorf("`http.stickiness_duration` must be between %ds and %ds", int(minStickinessDuration.Seconds()), int(maxStickinessDuration.Seconds()))
+
+// Valid number of days to retain log events in a log group.
+// See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-logs-loggroup.html#cfn-logs-loggroup-retentionindays
+var validLogRetentionInDays = []int{1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1827, 3653}
+
 // Container dependency status options
 var (
 	essentialContainerValidStatuses = []string{dependsOnStart, dependsOnHealthy}
@@ -65,6 +155,100 @@ func validatePath(input string, maxLength int) error {
 	return nil
 }
 
+func validateRoutingRule(rule manifest.RoutingRule) error {
+	if rule.Path == nil && rule.Host == nil {
+		return errNoRoutingRuleCondition
+	}
+	if rule.Host != nil {
+		if err := validateHostHeader(aws.StringValue(rule.Host)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateHostHeader returns an error if the host can't be used as the host condition of a listener rule.
+func validateHostHeader(host string) error {
+	if host == "" {
+		return errors.New("`http.host` cannot be empty")
+	}
+	if len(host) > maxHostHeaderLength {
+		return fmt.Errorf("`http.host` must be at most %d characters long", maxHostHeaderLength)
+	}
+	if !hostHeaderRegexp.MatchString(host) {
+		return fmt.Errorf("`http.host` %s can only contain the characters a-zA-Z0-9.-*?", host)
+	}
+	return nil
+}
+
+// validateBackendHTTPConfig returns an error if a backend service can't be placed behind the environment's internal load balancer.
+func validateBackendHTTPConfig(http manifest.BackendServiceHTTPConfig, port *uint16, network *manifest.NetworkConfig) error {
+	if http.Path == nil && http.Host == nil {
+		return errNoRoutingRuleCondition
+	}
+	if http.Host != nil {
+		if err := validateHostHeader(aws.StringValue(http.Host)); err != nil {
+			return err
+		}
+	}
+	if port == nil {
+		return errInternalALBWithoutPort
+	}
+	// The internal load balancer is placed in the private subnets of the environment.
+	if network == nil || network.VPC == nil || aws.StringValue(network.VPC.Placement) != manifest.PrivateSubnetPlacement {
+		return errInternalALBInPublicSubnets
+	}
+	return nil
+}
+
+// validateClusterCapacityProviders returns an error if the service places tasks on a capacity provider that isn't associated with the imported cluster.
+func validateClusterCapacityProviders(cps []*template.CapacityProviderStrategy, cluster *ImportedCluster) error {
+	if cluster == nil {
+		return nil
+	}
+	for _, cp := range cps {
+		var associated bool
+		for _, provider := range cluster.CapacityProviders {
+			if cp.CapacityProvider == provider {
+				associated = true
+				break
+			}
+		}
+		if !associated {
+			return fmt.Errorf("capacity provider %s is not associated with the imported cluster %s", cp.CapacityProvider, cluster.ARN)
+		}
+	}
+	return nil
+}
+
+// validateAutoScalingConfigurationARN returns an error if the input is set but isn't an App Runner auto scaling configuration ARN.
+func validateAutoScalingConfigurationARN(in string) error {
+	if in == "" {
+		return nil
+	}
+	parsed, err := arn.Parse(in)
+	if err != nil {
+		return errInvalidAutoScalingConfigurationARN
+	}
+	if parsed.Service != appRunnerServiceName || !strings.HasPrefix(parsed.Resource, appRunnerAutoScalingConfigurationPrefix) {
+		return errInvalidAutoScalingConfigurationARN
+	}
+	return nil
+}
+
+func validateLogRetention(days int) error {
+	for _, valid := range validLogRetentionInDays {
+		if days == valid {
+			return nil
+		}
+	}
+	validDays := make([]string, len(validLogRetentionInDays))
+	for i, valid := range validLogRetentionInDays {
+		validDays[i] = strconv.Itoa(valid)
+	}
+	return fmt.Errorf("`logging.retention` must be one of < %s >", strings.Join(validDays, " | "))
+}
+
 func validateStorageConfig(in *manifest.Storage) error {
 	if in == nil {
 		return nil
@@ -97,6 +281,9 @@ func validateMountPointConfig(in manifest.Volume) error {
 	if path == "" {
 		return errNoContainerPath
 	}
+	if !strings.HasPrefix(path, "/") {
+		return errRelativeContainerPath
+	}
 	if err := validateContainerPath(path); err != nil {
 		return fmt.Errorf("validate container path %s: %w", path, err)
 	}
@@ -111,6 +298,9 @@ func validateSidecarMountPoints(in []manifest.SidecarMountPoint) error {
 		if aws.StringValue(mp.ContainerPath) == "" {
 			return errNoContainerPath
 		}
+		if !strings.HasPrefix(aws.StringValue(mp.ContainerPath), "/") {
+			return errRelativeContainerPath
+		}
 		if aws.StringValue(mp.SourceVolume) == "" {
 			return errNoSourceVolume
 		}
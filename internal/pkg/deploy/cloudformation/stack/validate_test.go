@@ -4,11 +4,14 @@
 package stack
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/stretchr/testify/require"
 )
 
@@ -498,3 +501,238 @@ func TestValidateImageDependsOn(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateLogRetention(t *testing.T) {
+	testCases := map[string]struct {
+		in      int
+		wantErr error
+	}{
+		"valid retention": {
+			in: 14,
+		},
+		"invalid retention": {
+			in:      10,
+			wantErr: fmt.Errorf("`logging.retention` must be one of < 1 | 3 | 5 | 7 | 14 | 30 | 60 | 90 | 120 | 150 | 180 | 365 | 400 | 545 | 731 | 1827 | 3653 >"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validateLogRetention(tc.in)
+			if tc.wantErr == nil {
+				require.NoError(t, gotErr)
+			} else {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			}
+		})
+	}
+}
+
+func Test_validateAutoScalingConfigurationARN(t *testing.T) {
+	testCases := map[string]struct {
+		in      string
+		wantErr error
+	}{
+		"unset": {},
+		"valid ARN": {
+			in: "arn:aws:apprunner:us-west-2:123456789012:autoscalingconfiguration/high-availability/1/abcd",
+		},
+		"not an ARN": {
+			in:      "high-availability",
+			wantErr: errInvalidAutoScalingConfigurationARN,
+		},
+		"not an App Runner ARN": {
+			in:      "arn:aws:ecs:us-west-2:123456789012:autoscalingconfiguration/high-availability/1/abcd",
+			wantErr: errInvalidAutoScalingConfigurationARN,
+		},
+		"not an auto scaling configuration": {
+			in:      "arn:aws:apprunner:us-west-2:123456789012:service/frontend/abcd",
+			wantErr: errInvalidAutoScalingConfigurationARN,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validateAutoScalingConfigurationARN(tc.in)
+			if tc.wantErr == nil {
+				require.NoError(t, gotErr)
+			} else {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			}
+		})
+	}
+}
+
+func Test_validateRoutingRule(t *testing.T) {
+	testCases := map[string]struct {
+		in      manifest.RoutingRule
+		wantErr error
+	}{
+		"path only": {
+			in: manifest.RoutingRule{
+				Path: aws.String("/"),
+			},
+		},
+		"host only": {
+			in: manifest.RoutingRule{
+				Host: aws.String("api.example.com"),
+			},
+		},
+		"wildcard host": {
+			in: manifest.RoutingRule{
+				Host: aws.String("*.example.com"),
+			},
+		},
+		"empty host": {
+			in: manifest.RoutingRule{
+				Host: aws.String(""),
+			},
+			wantErr: errors.New("`http.host` cannot be empty"),
+		},
+		"host with invalid characters": {
+			in: manifest.RoutingRule{
+				Host: aws.String("example.com/api"),
+			},
+			wantErr: errors.New("`http.host` example.com/api can only contain the characters a-zA-Z0-9.-*?"),
+		},
+		"host that is too long": {
+			in: manifest.RoutingRule{
+				Host: aws.String(strings.Repeat("a", 129)),
+			},
+			wantErr: errors.New("`http.host` must be at most 128 characters long"),
+		},
+		"path and host": {
+			in: manifest.RoutingRule{
+				Path: aws.String("/api"),
+				Host: aws.String("example.com"),
+			},
+		},
+		"no conditions": {
+			in:      manifest.RoutingRule{},
+			wantErr: errNoRoutingRuleCondition,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validateRoutingRule(tc.in)
+			if tc.wantErr == nil {
+				require.NoError(t, gotErr)
+			} else {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			}
+		})
+	}
+}
+
+func Test_validateBackendHTTPConfig(t *testing.T) {
+	publicNetwork := manifest.NewBackendService(manifest.BackendServiceProps{}).Network
+	privateNetwork := manifest.NewBackendService(manifest.BackendServiceProps{}).Network
+	privateNetwork.VPC.Placement = aws.String(manifest.PrivateSubnetPlacement)
+
+	testCases := map[string]struct {
+		in      manifest.BackendServiceHTTPConfig
+		port    *uint16
+		network *manifest.NetworkConfig
+		wantErr error
+	}{
+		"valid configuration": {
+			in: manifest.BackendServiceHTTPConfig{
+				Path: aws.String("/api"),
+			},
+			port:    aws.Uint16(8080),
+			network: privateNetwork,
+		},
+		"no conditions": {
+			in:      manifest.BackendServiceHTTPConfig{},
+			port:    aws.Uint16(8080),
+			network: privateNetwork,
+			wantErr: errNoRoutingRuleCondition,
+		},
+		"no port": {
+			in: manifest.BackendServiceHTTPConfig{
+				Host: aws.String("api.internal"),
+			},
+			network: privateNetwork,
+			wantErr: errInternalALBWithoutPort,
+		},
+		"invalid host": {
+			in: manifest.BackendServiceHTTPConfig{
+				Host: aws.String("api internal"),
+			},
+			port:    aws.Uint16(8080),
+			network: privateNetwork,
+			wantErr: errors.New("`http.host` api internal can only contain the characters a-zA-Z0-9.-*?"),
+		},
+		"public subnets": {
+			in: manifest.BackendServiceHTTPConfig{
+				Path: aws.String("/api"),
+			},
+			port:    aws.Uint16(8080),
+			network: publicNetwork,
+			wantErr: errInternalALBInPublicSubnets,
+		},
+		"no network configuration": {
+			in: manifest.BackendServiceHTTPConfig{
+				Path: aws.String("/api"),
+			},
+			port:    aws.Uint16(8080),
+			wantErr: errInternalALBInPublicSubnets,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validateBackendHTTPConfig(tc.in, tc.port, tc.network)
+			if tc.wantErr == nil {
+				require.NoError(t, gotErr)
+			} else {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			}
+		})
+	}
+}
+
+func Test_validateClusterCapacityProviders(t *testing.T) {
+	spot := []*template.CapacityProviderStrategy{
+		{
+			Weight:           aws.Int(1),
+			CapacityProvider: capacityProviderFargateSpot,
+		},
+	}
+	testCases := map[string]struct {
+		inCps     []*template.CapacityProviderStrategy
+		inCluster *ImportedCluster
+		wantErr   error
+	}{
+		"no imported cluster": {
+			inCps: spot,
+		},
+		"no capacity providers": {
+			inCluster: &ImportedCluster{
+				ARN: "arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster",
+			},
+		},
+		"capacity providers associated with the cluster": {
+			inCps: spot,
+			inCluster: &ImportedCluster{
+				ARN:               "arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster",
+				CapacityProviders: []string{capacityProviderFargate, capacityProviderFargateSpot},
+			},
+		},
+		"capacity provider not associated with the cluster": {
+			inCps: spot,
+			inCluster: &ImportedCluster{
+				ARN:               "arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster",
+				CapacityProviders: []string{capacityProviderFargate},
+			},
+			wantErr: errors.New("capacity provider FARGATE_SPOT is not associated with the imported cluster arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validateClusterCapacityProviders(tc.inCps, tc.inCluster)
+			if tc.wantErr == nil {
+				require.NoError(t, gotErr)
+			} else {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			}
+		})
+	}
+}
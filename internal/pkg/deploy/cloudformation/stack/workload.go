@@ -7,7 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -41,6 +43,12 @@ const (
 	WorkloadLogRetentionParamKey = "LogRetention"
 )
 
+// Output logical IDs for services on ECS.
+const (
+	ServiceOutputAlarmTopicARN       = "AlarmTopicArn"
+	ServiceOutputDiscoveryServiceARN = "DiscoveryServiceARN"
+)
+
 // Parameter logical IDs for workloads on App Runner.
 const (
 	WorkloadImageRepositoryType                   = "ImageRepositoryType"
@@ -54,6 +62,9 @@ const (
 	WorkloadHealthCheckUnhealthyThresholdParamKey = "HealthCheckUnhealthyThreshold"
 )
 
+// Number of days to retain the workload's logs if the manifest doesn't specify a retention.
+const defaultLogRetentionInDays = 30
+
 // Matches alphanumeric characters and -._
 var pathRegexp = regexp.MustCompile(`^[a-zA-Z0-9\-\.\_/]+$`)
 
@@ -72,6 +83,16 @@ type RuntimeConfig struct {
 	AddonsTemplateURL        string            // Optional. S3 object URL for the addons template.
 	AdditionalTags           map[string]string // AdditionalTags are labels applied to resources in the workload stack.
 	ServiceDiscoveryEndpoint string            // Endpoint for the service discovery namespace in the environment.
+	EnvCapacityProvider      string            // Optional. Default capacity provider of services in the environment.
+	RulePriority             int               // Optional. Listener rule priority of the service, overrides the priority derived from its name and path.
+	EnvPublicSubnetCount     int               // Optional. Number of public subnets in the environment, used to validate the Elastic IPs of the network load balancer.
+	ImportedCluster          *ImportedCluster  // Optional. ECS cluster imported by the environment, used to validate the capacity providers of the service.
+}
+
+// ImportedCluster represents an existing ECS cluster that the environment deploys its services to.
+type ImportedCluster struct {
+	ARN               string   // ARN of the cluster.
+	CapacityProviders []string // Names of the capacity providers associated with the cluster.
 }
 
 // ECRImage represents configuration about the pushed ECR image that is needed to
@@ -113,8 +134,9 @@ type wkld struct {
 	rc    RuntimeConfig
 	image location
 
-	parser template.Parser
-	addons templater
+	parser       template.Parser
+	addons       templater
+	addonsParams map[string]string // Values for the parameters of the addons stack.
 }
 
 // StackName returns the name of the stack.
@@ -196,15 +218,23 @@ func (w *wkld) addonsOutputs() (*template.WorkloadNestedStackOpts, error) {
 		if !errors.As(err, &notFoundErr){
 			return nil, fmt.Errorf("generate addons template for %s: %w", w.name, err)
 		}
+		if len(w.addonsParams) != 0 {
+			return nil, fmt.Errorf("addons parameters %s are set in the manifest of %s but it has no addons",
+				strings.Join(sortedKeys(w.addonsParams), ", "), w.name)
+		}
 		return nil, nil // No addons found, so there are no outputs and error.
 	}
 
+	if err := w.validateAddonsParams(stack); err != nil {
+		return nil, err
+	}
 	out, err := addon.Outputs(stack)
 	if err != nil {
 		return nil, fmt.Errorf("get addons outputs for %s: %w", w.name, err)
 	}
 	return &template.WorkloadNestedStackOpts{
 		StackName:            addon.StackName,
+		Parameters:           w.addonsParams,
 		VariableOutputs:      envVarOutputNames(out),
 		SecretOutputs:        secretOutputNames(out),
 		PolicyOutputs:        managedPolicyOutputNames(out),
@@ -212,6 +242,48 @@ func (w *wkld) addonsOutputs() (*template.WorkloadNestedStackOpts, error) {
 	}, nil
 }
 
+// validateAddonsParams returns an error if a parameter set in the manifest isn't declared by the addons template,
+// or if it's one of the parameters that Copilot passes to the addons stack.
+func (w *wkld) validateAddonsParams(addonsTemplate string) error {
+	if len(w.addonsParams) == 0 {
+		return nil
+	}
+	params, err := addon.Parameters(addonsTemplate)
+	if err != nil {
+		return fmt.Errorf("get addons parameters for %s: %w", w.name, err)
+	}
+	declared := make(map[string]bool)
+	for _, param := range params {
+		declared[param] = true
+	}
+	for _, name := range sortedKeys(w.addonsParams) {
+		if addon.IsRequiredParameter(name) {
+			return fmt.Errorf("addons parameter %s of %s cannot be set in the manifest as Copilot passes it to the addons stack", name, w.name)
+		}
+		if !declared[name] {
+			return fmt.Errorf(`addons parameter %s of %s is not declared under "Parameters" in its addons`, name, w.name)
+		}
+	}
+	return nil
+}
+
+// addonsParameters returns the values for the parameters of the addons stack configured in the manifest.
+func addonsParameters(cfg *manifest.AddonsConfig) map[string]string {
+	if cfg == nil {
+		return nil
+	}
+	return cfg.Parameters
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func securityGroupOutputNames(outputs []addon.Output) []string {
 	var securityGroups []string
 	for _, out := range outputs {
@@ -254,7 +326,8 @@ func envVarOutputNames(outputs []addon.Output) []string {
 
 type ecsWkld struct {
 	*wkld
-	tc manifest.TaskConfig
+	tc      manifest.TaskConfig
+	logging *manifest.Logging
 }
 
 // Parameters returns the list of CloudFormation parameters used by the template.
@@ -267,6 +340,13 @@ func (w *ecsWkld) Parameters() ([]*cloudformation.Parameter, error) {
 	if err != nil {
 		return nil, err
 	}
+	logRetention := defaultLogRetentionInDays
+	if w.logging != nil && w.logging.Retention != nil {
+		if err := validateLogRetention(*w.logging.Retention); err != nil {
+			return nil, err
+		}
+		logRetention = *w.logging.Retention
+	}
 	return append(wkldParameters, []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(WorkloadTaskCPUParamKey),
@@ -282,7 +362,7 @@ func (w *ecsWkld) Parameters() ([]*cloudformation.Parameter, error) {
 		},
 		{
 			ParameterKey:   aws.String(WorkloadLogRetentionParamKey),
-			ParameterValue: aws.String("30"),
+			ParameterValue: aws.String(strconv.Itoa(logRetention)),
 		},
 	}...), nil
 }
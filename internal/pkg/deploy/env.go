@@ -13,7 +13,7 @@ const (
 	// LegacyEnvTemplateVersion is the version associated with the environment template before we started versioning.
 	LegacyEnvTemplateVersion = "v0.0.0"
 	// LatestEnvTemplateVersion is the latest version number available for environment templates.
-	LatestEnvTemplateVersion = "v1.5.0"
+	LatestEnvTemplateVersion = "v1.6.0"
 )
 
 // CreateEnvironmentInput holds the fields required to deploy an environment.
@@ -30,6 +30,13 @@ type CreateEnvironmentInput struct {
 	CustomResourcesURLs      map[string]string // Environment custom resource script S3 object URLs.
 	ImportVPCConfig          *config.ImportVPC // Optional configuration if users have an existing VPC.
 	AdjustVPCConfig          *config.AdjustVPC // Optional configuration if users want to override default VPC configuration.
+	WebACLARN                string            // Optional. ARN of an AWS WAF web ACL to associate with the load balancer.
+	ImportCertARNs           []string          // Optional. ARNs of existing ACM certificates to use for the HTTPS listener.
+	HTTPToHTTPSRedirect      bool              // Optional. Whether to redirect HTTP traffic to the HTTPS listener.
+	IdleTimeout              int64             // Optional. Idle timeout of the load balancers in seconds, defaults to 60 if unset.
+	NATGateways              string            // Optional. Number of NAT gateways in the Copilot-managed VPC, defaults to one per availability zone.
+	VPCEndpoints             bool              // Optional. Whether to create VPC endpoints for ECR, S3, and CloudWatch Logs in the Copilot-managed VPC.
+	ImportClusterARN         string            // Optional. ARN of an existing ECS cluster to use instead of creating one.
 
 	CFNServiceRoleARN string // Optional. A service role ARN that CloudFormation should use to make calls to resources in the stack.
 }
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 
@@ -29,6 +30,8 @@ var (
 	ccRepoExp = regexp.MustCompile(`(https:\/\/(?P<region>.+).console.aws.amazon.com\/codesuite\/codecommit\/repositories\/(?P<repo>.+)(\/browse))`)
 	// Ex: https://bitbucket.org/repoOwner/repoName
 	bbRepoExp = regexp.MustCompile(`(https:\/\/bitbucket.org\/)(?P<owner>.+)\/(?P<repo>.+)`)
+	// Ex: https://gitlab.com/repoOwner/repoName or https://gitlab.com/group/subgroup/repoName
+	glRepoExp = regexp.MustCompile(`(https:\/\/gitlab.com\/)(?P<owner>.+)\/(?P<repo>.+)`)
 )
 
 // CreatePipelineInput represents the fields required to deploy a pipeline.
@@ -123,6 +126,14 @@ type BitbucketSource struct {
 	ConnectionARN string
 }
 
+// GitLabSource defines the (GL) source of the artifacts to be built and deployed.
+type GitLabSource struct {
+	ProviderName  string
+	Branch        string
+	RepositoryURL string
+	ConnectionARN string
+}
+
 // PipelineSourceFromManifest processes manifest info about the source based on provider type.
 // The return boolean is true for CodeStar Connections sources that require a polling prompt.
 func PipelineSourceFromManifest(mfSource *manifest.Source) (source interface{}, shouldPrompt bool, err error) {
@@ -178,6 +189,19 @@ func PipelineSourceFromManifest(mfSource *manifest.Source) (source interface{},
 		}
 		repo.ConnectionARN = connection.(string)
 		return repo, false, nil
+	case manifest.GitLabProviderName:
+		// If an existing CSC connection is being used, don't prompt to update connection from 'PENDING' to 'AVAILABLE'.
+		connection, ok := mfSource.Properties["connection_arn"]
+		repo := &GitLabSource{
+			ProviderName:  manifest.GitLabProviderName,
+			Branch:        (mfSource.Properties["branch"]).(string),
+			RepositoryURL: (mfSource.Properties["repository"]).(string),
+		}
+		if !ok {
+			return repo, true, nil
+		}
+		repo.ConnectionARN = connection.(string)
+		return repo, false, nil
 	default:
 		return nil, false, fmt.Errorf("invalid repo source provider: %s", mfSource.ProviderName)
 	}
@@ -214,6 +238,11 @@ func (s *GitHubSource) Connection() string {
 	return s.ConnectionARN
 }
 
+// Connection returns the ARN correlated with a ConnectionName in the pipeline manifest.
+func (s *GitLabSource) Connection() string {
+	return s.ConnectionARN
+}
+
 // parse parses the owner and repo name from the GH repo URL, which was formatted and assigned in cli/pipeline_init.go.
 func (url GitHubURL) parse() (owner, repo string, err error) {
 	if url == "" {
@@ -275,6 +304,27 @@ func (s *BitbucketSource) parseOwnerAndRepo() (owner, repo string, err error) {
 	return matches["owner"], matches["repo"], nil
 }
 
+// parseOwnerAndRepo parses the owner and repo name from the GL repo URL, which was formatted and assigned in cli/pipeline_init.go.
+// The owner of a repository in a subgroup contains the full group path, such as "group/subgroup".
+func (s *GitLabSource) parseOwnerAndRepo() (owner, repo string, err error) {
+	if s.RepositoryURL == "" {
+		return "", "", fmt.Errorf("unable to locate the repository")
+	}
+
+	match := glRepoExp.FindStringSubmatch(s.RepositoryURL)
+	if len(match) == 0 {
+		return "", "", fmt.Errorf(fmtInvalidRepo, s.RepositoryURL)
+	}
+
+	matches := make(map[string]string)
+	for i, name := range glRepoExp.SubexpNames() {
+		if i != 0 && name != "" {
+			matches[name] = match[i]
+		}
+	}
+	return matches["owner"], matches["repo"], nil
+}
+
 // ConnectionName generates a string of maximum length 32 to be used as a CodeStar Connections ConnectionName.
 // If there is a duplicate ConnectionName generated by CFN, the previous one is replaced. (Duplicate names
 // generated by the aws cli don't have to be unique for some reason.)
@@ -303,6 +353,16 @@ func (s *GitHubSource) ConnectionName() (string, error) {
 	return formatConnectionName(owner, repo), nil
 }
 
+// ConnectionName generates a recognizable string by which the connection may be identified.
+func (s *GitLabSource) ConnectionName() (string, error) {
+	owner, repo, err := s.parseOwnerAndRepo()
+	if err != nil {
+		return "", fmt.Errorf("parse owner and repo to generate connection name: %w", err)
+	}
+	// Only keep the top-level group so that the name stays readable for repositories in subgroups.
+	return formatConnectionName(strings.Split(owner, "/")[0], repo), nil
+}
+
 func formatConnectionName(owner, repo string) string {
 	if len(owner) > maxOwnerLength {
 		owner = owner[:maxOwnerLength]
@@ -343,6 +403,16 @@ func (s *GitHubSource) Repository() (string, error) {
 	return fmt.Sprintf("%s/%s", owner, repo), nil
 }
 
+// Repository returns the repository portion. For CodeStar Connections,
+// this needs to be in the format "some-group/my-repo."
+func (s *GitLabSource) Repository() (string, error) {
+	owner, repo, err := s.parseOwnerAndRepo()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/%s", owner, repo), nil
+}
+
 // Repository returns the repository portion. For example,
 // given "aws/amazon-copilot", this function returns "amazon-copilot".
 func (s *CodeCommitSource) Repository() (string, error) {
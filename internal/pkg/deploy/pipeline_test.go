@@ -105,6 +105,40 @@ func TestPipelineSourceFromManifest(t *testing.T) {
 			expectedShouldPrompt: false,
 			expectedErr:          nil,
 		},
+		"transforms GitLab source without existing connection": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.GitLabProviderName,
+				Properties: map[string]interface{}{
+					"branch":     "test",
+					"repository": "some/repository/URL",
+				},
+			},
+			expectedDeploySource: &GitLabSource{
+				ProviderName:  manifest.GitLabProviderName,
+				Branch:        "test",
+				RepositoryURL: "some/repository/URL",
+			},
+			expectedShouldPrompt: true,
+			expectedErr:          nil,
+		},
+		"transforms GitLab source with existing connection": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.GitLabProviderName,
+				Properties: map[string]interface{}{
+					"branch":         "test",
+					"repository":     "some/repository/URL",
+					"connection_arn": "garnARN",
+				},
+			},
+			expectedDeploySource: &GitLabSource{
+				ProviderName:  manifest.GitLabProviderName,
+				Branch:        "test",
+				RepositoryURL: "some/repository/URL",
+				ConnectionARN: "garnARN",
+			},
+			expectedShouldPrompt: false,
+			expectedErr:          nil,
+		},
 		"transforms CodeCommit source": {
 			mfSource: &manifest.Source{
 				ProviderName: manifest.CodeCommitProviderName,
@@ -259,3 +293,56 @@ func TestParseRepo(t *testing.T) {
 		})
 	}
 }
+
+func TestGitLabSource_RepositoryAndConnectionName(t *testing.T) {
+	testCases := map[string]struct {
+		src *GitLabSource
+
+		expectedErrMsg         *string
+		expectedRepository     string
+		expectedConnectionName string
+	}{
+		"missing repository property": {
+			src: &GitLabSource{
+				RepositoryURL: "",
+			},
+			expectedErrMsg: aws.String("unable to locate the repository"),
+		},
+		"invalid repository URL": {
+			src: &GitLabSource{
+				RepositoryURL: "gitlab.com/chicken/wings",
+			},
+			expectedErrMsg: aws.String("unable to locate the repository URL from the properties: gitlab.com/chicken/wings"),
+		},
+		"valid GL repository": {
+			src: &GitLabSource{
+				RepositoryURL: "https://gitlab.com/chicken/wings",
+			},
+			expectedRepository:     "chicken/wings",
+			expectedConnectionName: "copilot-chick-wings",
+		},
+		"valid GL repository in a subgroup": {
+			src: &GitLabSource{
+				RepositoryURL: "https://gitlab.com/farm/coop/wings",
+			},
+			expectedRepository:     "farm/coop/wings",
+			expectedConnectionName: "copilot-farm-wings",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			repo, err := tc.src.Repository()
+			if tc.expectedErrMsg != nil {
+				require.Contains(t, err.Error(), *tc.expectedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedRepository, repo)
+
+			connectionName, err := tc.src.ConnectionName()
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedConnectionName, connectionName)
+		})
+	}
+}
@@ -5,7 +5,6 @@ package describe
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
 	"strings"
 	"text/tabwriter"
@@ -23,17 +22,26 @@ import (
 
 // App contains serialized parameters for an application.
 type App struct {
-	Name      string                   `json:"name"`
-	Version   string                   `json:"version"`
-	URI       string                   `json:"uri"`
-	Envs      []*config.Environment    `json:"environments"`
-	Services  []*config.Workload       `json:"services"`
-	Pipelines []*codepipeline.Pipeline `json:"pipelines"`
+	Name           string                   `json:"name"`
+	Version        string                   `json:"version"`
+	URI            string                   `json:"uri"`
+	Envs           []*config.Environment    `json:"environments"`
+	Services       []*config.Workload       `json:"services"`
+	Pipelines      []*codepipeline.Pipeline `json:"pipelines"`
+	StackInstances []*AppStackInstance      `json:"stackInstances,omitempty"`
+}
+
+// AppStackInstance contains serialized parameters for an account and region pair
+// in which the application's shared resources are deployed.
+type AppStackInstance struct {
+	Account string `json:"account"`
+	Region  string `json:"region"`
+	Status  string `json:"status"`
 }
 
 // JSONString returns the stringified App struct with json format.
 func (a *App) JSONString() (string, error) {
-	b, err := json.Marshal(a)
+	b, err := marshalWithSchemaVersion(a)
 	if err != nil {
 		return "", fmt.Errorf("marshal application description: %w", err)
 	}
@@ -77,6 +85,16 @@ func (a *App) HumanString() string {
 	for _, pipeline := range a.Pipelines {
 		fmt.Fprintf(writer, "  %s\n", pipeline.Name)
 	}
+	if len(a.StackInstances) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nAccounts and Regions\n\n"))
+		writer.Flush()
+		headers = []string{"Account", "Region", "Status"}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, instance := range a.StackInstances {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", instance.Account, instance.Region, instance.Status)
+		}
+	}
 	writer.Flush()
 	return b.String()
 }
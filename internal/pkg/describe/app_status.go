@@ -0,0 +1,114 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// AppStatusDescriber retrieves the status of the alarms of an application across its environments.
+type AppStatusDescriber struct {
+	app string
+
+	envs         []string
+	alarmGetters map[string]alarmStatusGetter // Keyed by environment name.
+}
+
+// NewAppStatusDescriber instantiates a new AppStatusDescriber struct.
+func NewAppStatusDescriber(app string, store ConfigStoreSvc) (*AppStatusDescriber, error) {
+	envs, err := store.ListEnvironments(app)
+	if err != nil {
+		return nil, fmt.Errorf("list environments for application %s: %w", app, err)
+	}
+	d := &AppStatusDescriber{
+		app:          app,
+		alarmGetters: make(map[string]alarmStatusGetter),
+	}
+	for _, env := range envs {
+		sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		d.envs = append(d.envs, env.Name)
+		d.alarmGetters[env.Name] = cloudwatch.New(sess)
+	}
+	return d, nil
+}
+
+// Describe returns a rollup of the states of the alarms across all the services and environments of the application.
+func (d *AppStatusDescriber) Describe() (HumanJSONStringer, error) {
+	status := &appAlarmStatus{
+		App: d.app,
+	}
+	seen := make(map[string]bool)
+	for _, env := range d.envs {
+		alarms, err := d.alarmGetters[env].AlarmsWithTags(map[string]string{
+			deploy.AppTagKey: d.app,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get tagged CloudWatch alarms in environment %s: %w", env, err)
+		}
+		for _, alarm := range alarms {
+			// Environments that share an account and a region return the same alarms.
+			if seen[alarm.Arn] {
+				continue
+			}
+			seen[alarm.Arn] = true
+			switch alarm.Status {
+			case "OK":
+				status.OK++
+			case "ALARM":
+				status.Alarm++
+				status.Alarms = append(status.Alarms, alarm)
+			default:
+				status.InsufficientData++
+			}
+		}
+	}
+	return status, nil
+}
+
+// appAlarmStatus contains the rollup of the alarm states of an application.
+type appAlarmStatus struct {
+	App              string                   `json:"application"`
+	OK               int                      `json:"ok"`
+	Alarm            int                      `json:"alarm"`
+	InsufficientData int                      `json:"insufficientData"`
+	Alarms           []cloudwatch.AlarmStatus `json:"alarms"` // Alarms only contains the alarms in the ALARM state.
+}
+
+// JSONString returns the stringified appAlarmStatus struct with json format.
+func (s *appAlarmStatus) JSONString() (string, error) {
+	b, err := marshalWithSchemaVersion(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal application alarm status: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified appAlarmStatus struct with human readable format.
+func (s *appAlarmStatus) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, statusMinCellWidth, tabWidth, statusCellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Alarm Summary\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%d\n", alarmHealthColor("OK"), s.OK)
+	fmt.Fprintf(writer, "  %s\t%d\n", alarmHealthColor("ALARM"), s.Alarm)
+	fmt.Fprintf(writer, "  %s\t%d\n", alarmHealthColor("INSUFFICIENT_DATA"), s.InsufficientData)
+	writer.Flush()
+	if len(s.Alarms) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nAlarms in ALARM\n\n"))
+		writer.Flush()
+		writeAlarms(writer, s.Alarms)
+		writer.Flush()
+	}
+	return b.String()
+}
@@ -0,0 +1,128 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppStatusDescriber_Describe(t *testing.T) {
+	appTags := map[string]string{
+		"copilot-application": "phonetool",
+	}
+	testCases := map[string]struct {
+		setupMocks func(test, prod *mocks.MockalarmStatusGetter)
+
+		wantedError   error
+		wantedContent *appAlarmStatus
+	}{
+		"errors if failed to get tagged alarms": {
+			setupMocks: func(test, prod *mocks.MockalarmStatusGetter) {
+				test.EXPECT().AlarmsWithTags(appTags).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("get tagged CloudWatch alarms in environment test: some error"),
+		},
+		"success": {
+			setupMocks: func(test, prod *mocks.MockalarmStatusGetter) {
+				gomock.InOrder(
+					test.EXPECT().AlarmsWithTags(appTags).Return([]cloudwatch.AlarmStatus{
+						{
+							Arn:    "arn:aws:cloudwatch:us-west-2:111111111111:alarm:frontend-cpu",
+							Name:   "frontend-cpu",
+							Status: "OK",
+						},
+						{
+							Arn:    "arn:aws:cloudwatch:us-west-2:111111111111:alarm:backend-cpu",
+							Name:   "backend-cpu",
+							Status: "ALARM",
+						},
+					}, nil),
+					prod.EXPECT().AlarmsWithTags(appTags).Return([]cloudwatch.AlarmStatus{
+						{
+							Arn:    "arn:aws:cloudwatch:us-west-2:111111111111:alarm:frontend-cpu",
+							Name:   "frontend-cpu",
+							Status: "OK",
+						},
+						{
+							Arn:    "arn:aws:cloudwatch:us-west-2:111111111111:alarm:frontend-memory",
+							Name:   "frontend-memory",
+							Status: "INSUFFICIENT_DATA",
+						},
+					}, nil),
+				)
+			},
+			wantedContent: &appAlarmStatus{
+				App:              "phonetool",
+				OK:               1,
+				Alarm:            1,
+				InsufficientData: 1,
+				Alarms: []cloudwatch.AlarmStatus{
+					{
+						Arn:    "arn:aws:cloudwatch:us-west-2:111111111111:alarm:backend-cpu",
+						Name:   "backend-cpu",
+						Status: "ALARM",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTest := mocks.NewMockalarmStatusGetter(ctrl)
+			mockProd := mocks.NewMockalarmStatusGetter(ctrl)
+			tc.setupMocks(mockTest, mockProd)
+
+			d := &AppStatusDescriber{
+				app:  "phonetool",
+				envs: []string{"test", "prod"},
+				alarmGetters: map[string]alarmStatusGetter{
+					"test": mockTest,
+					"prod": mockProd,
+				},
+			}
+
+			// WHEN
+			status, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, status)
+			}
+		})
+	}
+}
+
+func TestAppAlarmStatus_JSONString(t *testing.T) {
+	status := &appAlarmStatus{
+		App:   "phonetool",
+		OK:    2,
+		Alarm: 1,
+		Alarms: []cloudwatch.AlarmStatus{
+			{
+				Name:   "backend-cpu",
+				Status: "ALARM",
+			},
+		},
+	}
+
+	json, err := status.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `{"schemaVersion":1,"application":"phonetool","ok":2,"alarm":1,"insufficientData":0,"alarms":[{"arn":"","name":"backend-cpu","condition":"","status":"ALARM","type":"","updatedTimes":"0001-01-01T00:00:00Z"}]}`+"\n", json)
+}
@@ -5,7 +5,6 @@ package describe
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
 	"text/tabwriter"
 
@@ -27,9 +26,15 @@ type BackendServiceDescriber struct {
 	app             string
 	svc             string
 	enableResources bool
+	enableMetrics   bool
+	enableTasks     bool
+	enableSGs       bool
+	enableDeps      bool
+	eventsLimit     int
 
 	store                DeployedEnvServicesLister
 	svcDescriber         map[string]ecsSvcDescriber
+	envDescriber         map[string]envDescriber
 	initServiceDescriber func(string) error
 }
 
@@ -37,6 +42,11 @@ type BackendServiceDescriber struct {
 type NewBackendServiceConfig struct {
 	NewServiceConfig
 	EnableResources bool
+	EnableMetrics   bool // Whether to include links to the service metrics in each environment.
+	EnableTasks     bool // Whether to include the network details of the running tasks in each environment.
+	EnableSGs       bool // Whether to include the security group rules of the service and the paths they open in each environment.
+	EnableDeps      bool // Whether to include the storage resources created by the addons of the service in each environment.
+	EventsLimit     int  // Number of the most recent stack events to retrieve per environment. No events are retrieved if not positive.
 	DeployStore     DeployedEnvServicesLister
 }
 
@@ -46,8 +56,14 @@ func NewBackendServiceDescriber(opt NewBackendServiceConfig) (*BackendServiceDes
 		app:             opt.App,
 		svc:             opt.Svc,
 		enableResources: opt.EnableResources,
+		enableMetrics:   opt.EnableMetrics,
+		enableTasks:     opt.EnableTasks,
+		enableSGs:       opt.EnableSGs,
+		enableDeps:      opt.EnableDeps,
+		eventsLimit:     opt.EventsLimit,
 		store:           opt.DeployStore,
 		svcDescriber:    make(map[string]ecsSvcDescriber),
+		envDescriber:    make(map[string]envDescriber),
 	}
 	describer.initServiceDescriber = func(env string) error {
 		if _, ok := describer.svcDescriber[env]; ok {
@@ -63,6 +79,15 @@ func NewBackendServiceDescriber(opt NewBackendServiceConfig) (*BackendServiceDes
 			return err
 		}
 		describer.svcDescriber[env] = d
+		envDescr, err := NewEnvDescriber(NewEnvDescriberConfig{
+			App:         opt.App,
+			Env:         env,
+			ConfigStore: opt.ConfigStore,
+		})
+		if err != nil {
+			return err
+		}
+		describer.envDescriber[env] = envDescr
 		return nil
 	}
 	return describer, nil
@@ -71,6 +96,12 @@ func NewBackendServiceDescriber(opt NewBackendServiceConfig) (*BackendServiceDes
 // URI returns the service discovery namespace and is used to make
 // BackendServiceDescriber have the same signature as WebServiceDescriber.
 func (d *BackendServiceDescriber) URI(envName string) (string, error) {
+	return d.ServiceDiscoveryURI(envName)
+}
+
+// ServiceDiscoveryURI returns the endpoint that other services in the environment can use to reach the service,
+// or BlankServiceDiscoveryURI if the service can't be reached with Service Discovery.
+func (d *BackendServiceDescriber) ServiceDiscoveryURI(envName string) (string, error) {
 	if err := d.initServiceDescriber(envName); err != nil {
 		return "", err
 	}
@@ -82,12 +113,14 @@ func (d *BackendServiceDescriber) URI(envName string) (string, error) {
 	if port == cfnstack.NoExposedContainerPort {
 		return BlankServiceDiscoveryURI, nil
 	}
-	s := serviceDiscovery{
-		Service: d.svc,
-		Port:    port,
-		App:     d.app,
+	sd, err := newServiceDiscovery(d.svc, port, d.svcDescriber[envName], d.envDescriber[envName])
+	if err != nil {
+		return "", fmt.Errorf("retrieve service discovery endpoint: %w", err)
 	}
-	return s.String(), nil
+	if sd == nil {
+		return BlankServiceDiscoveryURI, nil
+	}
+	return sd.String(), nil
 }
 
 // Describe returns info of a backend service.
@@ -101,6 +134,7 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 	var services []*ServiceDiscovery
 	var envVars []*containerEnvVar
 	var secrets []*secret
+	ports := make(map[string]string)
 	for _, env := range environments {
 		err := d.initServiceDescriber(env)
 		if err != nil {
@@ -113,13 +147,15 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 		port := blankContainerPort
 		if svcParams[cfnstack.LBWebServiceContainerPortParamKey] != cfnstack.NoExposedContainerPort {
 			port = svcParams[cfnstack.LBWebServiceContainerPortParamKey]
-			services = appendServiceDiscovery(services, serviceDiscovery{
-				Service: d.svc,
-				Port:    port,
-				Env:     env,
-				App:     d.app,
-			}, env)
+			sd, err := newServiceDiscovery(d.svc, port, d.svcDescriber[env], d.envDescriber[env])
+			if err != nil {
+				return nil, fmt.Errorf("retrieve service discovery endpoint: %w", err)
+			}
+			if sd != nil {
+				services = appendServiceDiscovery(services, *sd, env)
+			}
 		}
+		ports[env] = port
 		configs = append(configs, &ECSServiceConfig{
 			ServiceConfig: &ServiceConfig{
 				Environment: env,
@@ -155,6 +191,93 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 			resources[env] = stackResources
 		}
 	}
+	var metrics []*ServiceMetrics
+	if d.enableMetrics {
+		for _, env := range environments {
+			err := d.initServiceDescriber(env)
+			if err != nil {
+				return nil, err
+			}
+			metricsURL, err := d.svcDescriber[env].MetricsURL()
+			if err != nil {
+				return nil, fmt.Errorf("retrieve metrics URL: %w", err)
+			}
+			metrics = append(metrics, &ServiceMetrics{
+				Environment: env,
+				URL:         metricsURL,
+			})
+		}
+	}
+	var tasks []*ServiceTask
+	if d.enableTasks {
+		for _, env := range environments {
+			err := d.initServiceDescriber(env)
+			if err != nil {
+				return nil, err
+			}
+			runningTasks, err := d.svcDescriber[env].RunningTasks()
+			if err != nil {
+				return nil, fmt.Errorf("retrieve running tasks: %w", err)
+			}
+			envTasks, err := newServiceTasks(env, runningTasks)
+			if err != nil {
+				return nil, err
+			}
+			tasks = append(tasks, envTasks...)
+		}
+	}
+	var sgs *serviceSecurityGroups
+	if d.enableSGs {
+		sgs = &serviceSecurityGroups{}
+		for _, env := range environments {
+			err := d.initServiceDescriber(env)
+			if err != nil {
+				return nil, err
+			}
+			groups, err := d.svcDescriber[env].SecurityGroups()
+			if err != nil {
+				return nil, fmt.Errorf("retrieve security groups: %w", err)
+			}
+			envSGID, err := d.svcDescriber[env].EnvSecurityGroupID(envSecurityGroupLogicalID)
+			if err != nil {
+				return nil, fmt.Errorf("retrieve environment security group: %w", err)
+			}
+			rules, conns := newServiceSecurityGroups(env, ports[env], groups, []connectivitySource{
+				{name: otherServicesConnectivitySource, securityGroupID: envSGID},
+			})
+			sgs.Rules = append(sgs.Rules, rules...)
+			sgs.Connectivity = append(sgs.Connectivity, conns...)
+		}
+	}
+	var deps []*ServiceDependency
+	if d.enableDeps {
+		for _, env := range environments {
+			err := d.initServiceDescriber(env)
+			if err != nil {
+				return nil, err
+			}
+			addonsStackARN, addonsResources, err := d.svcDescriber[env].AddonsStackResources()
+			if err != nil {
+				return nil, fmt.Errorf("retrieve addons resources: %w", err)
+			}
+			envDeps, err := newServiceDependencies(env, addonsStackARN, addonsResources)
+			if err != nil {
+				return nil, err
+			}
+			deps = append(deps, envDeps...)
+		}
+	}
+	var events map[string][]*stack.Event
+	if d.eventsLimit > 0 {
+		events = make(map[string][]*stack.Event)
+		for _, env := range environments {
+			stackEvents, err := d.svcDescriber[env].ServiceStackEvents(d.eventsLimit)
+			if err != nil {
+				return nil, fmt.Errorf("retrieve service events: %w", err)
+			}
+			events[env] = stackEvents
+		}
+	}
 
 	return &backendSvcDesc{
 		Service:          d.svc,
@@ -165,6 +288,11 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 		Variables:        envVars,
 		Secrets:          secrets,
 		Resources:        resources,
+		Metrics:          metrics,
+		Tasks:            tasks,
+		SecurityGroups:   sgs,
+		Dependencies:     deps,
+		Events:           events,
 
 		environments: environments,
 	}, nil
@@ -172,21 +300,26 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 
 // backendSvcDesc contains serialized parameters for a backend service.
 type backendSvcDesc struct {
-	Service          string               `json:"service"`
-	Type             string               `json:"type"`
-	App              string               `json:"application"`
-	Configurations   ecsConfigurations    `json:"configurations"`
-	ServiceDiscovery serviceDiscoveries   `json:"serviceDiscovery"`
-	Variables        containerEnvVars     `json:"variables"`
-	Secrets          secrets              `json:"secrets,omitempty"`
-	Resources        deployedSvcResources `json:"resources,omitempty"`
+	Service          string                 `json:"service"`
+	Type             string                 `json:"type"`
+	App              string                 `json:"application"`
+	Configurations   ecsConfigurations      `json:"configurations"`
+	ServiceDiscovery serviceDiscoveries     `json:"serviceDiscovery"`
+	Variables        containerEnvVars       `json:"variables"`
+	Secrets          secrets                `json:"secrets,omitempty"`
+	Resources        deployedSvcResources   `json:"resources,omitempty"`
+	Metrics          serviceMetrics         `json:"metrics,omitempty"`
+	Tasks            serviceTasks           `json:"tasks,omitempty"`
+	SecurityGroups   *serviceSecurityGroups `json:"securityGroups,omitempty"`
+	Dependencies     serviceDependencies    `json:"dependencies,omitempty"`
+	Events           deployedSvcEvents      `json:"events,omitempty"`
 
 	environments []string `json:"-"`
 }
 
 // JSONString returns the stringified backendService struct with json format.
 func (w *backendSvcDesc) JSONString() (string, error) {
-	b, err := json.Marshal(w)
+	b, err := marshalWithSchemaVersion(w)
 	if err != nil {
 		return "", fmt.Errorf("marshal backend service description: %w", err)
 	}
@@ -222,6 +355,32 @@ func (w *backendSvcDesc) HumanString() string {
 
 		w.Resources.humanStringByEnv(writer, w.environments)
 	}
+	if len(w.Metrics) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nMetrics\n\n"))
+		writer.Flush()
+		w.Metrics.humanString(writer)
+	}
+	if len(w.Tasks) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nTasks\n\n"))
+		writer.Flush()
+		w.Tasks.humanString(writer)
+	}
+	if w.SecurityGroups != nil {
+		fmt.Fprint(writer, color.Bold.Sprint("\nSecurity Groups\n\n"))
+		writer.Flush()
+		w.SecurityGroups.humanString(writer)
+	}
+	if len(w.Dependencies) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nDependencies\n\n"))
+		writer.Flush()
+		w.Dependencies.humanString(writer)
+	}
+	if len(w.Events) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nEvents\n"))
+		writer.Flush()
+
+		w.Events.humanStringByEnv(writer, w.environments)
+	}
 	writer.Flush()
 	return b.String()
 }
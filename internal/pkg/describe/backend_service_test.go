@@ -28,6 +28,7 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 	mockErr := errors.New("some error")
 	testCases := map[string]struct {
 		shouldOutputResources bool
+		inEventsLimit         int
 
 		setupMocks func(mocks lbWebSvcDescriberMocks)
 
@@ -51,6 +52,21 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("get stack parameters for environment test: some error"),
 		},
+		"return error if fail to retrieve service discovery endpoint": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.ecsSvcDescriber.EXPECT().Params().Return(map[string]string{
+						cfnstack.LBWebServiceContainerPortParamKey: "80",
+						cfnstack.WorkloadTaskCountParamKey:         "1",
+						cfnstack.WorkloadTaskMemoryParamKey:        "512",
+						cfnstack.WorkloadTaskCPUParamKey:           "256",
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve service discovery endpoint: get stack outputs for service jobs: some error"),
+		},
 		"return error if fail to retrieve environment variables": {
 			setupMocks: func(m lbWebSvcDescriberMocks) {
 				gomock.InOrder(
@@ -61,6 +77,10 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						cfnstack.WorkloadTaskMemoryParamKey:        "512",
 						cfnstack.WorkloadTaskCPUParamKey:           "256",
 					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(map[string]string{
+						cfnstack.ServiceOutputDiscoveryServiceARN: "arn:aws:servicediscovery:us-west-2:123456789012:service/srv-test",
+					}, nil),
+					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
 					m.ecsSvcDescriber.EXPECT().EnvVars().Return(nil, mockErr),
 				)
 			},
@@ -77,6 +97,10 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						cfnstack.WorkloadTaskCPUParamKey:           "256",
 						cfnstack.WorkloadTaskMemoryParamKey:        "512",
 					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(map[string]string{
+						cfnstack.ServiceOutputDiscoveryServiceARN: "arn:aws:servicediscovery:us-west-2:123456789012:service/srv-test",
+					}, nil),
+					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
 					m.ecsSvcDescriber.EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
 						{
 							Name:      "COPILOT_ENVIRONMENT_NAME",
@@ -89,6 +113,149 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("retrieve secrets: some error"),
 		},
+		"return error if fail to retrieve service events": {
+			inEventsLimit: 5,
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.ecsSvcDescriber.EXPECT().Params().Return(map[string]string{
+						cfnstack.LBWebServiceContainerPortParamKey: "80",
+						cfnstack.WorkloadTaskCountParamKey:         "1",
+						cfnstack.WorkloadTaskCPUParamKey:           "256",
+						cfnstack.WorkloadTaskMemoryParamKey:        "512",
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(map[string]string{
+						cfnstack.ServiceOutputDiscoveryServiceARN: "arn:aws:servicediscovery:us-west-2:123456789012:service/srv-test",
+					}, nil),
+					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
+					m.ecsSvcDescriber.EXPECT().EnvVars().Return(nil, nil),
+					m.ecsSvcDescriber.EXPECT().Secrets().Return(nil, nil),
+					m.ecsSvcDescriber.EXPECT().ServiceStackEvents(5).Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve service events: some error"),
+		},
+		"success with service events": {
+			inEventsLimit: 5,
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.ecsSvcDescriber.EXPECT().Params().Return(map[string]string{
+						cfnstack.LBWebServiceContainerPortParamKey: "80",
+						cfnstack.WorkloadTaskCountParamKey:         "1",
+						cfnstack.WorkloadTaskCPUParamKey:           "256",
+						cfnstack.WorkloadTaskMemoryParamKey:        "512",
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(map[string]string{
+						cfnstack.ServiceOutputDiscoveryServiceARN: "arn:aws:servicediscovery:us-west-2:123456789012:service/srv-test",
+					}, nil),
+					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
+					m.ecsSvcDescriber.EXPECT().EnvVars().Return(nil, nil),
+					m.ecsSvcDescriber.EXPECT().Secrets().Return(nil, nil),
+					m.ecsSvcDescriber.EXPECT().ServiceStackEvents(5).Return([]*stack.Event{
+						{
+							LogicalID:    "Service",
+							ResourceType: "AWS::ECS::Service",
+							Status:       "UPDATE_COMPLETE",
+						},
+					}, nil),
+				)
+			},
+			wantedBackendSvc: &backendSvcDesc{
+				Service: testSvc,
+				Type:    "Backend Service",
+				App:     testApp,
+				Configurations: []*ECSServiceConfig{
+					{
+						ServiceConfig: &ServiceConfig{
+							CPU:         "256",
+							Environment: "test",
+							Memory:      "512",
+							Port:        "80",
+						},
+						Tasks: "1",
+					},
+				},
+				ServiceDiscovery: []*ServiceDiscovery{
+					{
+						Environment: []string{"test"},
+						Namespace:   "jobs.test.phonetool.local:80",
+					},
+				},
+				Resources: map[string][]*stack.Resource{},
+				Events: map[string][]*stack.Event{
+					"test": {
+						{
+							LogicalID:    "Service",
+							ResourceType: "AWS::ECS::Service",
+							Status:       "UPDATE_COMPLETE",
+						},
+					},
+				},
+				environments: []string{"test"},
+			},
+		},
+		"success with a legacy environment and a service that is not registered in service discovery": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv, prodEnv}, nil),
+					m.ecsSvcDescriber.EXPECT().Params().Return(map[string]string{
+						cfnstack.LBWebServiceContainerPortParamKey: "80",
+						cfnstack.WorkloadTaskCountParamKey:         "1",
+						cfnstack.WorkloadTaskCPUParamKey:           "256",
+						cfnstack.WorkloadTaskMemoryParamKey:        "512",
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(map[string]string{
+						cfnstack.ServiceOutputDiscoveryServiceARN: "arn:aws:servicediscovery:us-west-2:123456789012:service/srv-test",
+					}, nil),
+					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("phonetool.local", nil),
+					m.ecsSvcDescriber.EXPECT().EnvVars().Return(nil, nil),
+					m.ecsSvcDescriber.EXPECT().Secrets().Return(nil, nil),
+					m.ecsSvcDescriber.EXPECT().Params().Return(map[string]string{
+						cfnstack.LBWebServiceContainerPortParamKey: "80",
+						cfnstack.WorkloadTaskCountParamKey:         "1",
+						cfnstack.WorkloadTaskCPUParamKey:           "256",
+						cfnstack.WorkloadTaskMemoryParamKey:        "512",
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
+					m.ecsSvcDescriber.EXPECT().EnvVars().Return(nil, nil),
+					m.ecsSvcDescriber.EXPECT().Secrets().Return(nil, nil),
+				)
+			},
+			wantedBackendSvc: &backendSvcDesc{
+				Service: testSvc,
+				Type:    "Backend Service",
+				App:     testApp,
+				Configurations: []*ECSServiceConfig{
+					{
+						ServiceConfig: &ServiceConfig{
+							CPU:         "256",
+							Environment: "test",
+							Memory:      "512",
+							Port:        "80",
+						},
+						Tasks: "1",
+					},
+					{
+						ServiceConfig: &ServiceConfig{
+							CPU:         "256",
+							Environment: "prod",
+							Memory:      "512",
+							Port:        "80",
+						},
+						Tasks: "1",
+					},
+				},
+				ServiceDiscovery: []*ServiceDiscovery{
+					{
+						Environment: []string{"test"},
+						Namespace:   "jobs.phonetool.local:80",
+					},
+				},
+				Resources:    map[string][]*stack.Resource{},
+				environments: []string{"test", "prod"},
+			},
+		},
 		"success": {
 			shouldOutputResources: true,
 			setupMocks: func(m lbWebSvcDescriberMocks) {
@@ -101,6 +268,10 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						cfnstack.WorkloadTaskCPUParamKey:           "256",
 						cfnstack.WorkloadTaskMemoryParamKey:        "512",
 					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(map[string]string{
+						cfnstack.ServiceOutputDiscoveryServiceARN: "arn:aws:servicediscovery:us-west-2:123456789012:service/srv-test",
+					}, nil),
+					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
 					m.ecsSvcDescriber.EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
 						{
 							Name:      "COPILOT_ENVIRONMENT_NAME",
@@ -121,6 +292,10 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						cfnstack.WorkloadTaskCPUParamKey:           "512",
 						cfnstack.WorkloadTaskMemoryParamKey:        "1024",
 					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(map[string]string{
+						cfnstack.ServiceOutputDiscoveryServiceARN: "arn:aws:servicediscovery:us-west-2:123456789012:service/srv-prod",
+					}, nil),
+					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("prod.phonetool.local", nil),
 					m.ecsSvcDescriber.EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
 						{
 							Name:      "COPILOT_ENVIRONMENT_NAME",
@@ -285,9 +460,11 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 
 			mockStore := mocks.NewMockDeployedEnvServicesLister(ctrl)
 			mockSvcDescriber := mocks.NewMockecsSvcDescriber(ctrl)
+			mockEnvDescriber := mocks.NewMockenvDescriber(ctrl)
 			mocks := lbWebSvcDescriberMocks{
 				storeSvc:        mockStore,
 				ecsSvcDescriber: mockSvcDescriber,
+				envDescriber:    mockEnvDescriber,
 			}
 
 			tc.setupMocks(mocks)
@@ -296,12 +473,18 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 				app:             testApp,
 				svc:             testSvc,
 				enableResources: tc.shouldOutputResources,
+				eventsLimit:     tc.inEventsLimit,
 				store:           mockStore,
 				svcDescriber: map[string]ecsSvcDescriber{
 					"test":    mockSvcDescriber,
 					"prod":    mockSvcDescriber,
 					"mockEnv": mockSvcDescriber,
 				},
+				envDescriber: map[string]envDescriber{
+					"test":    mockEnvDescriber,
+					"prod":    mockEnvDescriber,
+					"mockEnv": mockEnvDescriber,
+				},
 				initServiceDescriber: func(string) error { return nil },
 			}
 
@@ -367,7 +550,7 @@ Resources
   prod
     AWS::EC2::SecurityGroupIngress  ContainerSecurityGroupIngressFromPublicALB
 `,
-			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Backend Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"cpu\":\"256\",\"memory\":\"512\",\"tasks\":\"1\"},{\"environment\":\"prod\",\"port\":\"5000\",\"cpu\":\"512\",\"memory\":\"1024\",\"tasks\":\"3\"}],\"serviceDiscovery\":[{\"environment\":[\"test\"],\"namespace\":\"http://my-svc.test.my-app.local:5000\"},{\"environment\":[\"prod\"],\"namespace\":\"http://my-svc.prod.my-app.local:5000\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\",\"container\":\"container\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\",\"container\":\"container\"}],\"secrets\":[{\"name\":\"GITHUB_WEBHOOK_SECRET\",\"container\":\"container\",\"environment\":\"test\",\"valueFrom\":\"GH_WEBHOOK_SECRET\"},{\"name\":\"SOME_OTHER_SECRET\",\"container\":\"container\",\"environment\":\"prod\",\"valueFrom\":\"SHHHHH\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
+			wantedJSONString: "{\"schemaVersion\":1,\"service\":\"my-svc\",\"type\":\"Backend Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"cpu\":\"256\",\"memory\":\"512\",\"tasks\":\"1\"},{\"environment\":\"prod\",\"port\":\"5000\",\"cpu\":\"512\",\"memory\":\"1024\",\"tasks\":\"3\"}],\"serviceDiscovery\":[{\"environment\":[\"test\"],\"namespace\":\"http://my-svc.test.my-app.local:5000\"},{\"environment\":[\"prod\"],\"namespace\":\"http://my-svc.prod.my-app.local:5000\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\",\"container\":\"container\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\",\"container\":\"container\"}],\"secrets\":[{\"name\":\"GITHUB_WEBHOOK_SECRET\",\"container\":\"container\",\"environment\":\"test\",\"valueFrom\":\"GH_WEBHOOK_SECRET\"},{\"name\":\"SOME_OTHER_SECRET\",\"container\":\"container\",\"environment\":\"prod\",\"valueFrom\":\"SHHHHH\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
 		},
 	}
 
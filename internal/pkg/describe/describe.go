@@ -4,9 +4,11 @@
 package describe
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
@@ -27,9 +29,18 @@ const (
 	statusCellPaddingWidth = 2
 )
 
+// JSONSchemaVersion is the version of the JSON output of the describe and status commands,
+// written as the top-level "schemaVersion" field so that tools can tell which fields to expect.
+// Increment it whenever a field of the JSON output is added, removed, renamed, or changes meaning.
+const JSONSchemaVersion = 1
+
 // humanizeTime is overridden in tests so that its output is constant as time passes.
 var humanizeTime = humanize.Time
 
+// timeLocation is the time zone used to render timestamps. It's the local time zone of the user,
+// which honors the TZ environment variable, and is overridden in tests.
+var timeLocation = time.Local
+
 // HumanJSONStringer contains methods that stringify app info for output.
 type HumanJSONStringer interface {
 	HumanString() string
@@ -39,8 +50,10 @@ type HumanJSONStringer interface {
 type stackDescriber interface {
 	Describe() (stack.StackDescription, error)
 	Resources() ([]*stack.Resource, error)
+	Events(limit int) ([]*stack.Event, error)
 	StackMetadata() (string, error)
 	StackSetMetadata() (string, error)
+	Drift() ([]*stack.ResourceDrift, error)
 }
 
 type deployedSvcResources map[string][]*stack.Resource
@@ -55,6 +68,18 @@ func (c deployedSvcResources) humanStringByEnv(w io.Writer, envs []string) {
 	}
 }
 
+type deployedSvcEvents map[string][]*stack.Event
+
+func (c deployedSvcEvents) humanStringByEnv(w io.Writer, envs []string) {
+	for _, env := range envs {
+		events := c[env]
+		fmt.Fprintf(w, "\n  %s\n", env)
+		for _, event := range events {
+			fmt.Fprintf(w, "    %s\t%s\t%s\t%s\n", event.Timestamp.Format(time.RFC3339), event.LogicalID, event.Status, event.Reason)
+		}
+	}
+}
+
 func flattenContainerEnvVars(envName string, envVars []*ecs.ContainerEnvVar) []*containerEnvVar {
 	var out []*containerEnvVar
 	for _, v := range envVars {
@@ -100,3 +125,19 @@ func printTable(w io.Writer, headers []string, rows [][]string) {
 		fmt.Fprintf(w, "  %s\n", strings.Join(cells, "\t"))
 	}
 }
+
+// marshalWithSchemaVersion returns the JSON encoding of the struct v, with the "schemaVersion" field added first.
+func marshalWithSchemaVersion(v interface{}) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < 2 || b[0] != '{' {
+		return nil, fmt.Errorf("JSON output must be an object: %s", b)
+	}
+	version := fmt.Sprintf(`{"schemaVersion":%d`, JSONSchemaVersion)
+	if string(b) == "{}" {
+		return []byte(version + "}"), nil
+	}
+	return append([]byte(version+","), b[1:]...), nil
+}
@@ -5,13 +5,16 @@ package describe
 
 import (
 	"bytes"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
 	"text/tabwriter"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/wafv2"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
@@ -20,17 +23,60 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
+const (
+	loadBalancerResourceType = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+)
+
 var (
 	fmtLegacySvcDiscoveryEndpoint = "%s.local"
 )
 
+type listenerRulesGetter interface {
+	ListenerRules(listenerARN string) ([]*elbv2.ListenerRule, error)
+	ResourceTags(resourceARNs []string) (map[string]map[string]string, error)
+}
+
+type webACLGetter interface {
+	WebACLForResource(resourceARN string) (string, error)
+}
+
+// ErrEnvNotDeployed occurs when the stack of an environment does not exist.
+type ErrEnvNotDeployed struct {
+	app string
+	env string
+}
+
+func (e *ErrEnvNotDeployed) Error() string {
+	return fmt.Sprintf("environment %s is not deployed in application %s", e.env, e.app)
+}
+
 // EnvDescription contains the information about an environment.
 type EnvDescription struct {
-	Environment    *config.Environment `json:"environment"`
-	Services       []*config.Workload  `json:"services"`
-	Tags           map[string]string   `json:"tags,omitempty"`
-	Resources      []*stack.Resource   `json:"resources,omitempty"`
-	EnvironmentVPC EnvironmentVPC      `json:"environmentVPC"`
+	Environment       *config.Environment `json:"environment"`
+	Services          []*config.Workload  `json:"services"`
+	Tags              map[string]string   `json:"tags,omitempty"`
+	Resources         []*stack.Resource   `json:"resources,omitempty"`
+	EnvironmentVPC    EnvironmentVPC      `json:"environmentVPC"`
+	LoadBalancerRules []*LoadBalancerRule `json:"loadBalancerRules,omitempty"`
+	WebACLARN         string              `json:"webACLARN,omitempty"`
+	CertificateARNs   []string            `json:"certificateARNs,omitempty"`
+	HTTPSRedirect     bool                `json:"httpsRedirect,omitempty"`
+	ImportedCluster   string              `json:"importedCluster,omitempty"`
+}
+
+// Names of the environment's load balancer listeners.
+const (
+	HTTPListenerName         = "HTTP"
+	HTTPSListenerName        = "HTTPS"
+	InternalHTTPListenerName = "Internal HTTP"
+)
+
+// LoadBalancerRule contains the routing information of a listener rule on the environment's load balancers.
+type LoadBalancerRule struct {
+	Listener   string   `json:"listener"`
+	Priority   string   `json:"priority"`
+	Conditions []string `json:"conditions"`
+	Service    string   `json:"service"`
 }
 
 // EnvironmentVPC holds the ID of the environment's VPC configuration.
@@ -42,22 +88,26 @@ type EnvironmentVPC struct {
 
 // EnvDescriber retrieves information about an environment.
 type EnvDescriber struct {
-	app             string
-	env             *config.Environment
-	enableResources bool
+	app                string
+	env                *config.Environment
+	enableResources    bool
+	enableLoadBalancer bool
 
 	configStore ConfigStoreSvc
 	deployStore DeployedEnvServicesLister
 	cfn         stackDescriber
+	lb          listenerRulesGetter
+	waf         webACLGetter
 }
 
 // NewEnvDescriberConfig contains fields that initiates EnvDescriber struct.
 type NewEnvDescriberConfig struct {
-	App             string
-	Env             string
-	EnableResources bool
-	ConfigStore     ConfigStoreSvc
-	DeployStore     DeployedEnvServicesLister
+	App                string
+	Env                string
+	EnableResources    bool
+	EnableLoadBalancer bool
+	ConfigStore        ConfigStoreSvc
+	DeployStore        DeployedEnvServicesLister
 }
 
 // NewEnvDescriber instantiates an environment describer.
@@ -71,13 +121,16 @@ func NewEnvDescriber(opt NewEnvDescriberConfig) (*EnvDescriber, error) {
 		return nil, fmt.Errorf("assume role for environment %s: %w", env.ManagerRoleARN, err)
 	}
 	return &EnvDescriber{
-		app:             opt.App,
-		env:             env,
-		enableResources: opt.EnableResources,
+		app:                opt.App,
+		env:                env,
+		enableResources:    opt.EnableResources,
+		enableLoadBalancer: opt.EnableLoadBalancer,
 
 		configStore: opt.ConfigStore,
 		deployStore: opt.DeployStore,
 		cfn:         stack.NewStackDescriber(cfnstack.NameForEnv(opt.App, opt.Env), sess),
+		lb:          elbv2.New(sess),
+		waf:         wafv2.New(sess),
 	}, nil
 }
 
@@ -101,12 +154,42 @@ func (d *EnvDescriber) Describe() (*EnvDescription, error) {
 		}
 	}
 
+	var lbRules []*LoadBalancerRule
+	if d.enableLoadBalancer {
+		lbRules, err = d.LoadBalancerRules()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var webACLARN string
+	if d.env.CustomConfig != nil && d.env.CustomConfig.WebACLARN != "" {
+		webACLARN, err = d.webACL()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var certARNs []string
+	var httpsRedirect bool
+	var importedCluster string
+	if d.env.CustomConfig != nil {
+		certARNs = d.env.CustomConfig.ImportCertARNs
+		httpsRedirect = d.env.CustomConfig.HTTPToHTTPSRedirect
+		importedCluster = d.env.CustomConfig.ImportClusterARN
+	}
+
 	return &EnvDescription{
-		Environment:    d.env,
-		Services:       svcs,
-		Tags:           tags,
-		Resources:      stackResources,
-		EnvironmentVPC: environmentVPC,
+		Environment:       d.env,
+		Services:          svcs,
+		Tags:              tags,
+		Resources:         stackResources,
+		EnvironmentVPC:    environmentVPC,
+		LoadBalancerRules: lbRules,
+		WebACLARN:         webACLARN,
+		CertificateARNs:   certARNs,
+		HTTPSRedirect:     httpsRedirect,
+		ImportedCluster:   importedCluster,
 	}, nil
 }
 
@@ -177,6 +260,10 @@ func (d *EnvDescriber) loadStackInfo() (map[string]string, EnvironmentVPC, error
 
 	envStack, err := d.cfn.Describe()
 	if err != nil {
+		var errStackNotFound *cloudformation.ErrStackNotFound
+		if errors.As(err, &errStackNotFound) || IsStackNotExistsErr(err) {
+			return nil, environmentVPC, &ErrEnvNotDeployed{app: d.app, env: d.env.Name}
+		}
 		return nil, environmentVPC, fmt.Errorf("retrieve environment stack: %w", err)
 	}
 
@@ -194,6 +281,82 @@ func (d *EnvDescriber) loadStackInfo() (map[string]string, EnvironmentVPC, error
 	return envStack.Tags, environmentVPC, nil
 }
 
+// LoadBalancerRules returns the listener rules of the environment's public and internal load balancers
+// along with the services they route to.
+func (d *EnvDescriber) LoadBalancerRules() ([]*LoadBalancerRule, error) {
+	outputs, err := d.Outputs()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve environment stack outputs: %w", err)
+	}
+	listeners := []struct {
+		name      string
+		outputKey string
+	}{
+		{name: HTTPListenerName, outputKey: cfnstack.EnvOutputHTTPListenerARN},
+		{name: HTTPSListenerName, outputKey: cfnstack.EnvOutputHTTPSListenerARN},
+		{name: InternalHTTPListenerName, outputKey: cfnstack.EnvOutputInternalHTTPListenerARN},
+	}
+	var rules []*LoadBalancerRule
+	ruleTargetGroups := make(map[*LoadBalancerRule][]string)
+	var targetGroupARNs []string
+	for _, listener := range listeners {
+		listenerARN, ok := outputs[listener.outputKey]
+		if !ok {
+			continue
+		}
+		listenerRules, err := d.lb.ListenerRules(listenerARN)
+		if err != nil {
+			return nil, fmt.Errorf("get rules for %s listener: %w", listener.name, err)
+		}
+		for _, lr := range listenerRules {
+			rule := &LoadBalancerRule{
+				Listener:   listener.name,
+				Priority:   lr.Priority,
+				Conditions: lr.Conditions,
+			}
+			rules = append(rules, rule)
+			ruleTargetGroups[rule] = lr.TargetGroupARNs
+			targetGroupARNs = append(targetGroupARNs, lr.TargetGroupARNs...)
+		}
+	}
+	if len(targetGroupARNs) == 0 {
+		return rules, nil
+	}
+	tags, err := d.lb.ResourceTags(targetGroupARNs)
+	if err != nil {
+		return nil, fmt.Errorf("get tags of target groups: %w", err)
+	}
+	for _, rule := range rules {
+		var svcs []string
+		for _, arn := range ruleTargetGroups[rule] {
+			if svc, ok := tags[arn][deploy.ServiceTagKey]; ok {
+				svcs = append(svcs, svc)
+			}
+		}
+		rule.Service = strings.Join(svcs, ", ")
+	}
+	return rules, nil
+}
+
+// webACL returns the ARN of the AWS WAF web ACL associated with the environment's load balancer, if any.
+func (d *EnvDescriber) webACL() (string, error) {
+	resources, err := d.cfn.Resources()
+	if err != nil {
+		return "", fmt.Errorf("retrieve environment resources: %w", err)
+	}
+	for _, resource := range resources {
+		if resource.Type != loadBalancerResourceType {
+			continue
+		}
+		webACLARN, err := d.waf.WebACLForResource(resource.PhysicalID)
+		if err != nil {
+			return "", fmt.Errorf("get web ACL associated with load balancer: %w", err)
+		}
+		return webACLARN, nil
+	}
+	return "", nil
+}
+
 func (d *EnvDescriber) filterDeployedSvcs() ([]*config.Workload, error) {
 	allSvcs, err := d.configStore.ListServices(d.app)
 	if err != nil {
@@ -216,7 +379,7 @@ func (d *EnvDescriber) filterDeployedSvcs() ([]*config.Workload, error) {
 
 // JSONString returns the stringified EnvDescription struct with json format.
 func (e *EnvDescription) JSONString() (string, error) {
-	b, err := json.Marshal(e)
+	b, err := marshalWithSchemaVersion(e)
 	if err != nil {
 		return "", fmt.Errorf("marshal environment description: %w", err)
 	}
@@ -233,6 +396,15 @@ func (e *EnvDescription) HumanString() string {
 	fmt.Fprintf(writer, "  %s\t%t\n", "Production", e.Environment.Prod)
 	fmt.Fprintf(writer, "  %s\t%s\n", "Region", e.Environment.Region)
 	fmt.Fprintf(writer, "  %s\t%s\n", "Account ID", e.Environment.AccountID)
+	if e.ImportedCluster != "" {
+		fmt.Fprintf(writer, "  %s\t%s\n", "Imported Cluster", e.ImportedCluster)
+	}
+	if e.WebACLARN != "" {
+		fmt.Fprintf(writer, "  %s\t%s\n", "Web ACL", e.WebACLARN)
+	}
+	if e.HTTPSRedirect {
+		fmt.Fprintf(writer, "  %s\t%t\n", "HTTPS Redirect", e.HTTPSRedirect)
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nServices\n\n"))
 	writer.Flush()
 	headers := []string{"Name", "Type"}
@@ -242,6 +414,14 @@ func (e *EnvDescription) HumanString() string {
 		fmt.Fprintf(writer, "  %s\t%s\n", svc.Name, svc.Type)
 	}
 	writer.Flush()
+	if len(e.CertificateARNs) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nHTTPS Certificates\n\n"))
+		writer.Flush()
+		for _, certARN := range e.CertificateARNs {
+			fmt.Fprintf(writer, "  %s\n", certARN)
+		}
+	}
+	writer.Flush()
 	if len(e.Tags) != 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nTags\n\n"))
 		writer.Flush()
@@ -268,5 +448,24 @@ func (e *EnvDescription) HumanString() string {
 		}
 	}
 	writer.Flush()
+	if len(e.LoadBalancerRules) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nLoad Balancer Rules\n\n"))
+		writer.Flush()
+		headers := []string{"Listener", "Priority", "Conditions", "Service"}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, rule := range e.LoadBalancerRules {
+			conditions := strings.Join(rule.Conditions, "; ")
+			if conditions == "" {
+				conditions = "-"
+			}
+			service := rule.Service
+			if service == "" {
+				service = "-"
+			}
+			fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", rule.Listener, rule.Priority, conditions, service)
+		}
+	}
+	writer.Flush()
 	return b.String()
 }
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	cfstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
@@ -21,6 +23,8 @@ type envDescriberMocks struct {
 	configStoreSvc *mocks.MockConfigStoreSvc
 	deployStoreSvc *mocks.MockDeployedEnvServicesLister
 	stackDescriber *mocks.MockstackDescriber
+	lb             *mocks.MocklistenerRulesGetter
+	waf            *mocks.MockwebACLGetter
 }
 
 var wantedResources = []*stack.Resource{
@@ -80,8 +84,16 @@ func TestEnvDescriber_Describe(t *testing.T) {
 	}
 	envSvcs := []*config.Workload{testSvc1, testSvc2}
 	mockError := errors.New("some error")
+	lbStackOutputs := map[string]string{
+		"VpcId":                   "vpc-012abcd345",
+		"PublicSubnets":           "subnet-0789ab,subnet-0123cd",
+		"PrivateSubnets":          "subnet-023ff,subnet-04af",
+		"HTTPListenerArn":         "listener-http",
+		"InternalHTTPListenerArn": "listener-internal-http",
+	}
 	testCases := map[string]struct {
-		shouldOutputResources bool
+		shouldOutputResources    bool
+		shouldOutputLoadBalancer bool
 
 		setupMocks func(mocks envDescriberMocks)
 
@@ -122,6 +134,19 @@ func TestEnvDescriber_Describe(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("retrieve environment stack: some error"),
 		},
+		"error if the environment stack does not exist": {
+			setupMocks: func(m envDescriberMocks) {
+				gomock.InOrder(
+					m.configStoreSvc.EXPECT().ListServices(testApp).Return([]*config.Workload{
+						testSvc1, testSvc2, testSvc3,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
+						Return([]string{"testSvc1", "testSvc2"}, nil),
+					m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{}, fmt.Errorf("describe stack testApp-testEnv: %w", &cloudformation.ErrStackNotFound{})),
+				)
+			},
+			wantedError: errors.New("environment testEnv is not deployed in application testApp"),
+		},
 		"error if fail to get env resources": {
 			shouldOutputResources: true,
 			setupMocks: func(m envDescriberMocks) {
@@ -166,6 +191,103 @@ func TestEnvDescriber_Describe(t *testing.T) {
 				},
 			},
 		},
+		"error if fail to get load balancer listener rules": {
+			shouldOutputLoadBalancer: true,
+			setupMocks: func(m envDescriberMocks) {
+				gomock.InOrder(
+					m.configStoreSvc.EXPECT().ListServices(testApp).Return([]*config.Workload{
+						testSvc1, testSvc2, testSvc3,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
+						Return([]string{"testSvc1", "testSvc2"}, nil),
+					m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{
+						Tags:    stackTags,
+						Outputs: lbStackOutputs,
+					}, nil),
+					m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{
+						Tags:    stackTags,
+						Outputs: lbStackOutputs,
+					}, nil),
+					m.lb.EXPECT().ListenerRules("listener-http").Return(nil, mockError),
+				)
+			},
+			wantedError: fmt.Errorf("get rules for HTTP listener: some error"),
+		},
+		"success with load balancer rules": {
+			shouldOutputLoadBalancer: true,
+			setupMocks: func(m envDescriberMocks) {
+				gomock.InOrder(
+					m.configStoreSvc.EXPECT().ListServices(testApp).Return([]*config.Workload{
+						testSvc1, testSvc2, testSvc3,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
+						Return([]string{"testSvc1", "testSvc2"}, nil),
+					m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{
+						Tags:    stackTags,
+						Outputs: lbStackOutputs,
+					}, nil),
+					m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{
+						Tags:    stackTags,
+						Outputs: lbStackOutputs,
+					}, nil),
+					m.lb.EXPECT().ListenerRules("listener-http").Return([]*elbv2.ListenerRule{
+						{
+							Priority:        "100",
+							Conditions:      []string{"path-pattern: /api, /api/*"},
+							TargetGroupARNs: []string{"group-1"},
+						},
+						{
+							Priority:        "default",
+							TargetGroupARNs: []string{"group-default"},
+						},
+					}, nil),
+					m.lb.EXPECT().ListenerRules("listener-internal-http").Return([]*elbv2.ListenerRule{
+						{
+							Priority:        "200",
+							Conditions:      []string{"path-pattern: /, /*"},
+							TargetGroupARNs: []string{"group-2"},
+						},
+					}, nil),
+					m.lb.EXPECT().ResourceTags([]string{"group-1", "group-default", "group-2"}).Return(map[string]map[string]string{
+						"group-1": {
+							deploy.ServiceTagKey: "testSvc1",
+						},
+						"group-default": {},
+						"group-2": {
+							deploy.ServiceTagKey: "testSvc2",
+						},
+					}, nil),
+				)
+			},
+			wantedEnv: &EnvDescription{
+				Environment: testEnv,
+				Services:    envSvcs,
+				Tags:        map[string]string{"copilot-application": "testApp", "copilot-environment": "testEnv"},
+				EnvironmentVPC: EnvironmentVPC{
+					ID:               "vpc-012abcd345",
+					PublicSubnetIDs:  []string{"subnet-0789ab", "subnet-0123cd"},
+					PrivateSubnetIDs: []string{"subnet-023ff", "subnet-04af"},
+				},
+				LoadBalancerRules: []*LoadBalancerRule{
+					{
+						Listener:   "HTTP",
+						Priority:   "100",
+						Conditions: []string{"path-pattern: /api, /api/*"},
+						Service:    "testSvc1",
+					},
+					{
+						Listener: "HTTP",
+						Priority: "default",
+					},
+					{
+						Listener:   "Internal HTTP",
+						Priority:   "200",
+						Conditions: []string{"path-pattern: /, /*"},
+						Service:    "testSvc2",
+					},
+				},
+			},
+		},
 		"success with resources": {
 			shouldOutputResources: true,
 			setupMocks: func(m envDescriberMocks) {
@@ -207,22 +329,26 @@ func TestEnvDescriber_Describe(t *testing.T) {
 			mockConfigStoreSvc := mocks.NewMockConfigStoreSvc(ctrl)
 			mockDeployedEnvServicesLister := mocks.NewMockDeployedEnvServicesLister(ctrl)
 			mockCFN := mocks.NewMockstackDescriber(ctrl)
+			mockLB := mocks.NewMocklistenerRulesGetter(ctrl)
 			mocks := envDescriberMocks{
 				configStoreSvc: mockConfigStoreSvc,
 				deployStoreSvc: mockDeployedEnvServicesLister,
 				stackDescriber: mockCFN,
+				lb:             mockLB,
 			}
 
 			tc.setupMocks(mocks)
 
 			d := &EnvDescriber{
-				env:             testEnv,
-				app:             testApp,
-				enableResources: tc.shouldOutputResources,
+				env:                testEnv,
+				app:                testApp,
+				enableResources:    tc.shouldOutputResources,
+				enableLoadBalancer: tc.shouldOutputLoadBalancer,
 
 				configStore: mockConfigStoreSvc,
 				deployStore: mockDeployedEnvServicesLister,
 				cfn:         mockCFN,
+				lb:          mockLB,
 			}
 
 			// WHEN
@@ -397,7 +523,7 @@ func TestEnvDescription_JSONString(t *testing.T) {
 		Type: "load-balanced",
 	}
 	allSvcs := []*config.Workload{testSvc1, testSvc2, testSvc3}
-	wantedContent := "{\"environment\":{\"app\":\"testApp\",\"name\":\"testEnv\",\"region\":\"us-west-2\",\"accountID\":\"123456789012\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\",\"customConfig\":{}},\"services\":[{\"app\":\"testApp\",\"name\":\"testSvc1\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc2\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc3\",\"type\":\"load-balanced\"}],\"tags\":{\"key1\":\"value1\",\"key2\":\"value2\"},\"resources\":[{\"type\":\"AWS::IAM::Role\",\"physicalID\":\"testApp-testEnv-CFNExecutionRole\"},{\"type\":\"testApp-testEnv-Cluster\",\"physicalID\":\"AWS::ECS::Cluster-jI63pYBWU6BZ\"}],\"environmentVPC\":{\"id\":\"\",\"publicSubnetIDs\":null,\"privateSubnetIDs\":null}}\n"
+	wantedContent := "{\"schemaVersion\":1,\"environment\":{\"app\":\"testApp\",\"name\":\"testEnv\",\"region\":\"us-west-2\",\"accountID\":\"123456789012\",\"prod\":false,\"registryURL\":\"\",\"executionRoleARN\":\"\",\"managerRoleARN\":\"\",\"customConfig\":{}},\"services\":[{\"app\":\"testApp\",\"name\":\"testSvc1\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc2\",\"type\":\"load-balanced\"},{\"app\":\"testApp\",\"name\":\"testSvc3\",\"type\":\"load-balanced\"}],\"tags\":{\"key1\":\"value1\",\"key2\":\"value2\"},\"resources\":[{\"type\":\"AWS::IAM::Role\",\"physicalID\":\"testApp-testEnv-CFNExecutionRole\"},{\"type\":\"testApp-testEnv-Cluster\",\"physicalID\":\"AWS::ECS::Cluster-jI63pYBWU6BZ\"}],\"environmentVPC\":{\"id\":\"\",\"publicSubnetIDs\":null,\"privateSubnetIDs\":null}}\n"
 
 	// GIVEN
 	ctrl := gomock.NewController(t)
@@ -494,3 +620,205 @@ Resources
 	// THEN
 	require.Equal(t, wantedContent, actual)
 }
+
+func TestEnvDescription_HumanString_LoadBalancerRules(t *testing.T) {
+	testEnv := &config.Environment{
+		App:       "testApp",
+		Name:      "testEnv",
+		Region:    "us-west-2",
+		AccountID: "123456789012",
+	}
+	wantedContent := `About
+
+  Name              testEnv
+  Production        false
+  Region            us-west-2
+  Account ID        123456789012
+
+Services
+
+  Name              Type
+  ----              ----
+
+Load Balancer Rules
+
+  Listener          Priority            Conditions                  Service
+  --------          --------            ----------                  -------
+  HTTP              100                 path-pattern: /api, /api/*  testSvc1
+  HTTP              default             -                           -
+`
+	d := &EnvDescription{
+		Environment: testEnv,
+		LoadBalancerRules: []*LoadBalancerRule{
+			{
+				Listener:   "HTTP",
+				Priority:   "100",
+				Conditions: []string{"path-pattern: /api, /api/*"},
+				Service:    "testSvc1",
+			},
+			{
+				Listener: "HTTP",
+				Priority: "default",
+			},
+		},
+	}
+
+	// WHEN
+	actual := d.HumanString()
+
+	// THEN
+	require.Equal(t, wantedContent, actual)
+}
+
+func TestEnvDescription_HumanString_HTTPS(t *testing.T) {
+	testEnv := &config.Environment{
+		App:       "testApp",
+		Name:      "testEnv",
+		Region:    "us-west-2",
+		AccountID: "123456789012",
+	}
+	wantedContent := `About
+
+  Name              testEnv
+  Production        false
+  Region            us-west-2
+  Account ID        123456789012
+  HTTPS Redirect    true
+
+Services
+
+  Name              Type
+  ----              ----
+
+HTTPS Certificates
+
+  arn:aws:acm:us-west-2:123456789012:certificate/mockCert1
+  arn:aws:acm:us-west-2:123456789012:certificate/mockCert2
+`
+	d := &EnvDescription{
+		Environment: testEnv,
+		CertificateARNs: []string{
+			"arn:aws:acm:us-west-2:123456789012:certificate/mockCert1",
+			"arn:aws:acm:us-west-2:123456789012:certificate/mockCert2",
+		},
+		HTTPSRedirect: true,
+	}
+
+	// WHEN
+	actual := d.HumanString()
+
+	// THEN
+	require.Equal(t, wantedContent, actual)
+}
+
+func TestEnvDescription_HumanString_ImportedCluster(t *testing.T) {
+	testEnv := &config.Environment{
+		App:       "testApp",
+		Name:      "testEnv",
+		Region:    "us-west-2",
+		AccountID: "123456789012",
+	}
+	wantedContent := `About
+
+  Name              testEnv
+  Production        false
+  Region            us-west-2
+  Account ID        123456789012
+  Imported Cluster  arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster
+
+Services
+
+  Name              Type
+  ----              ----
+`
+	d := &EnvDescription{
+		Environment:     testEnv,
+		ImportedCluster: "arn:aws:ecs:us-west-2:123456789012:cluster/mockCluster",
+	}
+
+	// WHEN
+	actual := d.HumanString()
+
+	// THEN
+	require.Equal(t, wantedContent, actual)
+}
+
+func TestEnvDescriber_webACL(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(mocks envDescriberMocks)
+
+		wantedWebACLARN string
+		wantedError     error
+	}{
+		"error if fail to get env resources": {
+			setupMocks: func(m envDescriberMocks) {
+				m.stackDescriber.EXPECT().Resources().Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("retrieve environment resources: some error"),
+		},
+		"error if fail to get web ACL": {
+			setupMocks: func(m envDescriberMocks) {
+				gomock.InOrder(
+					m.stackDescriber.EXPECT().Resources().Return([]*stack.Resource{
+						{
+							Type:       "AWS::ElasticLoadBalancingV2::LoadBalancer",
+							PhysicalID: "mockLoadBalancerARN",
+						},
+					}, nil),
+					m.waf.EXPECT().WebACLForResource("mockLoadBalancerARN").Return("", mockError),
+				)
+			},
+			wantedError: fmt.Errorf("get web ACL associated with load balancer: some error"),
+		},
+		"no load balancer in the environment": {
+			setupMocks: func(m envDescriberMocks) {
+				m.stackDescriber.EXPECT().Resources().Return(wantedResources, nil)
+			},
+		},
+		"success": {
+			setupMocks: func(m envDescriberMocks) {
+				gomock.InOrder(
+					m.stackDescriber.EXPECT().Resources().Return([]*stack.Resource{
+						{
+							Type:       "AWS::ElasticLoadBalancingV2::LoadBalancer",
+							PhysicalID: "mockLoadBalancerARN",
+						},
+					}, nil),
+					m.waf.EXPECT().WebACLForResource("mockLoadBalancerARN").Return("mockWebACLARN", nil),
+				)
+			},
+			wantedWebACLARN: "mockWebACLARN",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCFN := mocks.NewMockstackDescriber(ctrl)
+			mockWAF := mocks.NewMockwebACLGetter(ctrl)
+			tc.setupMocks(envDescriberMocks{
+				stackDescriber: mockCFN,
+				waf:            mockWAF,
+			})
+
+			d := &EnvDescriber{
+				cfn: mockCFN,
+				waf: mockWAF,
+			}
+
+			// WHEN
+			actual, err := d.webACL()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedWebACLARN, actual)
+			}
+		})
+	}
+}
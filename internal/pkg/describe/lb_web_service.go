@@ -52,19 +52,19 @@ func (u *LBWebServiceURI) String() string {
 }
 
 type serviceDiscovery struct {
-	Service string
-	App     string
-	Env     string
-	Port    string
+	Service   string
+	Namespace string
+	Port      string
 }
 
 func (s *serviceDiscovery) String() string {
-	return fmt.Sprintf("%s.%s.%s.local:%s", s.Service, s.Env, s.App, s.Port)
+	return fmt.Sprintf("%s.%s:%s", s.Service, s.Namespace, s.Port)
 }
 
 type envDescriber interface {
 	Params() (map[string]string, error)
 	Outputs() (map[string]string, error)
+	ServiceDiscoveryEndpoint() (string, error)
 }
 
 // LBWebServiceDescriber retrieves information about a load balanced web service.
@@ -72,6 +72,11 @@ type LBWebServiceDescriber struct {
 	app             string
 	svc             string
 	enableResources bool
+	enableMetrics   bool
+	enableTasks     bool
+	enableSGs       bool
+	enableDeps      bool
+	eventsLimit     int
 
 	store         DeployedEnvServicesLister
 	svcDescriber  map[string]ecsSvcDescriber
@@ -86,6 +91,11 @@ type LBWebServiceDescriber struct {
 type NewLBWebServiceConfig struct {
 	NewServiceConfig
 	EnableResources bool
+	EnableMetrics   bool // Whether to include links to the service metrics in each environment.
+	EnableTasks     bool // Whether to include the network details of the running tasks in each environment.
+	EnableSGs       bool // Whether to include the security group rules of the service and the paths they open in each environment.
+	EnableDeps      bool // Whether to include the storage resources created by the addons of the service in each environment.
+	EventsLimit     int  // Number of the most recent stack events to retrieve per environment. No events are retrieved if not positive.
 	DeployStore     DeployedEnvServicesLister
 }
 
@@ -95,6 +105,11 @@ func NewLBWebServiceDescriber(opt NewLBWebServiceConfig) (*LBWebServiceDescriber
 		app:             opt.App,
 		svc:             opt.Svc,
 		enableResources: opt.EnableResources,
+		enableMetrics:   opt.EnableMetrics,
+		enableTasks:     opt.EnableTasks,
+		enableSGs:       opt.EnableSGs,
+		enableDeps:      opt.EnableDeps,
+		eventsLimit:     opt.EventsLimit,
 		store:           opt.DeployStore,
 		svcDescriber:    make(map[string]ecsSvcDescriber),
 		envDescriber:    make(map[string]envDescriber),
@@ -139,6 +154,7 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 	var serviceDiscoveries []*ServiceDiscovery
 	var envVars []*containerEnvVar
 	var secrets []*secret
+	ports := make(map[string]string)
 	for _, env := range environments {
 		err := d.initDescriber(env)
 		if err != nil {
@@ -161,12 +177,17 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 			},
 			Tasks: d.svcParams[cfnstack.WorkloadTaskCountParamKey],
 		})
-		serviceDiscoveries = appendServiceDiscovery(serviceDiscoveries, serviceDiscovery{
-			Service: d.svc,
-			Port:    d.svcParams[cfnstack.LBWebServiceContainerPortParamKey],
-			Env:     env,
-			App:     d.app,
-		}, env)
+		ports[env] = d.svcParams[cfnstack.LBWebServiceTargetPortParamKey]
+		if ports[env] == "" {
+			ports[env] = d.svcParams[cfnstack.LBWebServiceContainerPortParamKey]
+		}
+		sd, err := newServiceDiscovery(d.svc, d.svcParams[cfnstack.LBWebServiceContainerPortParamKey], d.svcDescriber[env], d.envDescriber[env])
+		if err != nil {
+			return nil, fmt.Errorf("retrieve service discovery endpoint: %w", err)
+		}
+		if sd != nil {
+			serviceDiscoveries = appendServiceDiscovery(serviceDiscoveries, *sd, env)
+		}
 		webSvcEnvVars, err := d.svcDescriber[env].EnvVars()
 		if err != nil {
 			return nil, fmt.Errorf("retrieve environment variables: %w", err)
@@ -192,6 +213,98 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 			resources[env] = stackResources
 		}
 	}
+	var metrics []*ServiceMetrics
+	if d.enableMetrics {
+		for _, env := range environments {
+			err := d.initDescriber(env)
+			if err != nil {
+				return nil, err
+			}
+			metricsURL, err := d.svcDescriber[env].MetricsURL()
+			if err != nil {
+				return nil, fmt.Errorf("retrieve metrics URL: %w", err)
+			}
+			metrics = append(metrics, &ServiceMetrics{
+				Environment: env,
+				URL:         metricsURL,
+			})
+		}
+	}
+	var tasks []*ServiceTask
+	if d.enableTasks {
+		for _, env := range environments {
+			err := d.initDescriber(env)
+			if err != nil {
+				return nil, err
+			}
+			runningTasks, err := d.svcDescriber[env].RunningTasks()
+			if err != nil {
+				return nil, fmt.Errorf("retrieve running tasks: %w", err)
+			}
+			envTasks, err := newServiceTasks(env, runningTasks)
+			if err != nil {
+				return nil, err
+			}
+			tasks = append(tasks, envTasks...)
+		}
+	}
+	var sgs *serviceSecurityGroups
+	if d.enableSGs {
+		sgs = &serviceSecurityGroups{}
+		for _, env := range environments {
+			err := d.initDescriber(env)
+			if err != nil {
+				return nil, err
+			}
+			groups, err := d.svcDescriber[env].SecurityGroups()
+			if err != nil {
+				return nil, fmt.Errorf("retrieve security groups: %w", err)
+			}
+			lbSGID, err := d.svcDescriber[env].EnvSecurityGroupID(publicLBSecurityGroupLogicalID)
+			if err != nil {
+				return nil, fmt.Errorf("retrieve load balancer security group: %w", err)
+			}
+			envSGID, err := d.svcDescriber[env].EnvSecurityGroupID(envSecurityGroupLogicalID)
+			if err != nil {
+				return nil, fmt.Errorf("retrieve environment security group: %w", err)
+			}
+			rules, conns := newServiceSecurityGroups(env, ports[env], groups, []connectivitySource{
+				{name: loadBalancerConnectivitySource, securityGroupID: lbSGID},
+				{name: otherServicesConnectivitySource, securityGroupID: envSGID},
+			})
+			sgs.Rules = append(sgs.Rules, rules...)
+			sgs.Connectivity = append(sgs.Connectivity, conns...)
+		}
+	}
+	var deps []*ServiceDependency
+	if d.enableDeps {
+		for _, env := range environments {
+			err := d.initDescriber(env)
+			if err != nil {
+				return nil, err
+			}
+			addonsStackARN, addonsResources, err := d.svcDescriber[env].AddonsStackResources()
+			if err != nil {
+				return nil, fmt.Errorf("retrieve addons resources: %w", err)
+			}
+			envDeps, err := newServiceDependencies(env, addonsStackARN, addonsResources)
+			if err != nil {
+				return nil, err
+			}
+			deps = append(deps, envDeps...)
+		}
+	}
+	var events map[string][]*stack.Event
+	if d.eventsLimit > 0 {
+		events = make(map[string][]*stack.Event)
+		for _, env := range environments {
+			stackEvents, err := d.svcDescriber[env].ServiceStackEvents(d.eventsLimit)
+			if err != nil {
+				return nil, fmt.Errorf("retrieve service events: %w", err)
+			}
+			events[env] = stackEvents
+		}
+	}
 
 	return &webSvcDesc{
 		Service:          d.svc,
@@ -203,6 +316,11 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 		Variables:        envVars,
 		Secrets:          secrets,
 		Resources:        resources,
+		Metrics:          metrics,
+		Tasks:            tasks,
+		SecurityGroups:   sgs,
+		Dependencies:     deps,
+		Events:           events,
 
 		environments: environments,
 	}, nil
@@ -231,6 +349,8 @@ func (d *LBWebServiceDescriber) URI(envName string) (string, error) {
 	uri := &LBWebServiceURI{
 		DNSNames: []string{envOutputs[envOutputPublicLoadBalancerDNSName]},
 		Path:     svcParams[cfnstack.LBWebServiceRulePathParamKey],
+		// Services in an environment with imported certificates are served on HTTPS without a subdomain.
+		HTTPS: svcParams[cfnstack.LBWebServiceHTTPSParamKey] == "true",
 	}
 	_, isHTTPS := envOutputs[envOutputSubdomain]
 	if isHTTPS {
@@ -333,22 +453,27 @@ func (s serviceDiscoveries) humanString(w io.Writer) {
 
 // webSvcDesc contains serialized parameters for a web service.
 type webSvcDesc struct {
-	Service          string               `json:"service"`
-	Type             string               `json:"type"`
-	App              string               `json:"application"`
-	Configurations   ecsConfigurations    `json:"configurations"`
-	Routes           []*WebServiceRoute   `json:"routes"`
-	ServiceDiscovery serviceDiscoveries   `json:"serviceDiscovery"`
-	Variables        containerEnvVars     `json:"variables"`
-	Secrets          secrets              `json:"secrets,omitempty"`
-	Resources        deployedSvcResources `json:"resources,omitempty"`
+	Service          string                 `json:"service"`
+	Type             string                 `json:"type"`
+	App              string                 `json:"application"`
+	Configurations   ecsConfigurations      `json:"configurations"`
+	Routes           []*WebServiceRoute     `json:"routes"`
+	ServiceDiscovery serviceDiscoveries     `json:"serviceDiscovery"`
+	Variables        containerEnvVars       `json:"variables"`
+	Secrets          secrets                `json:"secrets,omitempty"`
+	Resources        deployedSvcResources   `json:"resources,omitempty"`
+	Metrics          serviceMetrics         `json:"metrics,omitempty"`
+	Tasks            serviceTasks           `json:"tasks,omitempty"`
+	SecurityGroups   *serviceSecurityGroups `json:"securityGroups,omitempty"`
+	Dependencies     serviceDependencies    `json:"dependencies,omitempty"`
+	Events           deployedSvcEvents      `json:"events,omitempty"`
 
 	environments []string
 }
 
 // JSONString returns the stringified webSvcDesc struct in json format.
 func (w *webSvcDesc) JSONString() (string, error) {
-	b, err := json.Marshal(w)
+	b, err := marshalWithSchemaVersion(w)
 	if err != nil {
 		return "", fmt.Errorf("marshal web service description: %w", err)
 	}
@@ -392,6 +517,32 @@ func (w *webSvcDesc) HumanString() string {
 
 		w.Resources.humanStringByEnv(writer, w.environments)
 	}
+	if len(w.Metrics) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nMetrics\n\n"))
+		writer.Flush()
+		w.Metrics.humanString(writer)
+	}
+	if len(w.Tasks) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nTasks\n\n"))
+		writer.Flush()
+		w.Tasks.humanString(writer)
+	}
+	if w.SecurityGroups != nil {
+		fmt.Fprint(writer, color.Bold.Sprint("\nSecurity Groups\n\n"))
+		writer.Flush()
+		w.SecurityGroups.humanString(writer)
+	}
+	if len(w.Dependencies) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nDependencies\n\n"))
+		writer.Flush()
+		w.Dependencies.humanString(writer)
+	}
+	if len(w.Events) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nEvents\n"))
+		writer.Flush()
+
+		w.Events.humanStringByEnv(writer, w.environments)
+	}
 	writer.Flush()
 	return b.String()
 }
@@ -420,6 +571,47 @@ func IsStackNotExistsErr(err error) bool {
 	return true
 }
 
+// ServiceDiscoveryURI returns the endpoint that other services in the environment can use to reach the service,
+// or BlankServiceDiscoveryURI if the service can't be reached with Service Discovery.
+func (d *LBWebServiceDescriber) ServiceDiscoveryURI(envName string) (string, error) {
+	if err := d.initDescriber(envName); err != nil {
+		return "", err
+	}
+	svcParams, err := d.svcDescriber[envName].Params()
+	if err != nil {
+		return "", fmt.Errorf("get stack parameters for service %s: %w", d.svc, err)
+	}
+	sd, err := newServiceDiscovery(d.svc, svcParams[cfnstack.LBWebServiceContainerPortParamKey], d.svcDescriber[envName], d.envDescriber[envName])
+	if err != nil {
+		return "", fmt.Errorf("retrieve service discovery endpoint: %w", err)
+	}
+	if sd == nil {
+		return BlankServiceDiscoveryURI, nil
+	}
+	return sd.String(), nil
+}
+
+// newServiceDiscovery returns the Cloud Map endpoint that other services in the environment can use to reach the service.
+// If the service stack doesn't output a discovery service, then the service isn't registered and nil is returned.
+func newServiceDiscovery(svc, port string, svcDescr ecsSvcDescriber, envDescr envDescriber) (*serviceDiscovery, error) {
+	outputs, err := svcDescr.Outputs()
+	if err != nil {
+		return nil, fmt.Errorf("get stack outputs for service %s: %w", svc, err)
+	}
+	if _, ok := outputs[cfnstack.ServiceOutputDiscoveryServiceARN]; !ok {
+		return nil, nil
+	}
+	namespace, err := envDescr.ServiceDiscoveryEndpoint()
+	if err != nil {
+		return nil, err
+	}
+	return &serviceDiscovery{
+		Service:   svc,
+		Namespace: namespace,
+		Port:      port,
+	}, nil
+}
+
 func appendServiceDiscovery(sds []*ServiceDiscovery, sd serviceDiscovery, env string) []*ServiceDiscovery {
 	exist := false
 	for _, s := range sds {
@@ -133,6 +133,22 @@ func TestLBWebServiceDescriber_URI(t *testing.T) {
 
 			wantedURI: "https://jobs.test.phonetool.com",
 		},
+		"https web service in an environment with imported certificates": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.envDescriber.EXPECT().Params().Return(map[string]string{}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().Params().Return(map[string]string{
+						cfnstack.LBWebServiceRulePathParamKey: "api",
+						cfnstack.LBWebServiceHTTPSParamKey:    "true",
+					}, nil),
+				)
+			},
+
+			wantedURI: "https://abc.us-west-1.elb.amazonaws.com/api",
+		},
 		"http web service": {
 			setupMocks: func(m lbWebSvcDescriberMocks) {
 				gomock.InOrder(
@@ -209,6 +225,107 @@ func TestLBWebServiceDescriber_URI(t *testing.T) {
 	}
 }
 
+func TestLBWebServiceDescriber_ServiceDiscoveryURI(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testEnv = "test"
+		testSvc = "jobs"
+	)
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(mocks lbWebSvcDescriberMocks)
+
+		wantedURI   string
+		wantedError error
+	}{
+		"fail to get parameters of service stack": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				m.ecsSvcDescriber.EXPECT().Params().Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("get stack parameters for service jobs: some error"),
+		},
+		"fail to get the service discovery endpoint of the environment": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.ecsSvcDescriber.EXPECT().Params().Return(map[string]string{
+						cfnstack.LBWebServiceContainerPortParamKey: "80",
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(map[string]string{
+						cfnstack.ServiceOutputDiscoveryServiceARN: "arn:aws:servicediscovery:us-west-2:123456789012:service/srv-test",
+					}, nil),
+					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("", mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve service discovery endpoint: some error"),
+		},
+		"return blank URI if the service is not registered": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.ecsSvcDescriber.EXPECT().Params().Return(map[string]string{
+						cfnstack.LBWebServiceContainerPortParamKey: "80",
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
+				)
+			},
+			wantedURI: BlankServiceDiscoveryURI,
+		},
+		"success": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.ecsSvcDescriber.EXPECT().Params().Return(map[string]string{
+						cfnstack.LBWebServiceContainerPortParamKey: "80",
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(map[string]string{
+						cfnstack.ServiceOutputDiscoveryServiceARN: "arn:aws:servicediscovery:us-west-2:123456789012:service/srv-test",
+					}, nil),
+					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
+				)
+			},
+			wantedURI: "jobs.test.phonetool.local:80",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvcDescriber := mocks.NewMockecsSvcDescriber(ctrl)
+			mockEnvDescriber := mocks.NewMockenvDescriber(ctrl)
+			mocks := lbWebSvcDescriberMocks{
+				ecsSvcDescriber: mockSvcDescriber,
+				envDescriber:    mockEnvDescriber,
+			}
+
+			tc.setupMocks(mocks)
+
+			d := &LBWebServiceDescriber{
+				app: testApp,
+				svc: testSvc,
+				svcDescriber: map[string]ecsSvcDescriber{
+					"test": mockSvcDescriber,
+				},
+				envDescriber: map[string]envDescriber{
+					"test": mockEnvDescriber,
+				},
+				initDescriber: func(string) error { return nil },
+			}
+
+			// WHEN
+			actual, err := d.ServiceDiscoveryURI(testEnv)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedURI, actual)
+			}
+		})
+	}
+}
+
 func TestLBWebServiceDescriber_Describe(t *testing.T) {
 	const (
 		testApp          = "phonetool"
@@ -223,6 +340,7 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 	mockErr := errors.New("some error")
 	testCases := map[string]struct {
 		shouldOutputResources bool
+		shouldOutputMetrics   bool
 
 		setupMocks func(mocks lbWebSvcDescriberMocks)
 
@@ -261,6 +379,10 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 						cfnstack.WorkloadTaskMemoryParamKey:        "512",
 						cfnstack.LBWebServiceRulePathParamKey:      testSvcPath,
 					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(map[string]string{
+						cfnstack.ServiceOutputDiscoveryServiceARN: "arn:aws:servicediscovery:us-west-2:123456789012:service/srv-test",
+					}, nil),
+					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
 					m.ecsSvcDescriber.EXPECT().EnvVars().Return(nil, mockErr),
 				)
 			},
@@ -281,6 +403,10 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 						cfnstack.WorkloadTaskMemoryParamKey:        "512",
 						cfnstack.LBWebServiceRulePathParamKey:      testSvcPath,
 					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(map[string]string{
+						cfnstack.ServiceOutputDiscoveryServiceARN: "arn:aws:servicediscovery:us-west-2:123456789012:service/srv-test",
+					}, nil),
+					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
 					m.ecsSvcDescriber.EXPECT().EnvVars().Return(nil, mockErr),
 				)
 			},
@@ -301,6 +427,10 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 						cfnstack.WorkloadTaskMemoryParamKey:        "512",
 						cfnstack.LBWebServiceRulePathParamKey:      testSvcPath,
 					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(map[string]string{
+						cfnstack.ServiceOutputDiscoveryServiceARN: "arn:aws:servicediscovery:us-west-2:123456789012:service/srv-test",
+					}, nil),
+					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
 					m.ecsSvcDescriber.EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
 						{
 							Name:      "COPILOT_ENVIRONMENT_NAME",
@@ -330,6 +460,10 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 						cfnstack.WorkloadTaskMemoryParamKey:        "512",
 						cfnstack.LBWebServiceRulePathParamKey:      testSvcPath,
 					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(map[string]string{
+						cfnstack.ServiceOutputDiscoveryServiceARN: "arn:aws:servicediscovery:us-west-2:123456789012:service/srv-test",
+					}, nil),
+					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
 					m.ecsSvcDescriber.EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
 						{
 							Name:      "COPILOT_ENVIRONMENT_NAME",
@@ -354,6 +488,68 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("retrieve service resources: some error"),
 		},
+		"success with metrics": {
+			shouldOutputMetrics: true,
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.envDescriber.EXPECT().Params().Return(map[string]string{}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().Params().Return(map[string]string{
+						cfnstack.LBWebServiceContainerPortParamKey: "5000",
+						cfnstack.WorkloadTaskCountParamKey:         "1",
+						cfnstack.WorkloadTaskCPUParamKey:           "256",
+						cfnstack.WorkloadTaskMemoryParamKey:        "512",
+						cfnstack.LBWebServiceRulePathParamKey:      testSvcPath,
+					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(map[string]string{
+						cfnstack.ServiceOutputDiscoveryServiceARN: "arn:aws:servicediscovery:us-west-2:123456789012:service/srv-test",
+					}, nil),
+					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
+					m.ecsSvcDescriber.EXPECT().EnvVars().Return(nil, nil),
+					m.ecsSvcDescriber.EXPECT().Secrets().Return(nil, nil),
+					m.ecsSvcDescriber.EXPECT().MetricsURL().Return("https://console.aws.amazon.com/cloudwatch/home?region=us-west-2#metricsV2:graph=~();search=phonetool-test-jobs;namespace=AWS/ECS", nil),
+				)
+			},
+			wantedWebSvc: &webSvcDesc{
+				Service: testSvc,
+				Type:    "Load Balanced Web Service",
+				App:     testApp,
+				Configurations: []*ECSServiceConfig{
+					{
+						ServiceConfig: &ServiceConfig{
+							CPU:         "256",
+							Environment: "test",
+							Memory:      "512",
+							Port:        "5000",
+						},
+						Tasks: "1",
+					},
+				},
+				Routes: []*WebServiceRoute{
+					{
+						Environment: "test",
+						URL:         "http://abc.us-west-1.elb.amazonaws.com/*",
+					},
+				},
+				ServiceDiscovery: []*ServiceDiscovery{
+					{
+						Environment: []string{"test"},
+						Namespace:   "jobs.test.phonetool.local:5000",
+					},
+				},
+				Resources: map[string][]*stack.Resource{},
+				Metrics: []*ServiceMetrics{
+					{
+						Environment: "test",
+						URL:         "https://console.aws.amazon.com/cloudwatch/home?region=us-west-2#metricsV2:graph=~();search=phonetool-test-jobs;namespace=AWS/ECS",
+					},
+				},
+				environments: []string{"test"},
+			},
+		},
 		"success": {
 			shouldOutputResources: true,
 			setupMocks: func(m lbWebSvcDescriberMocks) {
@@ -371,6 +567,10 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 						cfnstack.WorkloadTaskMemoryParamKey:        "512",
 						cfnstack.LBWebServiceRulePathParamKey:      testSvcPath,
 					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(map[string]string{
+						cfnstack.ServiceOutputDiscoveryServiceARN: "arn:aws:servicediscovery:us-west-2:123456789012:service/srv-test",
+					}, nil),
+					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
 					m.ecsSvcDescriber.EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
 						{
 							Name:      "COPILOT_ENVIRONMENT_NAME",
@@ -396,6 +596,10 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 						cfnstack.WorkloadTaskMemoryParamKey:        "1024",
 						cfnstack.LBWebServiceRulePathParamKey:      prodSvcPath,
 					}, nil),
+					m.ecsSvcDescriber.EXPECT().Outputs().Return(map[string]string{
+						cfnstack.ServiceOutputDiscoveryServiceARN: "arn:aws:servicediscovery:us-west-2:123456789012:service/srv-prod",
+					}, nil),
+					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("prod.phonetool.local", nil),
 					m.ecsSvcDescriber.EXPECT().EnvVars().Return([]*ecs.ContainerEnvVar{
 						{
 							Name:      "COPILOT_ENVIRONMENT_NAME",
@@ -539,6 +743,7 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 				app:             testApp,
 				svc:             testSvc,
 				enableResources: tc.shouldOutputResources,
+				enableMetrics:   tc.shouldOutputMetrics,
 				store:           mockStore,
 				svcDescriber: map[string]ecsSvcDescriber{
 					"test": mockSvcDescriber,
@@ -621,7 +826,7 @@ Resources
   prod
     AWS::EC2::SecurityGroupIngress  ContainerSecurityGroupIngressFromPublicALB
 `,
-			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Load Balanced Web Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"cpu\":\"256\",\"memory\":\"512\",\"tasks\":\"1\"},{\"environment\":\"prod\",\"port\":\"5000\",\"cpu\":\"512\",\"memory\":\"1024\",\"tasks\":\"3\"}],\"routes\":[{\"environment\":\"test\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/frontend\"},{\"environment\":\"prod\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/backend\"}],\"serviceDiscovery\":[{\"environment\":[\"test\"],\"namespace\":\"http://my-svc.test.my-app.local:5000\"},{\"environment\":[\"prod\"],\"namespace\":\"http://my-svc.prod.my-app.local:5000\"}],\"variables\":[{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\",\"container\":\"containerA\"},{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\",\"container\":\"containerB\"},{\"environment\":\"prod\",\"name\":\"DIFFERENT_ENV_VAR\",\"value\":\"prod\",\"container\":\"containerB\"}],\"secrets\":[{\"name\":\"GITHUB_WEBHOOK_SECRET\",\"container\":\"containerA\",\"environment\":\"test\",\"valueFrom\":\"GH_WEBHOOK_SECRET\"},{\"name\":\"SOME_OTHER_SECRET\",\"container\":\"containerB\",\"environment\":\"prod\",\"valueFrom\":\"SHHHHH\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
+			wantedJSONString: "{\"schemaVersion\":1,\"service\":\"my-svc\",\"type\":\"Load Balanced Web Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"cpu\":\"256\",\"memory\":\"512\",\"tasks\":\"1\"},{\"environment\":\"prod\",\"port\":\"5000\",\"cpu\":\"512\",\"memory\":\"1024\",\"tasks\":\"3\"}],\"routes\":[{\"environment\":\"test\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/frontend\"},{\"environment\":\"prod\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/backend\"}],\"serviceDiscovery\":[{\"environment\":[\"test\"],\"namespace\":\"http://my-svc.test.my-app.local:5000\"},{\"environment\":[\"prod\"],\"namespace\":\"http://my-svc.prod.my-app.local:5000\"}],\"variables\":[{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\",\"container\":\"containerA\"},{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\",\"container\":\"containerB\"},{\"environment\":\"prod\",\"name\":\"DIFFERENT_ENV_VAR\",\"value\":\"prod\",\"container\":\"containerB\"}],\"secrets\":[{\"name\":\"GITHUB_WEBHOOK_SECRET\",\"container\":\"containerA\",\"environment\":\"test\",\"valueFrom\":\"GH_WEBHOOK_SECRET\"},{\"name\":\"SOME_OTHER_SECRET\",\"container\":\"containerB\",\"environment\":\"prod\",\"valueFrom\":\"SHHHHH\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
 		},
 	}
 
@@ -101,6 +101,36 @@ func (mr *MockstackDescriberMockRecorder) Describe() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstackDescriber)(nil).Describe))
 }
 
+// Drift mocks base method.
+func (m *MockstackDescriber) Drift() ([]*stack.ResourceDrift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Drift")
+	ret0, _ := ret[0].([]*stack.ResourceDrift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Drift indicates an expected call of Drift.
+func (mr *MockstackDescriberMockRecorder) Drift() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Drift", reflect.TypeOf((*MockstackDescriber)(nil).Drift))
+}
+
+// Events mocks base method.
+func (m *MockstackDescriber) Events(limit int) ([]*stack.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Events", limit)
+	ret0, _ := ret[0].([]*stack.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Events indicates an expected call of Events.
+func (mr *MockstackDescriberMockRecorder) Events(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MockstackDescriber)(nil).Events), limit)
+}
+
 // Resources mocks base method.
 func (m *MockstackDescriber) Resources() ([]*stack.Resource, error) {
 	m.ctrl.T.Helper()
@@ -8,9 +8,11 @@ import (
 	reflect "reflect"
 
 	apprunner "github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
+	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
 	stack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	ecs0 "github.com/aws/copilot-cli/internal/pkg/ecs"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -37,6 +39,36 @@ func (m *MockecsClient) EXPECT() *MockecsClientMockRecorder {
 	return m.recorder
 }
 
+// DescribeService mocks base method.
+func (m *MockecsClient) DescribeService(app, env, svc string) (*ecs0.ServiceDesc, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeService", app, env, svc)
+	ret0, _ := ret[0].(*ecs0.ServiceDesc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeService indicates an expected call of DescribeService.
+func (mr *MockecsClientMockRecorder) DescribeService(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeService", reflect.TypeOf((*MockecsClient)(nil).DescribeService), app, env, svc)
+}
+
+// NetworkConfiguration mocks base method.
+func (m *MockecsClient) NetworkConfiguration(app, env, svc string) (*ecs.NetworkConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetworkConfiguration", app, env, svc)
+	ret0, _ := ret[0].(*ecs.NetworkConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NetworkConfiguration indicates an expected call of NetworkConfiguration.
+func (mr *MockecsClientMockRecorder) NetworkConfiguration(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkConfiguration", reflect.TypeOf((*MockecsClient)(nil).NetworkConfiguration), app, env, svc)
+}
+
 // TaskDefinition mocks base method.
 func (m *MockecsClient) TaskDefinition(app, env, svc string) (*ecs.TaskDefinition, error) {
 	m.ctrl.T.Helper()
@@ -90,6 +122,69 @@ func (mr *MockapprunnerClientMockRecorder) DescribeService(svcArn interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeService", reflect.TypeOf((*MockapprunnerClient)(nil).DescribeService), svcArn)
 }
 
+// Mockec2Client is a mock of ec2Client interface.
+type Mockec2Client struct {
+	ctrl     *gomock.Controller
+	recorder *Mockec2ClientMockRecorder
+}
+
+// Mockec2ClientMockRecorder is the mock recorder for Mockec2Client.
+type Mockec2ClientMockRecorder struct {
+	mock *Mockec2Client
+}
+
+// NewMockec2Client creates a new mock instance.
+func NewMockec2Client(ctrl *gomock.Controller) *Mockec2Client {
+	mock := &Mockec2Client{ctrl: ctrl}
+	mock.recorder = &Mockec2ClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockec2Client) EXPECT() *Mockec2ClientMockRecorder {
+	return m.recorder
+}
+
+// SecurityGroupRules mocks base method.
+func (m *Mockec2Client) SecurityGroupRules(groupIDs ...string) ([]*ec2.SecurityGroup, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range groupIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SecurityGroupRules", varargs...)
+	ret0, _ := ret[0].([]*ec2.SecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecurityGroupRules indicates an expected call of SecurityGroupRules.
+func (mr *Mockec2ClientMockRecorder) SecurityGroupRules(groupIDs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{}, groupIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecurityGroupRules", reflect.TypeOf((*Mockec2Client)(nil).SecurityGroupRules), varargs...)
+}
+
+// SecurityGroups mocks base method.
+func (m *Mockec2Client) SecurityGroups(filters ...ec2.Filter) ([]string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range filters {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SecurityGroups", varargs...)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecurityGroups indicates an expected call of SecurityGroups.
+func (mr *Mockec2ClientMockRecorder) SecurityGroups(filters ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{}, filters...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecurityGroups", reflect.TypeOf((*Mockec2Client)(nil).SecurityGroups), varargs...)
+}
+
 // MockapprunnerSvcDescriber is a mock of apprunnerSvcDescriber interface.
 type MockapprunnerSvcDescriber struct {
 	ctrl     *gomock.Controller
@@ -113,6 +208,22 @@ func (m *MockapprunnerSvcDescriber) EXPECT() *MockapprunnerSvcDescriberMockRecor
 	return m.recorder
 }
 
+// AddonsStackResources mocks base method.
+func (m *MockapprunnerSvcDescriber) AddonsStackResources() (string, []*stack.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddonsStackResources")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]*stack.Resource)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddonsStackResources indicates an expected call of AddonsStackResources.
+func (mr *MockapprunnerSvcDescriberMockRecorder) AddonsStackResources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddonsStackResources", reflect.TypeOf((*MockapprunnerSvcDescriber)(nil).AddonsStackResources))
+}
+
 // Params mocks base method.
 func (m *MockapprunnerSvcDescriber) Params() (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -158,6 +269,21 @@ func (mr *MockapprunnerSvcDescriberMockRecorder) ServiceARN() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceARN", reflect.TypeOf((*MockapprunnerSvcDescriber)(nil).ServiceARN))
 }
 
+// ServiceStackEvents mocks base method.
+func (m *MockapprunnerSvcDescriber) ServiceStackEvents(limit int) ([]*stack.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceStackEvents", limit)
+	ret0, _ := ret[0].([]*stack.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceStackEvents indicates an expected call of ServiceStackEvents.
+func (mr *MockapprunnerSvcDescriberMockRecorder) ServiceStackEvents(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceStackEvents", reflect.TypeOf((*MockapprunnerSvcDescriber)(nil).ServiceStackEvents), limit)
+}
+
 // ServiceStackResources mocks base method.
 func (m *MockapprunnerSvcDescriber) ServiceStackResources() ([]*stack.Resource, error) {
 	m.ctrl.T.Helper()
@@ -211,6 +337,37 @@ func (m *MockecsSvcDescriber) EXPECT() *MockecsSvcDescriberMockRecorder {
 	return m.recorder
 }
 
+// AddonsStackResources mocks base method.
+func (m *MockecsSvcDescriber) AddonsStackResources() (string, []*stack.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddonsStackResources")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]*stack.Resource)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddonsStackResources indicates an expected call of AddonsStackResources.
+func (mr *MockecsSvcDescriberMockRecorder) AddonsStackResources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddonsStackResources", reflect.TypeOf((*MockecsSvcDescriber)(nil).AddonsStackResources))
+}
+
+// EnvSecurityGroupID mocks base method.
+func (m *MockecsSvcDescriber) EnvSecurityGroupID(logicalID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvSecurityGroupID", logicalID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnvSecurityGroupID indicates an expected call of EnvSecurityGroupID.
+func (mr *MockecsSvcDescriberMockRecorder) EnvSecurityGroupID(logicalID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvSecurityGroupID", reflect.TypeOf((*MockecsSvcDescriber)(nil).EnvSecurityGroupID), logicalID)
+}
+
 // EnvVars mocks base method.
 func (m *MockecsSvcDescriber) EnvVars() ([]*ecs.ContainerEnvVar, error) {
 	m.ctrl.T.Helper()
@@ -226,6 +383,21 @@ func (mr *MockecsSvcDescriberMockRecorder) EnvVars() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvVars", reflect.TypeOf((*MockecsSvcDescriber)(nil).EnvVars))
 }
 
+// MetricsURL mocks base method.
+func (m *MockecsSvcDescriber) MetricsURL() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MetricsURL")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MetricsURL indicates an expected call of MetricsURL.
+func (mr *MockecsSvcDescriberMockRecorder) MetricsURL() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MetricsURL", reflect.TypeOf((*MockecsSvcDescriber)(nil).MetricsURL))
+}
+
 // Outputs mocks base method.
 func (m *MockecsSvcDescriber) Outputs() (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -256,6 +428,21 @@ func (mr *MockecsSvcDescriberMockRecorder) Params() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MockecsSvcDescriber)(nil).Params))
 }
 
+// RunningTasks mocks base method.
+func (m *MockecsSvcDescriber) RunningTasks() ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunningTasks")
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunningTasks indicates an expected call of RunningTasks.
+func (mr *MockecsSvcDescriberMockRecorder) RunningTasks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTasks", reflect.TypeOf((*MockecsSvcDescriber)(nil).RunningTasks))
+}
+
 // Secrets mocks base method.
 func (m *MockecsSvcDescriber) Secrets() ([]*ecs.ContainerSecret, error) {
 	m.ctrl.T.Helper()
@@ -271,6 +458,36 @@ func (mr *MockecsSvcDescriberMockRecorder) Secrets() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Secrets", reflect.TypeOf((*MockecsSvcDescriber)(nil).Secrets))
 }
 
+// SecurityGroups mocks base method.
+func (m *MockecsSvcDescriber) SecurityGroups() ([]*ec2.SecurityGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecurityGroups")
+	ret0, _ := ret[0].([]*ec2.SecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecurityGroups indicates an expected call of SecurityGroups.
+func (mr *MockecsSvcDescriberMockRecorder) SecurityGroups() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecurityGroups", reflect.TypeOf((*MockecsSvcDescriber)(nil).SecurityGroups))
+}
+
+// ServiceStackEvents mocks base method.
+func (m *MockecsSvcDescriber) ServiceStackEvents(limit int) ([]*stack.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceStackEvents", limit)
+	ret0, _ := ret[0].([]*stack.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceStackEvents indicates an expected call of ServiceStackEvents.
+func (mr *MockecsSvcDescriberMockRecorder) ServiceStackEvents(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceStackEvents", reflect.TypeOf((*MockecsSvcDescriber)(nil).ServiceStackEvents), limit)
+}
+
 // ServiceStackResources mocks base method.
 func (m *MockecsSvcDescriber) ServiceStackResources() ([]*stack.Resource, error) {
 	m.ctrl.T.Helper()
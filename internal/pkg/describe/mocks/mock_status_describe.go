@@ -77,6 +77,21 @@ func (m *MockalarmStatusGetter) EXPECT() *MockalarmStatusGetterMockRecorder {
 	return m.recorder
 }
 
+// AlarmHistory mocks base method.
+func (m *MockalarmStatusGetter) AlarmHistory(alarm string, limit int) ([]cloudwatch.AlarmHistoryItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlarmHistory", alarm, limit)
+	ret0, _ := ret[0].([]cloudwatch.AlarmHistoryItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AlarmHistory indicates an expected call of AlarmHistory.
+func (mr *MockalarmStatusGetterMockRecorder) AlarmHistory(alarm, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlarmHistory", reflect.TypeOf((*MockalarmStatusGetter)(nil).AlarmHistory), alarm, limit)
+}
+
 // AlarmStatus mocks base method.
 func (m *MockalarmStatusGetter) AlarmStatus(alarms []string) ([]cloudwatch.AlarmStatus, error) {
 	m.ctrl.T.Helper()
@@ -236,6 +251,21 @@ func (mr *MockserviceDescriberMockRecorder) DescribeService(app, env, svc interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeService", reflect.TypeOf((*MockserviceDescriber)(nil).DescribeService), app, env, svc)
 }
 
+// ServiceARN mocks base method.
+func (m *MockserviceDescriber) ServiceARN(app, env, svc string) (*ecs.ServiceArn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceARN", app, env, svc)
+	ret0, _ := ret[0].(*ecs.ServiceArn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceARN indicates an expected call of ServiceARN.
+func (mr *MockserviceDescriberMockRecorder) ServiceARN(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceARN", reflect.TypeOf((*MockserviceDescriber)(nil).ServiceARN), app, env, svc)
+}
+
 // MockappRunnerServiceDescriber is a mock of appRunnerServiceDescriber interface.
 type MockappRunnerServiceDescriber struct {
 	ctrl     *gomock.Controller
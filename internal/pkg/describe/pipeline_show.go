@@ -5,7 +5,6 @@ package describe
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
 	"strings"
 	"text/tabwriter"
@@ -75,7 +74,7 @@ func (d *PipelineDescriber) Describe() (HumanJSONStringer, error) {
 
 // JSONString returns the stringified Pipeline struct with JSON format.
 func (p *Pipeline) JSONString() (string, error) {
-	b, err := json.Marshal(p)
+	b, err := marshalWithSchemaVersion(p)
 	if err != nil {
 		return "", fmt.Errorf("marshal pipeline: %w", err)
 	}
@@ -5,7 +5,6 @@ package describe
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
 	"strings"
 	"text/tabwriter"
@@ -56,7 +55,7 @@ func (d *PipelineStatusDescriber) Describe() (HumanJSONStringer, error) {
 
 // JSONString returns stringified PipelineStatus struct with json format.
 func (p PipelineStatus) JSONString() (string, error) {
-	b, err := json.Marshal(p)
+	b, err := marshalWithSchemaVersion(p)
 	if err != nil {
 		return "", fmt.Errorf("marshal pipeline status: %w", err)
 	}
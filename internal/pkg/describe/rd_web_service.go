@@ -5,7 +5,6 @@ package describe
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
 	"strings"
 	"text/tabwriter"
@@ -20,6 +19,8 @@ type RDWebServiceDescriber struct {
 	app             string
 	svc             string
 	enableResources bool
+	enableDeps      bool
+	eventsLimit     int
 
 	store                DeployedEnvServicesLister
 	envSvcDescribers     map[string]apprunnerSvcDescriber
@@ -30,6 +31,8 @@ type RDWebServiceDescriber struct {
 type NewRDWebServiceConfig struct {
 	NewServiceConfig
 	EnableResources bool
+	EnableDeps      bool // Whether to include the storage resources created by the addons of the service in each environment.
+	EventsLimit     int  // Number of the most recent stack events to retrieve per environment. No events are retrieved if not positive.
 	DeployStore     DeployedEnvServicesLister
 }
 
@@ -39,6 +42,8 @@ func NewRDWebServiceDescriber(opt NewRDWebServiceConfig) (*RDWebServiceDescriber
 		app:              opt.App,
 		svc:              opt.Svc,
 		enableResources:  opt.EnableResources,
+		enableDeps:       opt.EnableDeps,
+		eventsLimit:      opt.EventsLimit,
 		store:            opt.DeployStore,
 		envSvcDescribers: make(map[string]apprunnerSvcDescriber),
 	}
@@ -72,6 +77,11 @@ func (d *RDWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 	var configs []*ServiceConfig
 	var envVars envVars
 	resources := make(map[string][]*stack.Resource)
+	var deps []*ServiceDependency
+	var events map[string][]*stack.Event
+	if d.eventsLimit > 0 {
+		events = make(map[string][]*stack.Event)
+	}
 	for _, env := range environments {
 		err := d.initServiceDescriber(env)
 		if err != nil {
@@ -110,6 +120,26 @@ func (d *RDWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 			}
 			resources[env] = stackResources
 		}
+
+		if d.enableDeps {
+			addonsStackARN, addonsResources, err := d.envSvcDescribers[env].AddonsStackResources()
+			if err != nil {
+				return nil, fmt.Errorf("retrieve addons resources: %w", err)
+			}
+			envDeps, err := newServiceDependencies(env, addonsStackARN, addonsResources)
+			if err != nil {
+				return nil, err
+			}
+			deps = append(deps, envDeps...)
+		}
+
+		if d.eventsLimit > 0 {
+			stackEvents, err := d.envSvcDescribers[env].ServiceStackEvents(d.eventsLimit)
+			if err != nil {
+				return nil, fmt.Errorf("retrieve service events: %w", err)
+			}
+			events[env] = stackEvents
+		}
 	}
 
 	return &rdWebSvcDesc{
@@ -120,6 +150,8 @@ func (d *RDWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 		Routes:         routes,
 		Variables:      envVars,
 		Resources:      resources,
+		Dependencies:   deps,
+		Events:         events,
 
 		environments: environments,
 	}, nil
@@ -150,13 +182,15 @@ type rdWebSvcDesc struct {
 	Routes         []*WebServiceRoute   `json:"routes"`
 	Variables      envVars              `json:"variables"`
 	Resources      deployedSvcResources `json:"resources,omitempty"`
+	Dependencies   serviceDependencies  `json:"dependencies,omitempty"`
+	Events         deployedSvcEvents    `json:"events,omitempty"`
 
 	environments []string `json:"-"`
 }
 
 // JSONString returns the stringified rdWebSvcDesc struct in json format.
 func (w *rdWebSvcDesc) JSONString() (string, error) {
-	b, err := json.Marshal(w)
+	b, err := marshalWithSchemaVersion(w)
 	if err != nil {
 		return "", fmt.Errorf("marshal web service description: %w", err)
 	}
@@ -194,6 +228,17 @@ func (w *rdWebSvcDesc) HumanString() string {
 
 		w.Resources.humanStringByEnv(writer, w.environments)
 	}
+	if len(w.Dependencies) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nDependencies\n\n"))
+		writer.Flush()
+		w.Dependencies.humanString(writer)
+	}
+	if len(w.Events) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nEvents\n"))
+		writer.Flush()
+
+		w.Events.humanStringByEnv(writer, w.environments)
+	}
 	writer.Flush()
 	return b.String()
 }
@@ -8,10 +8,17 @@ import (
 	"io"
 	"net/url"
 	"sort"
+	"strconv"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/partition"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 
@@ -30,6 +37,36 @@ const (
 
 const apprunnerServiceType = "AWS::AppRunner::Service"
 
+const nestedStackType = "AWS::CloudFormation::Stack"
+
+// Storage resource types that a service can depend on through its addons.
+const (
+	dynamoDBTableType = "AWS::DynamoDB::Table"
+	s3BucketType      = "AWS::S3::Bucket"
+	rdsDBClusterType  = "AWS::RDS::DBCluster"
+	efsFileSystemType = "AWS::EFS::FileSystem"
+)
+
+const (
+	cfnLogicalIDTagKey = "aws:cloudformation:logical-id"
+
+	// Logical IDs of the security groups in the environment stack.
+	envSecurityGroupLogicalID       = "EnvironmentSecurityGroup"
+	publicLBSecurityGroupLogicalID  = "PublicLoadBalancerSecurityGroup"
+	loadBalancerConnectivitySource  = "Load balancer"
+	otherServicesConnectivitySource = "Other services"
+)
+
+// fmtECSMetricsConsoleURL is the CloudWatch console URL that searches the ECS metrics of a service stack.
+const fmtECSMetricsConsoleURL = "https://%s/cloudwatch/home?region=%s#metricsV2:graph=~();search=%s;namespace=AWS/ECS"
+
+// consoleDomains maps a partition ID to the domain of its AWS console.
+var consoleDomains = map[string]string{
+	"aws":        "console.aws.amazon.com",
+	"aws-cn":     "console.amazonaws.cn",
+	"aws-us-gov": "console.amazonaws-us-gov.com",
+}
+
 // envVar contains serialized environment variables for a service.
 type envVar struct {
 	Environment string `json:"environment"`
@@ -76,18 +113,27 @@ func (e containerEnvVars) humanString(w io.Writer) {
 
 type ecsClient interface {
 	TaskDefinition(app, env, svc string) (*awsecs.TaskDefinition, error)
+	DescribeService(app, env, svc string) (*ecs.ServiceDesc, error)
+	NetworkConfiguration(app, env, svc string) (*awsecs.NetworkConfiguration, error)
 }
 
 type apprunnerClient interface {
 	DescribeService(svcArn string) (*apprunner.Service, error)
 }
 
+type ec2Client interface {
+	SecurityGroups(filters ...ec2.Filter) ([]string, error)
+	SecurityGroupRules(groupIDs ...string) ([]*ec2.SecurityGroup, error)
+}
+
 type apprunnerSvcDescriber interface {
 	Params() (map[string]string, error)
 	ServiceStackResources() ([]*stack.Resource, error)
+	ServiceStackEvents(limit int) ([]*stack.Event, error)
 	Service() (*apprunner.Service, error)
 	ServiceARN() (string, error)
 	ServiceURL() (string, error)
+	AddonsStackResources() (string, []*stack.Resource, error)
 }
 
 type ecsSvcDescriber interface {
@@ -96,6 +142,12 @@ type ecsSvcDescriber interface {
 	EnvVars() ([]*awsecs.ContainerEnvVar, error)
 	Secrets() ([]*awsecs.ContainerSecret, error)
 	ServiceStackResources() ([]*stack.Resource, error)
+	ServiceStackEvents(limit int) ([]*stack.Event, error)
+	MetricsURL() (string, error)
+	RunningTasks() ([]*awsecs.Task, error)
+	SecurityGroups() ([]*ec2.SecurityGroup, error)
+	EnvSecurityGroupID(logicalID string) (string, error)
+	AddonsStackResources() (string, []*stack.Resource, error)
 }
 
 // ConfigStoreSvc wraps methods of config store.
@@ -132,6 +184,115 @@ func (c configurations) humanString(w io.Writer) {
 	printTable(w, headers, rows)
 }
 
+// ServiceMetrics contains the link to the metrics of a service in an environment.
+type ServiceMetrics struct {
+	Environment string `json:"environment"`
+	URL         string `json:"url"`
+}
+
+type serviceMetrics []*ServiceMetrics
+
+func (m serviceMetrics) humanString(w io.Writer) {
+	headers := []string{"Environment", "URL"}
+	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, metrics := range m {
+		fmt.Fprintf(w, "  %s\t%s\n", metrics.Environment, metrics.URL)
+	}
+}
+
+// ServiceTask contains the network details of a running task of a service.
+type ServiceTask struct {
+	Environment      string `json:"environment"`
+	ID               string `json:"id"`
+	PrivateIP        string `json:"privateIP"`
+	ENIID            string `json:"eniID"`
+	AvailabilityZone string `json:"availabilityZone"`
+	SubnetID         string `json:"subnetID"`
+}
+
+type serviceTasks []*ServiceTask
+
+func (t serviceTasks) humanString(w io.Writer) {
+	headers := []string{"Environment", "Task ID", "Private IP", "ENI ID", "Availability Zone", "Subnet ID"}
+	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, task := range t {
+		cols := []string{task.Environment, shortTaskID(task.ID), task.PrivateIP, task.ENIID, task.AvailabilityZone, task.SubnetID}
+		for i, col := range cols {
+			if col == "" {
+				cols[i] = "-"
+			}
+		}
+		fmt.Fprintf(w, "  %s\n", strings.Join(cols, "\t"))
+	}
+}
+
+// ServiceSecurityGroupRule contains a rule of a security group attached to the tasks of a service.
+type ServiceSecurityGroupRule struct {
+	Environment   string `json:"environment"`
+	SecurityGroup string `json:"securityGroup"`
+	Direction     string `json:"direction"`
+	Protocol      string `json:"protocol"`
+	Ports         string `json:"ports"`
+	Peers         string `json:"peers"`
+}
+
+// ServiceConnectivity contains whether the security groups of a service allow traffic from a source on the service's port.
+type ServiceConnectivity struct {
+	Environment string `json:"environment"`
+	Source      string `json:"source"`
+	Port        string `json:"port"`
+	Open        bool   `json:"open"`
+}
+
+type serviceSecurityGroups struct {
+	Rules        []*ServiceSecurityGroupRule `json:"rules"`
+	Connectivity []*ServiceConnectivity      `json:"connectivity"`
+}
+
+func (s *serviceSecurityGroups) humanString(w io.Writer) {
+	headers := []string{"Environment", "Security Group", "Direction", "Protocol", "Ports", "Peers"}
+	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, rule := range s.Rules {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\t%s\n", rule.Environment, rule.SecurityGroup, rule.Direction, rule.Protocol, rule.Ports, rule.Peers)
+	}
+	if len(s.Connectivity) == 0 {
+		return
+	}
+	fmt.Fprintln(w)
+	headers = []string{"Environment", "Source", "Port", "Status"}
+	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, conn := range s.Connectivity {
+		status := "blocked"
+		if conn.Open {
+			status = "open"
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", conn.Environment, conn.Source, conn.Port, status)
+	}
+}
+
+// ServiceDependency contains a storage resource created by the addons of a service.
+type ServiceDependency struct {
+	Environment string `json:"environment"`
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	ARN         string `json:"arn"`
+}
+
+type serviceDependencies []*ServiceDependency
+
+func (d serviceDependencies) humanString(w io.Writer) {
+	headers := []string{"Environment", "Type", "Name", "ARN"}
+	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, dep := range d {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", dep.Environment, dep.Type, dep.Name, dep.ARN)
+	}
+}
+
 type ECSServiceConfig struct {
 	*ServiceConfig
 
@@ -156,9 +317,11 @@ type ServiceDescriber struct {
 	service string
 	env     string
 
-	cfn       stackDescriber
-	ecsClient ecsClient
-	sess      *session.Session
+	cfn               stackDescriber
+	newStackDescriber func(stackName string) stackDescriber
+	ecsClient         ecsClient
+	ec2Client         ec2Client
+	sess              *session.Session
 }
 
 // NewServiceConfig contains fields that initiates ServiceDescriber struct.
@@ -183,8 +346,12 @@ func NewServiceDescriber(opt NewServiceConfig) (*ServiceDescriber, error) {
 		service: opt.Svc,
 		env:     opt.Env,
 
-		cfn:       stack.NewStackDescriber(cfnstack.NameForService(opt.App, opt.Env, opt.Svc), sess),
+		cfn: stack.NewStackDescriber(cfnstack.NameForService(opt.App, opt.Env, opt.Svc), sess),
+		newStackDescriber: func(stackName string) stackDescriber {
+			return stack.NewStackDescriber(stackName, sess)
+		},
 		ecsClient: ecs.New(sess),
+		ec2Client: ec2.New(sess),
 		sess:      sess,
 	}, nil
 }
@@ -229,6 +396,232 @@ func (d *ServiceDescriber) ServiceStackResources() ([]*stack.Resource, error) {
 	return resources, nil
 }
 
+// ServiceStackEvents returns at most limit of the most recent service stack events in chronological order.
+func (d *ServiceDescriber) ServiceStackEvents(limit int) ([]*stack.Event, error) {
+	return d.cfn.Events(limit)
+}
+
+// MetricsURL returns the link to the CloudWatch console that shows the ECS metrics of the service.
+func (d *ServiceDescriber) MetricsURL() (string, error) {
+	region := aws.StringValue(d.sess.Config.Region)
+	partitionID, err := partition.FromRegion(region)
+	if err != nil {
+		return "", err
+	}
+	domain, ok := consoleDomains[partitionID]
+	if !ok {
+		return "", fmt.Errorf("partition %s does not have an AWS console", partitionID)
+	}
+	return fmt.Sprintf(fmtECSMetricsConsoleURL, domain, region, cfnstack.NameForService(d.app, d.env, d.service)), nil
+}
+
+// RunningTasks returns the tasks of the service whose desired status is RUNNING.
+func (d *ServiceDescriber) RunningTasks() ([]*awsecs.Task, error) {
+	svcDesc, err := d.ecsClient.DescribeService(d.app, d.env, d.service)
+	if err != nil {
+		return nil, fmt.Errorf("describe ECS service for %s: %w", d.service, err)
+	}
+	return svcDesc.Tasks, nil
+}
+
+// SecurityGroups returns the rules of the security groups attached to the tasks of the service.
+func (d *ServiceDescriber) SecurityGroups() ([]*ec2.SecurityGroup, error) {
+	networkConfig, err := d.ecsClient.NetworkConfiguration(d.app, d.env, d.service)
+	if err != nil {
+		return nil, fmt.Errorf("get network configuration of service %s: %w", d.service, err)
+	}
+	if len(networkConfig.SecurityGroups) == 0 {
+		return nil, nil
+	}
+	return d.ec2Client.SecurityGroupRules(networkConfig.SecurityGroups...)
+}
+
+// EnvSecurityGroupID returns the ID of the security group with the CloudFormation logical ID in the service's environment.
+// If the environment doesn't have the security group, it returns an empty string.
+func (d *ServiceDescriber) EnvSecurityGroupID(logicalID string) (string, error) {
+	ids, err := d.ec2Client.SecurityGroups(
+		ec2.Filter{
+			Name:   fmt.Sprintf(ec2.TagFilterName, deploy.AppTagKey),
+			Values: []string{d.app},
+		},
+		ec2.Filter{
+			Name:   fmt.Sprintf(ec2.TagFilterName, deploy.EnvTagKey),
+			Values: []string{d.env},
+		},
+		ec2.Filter{
+			Name:   fmt.Sprintf(ec2.TagFilterName, cfnLogicalIDTagKey),
+			Values: []string{logicalID},
+		},
+	)
+	if err != nil {
+		return "", fmt.Errorf("get security group %s of environment %s: %w", logicalID, d.env, err)
+	}
+	if len(ids) == 0 {
+		return "", nil
+	}
+	return ids[0], nil
+}
+
+// newServiceSecurityGroups returns the rules of the security groups of a service in an environment,
+// and whether the rules allow traffic on the port from each of the sources.
+func newServiceSecurityGroups(env, port string, groups []*ec2.SecurityGroup, sources []connectivitySource) ([]*ServiceSecurityGroupRule, []*ServiceConnectivity) {
+	var rules []*ServiceSecurityGroupRule
+	for _, group := range groups {
+		for _, direction := range []struct {
+			name  string
+			rules []ec2.SecurityGroupRule
+		}{
+			{name: "Inbound", rules: group.Inbound},
+			{name: "Outbound", rules: group.Outbound},
+		} {
+			for _, rule := range direction.rules {
+				rules = append(rules, &ServiceSecurityGroupRule{
+					Environment:   env,
+					SecurityGroup: group.ID,
+					Direction:     direction.name,
+					Protocol:      rule.ProtocolName(),
+					Ports:         rule.PortRange(),
+					Peers:         strings.Join(rule.Peers, ", "),
+				})
+			}
+		}
+	}
+	portNum, err := strconv.ParseInt(port, 10, 64)
+	if err != nil {
+		// The service doesn't expose a port, so there is no path to check.
+		return rules, nil
+	}
+	var conns []*ServiceConnectivity
+	for _, source := range sources {
+		if source.securityGroupID == "" {
+			continue
+		}
+		conns = append(conns, &ServiceConnectivity{
+			Environment: env,
+			Source:      source.name,
+			Port:        port,
+			Open:        allowsInboundTCP(groups, portNum, source.securityGroupID),
+		})
+	}
+	return rules, conns
+}
+
+// connectivitySource is a security group that traffic to a service originates from.
+type connectivitySource struct {
+	name            string
+	securityGroupID string
+}
+
+// allowsInboundTCP returns true if any of the security groups allows inbound TCP traffic on the port from the peer.
+func allowsInboundTCP(groups []*ec2.SecurityGroup, port int64, peer string) bool {
+	for _, group := range groups {
+		for _, rule := range group.Inbound {
+			if rule.AllowsTCP(port, peer) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AddonsStackResources returns the ARN and the resources of the addons nested stack of the service.
+// If the service doesn't have addons, it returns an empty ARN and no resources.
+func (d *ServiceDescriber) AddonsStackResources() (string, []*stack.Resource, error) {
+	svcResources, err := d.cfn.Resources()
+	if err != nil {
+		return "", nil, err
+	}
+	for _, resource := range svcResources {
+		// The addons stack is the only nested stack of a service stack.
+		if resource.Type != nestedStackType || resource.PhysicalID == "" {
+			continue
+		}
+		addonsResources, err := d.newStackDescriber(resource.PhysicalID).Resources()
+		if err != nil {
+			return "", nil, err
+		}
+		return resource.PhysicalID, addonsResources, nil
+	}
+	return "", nil, nil
+}
+
+// newServiceDependencies returns the storage resources in the addons stack of a service in an environment.
+func newServiceDependencies(env, addonsStackARN string, resources []*stack.Resource) ([]*ServiceDependency, error) {
+	if addonsStackARN == "" {
+		return nil, nil
+	}
+	stackARN, err := arn.Parse(addonsStackARN)
+	if err != nil {
+		return nil, fmt.Errorf("parse addons stack ARN %s: %w", addonsStackARN, err)
+	}
+	var deps []*ServiceDependency
+	for _, resource := range resources {
+		if resource.PhysicalID == "" {
+			continue
+		}
+		resourceARN := arn.ARN{
+			Partition: stackARN.Partition,
+			Region:    stackARN.Region,
+			AccountID: stackARN.AccountID,
+		}
+		var friendlyType string
+		switch resource.Type {
+		case dynamoDBTableType:
+			friendlyType = "DynamoDB table"
+			resourceARN.Service = "dynamodb"
+			resourceARN.Resource = "table/" + resource.PhysicalID
+		case s3BucketType:
+			// Bucket ARNs don't contain the region and account.
+			friendlyType = "S3 bucket"
+			resourceARN.Service = "s3"
+			resourceARN.Region = ""
+			resourceARN.AccountID = ""
+			resourceARN.Resource = resource.PhysicalID
+		case rdsDBClusterType:
+			friendlyType = "Aurora cluster"
+			resourceARN.Service = "rds"
+			resourceARN.Resource = "cluster:" + resource.PhysicalID
+		case efsFileSystemType:
+			friendlyType = "EFS file system"
+			resourceARN.Service = "elasticfilesystem"
+			resourceARN.Resource = "file-system/" + resource.PhysicalID
+		default:
+			continue
+		}
+		deps = append(deps, &ServiceDependency{
+			Environment: env,
+			Type:        friendlyType,
+			Name:        resource.PhysicalID,
+			ARN:         resourceARN.String(),
+		})
+	}
+	return deps, nil
+}
+
+// newServiceTasks returns the network details of the running tasks of a service in an environment.
+func newServiceTasks(env string, runningTasks []*awsecs.Task) ([]*ServiceTask, error) {
+	var tasks []*ServiceTask
+	for _, task := range runningTasks {
+		taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+		if err != nil {
+			return nil, err
+		}
+		// A task that is still provisioning might not have the details of its network interface yet, so leave them empty.
+		eniID, _ := task.ENI()
+		privateIP, _ := task.PrivateIP()
+		subnetID, _ := task.SubnetID()
+		tasks = append(tasks, &ServiceTask{
+			Environment:      env,
+			ID:               taskID,
+			PrivateIP:        privateIP,
+			ENIID:            eniID,
+			AvailabilityZone: aws.StringValue(task.AvailabilityZone),
+			SubnetID:         subnetID,
+		})
+	}
+	return tasks, nil
+}
+
 // Params returns the parameters of the service stack.
 func (d *ServiceDescriber) Params() (map[string]string, error) {
 	descr, err := d.cfn.Describe()
@@ -6,15 +6,20 @@ package describe
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"text/tabwriter"
 
 	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	ecs0 "github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -22,6 +27,7 @@ import (
 type ecsSvcDescriberMocks struct {
 	mockCFN       *mocks.MockstackDescriber
 	mockECSClient *mocks.MockecsClient
+	mockEC2Client *mocks.Mockec2Client
 }
 
 func TestServiceDescriber_EnvVars(t *testing.T) {
@@ -298,3 +304,511 @@ func TestServiceDescriber_ServiceStackResources(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceDescriber_RunningTasks(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testEnv = "test"
+		testSvc = "jobs"
+	)
+	testTasks := []*ecs.Task{
+		{
+			TaskArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task/my-project-test-Cluster-9F7Y0RLP60R7/4082490ee6c245e09d2145010aa1ba8d"),
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(mocks ecsSvcDescriberMocks)
+
+		wantedTasks []*ecs.Task
+		wantedError error
+	}{
+		"returns error when fail to describe the ECS service": {
+			setupMocks: func(m ecsSvcDescriberMocks) {
+				m.mockECSClient.EXPECT().DescribeService(testApp, testEnv, testSvc).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("describe ECS service for jobs: some error"),
+		},
+		"success": {
+			setupMocks: func(m ecsSvcDescriberMocks) {
+				m.mockECSClient.EXPECT().DescribeService(testApp, testEnv, testSvc).Return(&ecs0.ServiceDesc{
+					Tasks: testTasks,
+				}, nil)
+			},
+
+			wantedTasks: testTasks,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockecsClient(ctrl)
+			mocks := ecsSvcDescriberMocks{
+				mockECSClient: mockECSClient,
+			}
+
+			tc.setupMocks(mocks)
+
+			d := &ServiceDescriber{
+				app:       testApp,
+				service:   testSvc,
+				env:       testEnv,
+				ecsClient: mockECSClient,
+			}
+
+			// WHEN
+			actual, err := d.RunningTasks()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTasks, actual)
+			}
+		})
+	}
+}
+
+func TestServiceDescriber_MetricsURL(t *testing.T) {
+	testCases := map[string]struct {
+		inRegion string
+
+		wantedURL   string
+		wantedError error
+	}{
+		"commercial region": {
+			inRegion:  "us-west-2",
+			wantedURL: "https://console.aws.amazon.com/cloudwatch/home?region=us-west-2#metricsV2:graph=~();search=phonetool-test-jobs;namespace=AWS/ECS",
+		},
+		"China region": {
+			inRegion:  "cn-north-1",
+			wantedURL: "https://console.amazonaws.cn/cloudwatch/home?region=cn-north-1#metricsV2:graph=~();search=phonetool-test-jobs;namespace=AWS/ECS",
+		},
+		"GovCloud region": {
+			inRegion:  "us-gov-west-1",
+			wantedURL: "https://console.amazonaws-us-gov.com/cloudwatch/home?region=us-gov-west-1#metricsV2:graph=~();search=phonetool-test-jobs;namespace=AWS/ECS",
+		},
+		"returns error if the partition doesn't have a console": {
+			inRegion:    "us-iso-east-1",
+			wantedError: errors.New("partition aws-iso does not have an AWS console"),
+		},
+		"returns error if the partition is unknown": {
+			inRegion:    "mars-west-1",
+			wantedError: errors.New("find the partition for region mars-west-1"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			d := &ServiceDescriber{
+				app:     "phonetool",
+				env:     "test",
+				service: "jobs",
+				sess: &session.Session{
+					Config: &aws.Config{
+						Region: aws.String(tc.inRegion),
+					},
+				},
+			}
+
+			// WHEN
+			actual, err := d.MetricsURL()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedURL, actual)
+			}
+		})
+	}
+}
+
+func TestServiceDescriber_SecurityGroups(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testEnv = "test"
+		testSvc = "jobs"
+	)
+	testGroups := []*ec2.SecurityGroup{
+		{
+			ID: "sg-1",
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(mocks ecsSvcDescriberMocks)
+
+		wantedGroups []*ec2.SecurityGroup
+		wantedError  error
+	}{
+		"returns error when fail to get the network configuration": {
+			setupMocks: func(m ecsSvcDescriberMocks) {
+				m.mockECSClient.EXPECT().NetworkConfiguration(testApp, testEnv, testSvc).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("get network configuration of service jobs: some error"),
+		},
+		"returns nil if the service has no security groups": {
+			setupMocks: func(m ecsSvcDescriberMocks) {
+				m.mockECSClient.EXPECT().NetworkConfiguration(testApp, testEnv, testSvc).Return(&ecs.NetworkConfiguration{}, nil)
+			},
+		},
+		"success": {
+			setupMocks: func(m ecsSvcDescriberMocks) {
+				m.mockECSClient.EXPECT().NetworkConfiguration(testApp, testEnv, testSvc).Return(&ecs.NetworkConfiguration{
+					SecurityGroups: []string{"sg-1"},
+				}, nil)
+				m.mockEC2Client.EXPECT().SecurityGroupRules("sg-1").Return(testGroups, nil)
+			},
+
+			wantedGroups: testGroups,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockecsClient(ctrl)
+			mockEC2Client := mocks.NewMockec2Client(ctrl)
+			mocks := ecsSvcDescriberMocks{
+				mockECSClient: mockECSClient,
+				mockEC2Client: mockEC2Client,
+			}
+
+			tc.setupMocks(mocks)
+
+			d := &ServiceDescriber{
+				app:       testApp,
+				service:   testSvc,
+				env:       testEnv,
+				ecsClient: mockECSClient,
+				ec2Client: mockEC2Client,
+			}
+
+			// WHEN
+			actual, err := d.SecurityGroups()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedGroups, actual)
+			}
+		})
+	}
+}
+
+func TestServiceDescriber_EnvSecurityGroupID(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testEnv = "test"
+		testSvc = "jobs"
+	)
+	testFilters := []ec2.Filter{
+		{
+			Name:   "tag:copilot-application",
+			Values: []string{testApp},
+		},
+		{
+			Name:   "tag:copilot-environment",
+			Values: []string{testEnv},
+		},
+		{
+			Name:   "tag:aws:cloudformation:logical-id",
+			Values: []string{"EnvironmentSecurityGroup"},
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(mocks ecsSvcDescriberMocks)
+
+		wantedID    string
+		wantedError error
+	}{
+		"returns error when fail to get the security groups": {
+			setupMocks: func(m ecsSvcDescriberMocks) {
+				m.mockEC2Client.EXPECT().SecurityGroups(testFilters).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("get security group EnvironmentSecurityGroup of environment test: some error"),
+		},
+		"returns empty if the environment doesn't have the security group": {
+			setupMocks: func(m ecsSvcDescriberMocks) {
+				m.mockEC2Client.EXPECT().SecurityGroups(testFilters).Return(nil, nil)
+			},
+		},
+		"success": {
+			setupMocks: func(m ecsSvcDescriberMocks) {
+				m.mockEC2Client.EXPECT().SecurityGroups(testFilters).Return([]string{"sg-1"}, nil)
+			},
+
+			wantedID: "sg-1",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEC2Client := mocks.NewMockec2Client(ctrl)
+			mocks := ecsSvcDescriberMocks{
+				mockEC2Client: mockEC2Client,
+			}
+
+			tc.setupMocks(mocks)
+
+			d := &ServiceDescriber{
+				app:       testApp,
+				service:   testSvc,
+				env:       testEnv,
+				ec2Client: mockEC2Client,
+			}
+
+			// WHEN
+			actual, err := d.EnvSecurityGroupID("EnvironmentSecurityGroup")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedID, actual)
+			}
+		})
+	}
+}
+
+func TestServiceSecurityGroups_humanString(t *testing.T) {
+	rules, conns := newServiceSecurityGroups("test", "80", []*ec2.SecurityGroup{
+		{
+			ID: "sg-svc",
+			Inbound: []ec2.SecurityGroupRule{
+				{
+					Protocol: "tcp",
+					FromPort: aws.Int64(80),
+					ToPort:   aws.Int64(80),
+					Peers:    []string{"sg-lb"},
+				},
+			},
+			Outbound: []ec2.SecurityGroupRule{
+				{
+					Protocol: "-1",
+					Peers:    []string{"0.0.0.0/0"},
+				},
+			},
+		},
+	}, []connectivitySource{
+		{name: loadBalancerConnectivitySource, securityGroupID: "sg-lb"},
+		{name: otherServicesConnectivitySource, securityGroupID: "sg-env"},
+	})
+
+	wanted := `  Environment       Security Group      Direction           Protocol            Ports               Peers
+  -----------       --------------      ---------           --------            -----               -----
+  test              sg-svc              Inbound             tcp                 80                  sg-lb
+  test              sg-svc              Outbound            all                 all                 0.0.0.0/0
+
+  Environment       Source              Port                Status
+  -----------       ------              ----                ------
+  test              Load balancer       80                  open
+  test              Other services      80                  blocked
+`
+	var b strings.Builder
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	sgs := &serviceSecurityGroups{
+		Rules:        rules,
+		Connectivity: conns,
+	}
+	sgs.humanString(writer)
+	require.NoError(t, writer.Flush())
+	require.Equal(t, wanted, b.String())
+}
+
+func TestServiceDescriber_AddonsStackResources(t *testing.T) {
+	const addonsStackARN = "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-jobs-AddonsStack-1ABC/1234"
+	testAddonsResources := []*stack.Resource{
+		{
+			Type:       "AWS::DynamoDB::Table",
+			PhysicalID: "phonetool-test-jobs-users",
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(svcCFN, addonsCFN *mocks.MockstackDescriber)
+
+		wantedARN       string
+		wantedResources []*stack.Resource
+		wantedError     error
+	}{
+		"returns error when fail to describe the service stack resources": {
+			setupMocks: func(svcCFN, addonsCFN *mocks.MockstackDescriber) {
+				svcCFN.EXPECT().Resources().Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"returns nothing if the service has no addons": {
+			setupMocks: func(svcCFN, addonsCFN *mocks.MockstackDescriber) {
+				svcCFN.EXPECT().Resources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::EC2::SecurityGroup",
+						PhysicalID: "sg-0758ed6b233743530",
+					},
+				}, nil)
+			},
+		},
+		"returns error when fail to describe the addons stack resources": {
+			setupMocks: func(svcCFN, addonsCFN *mocks.MockstackDescriber) {
+				svcCFN.EXPECT().Resources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::CloudFormation::Stack",
+						PhysicalID: addonsStackARN,
+					},
+				}, nil)
+				addonsCFN.EXPECT().Resources().Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"success": {
+			setupMocks: func(svcCFN, addonsCFN *mocks.MockstackDescriber) {
+				svcCFN.EXPECT().Resources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::EC2::SecurityGroup",
+						PhysicalID: "sg-0758ed6b233743530",
+					},
+					{
+						Type:       "AWS::CloudFormation::Stack",
+						PhysicalID: addonsStackARN,
+					},
+				}, nil)
+				addonsCFN.EXPECT().Resources().Return(testAddonsResources, nil)
+			},
+
+			wantedARN:       addonsStackARN,
+			wantedResources: testAddonsResources,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svcCFN := mocks.NewMockstackDescriber(ctrl)
+			addonsCFN := mocks.NewMockstackDescriber(ctrl)
+			tc.setupMocks(svcCFN, addonsCFN)
+
+			d := &ServiceDescriber{
+				app:     "phonetool",
+				service: "jobs",
+				env:     "test",
+				cfn:     svcCFN,
+				newStackDescriber: func(stackName string) stackDescriber {
+					require.Equal(t, addonsStackARN, stackName)
+					return addonsCFN
+				},
+			}
+
+			// WHEN
+			actualARN, actualResources, err := d.AddonsStackResources()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedARN, actualARN)
+				require.Equal(t, tc.wantedResources, actualResources)
+			}
+		})
+	}
+}
+
+func TestServiceDependencies_humanString(t *testing.T) {
+	deps, err := newServiceDependencies("test", "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-jobs-AddonsStack-1ABC/1234", []*stack.Resource{
+		{
+			Type:       "AWS::DynamoDB::Table",
+			PhysicalID: "users",
+		},
+		{
+			Type:       "AWS::IAM::ManagedPolicy",
+			PhysicalID: "arn:aws:iam::123456789012:policy/usersAccessPolicy",
+		},
+		{
+			Type:       "AWS::S3::Bucket",
+			PhysicalID: "avatars",
+		},
+		{
+			Type:       "AWS::RDS::DBCluster",
+			PhysicalID: "orders",
+		},
+	})
+	require.NoError(t, err)
+
+	wanted := `  Environment       Type                Name                ARN
+  -----------       ----                ----                ---
+  test              DynamoDB table      users               arn:aws:dynamodb:us-west-2:123456789012:table/users
+  test              S3 bucket           avatars             arn:aws:s3:::avatars
+  test              Aurora cluster      orders              arn:aws:rds:us-west-2:123456789012:cluster:orders
+`
+	var b strings.Builder
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	serviceDependencies(deps).humanString(writer)
+	require.NoError(t, writer.Flush())
+	require.Equal(t, wanted, b.String())
+}
+
+func TestServiceTasks_humanString(t *testing.T) {
+	tasks, err := newServiceTasks("test", []*ecs.Task{
+		{
+			TaskArn:          aws.String("arn:aws:ecs:us-west-2:123456789012:task/my-project-test-Cluster-9F7Y0RLP60R7/4082490ee6c245e09d2145010aa1ba8d"),
+			AvailabilityZone: aws.String("us-west-2a"),
+			Attachments: []*ecsapi.Attachment{
+				{
+					Type: aws.String("ElasticNetworkInterface"),
+					Details: []*ecsapi.KeyValuePair{
+						{
+							Name:  aws.String("subnetId"),
+							Value: aws.String("subnet-0a1b2c3d"),
+						},
+						{
+							Name:  aws.String("networkInterfaceId"),
+							Value: aws.String("eni-01234567"),
+						},
+						{
+							Name:  aws.String("privateIPv4Address"),
+							Value: aws.String("10.0.0.12"),
+						},
+					},
+				},
+			},
+		},
+		{
+			TaskArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task/my-project-test-Cluster-9F7Y0RLP60R7/9c1d2a4e3b5f4c6d8e7f0a1b2c3d4e5f"),
+		},
+	})
+	require.NoError(t, err)
+
+	wanted := `  Environment       Task ID             Private IP          ENI ID              Availability Zone   Subnet ID
+  -----------       -------             ----------          ------              -----------------   ---------
+  test              4082490e            10.0.0.12           eni-01234567        us-west-2a          subnet-0a1b2c3d
+  test              9c1d2a4e            -                   -                   -                   -
+`
+	var b strings.Builder
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	serviceTasks(tasks).humanString(writer)
+	require.NoError(t, writer.Flush())
+	require.Equal(t, wanted, b.String())
+}
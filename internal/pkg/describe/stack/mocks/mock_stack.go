@@ -49,6 +49,21 @@ func (mr *MockcfnMockRecorder) Describe(name interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*Mockcfn)(nil).Describe), name)
 }
 
+// DriftedResources mocks base method.
+func (m *Mockcfn) DriftedResources(stackName string) ([]cloudformation.StackResourceDrift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DriftedResources", stackName)
+	ret0, _ := ret[0].([]cloudformation.StackResourceDrift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DriftedResources indicates an expected call of DriftedResources.
+func (mr *MockcfnMockRecorder) DriftedResources(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DriftedResources", reflect.TypeOf((*Mockcfn)(nil).DriftedResources), stackName)
+}
+
 // Metadata mocks base method.
 func (m *Mockcfn) Metadata(opt cloudformation.MetadataOpts) (string, error) {
 	m.ctrl.T.Helper()
@@ -64,6 +79,21 @@ func (mr *MockcfnMockRecorder) Metadata(opt interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Metadata", reflect.TypeOf((*Mockcfn)(nil).Metadata), opt)
 }
 
+// RecentEvents mocks base method.
+func (m *Mockcfn) RecentEvents(stackName string, limit int) ([]cloudformation.StackEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecentEvents", stackName, limit)
+	ret0, _ := ret[0].([]cloudformation.StackEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecentEvents indicates an expected call of RecentEvents.
+func (mr *MockcfnMockRecorder) RecentEvents(stackName, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecentEvents", reflect.TypeOf((*Mockcfn)(nil).RecentEvents), stackName, limit)
+}
+
 // StackResources mocks base method.
 func (m *Mockcfn) StackResources(name string) ([]*cloudformation.StackResource, error) {
 	m.ctrl.T.Helper()
@@ -4,7 +4,9 @@
 package stack
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -15,6 +17,8 @@ type cfn interface {
 	Describe(name string) (*cloudformation.StackDescription, error)
 	StackResources(name string) ([]*cloudformation.StackResource, error)
 	Metadata(opt cloudformation.MetadataOpts) (string, error)
+	RecentEvents(stackName string, limit int) ([]cloudformation.StackEvent, error)
+	DriftedResources(stackName string) ([]cloudformation.StackResourceDrift, error)
 }
 
 // StackDescription is the description of a cloudformation stack.
@@ -35,6 +39,32 @@ func (c Resource) HumanString() string {
 	return fmt.Sprintf("%s\t%s\n", c.Type, c.PhysicalID)
 }
 
+// Event contains cloudformation stack event info.
+type Event struct {
+	Timestamp    time.Time `json:"timestamp"`
+	LogicalID    string    `json:"logicalID"`
+	ResourceType string    `json:"type"`
+	Status       string    `json:"status"`
+	Reason       string    `json:"reason,omitempty"`
+}
+
+// ResourceDrift contains the differences between a stack resource's template and its actual configuration.
+type ResourceDrift struct {
+	LogicalID    string                `json:"logicalID"`
+	ResourceType string                `json:"type"`
+	PhysicalID   string                `json:"physicalID"`
+	Status       string                `json:"status"`
+	Differences  []*PropertyDifference `json:"differences,omitempty"`
+}
+
+// PropertyDifference is a single property of a resource that drifted from its expected value.
+type PropertyDifference struct {
+	Path     string `json:"path"`
+	Type     string `json:"type"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+}
+
 // StackDescriber retrieves information about a stack.
 type StackDescriber struct {
 	name string
@@ -83,6 +113,59 @@ func (d *StackDescriber) Resources() ([]*Resource, error) {
 	return flattenResources(resources), nil
 }
 
+// Events retrieves at most limit of the most recent events of the stack in chronological order.
+func (d *StackDescriber) Events(limit int) ([]*Event, error) {
+	stackEvents, err := d.cfn.RecentEvents(d.name, limit)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve events for stack %s: %w", d.name, err)
+	}
+	events := make([]*Event, len(stackEvents))
+	for i, event := range stackEvents {
+		events[i] = &Event{
+			Timestamp:    aws.TimeValue(event.Timestamp),
+			LogicalID:    aws.StringValue(event.LogicalResourceId),
+			ResourceType: aws.StringValue(event.ResourceType),
+			Status:       aws.StringValue(event.ResourceStatus),
+			Reason:       aws.StringValue(event.ResourceStatusReason),
+		}
+	}
+	return events, nil
+}
+
+// Drift detects drift on the stack and returns the resources that were modified or deleted outside of CloudFormation.
+// If the drift detection fails, the drifted resources that CloudFormation could check are returned along with
+// an error that wraps a cloudformation.ErrDriftDetectionFailed.
+func (d *StackDescriber) Drift() ([]*ResourceDrift, error) {
+	stackDrifts, err := d.cfn.DriftedResources(d.name)
+	var errFailed *cloudformation.ErrDriftDetectionFailed
+	if err != nil && !errors.As(err, &errFailed) {
+		return nil, fmt.Errorf("retrieve drifted resources for stack %s: %w", d.name, err)
+	}
+	drifts := make([]*ResourceDrift, len(stackDrifts))
+	for i, drift := range stackDrifts {
+		var diffs []*PropertyDifference
+		for _, diff := range drift.PropertyDifferences {
+			diffs = append(diffs, &PropertyDifference{
+				Path:     aws.StringValue(diff.PropertyPath),
+				Type:     aws.StringValue(diff.DifferenceType),
+				Expected: aws.StringValue(diff.ExpectedValue),
+				Actual:   aws.StringValue(diff.ActualValue),
+			})
+		}
+		drifts[i] = &ResourceDrift{
+			LogicalID:    aws.StringValue(drift.LogicalResourceId),
+			ResourceType: aws.StringValue(drift.ResourceType),
+			PhysicalID:   aws.StringValue(drift.PhysicalResourceId),
+			Status:       aws.StringValue(drift.StackResourceDriftStatus),
+			Differences:  diffs,
+		}
+	}
+	if err != nil {
+		return drifts, fmt.Errorf("retrieve drifted resources for stack %s: %w", d.name, err)
+	}
+	return drifts, nil
+}
+
 // StackMetadata returns the metadata of the stack.
 func (d *StackDescriber) StackMetadata() (string, error) {
 	metadata, err := d.cfn.Metadata(cloudformation.MetadataWithStackName(d.name))
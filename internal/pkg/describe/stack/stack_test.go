@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	sdkcfn "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -169,6 +170,198 @@ func TestStackDescriber_Resources(t *testing.T) {
 	}
 }
 
+func TestStackDescriber_Events(t *testing.T) {
+	const mockStackName = "phonetool"
+	mockErr := errors.New("some error")
+	mockTime := time.Unix(1613145600, 0)
+	testCases := map[string]struct {
+		setupMocks func(mocks stackDescriberMocks)
+
+		wantedEvents []*Event
+		wantedError  error
+	}{
+		"return error if fail to get stack events": {
+			setupMocks: func(m stackDescriberMocks) {
+				m.cfn.EXPECT().RecentEvents(mockStackName, 10).Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("retrieve events for stack phonetool: some error"),
+		},
+		"success": {
+			setupMocks: func(m stackDescriberMocks) {
+				m.cfn.EXPECT().RecentEvents(mockStackName, 10).Return([]cloudformation.StackEvent{
+					{
+						Timestamp:            aws.Time(mockTime),
+						LogicalResourceId:    aws.String("Service"),
+						ResourceType:         aws.String("AWS::ECS::Service"),
+						ResourceStatus:       aws.String("UPDATE_FAILED"),
+						ResourceStatusReason: aws.String("Resource timed out"),
+					},
+				}, nil)
+			},
+			wantedEvents: []*Event{
+				{
+					Timestamp:    mockTime,
+					LogicalID:    "Service",
+					ResourceType: "AWS::ECS::Service",
+					Status:       "UPDATE_FAILED",
+					Reason:       "Resource timed out",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcfn := mocks.NewMockcfn(ctrl)
+			mocks := stackDescriberMocks{
+				cfn: mockcfn,
+			}
+
+			tc.setupMocks(mocks)
+
+			d := &StackDescriber{
+				name: mockStackName,
+				cfn:  mockcfn,
+			}
+
+			// WHEN
+			actual, err := d.Events(10)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedEvents, actual)
+			}
+		})
+	}
+}
+
+func TestStackDescriber_Drift(t *testing.T) {
+	const mockStackName = "phonetool"
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(mocks stackDescriberMocks)
+
+		wantedDrifts []*ResourceDrift
+		wantedError  error
+	}{
+		"return error if fail to detect drift": {
+			setupMocks: func(m stackDescriberMocks) {
+				m.cfn.EXPECT().DriftedResources(mockStackName).Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("retrieve drifted resources for stack phonetool: some error"),
+		},
+		"return the drifted resources that were checked along with the error if the drift detection fails": {
+			setupMocks: func(m stackDescriberMocks) {
+				m.cfn.EXPECT().DriftedResources(mockStackName).Return([]cloudformation.StackResourceDrift{
+					{
+						LogicalResourceId:        aws.String("LogGroup"),
+						ResourceType:             aws.String("AWS::Logs::LogGroup"),
+						PhysicalResourceId:       aws.String("/copilot/phonetool-test-api"),
+						StackResourceDriftStatus: aws.String("DELETED"),
+					},
+				}, &cloudformation.ErrDriftDetectionFailed{
+					StackName:   mockStackName,
+					DetectionID: "1234",
+					Reason:      "some reason",
+				})
+			},
+			wantedDrifts: []*ResourceDrift{
+				{
+					LogicalID:    "LogGroup",
+					ResourceType: "AWS::Logs::LogGroup",
+					PhysicalID:   "/copilot/phonetool-test-api",
+					Status:       "DELETED",
+				},
+			},
+			wantedError: fmt.Errorf("retrieve drifted resources for stack phonetool: drift detection 1234 of stack phonetool failed: some reason"),
+		},
+		"success": {
+			setupMocks: func(m stackDescriberMocks) {
+				m.cfn.EXPECT().DriftedResources(mockStackName).Return([]cloudformation.StackResourceDrift{
+					{
+						LogicalResourceId:        aws.String("Service"),
+						ResourceType:             aws.String("AWS::ECS::Service"),
+						PhysicalResourceId:       aws.String("arn:aws:ecs:us-west-2:123456789012:service/phonetool-test-Cluster/phonetool-test-api"),
+						StackResourceDriftStatus: aws.String("MODIFIED"),
+						PropertyDifferences: []*sdkcfn.PropertyDifference{
+							{
+								PropertyPath:   aws.String("/DesiredCount"),
+								DifferenceType: aws.String("NOT_EQUAL"),
+								ExpectedValue:  aws.String("1"),
+								ActualValue:    aws.String("3"),
+							},
+						},
+					},
+					{
+						LogicalResourceId:        aws.String("LogGroup"),
+						ResourceType:             aws.String("AWS::Logs::LogGroup"),
+						PhysicalResourceId:       aws.String("/copilot/phonetool-test-api"),
+						StackResourceDriftStatus: aws.String("DELETED"),
+					},
+				}, nil)
+			},
+			wantedDrifts: []*ResourceDrift{
+				{
+					LogicalID:    "Service",
+					ResourceType: "AWS::ECS::Service",
+					PhysicalID:   "arn:aws:ecs:us-west-2:123456789012:service/phonetool-test-Cluster/phonetool-test-api",
+					Status:       "MODIFIED",
+					Differences: []*PropertyDifference{
+						{
+							Path:     "/DesiredCount",
+							Type:     "NOT_EQUAL",
+							Expected: "1",
+							Actual:   "3",
+						},
+					},
+				},
+				{
+					LogicalID:    "LogGroup",
+					ResourceType: "AWS::Logs::LogGroup",
+					PhysicalID:   "/copilot/phonetool-test-api",
+					Status:       "DELETED",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcfn := mocks.NewMockcfn(ctrl)
+			mocks := stackDescriberMocks{
+				cfn: mockcfn,
+			}
+
+			tc.setupMocks(mocks)
+
+			d := &StackDescriber{
+				name: mockStackName,
+				cfn:  mockcfn,
+			}
+
+			// WHEN
+			actual, err := d.Drift()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedDrifts, actual)
+		})
+	}
+}
+
 func TestStackDescriber_Metadata(t *testing.T) {
 	const mockStackName = "phonetool"
 	mockErr := errors.New("some error")
@@ -5,7 +5,6 @@ package describe
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
 	"io"
 	"math"
@@ -21,6 +20,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 
 	fcolor "github.com/fatih/color"
@@ -34,6 +34,16 @@ const (
 	emptyRep                  = "░"
 )
 
+// Statuses of services that the status describers watch for.
+const (
+	ecsServiceStatusInactive = "INACTIVE"
+
+	appRunnerServiceStatusRunning      = "RUNNING"
+	appRunnerServiceStatusPaused       = "PAUSED"
+	appRunnerServiceStatusCreateFailed = "CREATE_FAILED"
+	appRunnerServiceStatusDeleteFailed = "DELETE_FAILED"
+)
+
 var (
 	summaryBarWidthConfig    = summarybar.WithWidth(summaryBarWidth)
 	summaryBarEmptyRepConfig = summarybar.WithEmptyRep(emptyRep)
@@ -46,6 +56,27 @@ type ecsServiceStatus struct {
 	Alarms                   []cloudwatch.AlarmStatus `json:"alarms"`
 	StoppedTasks             []awsecs.TaskStatus      `json:"stoppedTasks"`
 	TargetHealthDescriptions []taskTargetHealth       `json:"targetHealthDescriptions"`
+	AlarmNotificationTopic   string                   `json:"alarmNotificationTopic,omitempty"`
+	StackDrift               []*stack.ResourceDrift   `json:"stackDrift,omitempty"`
+	StackDriftFailureReason  string                   `json:"stackDriftFailureReason,omitempty"`
+
+	driftDetected bool // True if drift detection ran on the service stack, even if no resource drifted.
+}
+
+// ecsServiceAlarmStatus contains only the alarm statuses of an ECS service.
+type ecsServiceAlarmStatus struct {
+	Alarms []cloudwatch.AlarmStatus `json:"alarms"`
+}
+
+// ecsServiceAlarmHistory contains the most recent state transitions of the alarms of an ECS service.
+type ecsServiceAlarmHistory struct {
+	Alarms []alarmHistory `json:"alarms"`
+}
+
+type alarmHistory struct {
+	Name        string                        `json:"name"`
+	Status      string                        `json:"status"`
+	Transitions []cloudwatch.AlarmHistoryItem `json:"transitions"`
 }
 
 // appRunnerServiceStatus contains the status for an AppRunner service.
@@ -62,13 +93,31 @@ type taskTargetHealth struct {
 
 // JSONString returns the stringified ecsServiceStatus struct with json format.
 func (s *ecsServiceStatus) JSONString() (string, error) {
-	b, err := json.Marshal(s)
+	b, err := marshalWithSchemaVersion(s)
 	if err != nil {
 		return "", fmt.Errorf("marshal services: %w", err)
 	}
 	return fmt.Sprintf("%s\n", b), nil
 }
 
+// JSONString returns the stringified ecsServiceAlarmStatus struct with json format.
+func (s *ecsServiceAlarmStatus) JSONString() (string, error) {
+	b, err := marshalWithSchemaVersion(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal alarms: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// JSONString returns the stringified ecsServiceAlarmHistory struct with json format.
+func (s *ecsServiceAlarmHistory) JSONString() (string, error) {
+	b, err := marshalWithSchemaVersion(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal alarm history: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
 // JSONString returns the stringified appRunnerServiceStatus struct with json format.
 func (a *appRunnerServiceStatus) JSONString() (string, error) {
 	data := struct {
@@ -90,7 +139,7 @@ func (a *appRunnerServiceStatus) JSONString() (string, error) {
 			ImageID: a.Service.ImageID,
 		},
 	}
-	b, err := json.Marshal(data)
+	b, err := marshalWithSchemaVersion(data)
 	if err != nil {
 		return "", fmt.Errorf("marshal services: %w", err)
 	}
@@ -121,15 +170,59 @@ func (s *ecsServiceStatus) HumanString() string {
 		writer.Flush()
 	}
 
-	if len(s.Alarms) > 0 {
+	if len(s.Alarms) > 0 || s.AlarmNotificationTopic != "" {
 		fmt.Fprint(writer, color.Bold.Sprint("\nAlarms\n\n"))
 		writer.Flush()
-		s.writeAlarms(writer)
+		if s.AlarmNotificationTopic != "" {
+			fmt.Fprintf(writer, "  %s\t%s\n", "Notifications", s.AlarmNotificationTopic)
+			writer.Flush()
+		}
+		if len(s.Alarms) > 0 {
+			writeAlarms(writer, s.Alarms)
+			writer.Flush()
+		}
+	}
+
+	if s.driftDetected {
+		fmt.Fprint(writer, color.Bold.Sprint("\nDrift\n\n"))
+		writer.Flush()
+		s.writeStackDrift(writer)
 		writer.Flush()
 	}
 	return b.String()
 }
 
+// HumanString returns the stringified ecsServiceAlarmStatus struct with human readable format.
+func (s *ecsServiceAlarmStatus) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, statusMinCellWidth, tabWidth, statusCellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Alarms\n\n"))
+	writer.Flush()
+	writeAlarms(writer, s.Alarms)
+	writer.Flush()
+	return b.String()
+}
+
+// HumanString returns the stringified ecsServiceAlarmHistory struct with human readable format.
+func (s *ecsServiceAlarmHistory) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, statusMinCellWidth, tabWidth, statusCellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Alarm History\n\n"))
+	writer.Flush()
+	for _, alarm := range s.Alarms {
+		fmt.Fprintf(writer, "  %s\t%s\n", alarm.Name, alarmHealthColor(alarm.Status))
+		if len(alarm.Transitions) == 0 {
+			fmt.Fprintf(writer, "    %s\t%s\n", "-", "No state transitions found")
+		}
+		for _, transition := range alarm.Transitions {
+			fmt.Fprintf(writer, "    %s\t%s\n", humanizeTime(transition.Timestamp), transition.Summary)
+		}
+		fmt.Fprintf(writer, "  %s\t%s\n", "", "")
+	}
+	writer.Flush()
+	return b.String()
+}
+
 // HumanString returns the stringified appRunnerServiceStatus struct with human readable format.
 func (a *appRunnerServiceStatus) HumanString() string {
 	var b bytes.Buffer
@@ -150,15 +243,51 @@ func (a *appRunnerServiceStatus) HumanString() string {
 	writer.Flush()
 	fmt.Fprint(writer, color.Bold.Sprint("\nSystem Logs\n\n"))
 	writer.Flush()
-	lo, _ := time.LoadLocation("UTC")
 	for _, event := range a.LogEvents {
-		timestamp := time.Unix(event.Timestamp/1000, 0).In(lo)
+		timestamp := time.Unix(event.Timestamp/1000, 0).In(timeLocation)
 		fmt.Fprintf(writer, "  %v\t%s\n", timestamp.Format(time.RFC3339), event.Message)
 	}
 	writer.Flush()
 	return b.String()
 }
 
+// Stable returns true if all the desired tasks of the service are running and its latest deployment is complete.
+// It returns an error if the service is inactive or its latest deployment failed, as neither recovers without a new deployment.
+func (s *ecsServiceStatus) Stable() (bool, error) {
+	if s.Service.Status == ecsServiceStatusInactive {
+		return false, fmt.Errorf("service is %s", ecsServiceStatusInactive)
+	}
+	var primary *awsecs.Deployment
+	for i, dp := range s.Service.Deployments {
+		if dp.Status == awsecs.ServiceDeploymentStatusPrimary {
+			primary = &s.Service.Deployments[i]
+			break
+		}
+	}
+	if primary == nil {
+		return false, nil
+	}
+	if primary.RolloutState == awsecs.ServiceDeploymentRolloutStateFailed {
+		return false, fmt.Errorf("deployment %s failed: %s", primary.Id, primary.RolloutStateReason)
+	}
+	return len(s.Service.Deployments) == 1 &&
+		primary.RolloutState != awsecs.ServiceDeploymentRolloutStateInProgress &&
+		s.Service.RunningCount == s.Service.DesiredCount, nil
+}
+
+// Stable returns true if the App Runner service is running or paused.
+// It returns an error if the service failed to be created or deleted.
+func (a *appRunnerServiceStatus) Stable() (bool, error) {
+	switch a.Service.Status {
+	case appRunnerServiceStatusCreateFailed, appRunnerServiceStatusDeleteFailed:
+		return false, fmt.Errorf("service is %s", a.Service.Status)
+	case appRunnerServiceStatusRunning, appRunnerServiceStatusPaused:
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
 func (s *ecsServiceStatus) writeTaskSummary(writer io.Writer) {
 	// NOTE: all the `bar` need to be fully colored. Observe how all the second parameter for all `summaryBar` function
 	// is a list of strings that are colored (e.g. `[]string{color.Green.Sprint("■"), color.Grey.Sprint("□")}`)
@@ -370,6 +499,36 @@ func (s *ecsServiceStatus) writeStoppedTasks(writer io.Writer) {
 	}
 }
 
+func (s *ecsServiceStatus) writeStackDrift(writer io.Writer) {
+	if s.StackDriftFailureReason != "" {
+		fmt.Fprintf(writer, "  %s %s\n", color.Red.Sprint("Drift detection failed:"), s.StackDriftFailureReason)
+		fmt.Fprintln(writer, "  Only the resources that CloudFormation could check are shown.")
+		fmt.Fprintln(writer)
+	}
+	if len(s.StackDrift) == 0 {
+		fmt.Fprintln(writer, "  No resources drifted from the service stack.")
+		return
+	}
+	headers := []string{"Logical ID", "Type", "Physical ID", "Drift Status"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, drift := range s.StackDrift {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", drift.LogicalID, drift.ResourceType, drift.PhysicalID, drift.Status)
+	}
+	for _, drift := range s.StackDrift {
+		if len(drift.Differences) == 0 {
+			continue
+		}
+		fmt.Fprintf(writer, "\n  %s\n", color.Emphasize(drift.LogicalID))
+		headers := []string{"Property", "Difference", "Expected", "Actual"}
+		fmt.Fprintf(writer, "    %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "    %s\n", strings.Join(underline(headers), "\t"))
+		for _, diff := range drift.Differences {
+			fmt.Fprintf(writer, "    %s\t%s\t%s\t%s\n", diff.Path, diff.Type, diff.Expected, diff.Actual)
+		}
+	}
+}
+
 func (s *ecsServiceStatus) writeRunningTasks(writer io.Writer) {
 	shouldShowHTTPHealth := anyTasksInAnyTargetGroup(s.DesiredRunningTasks, s.TargetHealthDescriptions)
 	shouldShowCapacityProvider := isCapacityProvidersEnabled(s.DesiredRunningTasks)
@@ -412,11 +571,11 @@ func (s *ecsServiceStatus) writeRunningTasks(writer io.Writer) {
 	}
 }
 
-func (s *ecsServiceStatus) writeAlarms(writer io.Writer) {
+func writeAlarms(writer io.Writer, alarms []cloudwatch.AlarmStatus) {
 	headers := []string{"Name", "Condition", "Last Updated", "Health"}
 	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
 	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
-	for _, alarm := range s.Alarms {
+	for _, alarm := range alarms {
 		updatedTimeSince := humanizeTime(alarm.UpdatedTimes)
 		printWithMaxWidth(writer, "  %s\t%s\t%s\t%s\n", maxAlarmStatusColumnWidth, alarm.Name, alarm.Condition, updatedTimeSince, alarmHealthColor(alarm.Status))
 		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", "", "", "", "")
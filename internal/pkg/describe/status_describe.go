@@ -4,18 +4,22 @@
 package describe
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 )
 
@@ -28,6 +32,7 @@ type targetHealthGetter interface {
 type alarmStatusGetter interface {
 	AlarmsWithTags(tags map[string]string) ([]cloudwatch.AlarmStatus, error)
 	AlarmStatus(alarms []string) ([]cloudwatch.AlarmStatus, error)
+	AlarmHistory(alarm string, limit int) ([]cloudwatch.AlarmHistoryItem, error)
 }
 
 type logGetter interface {
@@ -41,6 +46,7 @@ type ecsServiceGetter interface {
 
 type serviceDescriber interface {
 	DescribeService(app, env, svc string) (*ecs.ServiceDesc, error)
+	ServiceARN(app, env, svc string) (*awsecs.ServiceArn, error)
 }
 
 type appRunnerServiceDescriber interface {
@@ -61,6 +67,9 @@ type ecsStatusDescriber struct {
 	cwSvcGetter        alarmStatusGetter
 	aasSvcGetter       autoscalingAlarmNamesGetter
 	targetHealthGetter targetHealthGetter
+	svcStackDescriber  stackDescriber
+
+	enableDrift bool
 }
 
 type appRunnerStatusDescriber struct {
@@ -78,6 +87,8 @@ type NewServiceStatusConfig struct {
 	Env         string
 	Svc         string
 	ConfigStore ConfigStoreSvc
+
+	EnableDrift bool // Set to true to detect drift on the service stack, only supported by ECS services.
 }
 
 // NewECSStatusDescriber instantiates a new ecsStatusDescriber struct.
@@ -99,6 +110,8 @@ func NewECSStatusDescriber(opt *NewServiceStatusConfig) (*ecsStatusDescriber, er
 		ecsSvcGetter:       awsecs.New(sess),
 		aasSvcGetter:       aas.New(sess),
 		targetHealthGetter: elbv2.New(sess),
+		svcStackDescriber:  stack.NewStackDescriber(cfnstack.NameForService(opt.App, opt.Env, opt.Svc), sess),
+		enableDrift:        opt.EnableDrift,
 	}, nil
 }
 
@@ -153,21 +166,10 @@ func (s *ecsStatusDescriber) Describe() (HumanJSONStringer, error) {
 		stoppedTaskStatus = append(stoppedTaskStatus, *status)
 	}
 
-	var alarms []cloudwatch.AlarmStatus
-	taggedAlarms, err := s.cwSvcGetter.AlarmsWithTags(map[string]string{
-		deploy.AppTagKey:     s.app,
-		deploy.EnvTagKey:     s.env,
-		deploy.ServiceTagKey: s.svc,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("get tagged CloudWatch alarms: %w", err)
-	}
-	alarms = append(alarms, taggedAlarms...)
-	autoscalingAlarms, err := s.ecsServiceAutoscalingAlarms(svcDesc.ClusterName, svcDesc.Name)
+	alarms, err := s.ecsServiceAlarms(svcDesc.ClusterName, svcDesc.Name)
 	if err != nil {
 		return nil, err
 	}
-	alarms = append(alarms, autoscalingAlarms...)
 
 	var tasksTargetHealth []taskTargetHealth
 	targetGroupsARN := service.TargetGroups()
@@ -185,15 +187,104 @@ func (s *ecsStatusDescriber) Describe() (HumanJSONStringer, error) {
 		return tasksTargetHealth[i].TargetGroupARN < tasksTargetHealth[j].TargetGroupARN
 	})
 
-	return &ecsServiceStatus{
+	svcStack, err := s.svcStackDescriber.Describe()
+	if err != nil {
+		return nil, fmt.Errorf("describe stack for service %s: %w", s.svc, err)
+	}
+
+	status := &ecsServiceStatus{
 		Service:                  service.ServiceStatus(),
 		DesiredRunningTasks:      taskStatus,
 		Alarms:                   alarms,
 		StoppedTasks:             stoppedTaskStatus,
 		TargetHealthDescriptions: tasksTargetHealth,
+		AlarmNotificationTopic:   svcStack.Outputs[cfnstack.ServiceOutputAlarmTopicARN],
+	}
+	if !s.enableDrift {
+		return status, nil
+	}
+	drift, err := s.svcStackDescriber.Drift()
+	var errFailed *cloudformation.ErrDriftDetectionFailed
+	if errors.As(err, &errFailed) {
+		// Show the resources that CloudFormation could check along with the reason the detection failed.
+		status.StackDriftFailureReason = errFailed.Reason
+	} else if err != nil {
+		return nil, fmt.Errorf("detect drift for service %s: %w", s.svc, err)
+	}
+	status.StackDrift = drift
+	status.driftDetected = true
+	return status, nil
+}
+
+// DescribeAlarms returns the status of the CloudWatch alarms of an ECS service, without querying its tasks or targets.
+func (s *ecsStatusDescriber) DescribeAlarms() (HumanJSONStringer, error) {
+	alarms, err := s.serviceAlarms()
+	if err != nil {
+		return nil, err
+	}
+	return &ecsServiceAlarmStatus{
+		Alarms: alarms,
+	}, nil
+}
+
+// DescribeAlarmHistory returns up to limit of the most recent state transitions of each CloudWatch alarm of an ECS service.
+func (s *ecsStatusDescriber) DescribeAlarmHistory(limit int) (HumanJSONStringer, error) {
+	alarms, err := s.serviceAlarms()
+	if err != nil {
+		return nil, err
+	}
+	var history []alarmHistory
+	for _, alarm := range alarms {
+		transitions, err := s.cwSvcGetter.AlarmHistory(alarm.Name, limit)
+		if err != nil {
+			return nil, fmt.Errorf("get alarm history: %w", err)
+		}
+		history = append(history, alarmHistory{
+			Name:        alarm.Name,
+			Status:      alarm.Status,
+			Transitions: transitions,
+		})
+	}
+	return &ecsServiceAlarmHistory{
+		Alarms: history,
 	}, nil
 }
 
+// serviceAlarms looks up the ECS service by its tags and returns the status of its alarms.
+func (s *ecsStatusDescriber) serviceAlarms() ([]cloudwatch.AlarmStatus, error) {
+	svcARN, err := s.svcDescriber.ServiceARN(s.app, s.env, s.svc)
+	if err != nil {
+		return nil, fmt.Errorf("get ECS service ARN for %s: %w", s.svc, err)
+	}
+	clusterName, err := svcARN.ClusterName()
+	if err != nil {
+		return nil, fmt.Errorf("get cluster name: %w", err)
+	}
+	serviceName, err := svcARN.ServiceName()
+	if err != nil {
+		return nil, fmt.Errorf("get service name: %w", err)
+	}
+	return s.ecsServiceAlarms(clusterName, serviceName)
+}
+
+func (s *ecsStatusDescriber) ecsServiceAlarms(cluster, service string) ([]cloudwatch.AlarmStatus, error) {
+	var alarms []cloudwatch.AlarmStatus
+	taggedAlarms, err := s.cwSvcGetter.AlarmsWithTags(map[string]string{
+		deploy.AppTagKey:     s.app,
+		deploy.EnvTagKey:     s.env,
+		deploy.ServiceTagKey: s.svc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get tagged CloudWatch alarms: %w", err)
+	}
+	alarms = append(alarms, taggedAlarms...)
+	autoscalingAlarms, err := s.ecsServiceAutoscalingAlarms(cluster, service)
+	if err != nil {
+		return nil, err
+	}
+	return append(alarms, autoscalingAlarms...), nil
+}
+
 func (s *ecsStatusDescriber) ecsServiceAutoscalingAlarms(cluster, service string) ([]cloudwatch.AlarmStatus, error) {
 	alarmNames, err := s.aasSvcGetter.ECSServiceAlarmNames(cluster, service)
 	if err != nil {
@@ -13,11 +13,13 @@ import (
 	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
 	elbv2api "github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -31,6 +33,7 @@ type serviceStatusDescriberMocks struct {
 	aas                   *mocks.MockautoscalingAlarmNamesGetter
 	logGetter             *mocks.MocklogGetter
 	targetHealthGetter    *mocks.MocktargetHealthGetter
+	stackDescriber        *mocks.MockstackDescriber
 }
 
 func TestServiceStatus_Describe(t *testing.T) {
@@ -52,8 +55,30 @@ func TestServiceStatus_Describe(t *testing.T) {
 		},
 	}
 	mockError := errors.New("some error")
+	mockMinimalStatus := func(m serviceStatusDescriberMocks) []*gomock.Call {
+		return []*gomock.Call{
+			m.serviceDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+				ClusterName: mockCluster,
+				Name:        mockService,
+			}, nil),
+			m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&awsecs.Service{
+				Status: aws.String("ACTIVE"),
+				Deployments: []*ecsapi.Deployment{
+					{
+						UpdatedAt:      &startTime,
+						TaskDefinition: aws.String("mockTaskDefinition"),
+					},
+				},
+			}, nil),
+			m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return(nil, nil),
+			m.aas.EXPECT().ECSServiceAlarmNames(mockCluster, mockService).Return(nil, nil),
+			m.alarmStatusGetter.EXPECT().AlarmStatus(nil).Return(nil, nil),
+			m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{}, nil),
+		}
+	}
 	testCases := map[string]struct {
-		setupMocks func(mocks serviceStatusDescriberMocks)
+		enableDrift bool
+		setupMocks  func(mocks serviceStatusDescriberMocks)
 
 		wantedError   error
 		wantedContent *ecsServiceStatus
@@ -154,6 +179,19 @@ func TestServiceStatus_Describe(t *testing.T) {
 
 			wantedError: fmt.Errorf("get auto scaling CloudWatch alarms: some error"),
 		},
+		"errors if failed to describe the service stack": {
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				gomock.InOrder(
+					m.serviceDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockServiceDesc, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&awsecs.Service{}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return([]cloudwatch.AlarmStatus{}, nil),
+					m.aas.EXPECT().ECSServiceAlarmNames(gomock.Any(), gomock.Any()).Return([]string{}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmStatus(gomock.Any()).Return([]cloudwatch.AlarmStatus{}, nil),
+					m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{}, errors.New("some error")),
+				)
+			},
+			wantedError: fmt.Errorf("describe stack for service mockSvc: some error"),
+		},
 		"do not error out if failed to get a service's target group health": {
 			setupMocks: func(m serviceStatusDescriberMocks) {
 				gomock.InOrder(
@@ -174,6 +212,7 @@ func TestServiceStatus_Describe(t *testing.T) {
 					m.aas.EXPECT().ECSServiceAlarmNames(gomock.Any(), gomock.Any()).Return([]string{}, nil),
 					m.alarmStatusGetter.EXPECT().AlarmStatus(gomock.Any()).Return([]cloudwatch.AlarmStatus{}, nil),
 					m.targetHealthGetter.EXPECT().TargetsHealth("group-1").Return(nil, errors.New("some error")),
+					m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{}, nil),
 				)
 			},
 			wantedContent: &ecsServiceStatus{
@@ -297,6 +336,7 @@ func TestServiceStatus_Describe(t *testing.T) {
 							},
 						},
 					}, nil),
+					m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{}, nil),
 				)
 			},
 
@@ -424,6 +464,11 @@ func TestServiceStatus_Describe(t *testing.T) {
 							UpdatedTimes: updateTime,
 						},
 					}, nil),
+					m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{
+						Outputs: map[string]string{
+							"AlarmTopicArn": "arn:aws:sns:us-west-2:123456789012:mockTopic",
+						},
+					}, nil),
 				)
 			},
 
@@ -479,9 +524,97 @@ func TestServiceStatus_Describe(t *testing.T) {
 						StoppedReason: "some reason",
 					},
 				},
+				AlarmNotificationTopic: "arn:aws:sns:us-west-2:123456789012:mockTopic",
 				//rendererConfigurer: &barRendererConfigurer{},
 			},
 		},
+		"errors if failed to detect drift": {
+			enableDrift: true,
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				gomock.InOrder(append(mockMinimalStatus(m),
+					m.stackDescriber.EXPECT().Drift().Return(nil, mockError),
+				)...)
+			},
+
+			wantedError: fmt.Errorf("detect drift for service mockSvc: some error"),
+		},
+		"shows the drifted resources that were checked if the drift detection fails": {
+			enableDrift: true,
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				gomock.InOrder(append(mockMinimalStatus(m),
+					m.stackDescriber.EXPECT().Drift().Return([]*stack.ResourceDrift{
+						{
+							LogicalID:    "Service",
+							ResourceType: "AWS::ECS::Service",
+							Status:       "MODIFIED",
+						},
+					}, fmt.Errorf("retrieve drifted resources for stack mockStack: %w", &cloudformation.ErrDriftDetectionFailed{
+						StackName:   "mockStack",
+						DetectionID: "1234",
+						Reason:      "Failed to detect drift on resource [LogGroup]",
+					})),
+				)...)
+			},
+
+			wantedContent: &ecsServiceStatus{
+				Service: awsecs.ServiceStatus{
+					Status: "ACTIVE",
+					Deployments: []awsecs.Deployment{
+						{
+							UpdatedAt:      startTime,
+							TaskDefinition: "mockTaskDefinition",
+						},
+					},
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockTaskDefinition",
+				},
+				StackDrift: []*stack.ResourceDrift{
+					{
+						LogicalID:    "Service",
+						ResourceType: "AWS::ECS::Service",
+						Status:       "MODIFIED",
+					},
+				},
+				StackDriftFailureReason: "Failed to detect drift on resource [LogGroup]",
+				driftDetected:           true,
+			},
+		},
+		"success with drifted resources": {
+			enableDrift: true,
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				gomock.InOrder(append(mockMinimalStatus(m),
+					m.stackDescriber.EXPECT().Drift().Return([]*stack.ResourceDrift{
+						{
+							LogicalID:    "Service",
+							ResourceType: "AWS::ECS::Service",
+							Status:       "MODIFIED",
+						},
+					}, nil),
+				)...)
+			},
+
+			wantedContent: &ecsServiceStatus{
+				Service: awsecs.ServiceStatus{
+					Status: "ACTIVE",
+					Deployments: []awsecs.Deployment{
+						{
+							UpdatedAt:      startTime,
+							TaskDefinition: "mockTaskDefinition",
+						},
+					},
+					LastDeploymentAt: startTime,
+					TaskDefinition:   "mockTaskDefinition",
+				},
+				StackDrift: []*stack.ResourceDrift{
+					{
+						LogicalID:    "Service",
+						ResourceType: "AWS::ECS::Service",
+						Status:       "MODIFIED",
+					},
+				},
+				driftDetected: true,
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -494,12 +627,14 @@ func TestServiceStatus_Describe(t *testing.T) {
 			mockSvcDescriber := mocks.NewMockserviceDescriber(ctrl)
 			mockaasClient := mocks.NewMockautoscalingAlarmNamesGetter(ctrl)
 			mockTargetHealthGetter := mocks.NewMocktargetHealthGetter(ctrl)
+			mockStackDescriber := mocks.NewMockstackDescriber(ctrl)
 			mocks := serviceStatusDescriberMocks{
 				ecsServiceGetter:   mockecsSvc,
 				alarmStatusGetter:  mockcwSvc,
 				serviceDescriber:   mockSvcDescriber,
 				aas:                mockaasClient,
 				targetHealthGetter: mockTargetHealthGetter,
+				stackDescriber:     mockStackDescriber,
 			}
 
 			tc.setupMocks(mocks)
@@ -513,6 +648,8 @@ func TestServiceStatus_Describe(t *testing.T) {
 				svcDescriber:       mockSvcDescriber,
 				aasSvcGetter:       mockaasClient,
 				targetHealthGetter: mockTargetHealthGetter,
+				svcStackDescriber:  mockStackDescriber,
+				enableDrift:        tc.enableDrift,
 			}
 
 			// WHEN
@@ -529,6 +666,224 @@ func TestServiceStatus_Describe(t *testing.T) {
 	}
 }
 
+func TestServiceStatus_DescribeAlarms(t *testing.T) {
+	mockServiceARN := awsecs.ServiceArn("arn:aws:ecs:us-west-2:123456789012:service/mockCluster/mockService")
+	updateTime := time.Unix(1584129030, 0)
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(mocks serviceStatusDescriberMocks)
+
+		wantedError   error
+		wantedContent *ecsServiceAlarmStatus
+	}{
+		"errors if failed to get the ECS service ARN": {
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				m.serviceDescriber.EXPECT().ServiceARN("mockApp", "mockEnv", "mockSvc").Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("get ECS service ARN for mockSvc: some error"),
+		},
+		"errors if failed to get tagged CloudWatch alarms": {
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				gomock.InOrder(
+					m.serviceDescriber.EXPECT().ServiceARN("mockApp", "mockEnv", "mockSvc").Return(&mockServiceARN, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return(nil, mockError),
+				)
+			},
+			wantedError: fmt.Errorf("get tagged CloudWatch alarms: some error"),
+		},
+		"success": {
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				gomock.InOrder(
+					m.serviceDescriber.EXPECT().ServiceARN("mockApp", "mockEnv", "mockSvc").Return(&mockServiceARN, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(map[string]string{
+						"copilot-application": "mockApp",
+						"copilot-environment": "mockEnv",
+						"copilot-service":     "mockSvc",
+					}).Return([]cloudwatch.AlarmStatus{
+						{
+							Name:         "mockAlarm1",
+							Status:       "OK",
+							UpdatedTimes: updateTime,
+						},
+					}, nil),
+					m.aas.EXPECT().ECSServiceAlarmNames("mockCluster", "mockService").Return([]string{"mockAlarm2"}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmStatus([]string{"mockAlarm2"}).Return([]cloudwatch.AlarmStatus{
+						{
+							Name:         "mockAlarm2",
+							Status:       "ALARM",
+							UpdatedTimes: updateTime,
+						},
+					}, nil),
+				)
+			},
+			wantedContent: &ecsServiceAlarmStatus{
+				Alarms: []cloudwatch.AlarmStatus{
+					{
+						Name:         "mockAlarm1",
+						Status:       "OK",
+						UpdatedTimes: updateTime,
+					},
+					{
+						Name:         "mockAlarm2",
+						Status:       "ALARM",
+						UpdatedTimes: updateTime,
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcwSvc := mocks.NewMockalarmStatusGetter(ctrl)
+			mockSvcDescriber := mocks.NewMockserviceDescriber(ctrl)
+			mockaasClient := mocks.NewMockautoscalingAlarmNamesGetter(ctrl)
+			mocks := serviceStatusDescriberMocks{
+				alarmStatusGetter: mockcwSvc,
+				serviceDescriber:  mockSvcDescriber,
+				aas:               mockaasClient,
+			}
+
+			tc.setupMocks(mocks)
+
+			svcStatus := &ecsStatusDescriber{
+				svc:          "mockSvc",
+				env:          "mockEnv",
+				app:          "mockApp",
+				cwSvcGetter:  mockcwSvc,
+				svcDescriber: mockSvcDescriber,
+				aasSvcGetter: mockaasClient,
+			}
+
+			// WHEN
+			alarmStatus, err := svcStatus.DescribeAlarms()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, alarmStatus, "expected output content match")
+			}
+		})
+	}
+}
+
+func TestServiceStatus_DescribeAlarmHistory(t *testing.T) {
+	mockServiceARN := awsecs.ServiceArn("arn:aws:ecs:us-west-2:123456789012:service/mockCluster/mockService")
+	updateTime := time.Unix(1584129030, 0)
+	testCases := map[string]struct {
+		setupMocks func(mocks serviceStatusDescriberMocks)
+
+		wantedError   error
+		wantedContent *ecsServiceAlarmHistory
+	}{
+		"errors if failed to get the history of an alarm": {
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				gomock.InOrder(
+					m.serviceDescriber.EXPECT().ServiceARN("mockApp", "mockEnv", "mockSvc").Return(&mockServiceARN, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return([]cloudwatch.AlarmStatus{
+						{
+							Name:   "mockAlarm1",
+							Status: "OK",
+						},
+					}, nil),
+					m.aas.EXPECT().ECSServiceAlarmNames("mockCluster", "mockService").Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmStatus(nil).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmHistory("mockAlarm1", 5).Return(nil, errors.New("some error")),
+				)
+			},
+			wantedError: fmt.Errorf("get alarm history: some error"),
+		},
+		"success": {
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				gomock.InOrder(
+					m.serviceDescriber.EXPECT().ServiceARN("mockApp", "mockEnv", "mockSvc").Return(&mockServiceARN, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return([]cloudwatch.AlarmStatus{
+						{
+							Name:   "mockAlarm1",
+							Status: "OK",
+						},
+					}, nil),
+					m.aas.EXPECT().ECSServiceAlarmNames("mockCluster", "mockService").Return([]string{"mockAlarm2"}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmStatus([]string{"mockAlarm2"}).Return([]cloudwatch.AlarmStatus{
+						{
+							Name:   "mockAlarm2",
+							Status: "ALARM",
+						},
+					}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmHistory("mockAlarm1", 5).Return(nil, nil),
+					m.alarmStatusGetter.EXPECT().AlarmHistory("mockAlarm2", 5).Return([]cloudwatch.AlarmHistoryItem{
+						{
+							Summary:   "Alarm updated from OK to ALARM",
+							Timestamp: updateTime,
+						},
+					}, nil),
+				)
+			},
+			wantedContent: &ecsServiceAlarmHistory{
+				Alarms: []alarmHistory{
+					{
+						Name:   "mockAlarm1",
+						Status: "OK",
+					},
+					{
+						Name:   "mockAlarm2",
+						Status: "ALARM",
+						Transitions: []cloudwatch.AlarmHistoryItem{
+							{
+								Summary:   "Alarm updated from OK to ALARM",
+								Timestamp: updateTime,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcwSvc := mocks.NewMockalarmStatusGetter(ctrl)
+			mockSvcDescriber := mocks.NewMockserviceDescriber(ctrl)
+			mockaasClient := mocks.NewMockautoscalingAlarmNamesGetter(ctrl)
+			mocks := serviceStatusDescriberMocks{
+				alarmStatusGetter: mockcwSvc,
+				serviceDescriber:  mockSvcDescriber,
+				aas:               mockaasClient,
+			}
+
+			tc.setupMocks(mocks)
+
+			svcStatus := &ecsStatusDescriber{
+				svc:          "mockSvc",
+				env:          "mockEnv",
+				app:          "mockApp",
+				cwSvcGetter:  mockcwSvc,
+				svcDescriber: mockSvcDescriber,
+				aasSvcGetter: mockaasClient,
+			}
+
+			// WHEN
+			history, err := svcStatus.DescribeAlarmHistory(5)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, history, "expected output content match")
+			}
+		})
+	}
+}
+
 func TestAppRunnerStatusDescriber_Describe(t *testing.T) {
 	appName := "testapp"
 	envName := "test"
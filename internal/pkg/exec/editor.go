@@ -0,0 +1,63 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package exec
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+const (
+	editorEnvVar  = "EDITOR"
+	defaultEditor = "vi"
+)
+
+// Editor opens content in the user's preferred text editor.
+type Editor struct {
+	runner
+	fs      afero.Fs
+	command []string // Editor command followed by its arguments.
+}
+
+// NewEditor returns an Editor that runs the command in the $EDITOR environment variable, or vi if it's unset.
+func NewEditor() *Editor {
+	command := strings.Fields(os.Getenv(editorEnvVar))
+	if len(command) == 0 {
+		command = []string{defaultEditor}
+	}
+	return &Editor{
+		runner:  NewCmd(),
+		fs:      afero.NewOsFs(),
+		command: command,
+	}
+}
+
+// Edit writes the content to a temporary file whose name matches the pattern, opens the file in the editor,
+// and returns the content of the file once the editor exits.
+func (e *Editor) Edit(pattern string, content []byte) ([]byte, error) {
+	f, err := afero.TempFile(e.fs, "", pattern)
+	if err != nil {
+		return nil, fmt.Errorf("create temporary file: %w", err)
+	}
+	defer e.fs.Remove(f.Name())
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("write temporary file %s: %w", f.Name(), err)
+	}
+	if err := f.Close(); err != nil {
+		return nil, fmt.Errorf("close temporary file %s: %w", f.Name(), err)
+	}
+	args := append(e.command[1:len(e.command):len(e.command)], f.Name())
+	if err := e.runner.InteractiveRun(e.command[0], args); err != nil {
+		return nil, fmt.Errorf("run editor %s: %w", e.command[0], err)
+	}
+	edited, err := afero.ReadFile(e.fs, f.Name())
+	if err != nil {
+		return nil, fmt.Errorf("read temporary file %s: %w", f.Name(), err)
+	}
+	return edited, nil
+}
@@ -0,0 +1,77 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package exec
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditor_Edit(t *testing.T) {
+	testCases := map[string]struct {
+		command    []string
+		setupMocks func(m *Mockrunner, fs afero.Fs)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"return error if the editor fails": {
+			command: []string{"vi"},
+			setupMocks: func(m *Mockrunner, fs afero.Fs) {
+				m.EXPECT().InteractiveRun("vi", gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("run editor vi: some error"),
+		},
+		"return the edited content": {
+			command: []string{"code", "--wait"},
+			setupMocks: func(m *Mockrunner, fs afero.Fs) {
+				m.EXPECT().InteractiveRun("code", gomock.Any()).DoAndReturn(func(_ string, args []string, _ ...CmdOption) error {
+					require.Len(t, args, 2)
+					require.Equal(t, "--wait", args[0])
+					content, err := afero.ReadFile(fs, args[1])
+					require.NoError(t, err)
+					require.Equal(t, "name: api\n", string(content))
+					return afero.WriteFile(fs, args[1], []byte("name: api\ntype: Backend Service\n"), 0644)
+				})
+			},
+			wantedContent: "name: api\ntype: Backend Service\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fs := afero.NewMemMapFs()
+			mockRunner := NewMockrunner(ctrl)
+			tc.setupMocks(mockRunner, fs)
+			editor := &Editor{
+				runner:  mockRunner,
+				fs:      fs,
+				command: tc.command,
+			}
+
+			// WHEN
+			content, err := editor.Edit("manifest-*.yml", []byte("name: api\n"))
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, string(content))
+			}
+			files, err := afero.ReadDir(fs, afero.GetTempDir(fs, ""))
+			require.NoError(t, err)
+			require.Empty(t, files, "temporary file should be removed")
+		})
+	}
+}
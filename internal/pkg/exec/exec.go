@@ -17,7 +17,7 @@ type httpClient interface {
 
 type runner interface {
 	Run(name string, args []string, options ...CmdOption) error
-	InteractiveRun(name string, args []string) error
+	InteractiveRun(name string, args []string, options ...CmdOption) error
 }
 
 type cmdRunner interface {
@@ -73,17 +73,22 @@ func (m *Mockrunner) EXPECT() *MockrunnerMockRecorder {
 }
 
 // InteractiveRun mocks base method.
-func (m *Mockrunner) InteractiveRun(name string, args []string) error {
+func (m *Mockrunner) InteractiveRun(name string, args []string, options ...CmdOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InteractiveRun", name, args)
+	varargs := []interface{}{name, args}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "InteractiveRun", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // InteractiveRun indicates an expected call of InteractiveRun.
-func (mr *MockrunnerMockRecorder) InteractiveRun(name, args interface{}) *gomock.Call {
+func (mr *MockrunnerMockRecorder) InteractiveRun(name, args interface{}, options ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InteractiveRun", reflect.TypeOf((*Mockrunner)(nil).InteractiveRun), name, args)
+	varargs := append([]interface{}{name, args}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InteractiveRun", reflect.TypeOf((*Mockrunner)(nil).InteractiveRun), varargs...)
 }
 
 // Run mocks base method.
@@ -47,13 +47,14 @@ func NewSSMPluginCommand(s *session.Session) SSMPluginCommand {
 }
 
 // StartSession starts a session using the ssm plugin.
-func (s SSMPluginCommand) StartSession(ssmSess *ecs.Session) error {
+// The options can redirect the streams of the plugin, for example to capture the output of the session.
+func (s SSMPluginCommand) StartSession(ssmSess *ecs.Session, opts ...CmdOption) error {
 	response, err := json.Marshal(ssmSess)
 	if err != nil {
 		return fmt.Errorf("marshal session response: %w", err)
 	}
 	if err := s.runner.InteractiveRun(ssmPluginBinaryName,
-		[]string{string(response), aws.StringValue(s.sess.Config.Region), startSessionAction}); err != nil {
+		[]string{string(response), aws.StringValue(s.sess.Config.Region), startSessionAction}, opts...); err != nil {
 		return fmt.Errorf("start session: %w", err)
 	}
 	return nil
@@ -4,6 +4,7 @@
 package exec
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"net/http"
@@ -41,6 +42,7 @@ func TestSSMPluginCommand_StartSession(t *testing.T) {
 	mockError := errors.New("some error")
 	tests := map[string]struct {
 		inSession   *ecs.Session
+		inOpts      []CmdOption
 		setupMocks  func(controller *gomock.Controller)
 		wantedError error
 	}{
@@ -61,6 +63,15 @@ func TestSSMPluginCommand_StartSession(t *testing.T) {
 					[]string{`{"SessionId":"mockSessionID","StreamUrl":"mockStreamURL","TokenValue":"mockTokenValue"}`, "us-west-2", "StartSession"}).Return(nil)
 			},
 		},
+		"success with the options passed to the plugin": {
+			inSession: mockSession,
+			inOpts:    []CmdOption{Stdout(&bytes.Buffer{})},
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = NewMockrunner(controller)
+				mockRunner.EXPECT().InteractiveRun(ssmPluginBinaryName,
+					[]string{`{"SessionId":"mockSessionID","StreamUrl":"mockStreamURL","TokenValue":"mockTokenValue"}`, "us-west-2", "StartSession"}, gomock.Any()).Return(nil)
+			},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -74,7 +85,7 @@ func TestSSMPluginCommand_StartSession(t *testing.T) {
 					},
 				},
 			}
-			err := s.StartSession(tc.inSession)
+			err := s.StartSession(tc.inSession, tc.inOpts...)
 			if tc.wantedError != nil {
 				require.EqualError(t, tc.wantedError, err.Error())
 			} else {
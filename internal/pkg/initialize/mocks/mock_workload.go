@@ -227,6 +227,44 @@ func (mr *MockWorkspaceMockRecorder) WriteServiceManifest(marshaler, serviceName
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteServiceManifest", reflect.TypeOf((*MockWorkspace)(nil).WriteServiceManifest), marshaler, serviceName)
 }
 
+// MockManifestEditor is a mock of ManifestEditor interface.
+type MockManifestEditor struct {
+	ctrl     *gomock.Controller
+	recorder *MockManifestEditorMockRecorder
+}
+
+// MockManifestEditorMockRecorder is the mock recorder for MockManifestEditor.
+type MockManifestEditorMockRecorder struct {
+	mock *MockManifestEditor
+}
+
+// NewMockManifestEditor creates a new mock instance.
+func NewMockManifestEditor(ctrl *gomock.Controller) *MockManifestEditor {
+	mock := &MockManifestEditor{ctrl: ctrl}
+	mock.recorder = &MockManifestEditorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManifestEditor) EXPECT() *MockManifestEditorMockRecorder {
+	return m.recorder
+}
+
+// Edit mocks base method.
+func (m *MockManifestEditor) Edit(pattern string, content []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Edit", pattern, content)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Edit indicates an expected call of Edit.
+func (mr *MockManifestEditorMockRecorder) Edit(pattern, content interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Edit", reflect.TypeOf((*MockManifestEditor)(nil).Edit), pattern, content)
+}
+
 // MockProg is a mock of Prog interface.
 type MockProg struct {
 	ctrl     *gomock.Controller
@@ -16,6 +16,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -53,6 +54,11 @@ type Workspace interface {
 	WriteServiceManifest(marshaler encoding.BinaryMarshaler, serviceName string) (string, error)
 }
 
+// ManifestEditor contains the methods needed to let users edit a manifest before it's written.
+type ManifestEditor interface {
+	Edit(pattern string, content []byte) ([]byte, error)
+}
+
 // Prog contains the methods needed to render multi-stage operations.
 type Prog interface {
 	Start(label string)
@@ -67,6 +73,7 @@ type WorkloadProps struct {
 	DockerfilePath string
 	Image          string
 	Platform       *manifest.PlatformConfig
+	Variables      map[string]string // Environment variables to write in the manifest.
 }
 
 // JobProps contains the information needed to represent a Job.
@@ -81,9 +88,14 @@ type JobProps struct {
 // ServiceProps contains the information needed to represent a Service (port, HealthCheck, and workload common props).
 type ServiceProps struct {
 	WorkloadProps
-	Port        uint16
-	HealthCheck *manifest.ContainerHealthCheck
-	appDomain   *string
+	Port            uint16
+	AdditionalPorts []uint16 // Ports of Load Balanced Web services that don't receive traffic from the load balancer.
+	HealthCheck     *manifest.ContainerHealthCheck
+	Logging         *manifest.Logging
+	Storage         *manifest.Storage             // Volumes of Load Balanced Web and Backend services.
+	Count           *manifest.Count               // Autoscaling configuration of Load Balanced Web services.
+	HTTPHealthCheck *manifest.HTTPHealthCheckArgs // Load balancer health check of Load Balanced Web services.
+	appDomain       *string
 }
 
 // WorkloadInitializer holds the clients necessary to initialize either a
@@ -93,6 +105,7 @@ type WorkloadInitializer struct {
 	Deployer WorkloadAdder
 	Ws       Workspace
 	Prog     Prog
+	Editor   ManifestEditor // Optional. If set, the service manifest is opened for edits before it's written.
 }
 
 // Service writes the service manifest, creates an ECR repository, and adds the service to SSM.
@@ -200,6 +213,12 @@ func (w *WorkloadInitializer) initService(props *ServiceProps) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if w.Editor != nil {
+		mf, err = w.editManifest(mf, props.WorkloadProps)
+		if err != nil {
+			return "", err
+		}
+	}
 	manifestPath, err := w.Ws.WriteServiceManifest(mf, props.Name)
 	if err != nil {
 		e, ok := err.(*workspace.ErrFileExists)
@@ -233,6 +252,49 @@ func (w *WorkloadInitializer) initService(props *ServiceProps) (string, error) {
 	return manifestPath, nil
 }
 
+// editManifest opens the rendered manifest in the editor and returns the edited manifest if it's still valid.
+func (w *WorkloadInitializer) editManifest(mf encoding.BinaryMarshaler, props WorkloadProps) (encoding.BinaryMarshaler, error) {
+	content, err := mf.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshal manifest for %s %s: %w", svcWlType, props.Name, err)
+	}
+	edited, err := w.Editor.Edit(fmt.Sprintf("%s-manifest-*.yml", props.Name), content)
+	if err != nil {
+		return nil, fmt.Errorf("edit manifest for %s %s: %w", svcWlType, props.Name, err)
+	}
+	if err := validateEditedManifest(edited, props); err != nil {
+		return nil, fmt.Errorf("validate edited manifest for %s %s: %w", svcWlType, props.Name, err)
+	}
+	return editedManifest(edited), nil
+}
+
+// validateEditedManifest returns an error if the edited manifest can't be parsed,
+// or if its name or type differ from the workload being initialized.
+func validateEditedManifest(content []byte, props WorkloadProps) error {
+	if _, err := manifest.UnmarshalWorkload(content); err != nil {
+		return err
+	}
+	var wl manifest.Workload
+	if err := yaml.Unmarshal(content, &wl); err != nil {
+		return fmt.Errorf("unmarshal workload name and type: %w", err)
+	}
+	if name := aws.StringValue(wl.Name); name != props.Name {
+		return fmt.Errorf("name %q cannot be changed to %q", props.Name, name)
+	}
+	if typ := aws.StringValue(wl.Type); typ != props.Type {
+		return fmt.Errorf("type %q cannot be changed to %q", props.Type, typ)
+	}
+	return nil
+}
+
+// editedManifest is a manifest that was edited by the user.
+type editedManifest []byte
+
+// MarshalBinary returns the content of the edited manifest as is.
+func (m editedManifest) MarshalBinary() ([]byte, error) {
+	return m, nil
+}
+
 func (w *WorkloadInitializer) addSvcToAppAndSSM(app *config.Application, props WorkloadProps) error {
 	return w.addWlToAppAndSSM(app, props, svcWlType)
 }
@@ -299,10 +361,16 @@ func (w *WorkloadInitializer) newLoadBalancedWebServiceManifest(i *ServiceProps)
 			Name:       i.Name,
 			Dockerfile: i.DockerfilePath,
 			Image:      i.Image,
+			Variables:  i.Variables,
 		},
-		Port:        i.Port,
-		HealthCheck: i.HealthCheck,
-		Path:        "/",
+		Port:            i.Port,
+		AdditionalPorts: i.AdditionalPorts,
+		HealthCheck:     i.HealthCheck,
+		Logging:         i.Logging,
+		Storage:         i.Storage,
+		Count:           i.Count,
+		HTTPHealthCheck: i.HTTPHealthCheck,
+		Path:            "/",
 	}
 	existingSvcs, err := w.Store.ListServices(i.App)
 	if err != nil {
@@ -325,6 +393,7 @@ func (w *WorkloadInitializer) newRequestDrivenWebServiceManifest(i *ServiceProps
 			Name:       i.Name,
 			Dockerfile: i.DockerfilePath,
 			Image:      i.Image,
+			Variables:  i.Variables,
 		},
 		Port: i.Port,
 	}
@@ -337,9 +406,12 @@ func newBackendServiceManifest(i *ServiceProps) (*manifest.BackendService, error
 			Name:       i.Name,
 			Dockerfile: i.DockerfilePath,
 			Image:      i.Image,
+			Variables:  i.Variables,
 		},
 		Port:        i.Port,
 		HealthCheck: i.HealthCheck,
+		Logging:     i.Logging,
+		Storage:     i.Storage,
 	}), nil
 }
 
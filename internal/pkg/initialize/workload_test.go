@@ -3,6 +3,7 @@
 package initialize
 
 import (
+	"encoding"
 	"errors"
 	"fmt"
 	"testing"
@@ -261,6 +262,7 @@ func TestAppInitOpts_createLoadBalancedAppManifest(t *testing.T) {
 		inSvcName        string
 		inDockerfilePath string
 		inAppName        string
+		inStorage        *manifest.Storage
 		mockstore        func(m *mocks.MockStore)
 
 		wantedErr  error
@@ -329,6 +331,31 @@ func TestAppInitOpts_createLoadBalancedAppManifest(t *testing.T) {
 
 			wantedPath: "frontend",
 		},
+		"creates manifest with an EFS volume": {
+			inAppName:        "app",
+			inSvcName:        "frontend",
+			inSvcPort:        80,
+			inDockerfilePath: "/Dockerfile",
+			inStorage: &manifest.Storage{
+				Volumes: map[string]manifest.Volume{
+					"data": {
+						EFS: &manifest.EFSConfigOrBool{
+							Enabled: aws.Bool(true),
+						},
+						MountPointOpts: manifest.MountPointOpts{
+							ContainerPath: aws.String("/var/efs"),
+							ReadOnly:      aws.Bool(false),
+						},
+					},
+				},
+			},
+
+			mockstore: func(m *mocks.MockStore) {
+				m.EXPECT().ListServices("app").Return(nil, nil)
+			},
+
+			wantedPath: "/",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -348,7 +375,8 @@ func TestAppInitOpts_createLoadBalancedAppManifest(t *testing.T) {
 					App:            tc.inAppName,
 					DockerfilePath: tc.inDockerfilePath,
 				},
-				Port: tc.inSvcPort,
+				Port:    tc.inSvcPort,
+				Storage: tc.inStorage,
 			}
 
 			initter := &WorkloadInitializer{
@@ -365,6 +393,7 @@ func TestAppInitOpts_createLoadBalancedAppManifest(t *testing.T) {
 				require.Equal(t, tc.inSvcPort, aws.Uint16Value(manifest.ImageConfig.Port))
 				require.Contains(t, tc.inDockerfilePath, aws.StringValue(manifest.ImageConfig.Build.BuildArgs.Dockerfile))
 				require.Equal(t, tc.wantedPath, aws.StringValue(manifest.Path))
+				require.Equal(t, tc.inStorage, manifest.Storage)
 			} else {
 				require.EqualError(t, err, tc.wantedErr.Error())
 			}
@@ -379,6 +408,7 @@ func TestAppInitOpts_createRequestDrivenWebServiceManifest(t *testing.T) {
 		inDockerfilePath string
 		inImage          string
 		inAppName        string
+		inVariables      map[string]string
 
 		wantedErr error
 	}{
@@ -394,6 +424,15 @@ func TestAppInitOpts_createRequestDrivenWebServiceManifest(t *testing.T) {
 			inSvcPort: 80,
 			inImage:   "111111111111.dkr.ecr.us-east-1.amazonaws.com/app/frontend",
 		},
+		"creates manifest with environment variables": {
+			inAppName: "app",
+			inSvcName: "frontend",
+			inSvcPort: 80,
+			inImage:   "nginx",
+			inVariables: map[string]string{
+				"LOG_LEVEL": "info",
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -405,6 +444,7 @@ func TestAppInitOpts_createRequestDrivenWebServiceManifest(t *testing.T) {
 					App:            tc.inAppName,
 					DockerfilePath: tc.inDockerfilePath,
 					Image:          tc.inImage,
+					Variables:      tc.inVariables,
 				},
 				Port: tc.inSvcPort,
 			}
@@ -423,6 +463,7 @@ func TestAppInitOpts_createRequestDrivenWebServiceManifest(t *testing.T) {
 			if tc.inDockerfilePath != "" {
 				require.Equal(t, tc.inDockerfilePath, *manifest.ImageConfig.Build.BuildArgs.Dockerfile)
 			}
+			require.Equal(t, tc.inVariables, manifest.Variables)
 		})
 	}
 }
@@ -449,6 +490,7 @@ func TestWorkloadInitializer_Service(t *testing.T) {
 		mockstore       func(m *mocks.MockStore)
 		mockappDeployer func(m *mocks.MockWorkloadAdder)
 		mockProg        func(m *mocks.MockProg)
+		mockEditor      func(m *mocks.MockManifestEditor)
 
 		wantedErr error
 	}{
@@ -619,6 +661,57 @@ func TestWorkloadInitializer_Service(t *testing.T) {
 				m.EXPECT().Stop(log.Ssuccessf(fmtAddWlToAppComplete, "service", "backend"))
 			},
 		},
+		"edits the manifest before writing it": {
+			inSvcType: manifest.BackendServiceType,
+			inAppName: "app",
+			inSvcName: "backend",
+			inImage:   "mockImage",
+
+			mockEditor: func(m *mocks.MockManifestEditor) {
+				m.EXPECT().Edit("backend-manifest-*.yml", gomock.Any()).
+					Return([]byte("name: backend\ntype: Backend Service\nimage:\n  location: nginx\n"), nil)
+			},
+			mockWriter: func(m *mocks.MockWorkspace) {
+				m.EXPECT().WriteServiceManifest(gomock.Any(), "backend").
+					Do(func(m encoding.BinaryMarshaler, _ string) {
+						content, err := m.MarshalBinary()
+						require.NoError(t, err)
+						require.Equal(t, "name: backend\ntype: Backend Service\nimage:\n  location: nginx\n", string(content))
+					}).Return("/backend/manifest.yml", nil)
+			},
+			mockstore: func(m *mocks.MockStore) {
+				m.EXPECT().CreateService(gomock.Any()).Return(nil)
+				m.EXPECT().GetApplication("app").Return(&config.Application{
+					Name:      "app",
+					AccountID: "1234",
+				}, nil)
+			},
+			mockappDeployer: func(m *mocks.MockWorkloadAdder) {
+				m.EXPECT().AddServiceToApp(gomock.Any(), "backend")
+			},
+			mockProg: func(m *mocks.MockProg) {
+				m.EXPECT().Start(gomock.Any())
+				m.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"edited manifest error": {
+			inSvcType: manifest.BackendServiceType,
+			inAppName: "app",
+			inSvcName: "backend",
+			inImage:   "mockImage",
+
+			mockEditor: func(m *mocks.MockManifestEditor) {
+				m.EXPECT().Edit("backend-manifest-*.yml", gomock.Any()).
+					Return([]byte("name: api\ntype: Backend Service\n"), nil)
+			},
+			mockstore: func(m *mocks.MockStore) {
+				m.EXPECT().GetApplication("app").Return(&config.Application{
+					Name:      "app",
+					AccountID: "1234",
+				}, nil)
+			},
+			wantedErr: errors.New(`validate edited manifest for service backend: name "backend" cannot be changed to "api"`),
+		},
 		"no healthcheck options": {
 			inSvcType:        manifest.BackendServiceType,
 			inAppName:        "app",
@@ -746,6 +839,11 @@ func TestWorkloadInitializer_Service(t *testing.T) {
 				Prog:     mockProg,
 				Deployer: mockappDeployer,
 			}
+			if tc.mockEditor != nil {
+				mockEditor := mocks.NewMockManifestEditor(ctrl)
+				tc.mockEditor(mockEditor)
+				initializer.Editor = mockEditor
+			}
 
 			// WHEN
 			_, err := initializer.Service(&ServiceProps{
@@ -769,3 +867,40 @@ func TestWorkloadInitializer_Service(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateEditedManifest(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedErr error
+	}{
+		"invalid YAML": {
+			inContent: "name: [backend",
+			wantedErr: errors.New("unmarshal to workload manifest: yaml: line 1: did not find expected ',' or ']'"),
+		},
+		"changed type": {
+			inContent: "name: backend\ntype: Scheduled Job\n",
+			wantedErr: errors.New(`type "Backend Service" cannot be changed to "Scheduled Job"`),
+		},
+		"valid": {
+			inContent: "name: backend\ntype: Backend Service\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			err := validateEditedManifest([]byte(tc.inContent), WorkloadProps{
+				Name: "backend",
+				Type: manifest.BackendServiceType,
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -4,9 +4,12 @@
 package list
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io"
+	"reflect"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 
@@ -45,6 +48,7 @@ type JobListWriter struct {
 	// Output configuration options.
 	ShowLocalJobs bool
 	OutputJSON    bool
+	OutputCSV     bool
 
 	Store Store     // Client to retrieve application configuration and job metadata.
 	Ws    Workspace // Client to retrieve local jobs.
@@ -56,6 +60,7 @@ type JobListWriter struct {
 type SvcListWriter struct {
 	ShowLocalSvcs bool
 	OutputJSON    bool
+	OutputCSV     bool
 
 	Store Store     // Client to retrieve application configuration and service metadata.
 	Ws    Workspace // Client to retrieve local jobs.
@@ -84,6 +89,10 @@ func (l *JobListWriter) Write(appName string) error {
 			return err
 		}
 		fmt.Fprint(l.Out, data)
+	} else if l.OutputCSV {
+		if err := CSV(l.Out, wklds); err != nil {
+			return fmt.Errorf("write jobs as CSV: %w", err)
+		}
 	} else {
 		humanOutput(wklds, l.Out)
 	}
@@ -112,6 +121,10 @@ func (l *SvcListWriter) Write(appName string) error {
 			return err
 		}
 		fmt.Fprint(l.Out, data)
+	} else if l.OutputCSV {
+		if err := CSV(l.Out, wklds); err != nil {
+			return fmt.Errorf("write services as CSV: %w", err)
+		}
 	} else {
 		humanOutput(wklds, l.Out)
 	}
@@ -174,3 +187,87 @@ func (l *JobListWriter) jsonOutputJobs(jobs []*config.Workload) (string, error)
 	}
 	return fmt.Sprintf("%s\n", b), nil
 }
+
+// CSV writes rows, a slice of structs or of pointers to structs, to w in CSV format.
+// The header row is made of the json field names of the struct, so that the columns match the keys of the JSON output.
+// Fields that are not strings, numbers or booleans are written as JSON.
+func CSV(w io.Writer, rows interface{}) error {
+	v := reflect.ValueOf(rows)
+	if v.Kind() != reflect.Slice {
+		return fmt.Errorf("rows must be a slice, got %s", v.Kind())
+	}
+	typ := v.Type().Elem()
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct {
+		return fmt.Errorf("rows must be a slice of structs, got a slice of %s", typ.Kind())
+	}
+
+	var headers []string
+	var fields []int
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			// Unexported fields are not part of the JSON output either.
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		headers = append(headers, name)
+		fields = append(fields, i)
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(headers); err != nil {
+		return fmt.Errorf("write header row: %w", err)
+	}
+	for i := 0; i < v.Len(); i++ {
+		row := reflect.Indirect(v.Index(i))
+		if !row.IsValid() {
+			continue
+		}
+		record := make([]string, len(fields))
+		for j, field := range fields {
+			cell, err := csvCell(row.Field(field))
+			if err != nil {
+				return fmt.Errorf("format column %s: %w", headers[j], err)
+			}
+			record[j] = cell
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("write row: %w", err)
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func csvCell(v reflect.Value) (string, error) {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), nil
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		if v.IsNil() {
+			return "", nil
+		}
+	}
+	b, err := json.Marshal(v.Interface())
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
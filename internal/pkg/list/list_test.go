@@ -190,6 +190,7 @@ func TestList_SvcListWriter(t *testing.T) {
 	testCases := map[string]struct {
 		inputAppName   string
 		inputWriteJSON bool
+		inputWriteCSV  bool
 		inputListLocal bool
 
 		wantedError   error
@@ -197,6 +198,24 @@ func TestList_SvcListWriter(t *testing.T) {
 
 		mocking func()
 	}{
+		"should succeed writing csv": {
+			inputAppName:  mockAppName,
+			inputWriteCSV: true,
+
+			wantedContent: "app,name,type\nbarnyard,trough,Backend Service\nbarnyard,\"gaggle, the loud one\",Load Balanced Web Service\n",
+			mocking: func() {
+				mockStore.EXPECT().
+					GetApplication(gomock.Eq("barnyard")).
+					Return(&config.Application{}, nil)
+				mockStore.
+					EXPECT().
+					ListServices(gomock.Eq("barnyard")).
+					Return([]*config.Workload{
+						{App: "barnyard", Name: "trough", Type: "Backend Service"},
+						{App: "barnyard", Name: "gaggle, the loud one", Type: "Load Balanced Web Service"},
+					}, nil)
+			},
+		},
 		"should succeed writing human readable": {
 			inputAppName:   mockAppName,
 			inputWriteJSON: false,
@@ -326,6 +345,7 @@ func TestList_SvcListWriter(t *testing.T) {
 
 				ShowLocalSvcs: tc.inputListLocal,
 				OutputJSON:    tc.inputWriteJSON,
+				OutputCSV:     tc.inputWriteCSV,
 			}
 
 			// WHEN
@@ -339,3 +359,70 @@ func TestList_SvcListWriter(t *testing.T) {
 		})
 	}
 }
+
+func TestList_CSV(t *testing.T) {
+	type row struct {
+		Name     string            `json:"name"`
+		Prod     bool              `json:"prod"`
+		Count    int               `json:"count"`
+		Tags     map[string]string `json:"tags,omitempty"`
+		Ignored  string            `json:"-"`
+		Untagged string
+		internal string
+	}
+	testCases := map[string]struct {
+		rows interface{}
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if rows is not a slice": {
+			rows: row{},
+
+			wantedError: fmt.Errorf("rows must be a slice, got struct"),
+		},
+		"errors if rows is not a slice of structs": {
+			rows: []string{"trough"},
+
+			wantedError: fmt.Errorf("rows must be a slice of structs, got a slice of string"),
+		},
+		"writes only the header row if there are no rows": {
+			rows: []*row{},
+
+			wantedContent: "name,prod,count,tags,Untagged\n",
+		},
+		"writes quoted cells and encodes nested values as JSON": {
+			rows: []*row{
+				{
+					Name:     "trough",
+					Prod:     true,
+					Count:    2,
+					Tags:     map[string]string{"team": "barn"},
+					Untagged: "hello, world",
+					internal: "hidden",
+				},
+				nil,
+				{
+					Name: "gaggle",
+				},
+			},
+
+			wantedContent: "name,prod,count,tags,Untagged\ntrough,true,2,\"{\"\"team\"\":\"\"barn\"\"}\",\"hello, world\"\ngaggle,false,0,,\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			b := &bytes.Buffer{}
+
+			err := CSV(b, tc.rows)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@
 package logging
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -21,6 +22,8 @@ import (
 
 const (
 	defaultServiceLogsLimit = 10
+	maxFollowPollInterval   = 8 * time.Second
+	maxLogGroupWaitAttempts = 5 // Number of times to wait for a missing log group when not following logs.
 
 	fmtSvclogGroupName    = "/copilot/%s-%s-%s"
 	fmtSvcLogStreamPrefix = "copilot/%s"
@@ -36,6 +39,9 @@ type ServiceClient struct {
 	logStreamNamePrefix string
 	eventsGetter        logGetter
 	w                   io.Writer
+
+	// Replaced in tests.
+	sleep func(d time.Duration)
 }
 
 // WriteLogEventsOpts wraps the parameters to call WriteLogEvents.
@@ -88,6 +94,7 @@ func NewServiceClient(opts *NewServiceLogsConfig) (*ServiceClient, error) {
 		logStreamNamePrefix: fmt.Sprintf(fmtSvcLogStreamPrefix, opts.Svc),
 		eventsGetter:        cloudwatchlogs.New(opts.Sess),
 		w:                   log.OutputWriter,
+		sleep:               time.Sleep,
 	}, nil
 }
 
@@ -126,6 +133,7 @@ func newAppRunnerServiceClient(opts *NewServiceLogsConfig) (*ServiceClient, erro
 		logGroupName: logGroup,
 		eventsGetter: cloudwatchlogs.New(opts.Sess),
 		w:            log.OutputWriter,
+		sleep:        time.Sleep,
 	}, nil
 }
 
@@ -140,24 +148,83 @@ func (s *ServiceClient) WriteLogEvents(opts WriteLogEventsOpts) error {
 	if opts.TaskIDs != nil {
 		logEventsOpts.LogStreams = s.logStreams(opts.TaskIDs)
 	}
+	if opts.Follow {
+		return s.followLogEvents(logEventsOpts, opts.OnEvents)
+	}
+	logEventsOutput, err := s.logEventsWhenLogGroupExists(logEventsOpts)
+	if err != nil {
+		return fmt.Errorf("get task log events for log group %s: %w", s.logGroupName, err)
+	}
+	return opts.OnEvents(s.w, cwEventsToHumanJSONStringers(logEventsOutput.Events))
+}
+
+// logEventsWhenLogGroupExists retrieves log events. If the log group doesn't exist yet, for example right after
+// the service is deployed, it waits for the log group to be created up to maxLogGroupWaitAttempts times.
+func (s *ServiceClient) logEventsWhenLogGroupExists(in cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error) {
+	interval := cloudwatchlogs.SleepDuration
+	for attempt := 0; ; attempt++ {
+		logEventsOutput, err := s.eventsGetter.LogEvents(in)
+		var errLogGroupNotFound *cloudwatchlogs.ErrLogGroupNotFound
+		if !errors.As(err, &errLogGroupNotFound) || attempt == maxLogGroupWaitAttempts {
+			return logEventsOutput, err
+		}
+		if attempt == 0 {
+			log.Infof("Waiting for log group %s to be created...\n", s.logGroupName)
+		}
+		interval = nextPollInterval(interval)
+		s.sleep(interval)
+	}
+}
+
+// followLogEvents polls the log group for new events until the process is interrupted.
+// Each poll resumes every log stream after the last event retrieved from it, so that the events of a stream
+// that are ingested late aren't skipped because another stream already has newer events.
+func (s *ServiceClient) followLogEvents(in cloudwatchlogs.LogEventsOpts, onEvents func(w io.Writer, logs []HumanJSONStringer) error) error {
+	interval := cloudwatchlogs.SleepDuration
+	var waitMsg string
 	for {
-		logEventsOutput, err := s.eventsGetter.LogEvents(logEventsOpts)
+		logEventsOutput, err := s.eventsGetter.LogEvents(in)
+		var errLogGroupNotFound *cloudwatchlogs.ErrLogGroupNotFound
+		var errLogStreamNotFound *cloudwatchlogs.ErrLogStreamNotFound
+		if errors.As(err, &errLogGroupNotFound) || errors.As(err, &errLogStreamNotFound) {
+			msg := fmt.Sprintf("Waiting for log group %s to be created...\n", s.logGroupName)
+			if errLogStreamNotFound != nil {
+				msg = fmt.Sprintf("Waiting for the first log stream of log group %s...\n", s.logGroupName)
+			}
+			if msg != waitMsg {
+				log.Info(msg)
+				waitMsg = msg
+			}
+			interval = nextPollInterval(interval)
+			s.sleep(interval)
+			continue
+		}
 		if err != nil {
 			return fmt.Errorf("get task log events for log group %s: %w", s.logGroupName, err)
 		}
-		if err := opts.OnEvents(s.w, cwEventsToHumanJSONStringers(logEventsOutput.Events)); err != nil {
-			return err
-		}
-		if !opts.Follow {
-			return nil
+		waitMsg = ""
+		in.StreamLastEventTime = logEventsOutput.StreamLastEventTime
+		// After the first batch, retrieve every new event instead of only the most recent ones.
+		in.Limit = nil
+		if len(logEventsOutput.Events) == 0 {
+			interval = nextPollInterval(interval)
+			s.sleep(interval)
+			continue
 		}
-		// for unit test.
-		if logEventsOutput.StreamLastEventTime == nil {
-			return nil
+		if err := onEvents(s.w, cwEventsToHumanJSONStringers(logEventsOutput.Events)); err != nil {
+			return err
 		}
-		logEventsOpts.StreamLastEventTime = logEventsOutput.StreamLastEventTime
-		time.Sleep(cloudwatchlogs.SleepDuration)
+		interval = cloudwatchlogs.SleepDuration
+		s.sleep(interval)
+	}
+}
+
+// nextPollInterval doubles the interval between polls up to maxFollowPollInterval.
+func nextPollInterval(interval time.Duration) time.Duration {
+	if interval*2 > maxFollowPollInterval {
+		return maxFollowPollInterval
 	}
+	return interval * 2
 }
 
 func (s *ServiceClient) logStreams(taskIDs []string) (logStreamName []string) {
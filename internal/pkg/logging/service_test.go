@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
@@ -30,9 +31,6 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warnin
 `
 		logEventsJSONString = "{\"logStreamName\":\"firelens_log_router/fcfe4ab8043841c08162318e5ad805f1\",\"ingestionTime\":0,\"message\":\"10.0.0.00 - - [01/Jan/1970 01:01:01] \\\"GET / HTTP/1.1\\\" 200 -\",\"timestamp\":0}\n{\"logStreamName\":\"firelens_log_router/fcfe4ab8043841c08162318e5ad805f1\",\"ingestionTime\":0,\"message\":\"10.0.0.00 - - [01/Jan/1970 01:01:01] \\\"FATA some error\\\" - -\",\"timestamp\":0}\n{\"logStreamName\":\"firelens_log_router/fcfe4ab8043841c08162318e5ad805f1\",\"ingestionTime\":0,\"message\":\"10.0.0.00 - - [01/Jan/1970 01:01:01] \\\"WARN some warning\\\" - -\",\"timestamp\":0}\n"
 	)
-	mockLastEventTime := map[string]int64{
-		"mockLogStreamName": 123456,
-	}
 	logEvents := []*cloudwatchlogs.Event{
 		{
 			LogStreamName: "firelens_log_router/fcfe4ab8043841c08162318e5ad805f1",
@@ -51,6 +49,7 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warnin
 		{
 			LogStreamName: "firelens_log_router/fcfe4ab8043841c08162318e5ad805f1",
 			Message:       `10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1" 404 -`,
+			Timestamp:     1,
 		},
 	}
 	mockLimit := aws.Int64(100)
@@ -67,6 +66,7 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warnin
 
 		wantedError   error
 		wantedContent string
+		wantedSleeps  []time.Duration
 	}{
 		"failed to get task log events": {
 			setupMocks: func(m serviceLogsMocks) {
@@ -110,33 +110,88 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warnin
 
 			wantedContent: logEventsJSONString,
 		},
-		"success with follow flag": {
+		"waits for the log group to be created": {
+			setupMocks: func(m serviceLogsMocks) {
+				gomock.InOrder(
+					m.logGetter.EXPECT().LogEvents(gomock.Any()).
+						Return(nil, &cloudwatchlogs.ErrLogGroupNotFound{}).Times(2),
+					m.logGetter.EXPECT().LogEvents(gomock.Any()).
+						Return(&cloudwatchlogs.LogEventsOutput{
+							Events: logEvents,
+						}, nil),
+				)
+			},
+
+			wantedContent: logEventsHumanString,
+			wantedSleeps:  []time.Duration{2 * time.Second, 4 * time.Second},
+		},
+		"gives up if the log group is still not created": {
+			setupMocks: func(m serviceLogsMocks) {
+				m.logGetter.EXPECT().LogEvents(gomock.Any()).
+					Return(nil, &cloudwatchlogs.ErrLogGroupNotFound{}).Times(6)
+			},
+
+			wantedError:  fmt.Errorf("get task log events for log group mockLogGroup: log group  does not exist"),
+			wantedSleeps: []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second, 8 * time.Second, 8 * time.Second},
+		},
+		"follow writes new events until an error occurs": {
 			follow:  true,
 			taskIDs: []string{"mockTaskID1", "mockTaskID2"},
 			setupMocks: func(m serviceLogsMocks) {
 				gomock.InOrder(
+					m.logGetter.EXPECT().LogEvents(gomock.Any()).
+						Return(nil, &cloudwatchlogs.ErrLogGroupNotFound{}),
+					m.logGetter.EXPECT().LogEvents(gomock.Any()).
+						Return(nil, &cloudwatchlogs.ErrLogStreamNotFound{}),
 					m.logGetter.EXPECT().LogEvents(gomock.Any()).
 						Do(func(param cloudwatchlogs.LogEventsOpts) {
 							require.Equal(t, param.LogStreams, []string{"mockLogStreamPrefix/mockTaskID1", "mockLogStreamPrefix/mockTaskID2"})
 							require.Equal(t, param.Limit, mockDefaultLimit)
+							require.Nil(t, param.StreamLastEventTime)
+						}).
+						Return(&cloudwatchlogs.LogEventsOutput{
+							Events: logEvents,
+							StreamLastEventTime: map[string]int64{
+								"firelens_log_router/fcfe4ab8043841c08162318e5ad805f1": 0,
+							},
+						}, nil),
+					m.logGetter.EXPECT().LogEvents(gomock.Any()).
+						Do(func(param cloudwatchlogs.LogEventsOpts) {
+							require.Nil(t, param.StartTime)
+							require.Equal(t, param.Limit, mockNilLimit)
+							require.Equal(t, map[string]int64{
+								"firelens_log_router/fcfe4ab8043841c08162318e5ad805f1": 0,
+							}, param.StreamLastEventTime)
 						}).
 						Return(&cloudwatchlogs.LogEventsOutput{
-							Events:              logEvents,
-							StreamLastEventTime: mockLastEventTime,
+							Events: moreLogEvents,
+							StreamLastEventTime: map[string]int64{
+								"firelens_log_router/fcfe4ab8043841c08162318e5ad805f1": 1,
+							},
 						}, nil),
 					m.logGetter.EXPECT().LogEvents(gomock.Any()).
+						Do(func(param cloudwatchlogs.LogEventsOpts) {
+							require.Equal(t, map[string]int64{
+								"firelens_log_router/fcfe4ab8043841c08162318e5ad805f1": 1,
+							}, param.StreamLastEventTime)
+						}).
 						Return(&cloudwatchlogs.LogEventsOutput{
-							Events:              moreLogEvents,
-							StreamLastEventTime: nil,
+							StreamLastEventTime: map[string]int64{
+								"firelens_log_router/fcfe4ab8043841c08162318e5ad805f1": 1,
+							},
 						}, nil),
+					m.logGetter.EXPECT().LogEvents(gomock.Any()).
+						Return(nil, errors.New("some error")),
 				)
 			},
 
+			wantedError: fmt.Errorf("get task log events for log group mockLogGroup: some error"),
 			wantedContent: `firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1" 200 -
 firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "FATA some error" - -
 firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warning" - -
 firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1" 404 -
 `,
+			wantedSleeps: []time.Duration{2 * time.Second, 4 * time.Second, time.Second, time.Second, 2 * time.Second},
 		},
 	}
 
@@ -154,11 +209,15 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1"
 			tc.setupMocks(mocks)
 
 			b := &bytes.Buffer{}
+			var sleeps []time.Duration
 			svcLogs := &ServiceClient{
 				logGroupName:        mockLogGroupName,
 				logStreamNamePrefix: mockLogStreamPrefix,
 				eventsGetter:        mocklogGetter,
 				w:                   b,
+				sleep: func(d time.Duration) {
+					sleeps = append(sleeps, d)
+				},
 			}
 
 			// WHEN
@@ -179,8 +238,9 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1"
 				require.EqualError(t, err, tc.wantedError.Error())
 			} else {
 				require.NoError(t, err)
-				require.Equal(t, tc.wantedContent, b.String(), "expected output content match")
 			}
+			require.Equal(t, tc.wantedContent, b.String(), "expected output content match")
+			require.Equal(t, tc.wantedSleeps, sleeps)
 		})
 	}
 }
@@ -38,6 +38,8 @@ type TaskClient struct {
 	eventsLogger  logGetter
 	taskDescriber TasksDescriber
 
+	stoppedTasks []*ecs.Task
+
 	// Replaced in tests.
 	sleep func()
 }
@@ -107,6 +109,7 @@ func (t *TaskClient) allTasksStopped() (bool, error) {
 
 	stopped := true
 	var runningTasks []*task.Task
+	var stoppedTasks []*ecs.Task
 	for _, t := range tasksResp {
 		if *t.LastStatus != ecs.DesiredStatusStopped {
 			stopped = false
@@ -114,12 +117,30 @@ func (t *TaskClient) allTasksStopped() (bool, error) {
 				ClusterARN: *t.ClusterArn,
 				TaskARN:    *t.TaskArn,
 			})
+			continue
 		}
+		stoppedTasks = append(stoppedTasks, t)
 	}
 	t.tasks = runningTasks
+	t.stoppedTasks = append(t.stoppedTasks, stoppedTasks...)
 	return stopped, nil
 }
 
+// ExitCode returns the first non-zero exit code of the tasks, or 0 if all of them exited successfully.
+// It should be called once WriteEventsUntilStopped returns.
+func (t *TaskClient) ExitCode() (int, error) {
+	for _, stopped := range t.stoppedTasks {
+		code, err := stopped.ExitCode()
+		if err != nil {
+			return 0, err
+		}
+		if code != 0 {
+			return code, nil
+		}
+	}
+	return 0, nil
+}
+
 func (t *TaskClient) logStreamNamesFromTasks(tasks []*task.Task) ([]string, error) {
 	var logStreamNames []string
 	for _, task := range tasks {
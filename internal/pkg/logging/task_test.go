@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/logging/mocks"
@@ -152,3 +153,65 @@ func TestEventsWriter_WriteEventsUntilStopped(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskClient_ExitCode(t *testing.T) {
+	testCases := map[string]struct {
+		stoppedTasks []*ecs.Task
+
+		wantedExitCode int
+		wantedError    error
+	}{
+		"all tasks exited successfully": {
+			stoppedTasks: []*ecs.Task{
+				{
+					TaskArn:    aws.String("task1"),
+					Containers: []*awsecs.Container{{Name: aws.String("main"), ExitCode: aws.Int64(0)}},
+				},
+				{
+					TaskArn:    aws.String("task2"),
+					Containers: []*awsecs.Container{{Name: aws.String("main"), ExitCode: aws.Int64(0)}},
+				},
+			},
+			wantedExitCode: 0,
+		},
+		"returns the first non-zero exit code": {
+			stoppedTasks: []*ecs.Task{
+				{
+					TaskArn:    aws.String("task1"),
+					Containers: []*awsecs.Container{{Name: aws.String("main"), ExitCode: aws.Int64(0)}},
+				},
+				{
+					TaskArn:    aws.String("task2"),
+					Containers: []*awsecs.Container{{Name: aws.String("main"), ExitCode: aws.Int64(2)}},
+				},
+			},
+			wantedExitCode: 2,
+		},
+		"error if a task stopped without an exit code": {
+			stoppedTasks: []*ecs.Task{
+				{
+					TaskArn:       aws.String("task1"),
+					StoppedReason: aws.String("Essential container in task exited"),
+					Containers:    []*awsecs.Container{{Name: aws.String("main")}},
+				},
+			},
+			wantedError: errors.New("container main of task task1 stopped without an exit code: Essential container in task exited"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := &TaskClient{
+				stoppedTasks: tc.stoppedTasks,
+			}
+
+			code, err := client.ExitCode()
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedExitCode, code)
+			}
+		})
+	}
+}
@@ -18,6 +18,8 @@ type BackendServiceProps struct {
 	WorkloadProps
 	Port        uint16
 	HealthCheck *ContainerHealthCheck // Optional healthcheck configuration.
+	Logging     *Logging              // Optional FireLens log router configuration.
+	Storage     *Storage              // Optional volumes mounted in the main container.
 }
 
 // BackendService holds the configuration to create a backend service manifest.
@@ -38,6 +40,20 @@ type BackendServiceConfig struct {
 	*Logging      `yaml:"logging,flow"`
 	Sidecars      map[string]*SidecarConfig `yaml:"sidecars"`
 	Network       *NetworkConfig            `yaml:"network"`
+	HTTP          *BackendServiceHTTPConfig `yaml:"http,flow"`
+	Addons        *AddonsConfig             `yaml:"addons"`
+
+	CapacityProvider *string `yaml:"capacity_provider"` // Overrides the environment's default capacity provider.
+
+	Observability      *ObservabilityConfig      `yaml:"observability"`
+	AlarmNotifications *AlarmNotificationsConfig `yaml:"alarm_notifications"`
+}
+
+// BackendServiceHTTPConfig represents the routing rule of a backend service on the environment's internal load balancer.
+type BackendServiceHTTPConfig struct {
+	Path        *string                 `yaml:"path"`
+	Host        *string                 `yaml:"host"`
+	HealthCheck HealthCheckArgsOrString `yaml:"healthcheck"`
 }
 
 // NewBackendService applies the props to a default backend service configuration with
@@ -50,6 +66,9 @@ func NewBackendService(props BackendServiceProps) *BackendService {
 	svc.BackendServiceConfig.ImageConfig.Build.BuildArgs.Dockerfile = stringP(props.Dockerfile)
 	svc.BackendServiceConfig.ImageConfig.Port = uint16P(props.Port)
 	svc.BackendServiceConfig.ImageConfig.HealthCheck = props.HealthCheck
+	svc.BackendServiceConfig.Logging = props.Logging
+	svc.BackendServiceConfig.TaskConfig.Variables = props.Variables
+	svc.BackendServiceConfig.TaskConfig.Storage = props.Storage
 	svc.parser = template.New()
 	return svc
 }
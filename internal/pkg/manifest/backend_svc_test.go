@@ -157,6 +157,18 @@ func TestBackendSvc_MarshalBinary(t *testing.T) {
 			},
 			wantedTestdata: "backend-svc-customhealthcheck.yml",
 		},
+		"with a log router": {
+			inProps: BackendServiceProps{
+				WorkloadProps: WorkloadProps{
+					Name:       "subscribers",
+					Dockerfile: "./subscribers/Dockerfile",
+				},
+				Logging: &Logging{
+					ConfigFile: aws.String("/fluent-bit/etc/extra.conf"),
+				},
+			},
+			wantedTestdata: "backend-svc-logging.yml",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -311,6 +323,25 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 			},
 		},
 	}
+	mockBackendServiceWithAddonsOverride := BackendService{
+		BackendServiceConfig: BackendServiceConfig{
+			Addons: &AddonsConfig{
+				Parameters: map[string]string{
+					"BucketRetentionDays": "7",
+					"TableCapacity":       "5",
+				},
+			},
+		},
+		Environments: map[string]*BackendServiceConfig{
+			"prod": {
+				Addons: &AddonsConfig{
+					Parameters: map[string]string{
+						"BucketRetentionDays": "365",
+					},
+				},
+			},
+		},
+	}
 	mockBackendServiceWithImageOverrideBuildByLocation := BackendService{
 		Workload: Workload{
 			Name: aws.String("phonetool"),
@@ -510,6 +541,22 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 			},
 			original: &mockBackendServiceWithAllOverride,
 		},
+		"with addons parameters overridden by env": {
+			svc:       &mockBackendServiceWithAddonsOverride,
+			inEnvName: "prod",
+
+			wanted: &BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					Addons: &AddonsConfig{
+						Parameters: map[string]string{
+							"BucketRetentionDays": "365",
+							"TableCapacity":       "5",
+						},
+					},
+				},
+			},
+			original: &mockBackendServiceWithAddonsOverride,
+		},
 		"with image build overridden by image location": {
 			svc:       &mockBackendServiceWithImageOverrideBuildByLocation,
 			inEnvName: "prod-iad",
@@ -6,6 +6,7 @@ package manifest
 import (
 	"errors"
 	"path/filepath"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -50,8 +51,30 @@ type LoadBalancedWebServiceConfig struct {
 	RoutingRule   `yaml:"http,flow"`
 	TaskConfig    `yaml:",inline"`
 	*Logging      `yaml:"logging,flow"`
-	Sidecars      map[string]*SidecarConfig `yaml:"sidecars"`
-	Network       *NetworkConfig            `yaml:"network"` // TODO: the type needs to be updated after we upgrade mergo
+	Sidecars      map[string]*SidecarConfig         `yaml:"sidecars"`
+	Network       *NetworkConfig                    `yaml:"network"` // TODO: the type needs to be updated after we upgrade mergo
+	Deployment    *DeploymentConfig                 `yaml:"deployment"`
+	NLBConfig     *NetworkLoadBalancerConfiguration `yaml:"nlb"`
+	Addons        *AddonsConfig                     `yaml:"addons"`
+
+	CapacityProvider *string `yaml:"capacity_provider"` // Overrides the environment's default capacity provider.
+
+	Observability      *ObservabilityConfig      `yaml:"observability"`
+	AlarmNotifications *AlarmNotificationsConfig `yaml:"alarm_notifications"`
+}
+
+// NetworkLoadBalancerConfiguration holds options for a public network load balancer in front of the service.
+type NetworkLoadBalancerConfiguration struct {
+	Port             *string  `yaml:"port"` // Listener port and optional protocol, e.g. 443 or 443/tcp.
+	TargetContainer  *string  `yaml:"target_container"`
+	TargetPort       *int     `yaml:"target_port"`
+	EIPAllocationIDs []string `yaml:"eip_allocation_ids"` // Elastic IPs to attach to the load balancer, one per public subnet.
+}
+
+// DeploymentConfig holds the configuration for how tasks are replaced during a deployment.
+type DeploymentConfig struct {
+	// DeregistrationDelay is the amount of time the load balancer waits before deregistering a draining target.
+	DeregistrationDelay *time.Duration `yaml:"deregistration_delay"`
 }
 
 // RoutingRule holds the path to route requests to the service.
@@ -60,18 +83,26 @@ type RoutingRule struct {
 	HealthCheck HealthCheckArgsOrString `yaml:"healthcheck"`
 	Stickiness  *bool                   `yaml:"stickiness"`
 	Alias       *string                 `yaml:"alias"`
+	Host        *string                 `yaml:"host"`
 	// TargetContainer is the container load balancer routes traffic to.
 	TargetContainer          *string   `yaml:"target_container"`
 	TargetContainerCamelCase *string   `yaml:"targetContainer"`    // "targetContainerCamelCase" for backwards compatibility
 	AllowedSourceIps         *[]string `yaml:"allowed_source_ips"` // TODO: the type needs to be updated after we upgrade mergo
+	// StickinessDuration is the lifetime of the load balancer generated cookie when stickiness is enabled.
+	StickinessDuration *time.Duration `yaml:"stickiness_duration"`
 }
 
 // LoadBalancedWebServiceProps contains properties for creating a new load balanced fargate service manifest.
 type LoadBalancedWebServiceProps struct {
 	*WorkloadProps
-	Path        string
-	Port        uint16
-	HealthCheck *ContainerHealthCheck // Optional healthcheck configuration.
+	Path            string
+	Port            uint16
+	AdditionalPorts []uint16              // Optional ports exposed by the container that don't receive traffic from the load balancer.
+	HealthCheck     *ContainerHealthCheck // Optional healthcheck configuration.
+	Logging         *Logging              // Optional FireLens log router configuration.
+	Storage         *Storage              // Optional volumes mounted in the main container.
+	Count           *Count                // Optional autoscaling configuration that replaces the default count.
+	HTTPHealthCheck *HTTPHealthCheckArgs  // Optional health check of the load balancer's target group.
 }
 
 // NewLoadBalancedWebService creates a new public load balanced web service, receives all the requests from the load balancer,
@@ -83,8 +114,22 @@ func NewLoadBalancedWebService(props *LoadBalancedWebServiceProps) *LoadBalanced
 	svc.LoadBalancedWebServiceConfig.ImageConfig.Image.Location = stringP(props.Image)
 	svc.LoadBalancedWebServiceConfig.ImageConfig.Build.BuildArgs.Dockerfile = stringP(props.Dockerfile)
 	svc.LoadBalancedWebServiceConfig.ImageConfig.Port = aws.Uint16(props.Port)
+	for _, port := range props.AdditionalPorts {
+		svc.LoadBalancedWebServiceConfig.ImageConfig.AdditionalPorts = append(svc.LoadBalancedWebServiceConfig.ImageConfig.AdditionalPorts, strconv.Itoa(int(port)))
+	}
 	svc.LoadBalancedWebServiceConfig.ImageConfig.HealthCheck = props.HealthCheck
 	svc.RoutingRule.Path = aws.String(props.Path)
+	svc.LoadBalancedWebServiceConfig.Logging = props.Logging
+	svc.LoadBalancedWebServiceConfig.TaskConfig.Variables = props.Variables
+	svc.LoadBalancedWebServiceConfig.TaskConfig.Storage = props.Storage
+	if props.Count != nil {
+		svc.LoadBalancedWebServiceConfig.TaskConfig.Count = *props.Count
+	}
+	if props.HTTPHealthCheck != nil {
+		svc.RoutingRule.HealthCheck = HealthCheckArgsOrString{
+			HealthCheckArgs: *props.HTTPHealthCheck,
+		}
+	}
 	svc.parser = template.New()
 	return svc
 }
@@ -31,7 +31,8 @@ func TestNewLoadBalancedWebService(t *testing.T) {
 				HealthCheck: &ContainerHealthCheck{
 					Command: []string{"CMD", "curl -f http://localhost:8080 || exit 1"},
 				},
-				Port: 80,
+				Port:            80,
+				AdditionalPorts: []uint16{9090},
 			},
 
 			wanted: &LoadBalancedWebService{
@@ -54,6 +55,7 @@ func TestNewLoadBalancedWebService(t *testing.T) {
 						HealthCheck: &ContainerHealthCheck{
 							Command: []string{"CMD", "curl -f http://localhost:8080 || exit 1"},
 						},
+						AdditionalPorts: []string{"9090"},
 					},
 					RoutingRule: RoutingRule{
 						Path: stringP("/"),
@@ -114,7 +116,8 @@ func TestNewLoadBalancedWebService_UnmarshalYaml(t *testing.T) {
     healthy_threshold: 5
     unhealthy_threshold: 6
     interval: 78s
-    timeout: 9s`),
+    timeout: 9s
+    grace_period: 2m`),
 			wantedStruct: HealthCheckArgsOrString{
 				HealthCheckArgs: HTTPHealthCheckArgs{
 					Path:               aws.String("/testing"),
@@ -122,6 +125,7 @@ func TestNewLoadBalancedWebService_UnmarshalYaml(t *testing.T) {
 					UnhealthyThreshold: aws.Int64(6),
 					Interval:           durationp(78 * time.Second),
 					Timeout:            durationp(9 * time.Second),
+					GracePeriod:        durationp(2 * time.Minute),
 				},
 				HealthCheckPath: nil,
 			},
@@ -147,6 +151,7 @@ func TestNewLoadBalancedWebService_UnmarshalYaml(t *testing.T) {
 				require.Equal(t, tc.wantedStruct.HealthCheckArgs.UnhealthyThreshold, rr.HealthCheck.HealthCheckArgs.UnhealthyThreshold)
 				require.Equal(t, tc.wantedStruct.HealthCheckArgs.Interval, rr.HealthCheck.HealthCheckArgs.Interval)
 				require.Equal(t, tc.wantedStruct.HealthCheckArgs.Timeout, rr.HealthCheck.HealthCheckArgs.Timeout)
+				require.Equal(t, tc.wantedStruct.HealthCheckArgs.GracePeriod, rr.HealthCheck.HealthCheckArgs.GracePeriod)
 			}
 		})
 	}
@@ -167,6 +172,37 @@ func TestLoadBalancedWebService_MarshalBinary(t *testing.T) {
 			},
 			wantedTestdata: "lb-svc.yml",
 		},
+		"with autoscaling": {
+			inProps: LoadBalancedWebServiceProps{
+				WorkloadProps: &WorkloadProps{
+					Name:       "frontend",
+					Dockerfile: "./frontend/Dockerfile",
+				},
+				Count: &Count{
+					AdvancedCount: AdvancedCount{
+						Range: &Range{
+							Value: (*IntRangeBand)(aws.String("1-10")),
+						},
+						CPU: aws.Int(70),
+					},
+				},
+			},
+			wantedTestdata: "lb-svc-autoscaling.yml",
+		},
+		"with a load balancer health check": {
+			inProps: LoadBalancedWebServiceProps{
+				WorkloadProps: &WorkloadProps{
+					Name:       "frontend",
+					Dockerfile: "./frontend/Dockerfile",
+				},
+				HTTPHealthCheck: &HTTPHealthCheckArgs{
+					Path:             aws.String("/_healthz"),
+					HealthyThreshold: aws.Int64(3),
+					Interval:         durationp(15 * time.Second),
+				},
+			},
+			wantedTestdata: "lb-svc-healthcheck.yml",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -17,6 +17,7 @@ const (
 	GithubV1ProviderName   = "GitHubV1"
 	CodeCommitProviderName = "CodeCommit"
 	BitbucketProviderName  = "Bitbucket"
+	GitLabProviderName     = "GitLab"
 
 	pipelineManifestPath = "cicd/pipeline.yml"
 )
@@ -26,6 +27,7 @@ var PipelineProviders = []string{
 	GithubProviderName,
 	CodeCommitProviderName,
 	BitbucketProviderName,
+	GitLabProviderName,
 }
 
 // Provider defines a source of the artifacts
@@ -92,6 +94,20 @@ func (p *bitbucketProvider) Properties() map[string]interface{} {
 	return structs.Map(p.properties)
 }
 
+type gitlabProvider struct {
+	properties *GitLabProperties
+}
+
+func (p *gitlabProvider) Name() string {
+	return GitLabProviderName
+}
+func (p *gitlabProvider) String() string {
+	return GitLabProviderName
+}
+func (p *gitlabProvider) Properties() map[string]interface{} {
+	return structs.Map(p.properties)
+}
+
 // GitHubV1Properties contain information for configuring a Githubv1
 // source provider.
 type GitHubV1Properties struct {
@@ -116,6 +132,13 @@ type BitbucketProperties struct {
 	Branch        string `structs:"branch" yaml:"branch"`
 }
 
+// GitLabProperties contains information for configuring a GitLab
+// source provider.
+type GitLabProperties struct {
+	RepositoryURL string `structs:"repository" yaml:"repository"`
+	Branch        string `structs:"branch" yaml:"branch"`
+}
+
 // CodeCommitProperties contains information for configuring a CodeCommit
 // source provider.
 type CodeCommitProperties struct {
@@ -143,6 +166,10 @@ func NewProvider(configs interface{}) (Provider, error) {
 		return &bitbucketProvider{
 			properties: props,
 		}, nil
+	case *GitLabProperties:
+		return &gitlabProvider{
+			properties: props,
+		}, nil
 	default:
 		return nil, &ErrUnknownProvider{unknownProviderProperties: props}
 	}
@@ -39,6 +39,12 @@ func TestNewProvider(t *testing.T) {
 				Branch:        defaultCCBranch,
 			},
 		},
+		"successfully create GitLab provider": {
+			providerConfig: &GitLabProperties{
+				RepositoryURL: "https://gitlab.com/gitlab-org/gitaly",
+				Branch:        "main",
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -29,6 +29,9 @@ type RequestDrivenWebServiceConfig struct {
 	ImageConfig                       ImageWithPort           `yaml:"image"`
 	Variables                         map[string]string       `yaml:"variables"`
 	Tags                              map[string]string       `yaml:"tags"`
+	Addons                            *AddonsConfig           `yaml:"addons"`
+	// AutoScalingConfigurationARN is the ARN of an existing App Runner auto scaling configuration.
+	AutoScalingConfigurationARN *string `yaml:"auto_scaling_configuration_arn"`
 }
 
 type RequestDrivenWebServiceHttpConfig struct {
@@ -54,6 +57,7 @@ func NewRequestDrivenWebService(props *RequestDrivenWebServiceProps) *RequestDri
 	svc.RequestDrivenWebServiceConfig.ImageConfig.Image.Location = stringP(props.Image)
 	svc.RequestDrivenWebServiceConfig.ImageConfig.Build.BuildArgs.Dockerfile = stringP(props.Dockerfile)
 	svc.RequestDrivenWebServiceConfig.ImageConfig.Port = aws.Uint16(props.Port)
+	svc.RequestDrivenWebServiceConfig.Variables = props.Variables
 	svc.parser = template.New()
 	return svc
 }
@@ -206,6 +206,17 @@ func (a *AdvancedCount) IsValid() error {
 		return errInvalidAutoscaling
 	}
 
+	if a.CPU != nil && (*a.CPU < 1 || *a.CPU > 100) {
+		return fmt.Errorf(`"cpu_percentage" must be between 1 and 100, got %d`, *a.CPU)
+	}
+
+	if a.Range != nil && (a.Range.Value != nil || (a.Range.RangeConfig.Min != nil && a.Range.RangeConfig.Max != nil)) {
+		// Malformed ranges are reported when the range is parsed during deployment.
+		if min, max, err := a.Range.Parse(); err == nil && min > max {
+			return fmt.Errorf(`minimum count %d of "range" must be less than or equal to the maximum count %d`, min, max)
+		}
+	}
+
 	return nil
 }
 
@@ -234,10 +245,12 @@ type HTTPHealthCheckArgs struct {
 	UnhealthyThreshold *int64         `yaml:"unhealthy_threshold"`
 	Timeout            *time.Duration `yaml:"timeout"`
 	Interval           *time.Duration `yaml:"interval"`
+	GracePeriod        *time.Duration `yaml:"grace_period"`
 }
 
 func (h *HTTPHealthCheckArgs) isEmpty() bool {
-	return h.Path == nil && h.HealthyThreshold == nil && h.UnhealthyThreshold == nil && h.Interval == nil && h.Timeout == nil
+	return h.Path == nil && h.HealthyThreshold == nil && h.UnhealthyThreshold == nil && h.Interval == nil && h.Timeout == nil &&
+		h.GracePeriod == nil
 }
 
 // HealthCheckArgsOrString is a custom type which supports unmarshaling yaml which
@@ -287,3 +300,19 @@ func (hc *HealthCheckArgsOrString) Path() *string {
 	}
 	return hc.HealthCheckArgs.Path
 }
+
+// ObservabilityConfig represents the monitoring resources created along with a service.
+type ObservabilityConfig struct {
+	Dashboard *bool `yaml:"dashboard"` // Create a CloudWatch dashboard with the metrics of the service.
+}
+
+// HasDashboard returns true if a CloudWatch dashboard should be created for the service.
+func (o *ObservabilityConfig) HasDashboard() bool {
+	return o != nil && aws.BoolValue(o.Dashboard)
+}
+
+// AlarmNotificationsConfig represents where to send notifications when the alarms of a service go into ALARM.
+type AlarmNotificationsConfig struct {
+	TopicARN    *string `yaml:"topic_arn"`    // ARN of an existing SNS topic. Mutually exclusive with CreateTopic.
+	CreateTopic *bool   `yaml:"create_topic"` // Create a new SNS topic along with the service.
+}
@@ -4,6 +4,7 @@
 package manifest
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -660,7 +661,7 @@ func TestAdvancedCount_IsValid(t *testing.T) {
 				Range: &Range{
 					Value: &mockRange,
 				},
-				CPU:      aws.Int(512),
+				CPU:      aws.Int(70),
 				Memory:   aws.Int(1024),
 				Requests: aws.Int(1000),
 			},
@@ -675,7 +676,7 @@ func TestAdvancedCount_IsValid(t *testing.T) {
 						Max: aws.Int(10),
 					},
 				},
-				CPU:      aws.Int(512),
+				CPU:      aws.Int(70),
 				Memory:   aws.Int(1024),
 				Requests: aws.Int(1000),
 			},
@@ -691,7 +692,7 @@ func TestAdvancedCount_IsValid(t *testing.T) {
 						SpotFrom: aws.Int(3),
 					},
 				},
-				CPU:      aws.Int(512),
+				CPU:      aws.Int(70),
 				Memory:   aws.Int(1024),
 				Requests: aws.Int(1000),
 			},
@@ -701,7 +702,7 @@ func TestAdvancedCount_IsValid(t *testing.T) {
 		"invalid with spot count and autoscaling config": {
 			input: &AdvancedCount{
 				Spot:     aws.Int(42),
-				CPU:      aws.Int(512),
+				CPU:      aws.Int(70),
 				Memory:   aws.Int(1024),
 				Requests: aws.Int(1000),
 			},
@@ -734,13 +735,54 @@ func TestAdvancedCount_IsValid(t *testing.T) {
 		},
 		"invalid with autoscaling fields and no range": {
 			input: &AdvancedCount{
-				CPU:      aws.Int(512),
+				CPU:      aws.Int(70),
 				Memory:   aws.Int(1024),
 				Requests: aws.Int(1000),
 			},
 
 			expectedErr: errInvalidAutoscaling,
 		},
+		"invalid with cpu percentage over 100": {
+			input: &AdvancedCount{
+				Range: &Range{
+					Value: &mockRange,
+				},
+				CPU: aws.Int(512),
+			},
+
+			expectedErr: errors.New(`"cpu_percentage" must be between 1 and 100, got 512`),
+		},
+		"invalid with cpu percentage of 0": {
+			input: &AdvancedCount{
+				Range: &Range{
+					Value: &mockRange,
+				},
+				CPU: aws.Int(0),
+			},
+
+			expectedErr: errors.New(`"cpu_percentage" must be between 1 and 100, got 0`),
+		},
+		"invalid with range value min greater than max": {
+			input: &AdvancedCount{
+				Range: &Range{
+					Value: (*IntRangeBand)(aws.String("10-1")),
+				},
+			},
+
+			expectedErr: errors.New(`minimum count 10 of "range" must be less than or equal to the maximum count 1`),
+		},
+		"invalid with range config min greater than max": {
+			input: &AdvancedCount{
+				Range: &Range{
+					RangeConfig: RangeConfig{
+						Min: aws.Int(5),
+						Max: aws.Int(2),
+					},
+				},
+			},
+
+			expectedErr: errors.New(`minimum count 5 of "range" must be less than or equal to the maximum count 2`),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -60,6 +60,7 @@ type WorkloadProps struct {
 	Name       string
 	Dockerfile string
 	Image      string
+	Variables  map[string]string // Optional environment variables of the main container.
 }
 
 // Workload holds the basic data that every workload manifest file needs to have.
@@ -122,8 +123,9 @@ type ImageWithHealthcheck struct {
 
 // ImageWithPortAndHealthcheck represents a container image with an exposed port and health check.
 type ImageWithPortAndHealthcheck struct {
-	ImageWithPort `yaml:",inline"`
-	HealthCheck   *ContainerHealthCheck `yaml:"healthcheck"`
+	ImageWithPort   `yaml:",inline"`
+	HealthCheck     *ContainerHealthCheck `yaml:"healthcheck"`
+	AdditionalPorts []string              `yaml:"additional_ports"` // Other ports exposed by the container, e.g. 9090 or 8125/udp.
 }
 
 // ImageWithPort represents a container image with an exposed port.
@@ -400,8 +402,9 @@ func (e ExecuteCommandConfig) IsEmpty() bool {
 	return e.Enable == nil
 }
 
-// Logging holds configuration for Firelens to route your logs.
+// Logging holds configuration for Firelens to route your logs, and for the retention of the workload's log group.
 type Logging struct {
+	Retention      *int              `yaml:"retention"`
 	Image          *string           `yaml:"image"`
 	Destination    map[string]string `yaml:"destination,flow"`
 	EnableMetadata *bool             `yaml:"enableMetadata"`
@@ -409,6 +412,13 @@ type Logging struct {
 	ConfigFile     *string           `yaml:"configFilePath"`
 }
 
+// IsRetentionOnly returns true if the logging configuration only sets the retention of the log group,
+// in which case there is no need to route logs with Firelens.
+func (lc *Logging) IsRetentionOnly() bool {
+	return lc.Retention != nil && lc.Image == nil && lc.Destination == nil && lc.EnableMetadata == nil &&
+		lc.SecretOptions == nil && lc.ConfigFile == nil
+}
+
 // LogImage returns the default Fluent Bit image if not otherwise configured.
 func (lc *Logging) LogImage() *string {
 	if lc.Image == nil {
@@ -450,6 +460,11 @@ type TaskConfig struct {
 	Storage        *Storage          `yaml:"storage"`
 }
 
+// AddonsConfig represents the configurable options for the addons stack of a workload.
+type AddonsConfig struct {
+	Parameters map[string]string `yaml:"parameters"` // Values for the parameters declared by the addon templates.
+}
+
 // PublishConfig represents the configurable options for setting up publishers.
 type PublishConfig struct {
 	Topics []Topic `yaml:"topics"`
@@ -514,6 +514,38 @@ func TestLogging_GetEnableMetadata(t *testing.T) {
 	}
 }
 
+func TestLogging_IsRetentionOnly(t *testing.T) {
+	testCases := map[string]struct {
+		in     Logging
+		wanted bool
+	}{
+		"empty logging configuration": {
+			in:     Logging{},
+			wanted: false,
+		},
+		"only retention specified": {
+			in: Logging{
+				Retention: aws.Int(14),
+			},
+			wanted: true,
+		},
+		"retention with a destination": {
+			in: Logging{
+				Retention: aws.Int(14),
+				Destination: map[string]string{
+					"Name": "cloudwatch",
+				},
+			},
+			wanted: false,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.IsRetentionOnly())
+		})
+	}
+}
+
 func TestNetworkConfig_UnmarshalYAML(t *testing.T) {
 	testCases := map[string]struct {
 		data string
@@ -15,6 +15,16 @@ const (
 	fmtEnvCFSubTemplatePath = "environment/partials/%s.yml"
 )
 
+// Number of NAT gateways created in a Copilot-managed VPC.
+const (
+	NATGatewaysPerAZ  = "per-az" // One NAT gateway per availability zone with a private subnet.
+	NATGatewaysSingle = "single" // A single NAT gateway shared by all private subnets.
+	NATGatewaysNone   = "none"   // No NAT gateways, workloads in private subnets can't reach the internet.
+)
+
+// NATGatewaysOptions are the valid NAT gateway configurations for a Copilot-managed VPC.
+var NATGatewaysOptions = []string{NATGatewaysPerAZ, NATGatewaysSingle, NATGatewaysNone}
+
 var (
 	// Template names under "environment/partials/".
 	envCFSubTemplateNames = []string{
@@ -25,6 +35,7 @@ var (
 		"lambdas",
 		"vpc-resources",
 		"nat-gateways",
+		"vpc-endpoints",
 	}
 )
 
@@ -39,8 +50,15 @@ type EnvOpts struct {
 	CustomDomainLambda        string
 	ScriptBucketName          string
 
-	ImportVPC *config.ImportVPC
-	VPCConfig *config.AdjustVPC
+	ImportVPC           *config.ImportVPC
+	VPCConfig           *config.AdjustVPC
+	WebACLARN           string
+	ImportCertARNs      []string
+	HTTPToHTTPSRedirect bool
+	IdleTimeout         int64
+	NATGateways         string // Number of NAT gateways in the Copilot-managed VPC, defaults to NATGatewaysPerAZ if empty.
+	VPCEndpoints        bool   // Whether to create VPC endpoints for ECR, S3, and CloudWatch Logs in the Copilot-managed VPC.
+	ImportClusterARN    string // ARN of an existing ECS cluster to use instead of creating one.
 }
 
 // ParseEnv parses an environment's CloudFormation template with the specified data object and returns its content.
@@ -181,6 +181,13 @@ func TestTemplate_ParseLoadBalancedWebService(t *testing.T) {
 				},
 			},
 		},
+		"renders a valid template with a wildcard host header": {
+			opts: template.WorkloadOpts{
+				HTTPHealthCheck: defaultHttpHealthCheck,
+				HostHeader:      "*.example.com",
+				RulePriority:    12345,
+			},
+		},
 		"renders a valid template with entrypoint and command overrides": {
 			opts: template.WorkloadOpts{
 				HTTPHealthCheck: defaultHttpHealthCheck,
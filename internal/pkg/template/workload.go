@@ -65,12 +65,15 @@ var (
 		"image-overrides",
 		"instancerole",
 		"accessrole",
+		"dashboard",
+		"alarm-notifications",
 	}
 )
 
 // WorkloadNestedStackOpts holds configuration that's needed if the workload stack has a nested stack.
 type WorkloadNestedStackOpts struct {
-	StackName string
+	StackName  string
+	Parameters map[string]string // Values for the parameters of the nested stack other than App, Env, and Name.
 
 	VariableOutputs      []string
 	SecretOutputs        []string
@@ -93,6 +96,12 @@ type SidecarOpts struct {
 	DependsOn    map[string]string
 }
 
+// PortMapping holds a port exposed by a container and its protocol.
+type PortMapping struct {
+	Port     string
+	Protocol string // Lower-case protocol, e.g. tcp or udp.
+}
+
 // StorageOpts holds data structures for rendering Volumes and Mount Points
 type StorageOpts struct {
 	Ephemeral         *int
@@ -165,6 +174,21 @@ type HTTPHealthCheckOpts struct {
 	UnhealthyThreshold *int64
 	Interval           *int64
 	Timeout            *int64
+	GracePeriod        *int64
+}
+
+// NetworkLoadBalancerOpts holds configuration that's needed for a network load balancer in front of a service.
+type NetworkLoadBalancerOpts struct {
+	Port             string
+	Protocol         string // Upper-case listener protocol, e.g. TCP or UDP.
+	TargetContainer  string
+	TargetPort       string
+	EIPAllocationIDs []string
+}
+
+// AlarmNotificationsOpts holds configuration that's needed to notify an SNS topic when the alarms of a service go into ALARM.
+type AlarmNotificationsOpts struct {
+	TopicARN string // Empty if the topic is created along with the service.
 }
 
 // AdvancedCount holds configuration for autoscaling and capacity provider
@@ -255,8 +279,18 @@ type WorkloadOpts struct {
 	WorkloadType        string
 	HealthCheck         *ecs.HealthCheck
 	HTTPHealthCheck     HTTPHealthCheckOpts
+	AdditionalPorts     []*PortMapping // Ports of the main container that don't receive traffic from the load balancer.
+	HostHeader          string
+	ImportedCertsOnly   bool // True if the HTTPS listener only serves imported certificates and the environment has no Copilot-managed domain.
+	DeregistrationDelay *int64
+	StickinessDuration  *int64
 	AllowedSourceIps    []string
-	RulePriorityLambda  string
+	RulePriority        int
+	RulePath            string
+	InternalALB         bool
+	NLB                 *NetworkLoadBalancerOpts
+	Dashboard           bool
+	AlarmNotifications  *AlarmNotificationsOpts
 	DesiredCountLambda  string
 	EnvControllerLambda string
 
@@ -272,6 +306,8 @@ type ParseRequestDrivenWebServiceInput struct {
 	NestedStack         *WorkloadNestedStackOpts // Outputs from nested stacks such as the addons stack.
 	EnableHealthCheck   bool
 	EnvControllerLambda string
+
+	AutoScalingConfigurationARN string // Empty to use the default auto scaling configuration of App Runner.
 }
 
 // ParseLoadBalancedWebService parses a load balanced web service's CloudFormation template
@@ -376,6 +412,9 @@ func envControllerParameters(o WorkloadOpts) []string {
 	if o.WorkloadType == "Load Balanced Web Service" {
 		parameters = append(parameters, []string{"ALBWorkloads,", "Aliases,"}...) // YAML needs the comma separator; resolved in EnvContr.
 	}
+	if o.InternalALB {
+		parameters = append(parameters, "InternalALBWorkloads,")
+	}
 	if o.Network.SubnetsType == PrivateSubnetsPlacement {
 		parameters = append(parameters, "NATWorkloads,") // YAML needs the comma separator; resolved in EnvContr.
 	}
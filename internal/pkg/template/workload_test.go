@@ -4,6 +4,7 @@
 package template
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -52,6 +53,8 @@ func TestTemplate_ParseSvc(t *testing.T) {
 				mockBox.AddString("workloads/partials/cf/image-overrides.yml", "image-overrides")
 				mockBox.AddString("workloads/partials/cf/instancerole.yml", "instancerole")
 				mockBox.AddString("workloads/partials/cf/accessrole.yml", "accessrole")
+				mockBox.AddString("workloads/partials/cf/dashboard.yml", "dashboard")
+				mockBox.AddString("workloads/partials/cf/alarm-notifications.yml", "alarm-notifications")
 
 				t.box = mockBox
 			},
@@ -78,6 +81,8 @@ func TestTemplate_ParseSvc(t *testing.T) {
   image-overrides
   instancerole
   accessrole
+  dashboard
+  alarm-notifications
 `,
 		},
 	}
@@ -232,3 +237,72 @@ func TestTemplate_ParseNetwork(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplate_ParseDashboard(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			Dashboard struct {
+				Properties struct {
+					DashboardBody struct {
+						Sub []interface{} `yaml:"Fn::Sub"`
+					} `yaml:"DashboardBody"`
+				} `yaml:"Properties"`
+			} `yaml:"Dashboard"`
+		} `yaml:"Resources"`
+	}
+	type dashboard struct {
+		Widgets []struct {
+			Properties struct {
+				Title string `json:"title"`
+			} `json:"properties"`
+		} `json:"widgets"`
+	}
+
+	testCases := map[string]struct {
+		parse func(tpl *Template) (*Content, error)
+
+		wantedTitles []string
+	}{
+		"should render load balancer metrics for a load balanced web service": {
+			parse: func(tpl *Template) (*Content, error) {
+				return tpl.ParseLoadBalancedWebService(WorkloadOpts{
+					WorkloadType: "Load Balanced Web Service",
+					Dashboard:    true,
+				})
+			},
+			wantedTitles: []string{"CPU utilization", "Memory utilization", "Request count", "Latency", "5XX responses"},
+		},
+		"should render only ECS metrics for a backend service without a load balancer": {
+			parse: func(tpl *Template) (*Content, error) {
+				return tpl.ParseBackendService(WorkloadOpts{
+					WorkloadType: "Backend Service",
+					Dashboard:    true,
+				})
+			},
+			wantedTitles: []string{"CPU utilization", "Memory utilization"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			content, err := tc.parse(New())
+
+			// THEN
+			require.NoError(t, err, "parse service template")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual template")
+			require.NotEmpty(t, actual.Resources.Dashboard.Properties.DashboardBody.Sub)
+			body, ok := actual.Resources.Dashboard.Properties.DashboardBody.Sub[0].(string)
+			require.True(t, ok, "dashboard body should be a string")
+			var db dashboard
+			require.NoError(t, json.Unmarshal([]byte(body), &db), "dashboard body should be valid JSON")
+			var titles []string
+			for _, widget := range db.Widgets {
+				titles = append(titles, widget.Properties.Title)
+			}
+			require.Equal(t, tc.wantedTitles, titles)
+		})
+	}
+}
@@ -24,6 +24,9 @@ var (
 	OutputWriter     = color.Output
 )
 
+// verbose is true if the messages written with the Verbose functions should be printed.
+var verbose bool
+
 // Log message prefixes.
 const (
 	warningPrefix = "Note:"
@@ -146,3 +149,25 @@ func Debugln(args ...interface{}) {
 func Debugf(format string, args ...interface{}) {
 	fmt.Fprint(DiagnosticWriter, debugSprintf(format, args...))
 }
+
+// EnableVerbose turns on the messages written with the Verbose functions.
+func EnableVerbose() {
+	verbose = true
+}
+
+// Verboseln writes the message to standard error in grey and with a new line if verbose messages are enabled.
+func Verboseln(args ...interface{}) {
+	if !verbose {
+		return
+	}
+	fmt.Fprintln(DiagnosticWriter, debugSprintf(fmt.Sprint(args...)))
+}
+
+// Verbosef formats according to the specifier, colors the message in grey, and writes to standard error
+// if verbose messages are enabled.
+func Verbosef(format string, args ...interface{}) {
+	if !verbose {
+		return
+	}
+	fmt.Fprint(DiagnosticWriter, debugSprintf(format, args...))
+}
@@ -230,3 +230,21 @@ func TestDebugf(t *testing.T) {
 	// THEN
 	require.Contains(t, b.String(), "hello world\n")
 }
+
+func TestVerbosef(t *testing.T) {
+	// GIVEN
+	b := &strings.Builder{}
+	DiagnosticWriter = b
+	defer func() { verbose = false }()
+
+	// WHEN
+	Verbosef("%s %s\n", "hidden", "message")
+	EnableVerbose()
+	Verbosef("%s %s\n", "hello", "world")
+	Verboseln("hello", " again")
+
+	// THEN
+	require.NotContains(t, b.String(), "hidden message")
+	require.Contains(t, b.String(), "hello world\n")
+	require.Contains(t, b.String(), "hello again\n")
+}
@@ -7,6 +7,7 @@ package prompt
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"strings"
 
@@ -114,11 +115,62 @@ type Prompt func(survey.Prompt, interface{}, ...survey.AskOpt) error
 // ValidatorFunc defines the function signature for validating inputs.
 type ValidatorFunc func(interface{}) error
 
+// ErrNoPrompt is returned when input is required from the user but prompts are disabled.
+type ErrNoPrompt struct {
+	Message string // Message of the prompt that couldn't be shown.
+}
+
+func (e *ErrNoPrompt) Error() string {
+	return fmt.Sprintf("cannot prompt %q because prompts are disabled", e.Message)
+}
+
+var disabled bool
+
+// Disable makes the Prompts returned by New fail with an ErrNoPrompt instead of waiting for user input.
+func Disable() {
+	disabled = true
+}
+
+// Disabled returns true if prompts are disabled.
+func Disabled() bool {
+	return disabled
+}
+
 // New returns a Prompt with default configuration.
 func New() Prompt {
+	if disabled {
+		return noPrompt
+	}
 	return survey.AskOne
 }
 
+func noPrompt(p survey.Prompt, _ interface{}, _ ...survey.AskOpt) error {
+	return &ErrNoPrompt{
+		Message: message(p),
+	}
+}
+
+// message returns the message of a prompt created by this package.
+func message(p survey.Prompt) string {
+	wrapper, ok := p.(*prompt)
+	if !ok {
+		return ""
+	}
+	switch typedPrompt := wrapper.prompter.(type) {
+	case *survey.Select:
+		return typedPrompt.Message
+	case *survey.Input:
+		return typedPrompt.Message
+	case *passwordPrompt:
+		return typedPrompt.Message
+	case *survey.Confirm:
+		return typedPrompt.Message
+	case *survey.MultiSelect:
+		return typedPrompt.Message
+	}
+	return ""
+}
+
 type prompter interface {
 	Prompt(config *survey.PromptConfig) (interface{}, error)
 	Cleanup(*survey.PromptConfig, interface{}) error
@@ -301,6 +353,27 @@ func WithTrueDefault() PromptConfig {
 	}
 }
 
+// WithFilter narrows the options of a select prompt, as the user types, to the ones accepted by filter.
+func WithFilter(filter func(input, option string) bool) PromptConfig {
+	return func(p *prompt) {
+		surveyFilter := func(input, option string, _ int) bool {
+			return filter(input, option)
+		}
+		switch sel := p.prompter.(type) {
+		case *survey.Select:
+			sel.Filter = surveyFilter
+		case *survey.MultiSelect:
+			sel.Filter = surveyFilter
+		}
+	}
+}
+
+// ContainsFold returns true if the displayed text of the option contains the input, ignoring case and colors.
+func ContainsFold(input, option string) bool {
+	displayed := regexpSGR.ReplaceAllString(option, "")
+	return strings.Contains(strings.ToLower(displayed), strings.ToLower(input))
+}
+
 func stdio() survey.AskOpt {
 	return survey.WithStdio(os.Stdin, os.Stderr, os.Stderr)
 }
@@ -240,3 +240,21 @@ func TestPrompt_Confirm(t *testing.T) {
 		})
 	}
 }
+
+func TestPrompt_Disable(t *testing.T) {
+	defer func() {
+		disabled = false
+	}()
+	Disable()
+	p := New()
+
+	_, getErr := p.Get("What's your name?", "", nil)
+	_, selectErr := p.SelectOne("Which environment?", "", []string{"test", "prod"})
+	_, confirmErr := p.Confirm("Are you sure?", "")
+
+	require.EqualError(t, getErr, `cannot prompt "What's your name?" because prompts are disabled`)
+	require.EqualError(t, selectErr, `cannot prompt "Which environment?" because prompts are disabled`)
+	var errNoPrompt *ErrNoPrompt
+	require.ErrorAs(t, confirmErr, &errNoPrompt)
+	require.Equal(t, "Are you sure?", errNoPrompt.Message)
+}
@@ -173,3 +173,58 @@ func TestPrompt_SelectOne(t *testing.T) {
 		})
 	}
 }
+
+func TestWithFilter(t *testing.T) {
+	// GIVEN
+	var p Prompt = func(p survey.Prompt, out interface{}, _ ...survey.AskOpt) error {
+		sel := p.(*prompt).prompter.(*survey.Select)
+		require.NotNil(t, sel.Filter)
+		require.True(t, sel.Filter("FRONT", "frontend", 0))
+		require.False(t, sel.Filter("back", "frontend", 1))
+		result := out.(*string)
+		*result = "frontend"
+		return nil
+	}
+
+	// WHEN
+	actual, err := p.SelectOne("Which service?", "", []string{"frontend", "api"}, WithFilter(ContainsFold))
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "frontend", actual)
+}
+
+func TestContainsFold(t *testing.T) {
+	testCases := map[string]struct {
+		input  string
+		option string
+		wanted bool
+	}{
+		"should match a substring regardless of case": {
+			input:  "Web",
+			option: "Load Balanced web service",
+			wanted: true,
+		},
+		"should not match colors in the displayed text": {
+			input:  "2m",
+			option: "Backend Service  \x1b[2m(ECS on Fargate)\x1b[0m",
+			wanted: false,
+		},
+		"should match hints in the displayed text": {
+			input:  "fargate",
+			option: "Backend Service  \x1b[2m(ECS on Fargate)\x1b[0m",
+			wanted: true,
+		},
+		"should not match if the input is absent": {
+			input:  "job",
+			option: "Backend Service",
+			wanted: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, ContainsFold(tc.input, tc.option))
+		})
+	}
+}
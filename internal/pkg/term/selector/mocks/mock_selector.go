@@ -445,18 +445,18 @@ func (mr *MockWorkspaceRetrieverMockRecorder) JobNames() *gomock.Call {
 }
 
 // ListDockerfiles mocks base method.
-func (m *MockWorkspaceRetriever) ListDockerfiles() ([]string, error) {
+func (m *MockWorkspaceRetriever) ListDockerfiles(depth int) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDockerfiles")
+	ret := m.ctrl.Call(m, "ListDockerfiles", depth)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListDockerfiles indicates an expected call of ListDockerfiles.
-func (mr *MockWorkspaceRetrieverMockRecorder) ListDockerfiles() *gomock.Call {
+func (mr *MockWorkspaceRetrieverMockRecorder) ListDockerfiles(depth interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDockerfiles", reflect.TypeOf((*MockWorkspaceRetriever)(nil).ListDockerfiles))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDockerfiles", reflect.TypeOf((*MockWorkspaceRetriever)(nil).ListDockerfiles), depth)
 }
 
 // ServiceNames mocks base method.
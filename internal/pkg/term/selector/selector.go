@@ -7,8 +7,10 @@ package selector
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -112,7 +114,7 @@ type WsWorkloadLister interface {
 type WorkspaceRetriever interface {
 	WsWorkloadLister
 	Summary() (*workspace.Summary, error)
-	ListDockerfiles() ([]string, error)
+	ListDockerfiles(depth int) ([]string, error)
 }
 
 // DeployStoreClient wraps methods of deploy store.
@@ -152,6 +154,8 @@ type WorkspaceSelect struct {
 	*Select
 	ws      WorkspaceRetriever
 	appName string
+
+	dockerfileSearchDepth int // Number of sub-directory levels searched for Dockerfiles.
 }
 
 // DeploySelect is a service and environment selector from the deploy store.
@@ -206,6 +210,7 @@ type TaskSelect struct {
 	defaultCluster bool
 	taskGroup      string
 	taskID         string
+	mostRecent     bool
 }
 
 // NewSelect returns a selector that chooses applications or environments.
@@ -226,10 +231,26 @@ func NewConfigSelect(prompt Prompter, store ConfigLister) *ConfigSelect {
 
 // NewWorkspaceSelect returns a new selector that chooses applications and environments from the config store, but
 // services from the local workspace.
-func NewWorkspaceSelect(prompt Prompter, store ConfigLister, ws WorkspaceRetriever) *WorkspaceSelect {
-	return &WorkspaceSelect{
-		Select: NewSelect(prompt, store),
-		ws:     ws,
+func NewWorkspaceSelect(prompt Prompter, store ConfigLister, ws WorkspaceRetriever, opts ...WorkspaceSelectOpts) *WorkspaceSelect {
+	sel := &WorkspaceSelect{
+		Select:                NewSelect(prompt, store),
+		ws:                    ws,
+		dockerfileSearchDepth: workspace.DefaultDockerfileSearchDepth,
+	}
+	for _, opt := range opts {
+		opt(sel)
+	}
+	return sel
+}
+
+// WorkspaceSelectOpts sets up optional parameters for a WorkspaceSelect.
+type WorkspaceSelectOpts func(*WorkspaceSelect)
+
+// WithDockerfileSearchDepth sets the number of sub-directory levels below the current directory
+// that are searched for Dockerfiles to choose from.
+func WithDockerfileSearchDepth(depth int) WorkspaceSelectOpts {
+	return func(s *WorkspaceSelect) {
+		s.dockerfileSearchDepth = depth
 	}
 }
 
@@ -283,6 +304,14 @@ func WithTaskID(id string) TaskOpts {
 	}
 }
 
+// WithMostRecent skips the prompt for TaskSelect and picks the running task that started last.
+// Tasks that started at the same time are ordered by task ID, and the lexically smallest one is picked.
+func WithMostRecent() TaskOpts {
+	return func(in *TaskSelect) {
+		in.mostRecent = true
+	}
+}
+
 // RunningTask has the user select a running task. Callers can provide either app and env names,
 // or use default cluster.
 func (s *TaskSelect) RunningTask(prompt, help string, opts ...TaskOpts) (*awsecs.Task, error) {
@@ -312,6 +341,9 @@ func (s *TaskSelect) RunningTask(prompt, help string, opts ...TaskOpts) (*awsecs
 			return nil, fmt.Errorf("list active tasks in environment %s: %w", s.env, err)
 		}
 	}
+	if s.mostRecent {
+		sortByMostRecent(tasks)
+	}
 	var taskStrList []string
 	taskStrMap := make(map[string]*awsecs.Task)
 	for _, task := range tasks {
@@ -322,6 +354,9 @@ func (s *TaskSelect) RunningTask(prompt, help string, opts ...TaskOpts) (*awsecs
 	if len(taskStrList) == 0 {
 		return nil, fmt.Errorf("no running tasks found")
 	}
+	if s.mostRecent {
+		return taskStrMap[taskStrList[0]], nil
+	}
 	// return if only one running task found
 	if len(taskStrList) == 1 {
 		log.Infof("Found only one running task %s\n", color.HighlightUserInput(taskStrList[0]))
@@ -338,6 +373,20 @@ func (s *TaskSelect) RunningTask(prompt, help string, opts ...TaskOpts) (*awsecs
 	return taskStrMap[task], nil
 }
 
+// sortByMostRecent sorts the tasks from the latest to the earliest start time.
+// Tasks that haven't started yet come last, and ties are ordered by task ID.
+func sortByMostRecent(tasks []*awsecs.Task) {
+	sort.SliceStable(tasks, func(i, j int) bool {
+		startedI, startedJ := aws.TimeValue(tasks[i].StartedAt), aws.TimeValue(tasks[j].StartedAt)
+		if !startedI.Equal(startedJ) {
+			return startedI.After(startedJ)
+		}
+		idI, _ := awsecs.TaskID(aws.StringValue(tasks[i].TaskArn))
+		idJ, _ := awsecs.TaskID(aws.StringValue(tasks[j].TaskArn))
+		return idI < idJ
+	})
+}
+
 // GetDeployedServiceOpts sets up optional parameters for GetDeployedServiceOpts function.
 type GetDeployedServiceOpts func(*DeploySelect)
 
@@ -656,7 +705,7 @@ func filterWlsByName(wls []*config.Workload, wantedNames []string) []string {
 }
 
 // Service fetches all services in an app and prompts the user to select one.
-func (s *ConfigSelect) Service(prompt, help, app string) (string, error) {
+func (s *ConfigSelect) Service(msg, help, app string) (string, error) {
 	services, err := s.retrieveServices(app)
 	if err != nil {
 		return "", err
@@ -671,7 +720,7 @@ func (s *ConfigSelect) Service(prompt, help, app string) (string, error) {
 		log.Infof("Only found one service, defaulting to: %s\n", color.HighlightUserInput(services[0]))
 		return services[0], nil
 	}
-	selectedSvcName, err := s.prompt.SelectOne(prompt, help, services)
+	selectedSvcName, err := s.prompt.SelectOne(msg, help, services, prompt.WithFilter(prompt.ContainsFold))
 	if err != nil {
 		return "", fmt.Errorf("select service: %w", err)
 	}
@@ -702,7 +751,7 @@ func (s *ConfigSelect) Job(prompt, help, app string) (string, error) {
 }
 
 // Environment fetches all the environments in an app and prompts the user to select one.
-func (s *Select) Environment(prompt, help, app string, additionalOpts ...string) (string, error) {
+func (s *Select) Environment(msg, help, app string, additionalOpts ...string) (string, error) {
 	envs, err := s.retrieveEnvironments(app)
 	if err != nil {
 		return "", fmt.Errorf("get environments for app %s from metadata store: %w", app, err)
@@ -720,7 +769,7 @@ func (s *Select) Environment(prompt, help, app string, additionalOpts ...string)
 		return envs[0], nil
 	}
 
-	selectedEnvName, err := s.prompt.SelectOne(prompt, help, envs)
+	selectedEnvName, err := s.prompt.SelectOne(msg, help, envs, prompt.WithFilter(prompt.ContainsFold))
 	if err != nil {
 		return "", fmt.Errorf("select environment: %w", err)
 	}
@@ -769,7 +818,7 @@ func (s *Select) Environments(prompt, help, app string, finalMsgFunc func(int) p
 }
 
 // Application fetches all the apps in an account/region and prompts the user to select one.
-func (s *Select) Application(prompt, help string, additionalOpts ...string) (string, error) {
+func (s *Select) Application(msg, help string, additionalOpts ...string) (string, error) {
 	appNames, err := s.retrieveApps()
 	if err != nil {
 		return "", err
@@ -787,7 +836,7 @@ func (s *Select) Application(prompt, help string, additionalOpts ...string) (str
 		return appNames[0], nil
 	}
 
-	app, err := s.prompt.SelectOne(prompt, help, appNames)
+	app, err := s.prompt.SelectOne(msg, help, appNames, prompt.WithFilter(prompt.ContainsFold))
 	if err != nil {
 		return "", fmt.Errorf("select application: %w", err)
 	}
@@ -867,9 +916,9 @@ func (s *WorkspaceSelect) retrieveWorkspaceWorkloads() ([]string, error) {
 }
 
 // Dockerfile asks the user to select from a list of Dockerfiles in the current
-// directory or one level down. If no dockerfiles are found, it asks for a custom path.
+// directory or down to the search depth, one level by default. If no dockerfiles are found, it asks for a custom path.
 func (s *WorkspaceSelect) Dockerfile(selPrompt, notFoundPrompt, selHelp, notFoundHelp string, pathValidator prompt.ValidatorFunc) (string, error) {
-	dockerfiles, err := s.ws.ListDockerfiles()
+	dockerfiles, err := s.ws.ListDockerfiles(s.dockerfileSearchDepth)
 	if err != nil {
 		return "", fmt.Errorf("list Dockerfiles: %w", err)
 	}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 
@@ -968,7 +969,7 @@ func TestConfigSelect_Service(t *testing.T) {
 					SelectOne(
 						gomock.Eq("Select a service"),
 						gomock.Eq("Help text"),
-						gomock.Eq([]string{"service1", "service2"})).
+						gomock.Eq([]string{"service1", "service2"}), gomock.Any()).
 					Return("service2", nil).
 					Times(1)
 			},
@@ -994,7 +995,7 @@ func TestConfigSelect_Service(t *testing.T) {
 					Times(1)
 				m.prompt.
 					EXPECT().
-					SelectOne(gomock.Any(), gomock.Any(), gomock.Eq([]string{"service1", "service2"})).
+					SelectOne(gomock.Any(), gomock.Any(), gomock.Eq([]string{"service1", "service2"}), gomock.Any()).
 					Return("", fmt.Errorf("error selecting")).
 					Times(1)
 			},
@@ -1234,7 +1235,7 @@ func TestSelect_Environment(t *testing.T) {
 					SelectOne(
 						gomock.Eq("Select an environment"),
 						gomock.Eq("Help text"),
-						gomock.Eq([]string{"env1", "env2"})).
+						gomock.Eq([]string{"env1", "env2"}), gomock.Any()).
 					Return("env2", nil).
 					Times(1)
 			},
@@ -1258,7 +1259,7 @@ func TestSelect_Environment(t *testing.T) {
 					Times(1)
 				m.prompt.
 					EXPECT().
-					SelectOne(gomock.Any(), gomock.Any(), gomock.Eq([]string{"env1", "env2"})).
+					SelectOne(gomock.Any(), gomock.Any(), gomock.Eq([]string{"env1", "env2"}), gomock.Any()).
 					Return("", fmt.Errorf("error selecting")).
 					Times(1)
 			},
@@ -1290,7 +1291,7 @@ func TestSelect_Environment(t *testing.T) {
 					Times(1)
 				m.prompt.
 					EXPECT().
-					SelectOne(gomock.Any(), gomock.Any(), []string{additionalOpt1, additionalOpt2}).
+					SelectOne(gomock.Any(), gomock.Any(), []string{additionalOpt1, additionalOpt2}, gomock.Any()).
 					Times(1).
 					Return(additionalOpt2, nil)
 			},
@@ -1600,7 +1601,7 @@ func TestSelect_Application(t *testing.T) {
 					SelectOne(
 						gomock.Eq("Select an app"),
 						gomock.Eq("Help text"),
-						gomock.Eq([]string{"app1", "app2"})).
+						gomock.Eq([]string{"app1", "app2"}), gomock.Any()).
 					Return("app2", nil).
 					Times(1)
 			},
@@ -1622,7 +1623,7 @@ func TestSelect_Application(t *testing.T) {
 					Times(1)
 				m.prompt.
 					EXPECT().
-					SelectOne(gomock.Any(), gomock.Any(), gomock.Eq([]string{"app1", "app2"})).
+					SelectOne(gomock.Any(), gomock.Any(), gomock.Eq([]string{"app1", "app2"}), gomock.Any()).
 					Return("", fmt.Errorf("error selecting")).
 					Times(1)
 			},
@@ -1680,7 +1681,7 @@ func TestWorkspaceSelect_Dockerfile(t *testing.T) {
 	}{
 		"choose an existing Dockerfile": {
 			mockWs: func(m *mocks.MockWorkspaceRetriever) {
-				m.EXPECT().ListDockerfiles().Return(dockerfiles, nil)
+				m.EXPECT().ListDockerfiles(1).Return(dockerfiles, nil)
 			},
 			mockPrompt: func(m *mocks.MockPrompter) {
 				m.EXPECT().SelectOne(
@@ -1694,7 +1695,7 @@ func TestWorkspaceSelect_Dockerfile(t *testing.T) {
 		},
 		"prompts user for custom path": {
 			mockWs: func(m *mocks.MockWorkspaceRetriever) {
-				m.EXPECT().ListDockerfiles().Return([]string{}, nil)
+				m.EXPECT().ListDockerfiles(1).Return([]string{}, nil)
 			},
 			mockPrompt: func(m *mocks.MockPrompter) {
 				m.EXPECT().SelectOne(
@@ -1717,14 +1718,14 @@ func TestWorkspaceSelect_Dockerfile(t *testing.T) {
 		},
 		"returns an error if fail to list Dockerfile": {
 			mockWs: func(m *mocks.MockWorkspaceRetriever) {
-				m.EXPECT().ListDockerfiles().Return(nil, errors.New("some error"))
+				m.EXPECT().ListDockerfiles(1).Return(nil, errors.New("some error"))
 			},
 			mockPrompt: func(m *mocks.MockPrompter) {},
 			wantedErr:  fmt.Errorf("list Dockerfiles: some error"),
 		},
 		"returns an error if fail to select Dockerfile": {
 			mockWs: func(m *mocks.MockWorkspaceRetriever) {
-				m.EXPECT().ListDockerfiles().Return(dockerfiles, nil)
+				m.EXPECT().ListDockerfiles(1).Return(dockerfiles, nil)
 			},
 			mockPrompt: func(m *mocks.MockPrompter) {
 				m.EXPECT().SelectOne(
@@ -1738,7 +1739,7 @@ func TestWorkspaceSelect_Dockerfile(t *testing.T) {
 		},
 		"returns an error if fail to get custom Dockerfile path": {
 			mockWs: func(m *mocks.MockWorkspaceRetriever) {
-				m.EXPECT().ListDockerfiles().Return(dockerfiles, nil)
+				m.EXPECT().ListDockerfiles(1).Return(dockerfiles, nil)
 			},
 			mockPrompt: func(m *mocks.MockPrompter) {
 				m.EXPECT().SelectOne(
@@ -1772,8 +1773,9 @@ func TestWorkspaceSelect_Dockerfile(t *testing.T) {
 					prompt: p,
 					config: s,
 				},
-				ws:      cfg,
-				appName: "app-name",
+				ws:                    cfg,
+				appName:               "app-name",
+				dockerfileSearchDepth: 1,
 			}
 
 			mockPromptText := "prompt"
@@ -2078,16 +2080,61 @@ func TestTaskSelect_Task(t *testing.T) {
 		TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789:task/0aa1ba8d4082490ee6c245e09d214501"),
 		TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789:task-definition/sample-fargate:3"),
 	}
+	startedAt := time.Date(2021, time.June, 1, 12, 0, 0, 0, time.UTC)
+	mockStartedTask1 := &awsecs.Task{
+		TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789:task/4082490ee6c245e09d2145010aa1ba8d"),
+		TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789:task-definition/sample-fargate:2"),
+		StartedAt:         aws.Time(startedAt),
+	}
+	mockStartedTask2 := &awsecs.Task{
+		TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789:task/0aa1ba8d4082490ee6c245e09d214501"),
+		TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789:task-definition/sample-fargate:3"),
+		StartedAt:         aws.Time(startedAt),
+	}
+	mockLatestTask := &awsecs.Task{
+		TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789:task/f00ba8d4082490ee6c245e09d2145010"),
+		TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789:task-definition/sample-fargate:3"),
+		StartedAt:         aws.Time(startedAt.Add(time.Minute)),
+	}
 	mockErr := errors.New("some error")
 	testCases := map[string]struct {
 		setupMocks func(mocks taskSelectMocks)
 		app        string
 		env        string
 		useDefault bool
+		mostRecent bool
 
 		wantErr  error
 		wantTask *awsecs.Task
 	}{
+		"return the task that started last without prompting": {
+			app:        mockApp,
+			env:        mockEnv,
+			mostRecent: true,
+			setupMocks: func(m taskSelectMocks) {
+				m.taskLister.EXPECT().ListActiveAppEnvTasks(gomock.Any()).Return([]*awsecs.Task{mockTask1, mockStartedTask1, mockLatestTask}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantTask: mockLatestTask,
+		},
+		"return the task with the smallest ID if the latest tasks started at the same time": {
+			app:        mockApp,
+			env:        mockEnv,
+			mostRecent: true,
+			setupMocks: func(m taskSelectMocks) {
+				m.taskLister.EXPECT().ListActiveAppEnvTasks(gomock.Any()).Return([]*awsecs.Task{mockStartedTask1, mockStartedTask2}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantTask: mockStartedTask2,
+		},
+		"return error if no running tasks found with most recent": {
+			useDefault: true,
+			mostRecent: true,
+			setupMocks: func(m taskSelectMocks) {
+				m.taskLister.EXPECT().ListActiveDefaultClusterTasks(gomock.Any()).Return(nil, nil)
+			},
+			wantErr: fmt.Errorf("no running tasks found"),
+		},
 		"return error if fail to list active cluster tasks": {
 			useDefault: true,
 			setupMocks: func(m taskSelectMocks) {
@@ -2192,15 +2239,14 @@ func TestTaskSelect_Task(t *testing.T) {
 				lister: mocktaskLister,
 				prompt: mockprompt,
 			}
-			var gotTask *awsecs.Task
-			var err error
+			opts := []TaskOpts{WithAppEnv(tc.app, tc.env)}
 			if tc.useDefault {
-				gotTask, err = sel.RunningTask(mockPromptText, mockHelpText,
-					WithAppEnv(tc.app, tc.env), WithDefault())
-			} else {
-				gotTask, err = sel.RunningTask(mockPromptText, mockHelpText,
-					WithAppEnv(tc.app, tc.env))
+				opts = append(opts, WithDefault())
+			}
+			if tc.mostRecent {
+				opts = append(opts, WithMostRecent())
 			}
+			gotTask, err := sel.RunningTask(mockPromptText, mockHelpText, opts...)
 			if tc.wantErr != nil {
 				require.EqualError(t, tc.wantErr, err.Error())
 			} else {
@@ -0,0 +1,149 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package version
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/afero"
+	"golang.org/x/mod/semver"
+)
+
+const (
+	// DisableUpdateCheckEnvVar is the environment variable that turns off the update check when set to "true".
+	DisableUpdateCheckEnvVar = "COPILOT_DISABLE_UPDATE_CHECK"
+
+	latestReleaseURL     = "https://api.github.com/repos/aws/copilot-cli/releases/latest"
+	updateCheckInterval  = 24 * time.Hour
+	updateCheckTimeout   = 5 * time.Second
+	updateCheckCacheDir  = "copilot"
+	updateCheckCacheFile = "update-check.json"
+)
+
+type httpClient interface {
+	Get(url string) (resp *http.Response, err error)
+}
+
+// UpdateChecker looks up whether a newer release of the CLI is available.
+// The result of the lookup is cached so that GitHub is queried at most once a day.
+type UpdateChecker struct {
+	current   string
+	cachePath string
+
+	http httpClient
+	fs   afero.Fs
+	now  func() time.Time
+}
+
+// updateCheckCache is the content of the file that records the last update check.
+type updateCheckCache struct {
+	CheckedAt     time.Time `json:"checkedAt"`
+	LatestVersion string    `json:"latestVersion"`
+}
+
+// NewUpdateChecker returns an UpdateChecker for the running version of the CLI.
+func NewUpdateChecker() (*UpdateChecker, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return nil, fmt.Errorf("get user cache directory: %w", err)
+	}
+	return &UpdateChecker{
+		current:   Version,
+		cachePath: filepath.Join(dir, updateCheckCacheDir, updateCheckCacheFile),
+		http: &http.Client{
+			Timeout: updateCheckTimeout,
+		},
+		fs:  afero.NewOsFs(),
+		now: time.Now,
+	}, nil
+}
+
+// NewerVersion returns the latest released version of the CLI if it is newer than the running version.
+// Otherwise, it returns an empty string.
+func (c *UpdateChecker) NewerVersion() (string, error) {
+	if !semver.IsValid(c.current) || semver.Prerelease(c.current) != "" {
+		// Local and development builds don't correspond to a release.
+		return "", nil
+	}
+	latest, err := c.latestVersion()
+	if err != nil {
+		return "", err
+	}
+	if !semver.IsValid(latest) || semver.Compare(latest, c.current) <= 0 {
+		return "", nil
+	}
+	return latest, nil
+}
+
+func (c *UpdateChecker) latestVersion() (string, error) {
+	cache, err := c.readCache()
+	if err == nil && c.now().Sub(cache.CheckedAt) < updateCheckInterval {
+		return cache.LatestVersion, nil
+	}
+	// Record the attempt even if the request fails so that we don't query GitHub on every command while offline.
+	next := updateCheckCache{
+		CheckedAt:     c.now(),
+		LatestVersion: cache.LatestVersion,
+	}
+	latest, fetchErr := c.fetchLatestVersion()
+	if fetchErr == nil {
+		next.LatestVersion = latest
+	}
+	if err := c.writeCache(next); err != nil {
+		return "", err
+	}
+	if fetchErr != nil {
+		return "", fetchErr
+	}
+	return latest, nil
+}
+
+func (c *UpdateChecker) fetchLatestVersion() (string, error) {
+	resp, err := c.http.Get(latestReleaseURL)
+	if err != nil {
+		return "", fmt.Errorf("get latest release: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("get latest release: unexpected status code %d", resp.StatusCode)
+	}
+	var release struct {
+		TagName string `json:"tag_name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
+		return "", fmt.Errorf("decode latest release: %w", err)
+	}
+	return release.TagName, nil
+}
+
+func (c *UpdateChecker) readCache() (updateCheckCache, error) {
+	var cache updateCheckCache
+	data, err := afero.ReadFile(c.fs, c.cachePath)
+	if err != nil {
+		return cache, fmt.Errorf("read update check cache %s: %w", c.cachePath, err)
+	}
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return updateCheckCache{}, fmt.Errorf("unmarshal update check cache %s: %w", c.cachePath, err)
+	}
+	return cache, nil
+}
+
+func (c *UpdateChecker) writeCache(cache updateCheckCache) error {
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return fmt.Errorf("marshal update check cache: %w", err)
+	}
+	if err := c.fs.MkdirAll(filepath.Dir(c.cachePath), 0755); err != nil {
+		return fmt.Errorf("create directory for update check cache: %w", err)
+	}
+	if err := afero.WriteFile(c.fs, c.cachePath, data, 0644); err != nil {
+		return fmt.Errorf("write update check cache %s: %w", c.cachePath, err)
+	}
+	return nil
+}
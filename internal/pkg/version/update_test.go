@@ -0,0 +1,152 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package version
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeHTTPClient struct {
+	statusCode int
+	content    []byte
+	err        error
+
+	calls int
+}
+
+func (c *fakeHTTPClient) Get(url string) (resp *http.Response, err error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	r := httptest.NewRecorder()
+	if c.statusCode != 0 {
+		r.WriteHeader(c.statusCode)
+	}
+	_, _ = r.Write(c.content)
+	return r.Result(), nil
+}
+
+func TestUpdateChecker_NewerVersion(t *testing.T) {
+	const cachePath = "/cache/copilot/update-check.json"
+	now := time.Date(2021, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		current      string
+		cacheContent string
+		client       *fakeHTTPClient
+
+		wantedVersion string
+		wantedCalls   int
+		wantedCache   string
+		wantedErr     error
+	}{
+		"skips the check for development builds": {
+			current: "v1.8.0-5-gabc1234",
+			client:  &fakeHTTPClient{},
+		},
+		"returns the newer version from GitHub and caches it": {
+			current: "v1.8.0",
+			client: &fakeHTTPClient{
+				content: []byte(`{"tag_name":"v1.9.0"}`),
+			},
+
+			wantedVersion: "v1.9.0",
+			wantedCalls:   1,
+			wantedCache:   `{"checkedAt":"2021-07-01T12:00:00Z","latestVersion":"v1.9.0"}`,
+		},
+		"returns an empty string if the CLI is up to date": {
+			current: "v1.9.0",
+			client: &fakeHTTPClient{
+				content: []byte(`{"tag_name":"v1.9.0"}`),
+			},
+
+			wantedCalls: 1,
+			wantedCache: `{"checkedAt":"2021-07-01T12:00:00Z","latestVersion":"v1.9.0"}`,
+		},
+		"uses the cached version if it was checked within a day": {
+			current:      "v1.8.0",
+			cacheContent: `{"checkedAt":"2021-07-01T00:00:00Z","latestVersion":"v1.9.0"}`,
+			client:       &fakeHTTPClient{},
+
+			wantedVersion: "v1.9.0",
+			wantedCache:   `{"checkedAt":"2021-07-01T00:00:00Z","latestVersion":"v1.9.0"}`,
+		},
+		"queries GitHub again if the cache is stale": {
+			current:      "v1.8.0",
+			cacheContent: `{"checkedAt":"2021-06-29T00:00:00Z","latestVersion":"v1.9.0"}`,
+			client: &fakeHTTPClient{
+				content: []byte(`{"tag_name":"v1.10.0"}`),
+			},
+
+			wantedVersion: "v1.10.0",
+			wantedCalls:   1,
+			wantedCache:   `{"checkedAt":"2021-07-01T12:00:00Z","latestVersion":"v1.10.0"}`,
+		},
+		"records the attempt if the request fails": {
+			current:      "v1.8.0",
+			cacheContent: `{"checkedAt":"2021-06-29T00:00:00Z","latestVersion":"v1.9.0"}`,
+			client: &fakeHTTPClient{
+				err: errors.New("some error"),
+			},
+
+			wantedCalls: 1,
+			wantedCache: `{"checkedAt":"2021-07-01T12:00:00Z","latestVersion":"v1.9.0"}`,
+			wantedErr:   errors.New("get latest release: some error"),
+		},
+		"errors on an unexpected status code": {
+			current: "v1.8.0",
+			client: &fakeHTTPClient{
+				statusCode: http.StatusForbidden,
+			},
+
+			wantedCalls: 1,
+			wantedCache: `{"checkedAt":"2021-07-01T12:00:00Z","latestVersion":""}`,
+			wantedErr:   errors.New("get latest release: unexpected status code 403"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			if tc.cacheContent != "" {
+				require.NoError(t, afero.WriteFile(fs, cachePath, []byte(tc.cacheContent), 0644))
+			}
+			c := &UpdateChecker{
+				current:   tc.current,
+				cachePath: cachePath,
+				http:      tc.client,
+				fs:        fs,
+				now: func() time.Time {
+					return now
+				},
+			}
+
+			// WHEN
+			got, err := c.NewerVersion()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedVersion, got)
+			}
+			require.Equal(t, tc.wantedCalls, tc.client.calls)
+			if tc.wantedCache != "" {
+				cache, err := afero.ReadFile(fs, cachePath)
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedCache, string(cache))
+			}
+		})
+	}
+}
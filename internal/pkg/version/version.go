@@ -4,4 +4,9 @@
 // Package version holds variables for generating version information
 package version
 
-var Version string
+// Build information populated by the linker at build time.
+var (
+	Version   string
+	GitCommit string
+	BuildDate string
+)
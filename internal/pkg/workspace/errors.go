@@ -20,6 +20,16 @@ func (e *ErrFileExists) Error() string {
 	return fmt.Sprintf("file %s already exists", e.FileName)
 }
 
+// ErrFileLocked means another copilot command is writing to the same file.
+type ErrFileLocked struct {
+	FileName     string
+	LockFileName string
+}
+
+func (e *ErrFileLocked) Error() string {
+	return fmt.Sprintf("file %s is being written by another copilot command: if no other command is running, delete %s and try again", e.FileName, e.LockFileName)
+}
+
 // errWorkspaceNotFound means we couldn't locate a workspace root.
 type errWorkspaceNotFound struct {
 	CurrentDirectory      string
@@ -34,6 +44,20 @@ func (e *errWorkspaceNotFound) Error() string {
 		e.CurrentDirectory)
 }
 
+// errOverriddenWorkspaceNotFound means the workspace set by an environment variable doesn't have a copilot directory.
+type errOverriddenWorkspaceNotFound struct {
+	EnvVar                string
+	Directory             string
+	ManifestDirectoryName string
+}
+
+func (e *errOverriddenWorkspaceNotFound) Error() string {
+	return fmt.Sprintf("couldn't find a directory called %s in %s set by %s",
+		e.ManifestDirectoryName,
+		e.Directory,
+		e.EnvVar)
+}
+
 // errNoAssociatedApplication means we couldn't locate a workspace summary file.
 type errNoAssociatedApplication struct{}
 
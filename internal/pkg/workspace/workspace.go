@@ -33,6 +33,15 @@ const (
 	CopilotDirName = "copilot"
 	// SummaryFileName is the name of the file that is associated with the application.
 	SummaryFileName = ".workspace"
+	// EnvVar is the environment variable that points to the workspace to use instead of searching from the working directory,
+	// for example to run commands for one of the workspaces of a monorepo.
+	EnvVar = "COPILOT_WORKSPACE"
+
+	// DefaultDockerfileSearchDepth is the default number of sub-directory levels searched for Dockerfiles.
+	DefaultDockerfileSearchDepth = 1
+	// MaxDockerfileSearchDepth is the maximum number of sub-directory levels searched for Dockerfiles,
+	// so that large directory trees aren't scanned entirely.
+	MaxDockerfileSearchDepth = 5
 
 	addonsDirName             = "addons"
 	maximumParentDirsToSearch = 5
@@ -41,6 +50,7 @@ const (
 	buildspecFileName         = "buildspec.yml"
 
 	ymlFileExtension = ".yml"
+	lockFileSuffix   = ".lock"
 
 	dockerfileName = "dockerfile"
 )
@@ -52,12 +62,15 @@ type Summary struct {
 
 // Workspace typically represents a Git repository where the user has its infrastructure-as-code files as well as source files.
 type Workspace struct {
-	workingDir string
-	copilotDir string
-	fsUtils    *afero.Afero
+	workingDir  string
+	copilotDir  string
+	overrideDir string // Absolute path set by the COPILOT_WORKSPACE environment variable.
+	fsUtils     *afero.Afero
 }
 
 // New returns a workspace, used for reading and writing to user's local workspace.
+// If the COPILOT_WORKSPACE environment variable is set, the workspace is the directory that it points to,
+// otherwise the workspace is searched from the current working directory.
 func New() (*Workspace, error) {
 	fs := afero.NewOsFs()
 	fsUtils := &afero.Afero{Fs: fs}
@@ -70,6 +83,12 @@ func New() (*Workspace, error) {
 		workingDir: workingDir,
 		fsUtils:    fsUtils,
 	}
+	if dir := os.Getenv(EnvVar); dir != "" {
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(workingDir, dir)
+		}
+		ws.overrideDir = filepath.Clean(dir)
+	}
 
 	return &ws, nil
 }
@@ -102,6 +121,12 @@ func (ws *Workspace) Create(appName string) error {
 	return err
 }
 
+// OverwriteSummary associates the workspace with the application, replacing the application of an existing summary.
+// It's used to repair a summary that can't be read or that references a deleted application.
+func (ws *Workspace) OverwriteSummary(appName string) error {
+	return ws.writeSummary(appName)
+}
+
 // Summary returns a summary of the workspace - including the application name.
 func (ws *Workspace) Summary() (*Summary, error) {
 	summaryPath, err := ws.summaryPath()
@@ -328,7 +353,7 @@ func (ws *Workspace) writeSummary(appName string) error {
 	if err != nil {
 		return err
 	}
-	return ws.fsUtils.WriteFile(summaryPath, serializedWorkspaceSummary, 0644)
+	return ws.writeFileAtomic(summaryPath, serializedWorkspaceSummary, 0644)
 }
 
 func (ws *Workspace) pipelineManifestPath() (string, error) {
@@ -355,14 +380,36 @@ func (ws *Workspace) createCopilotDir() error {
 	if existingWorkspace != "" {
 		return nil
 	}
+	if ws.overrideDir != "" {
+		return ws.fsUtils.Mkdir(ws.overrideCopilotDirPath(), 0755)
+	}
 	return ws.fsUtils.Mkdir(CopilotDirName, 0755)
 }
 
 // CopilotDirPath returns the absolute path to the workspace's copilot dir.
+// The directory set by the COPILOT_WORKSPACE environment variable takes precedence,
+// then the working directory if it's the copilot dir, and finally the copilot dir of the working directory or one of its parents.
 func (ws *Workspace) CopilotDirPath() (string, error) {
 	if ws.copilotDir != "" {
 		return ws.copilotDir, nil
 	}
+	if ws.overrideDir != "" {
+		// Don't search the parents of an overridden workspace, they can hold the copilot dir of another workspace.
+		copilotDir := ws.overrideCopilotDirPath()
+		exists, err := ws.fsUtils.DirExists(copilotDir)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return "", &errOverriddenWorkspaceNotFound{
+				EnvVar:                EnvVar,
+				Directory:             ws.overrideDir,
+				ManifestDirectoryName: CopilotDirName,
+			}
+		}
+		ws.copilotDir = copilotDir
+		return ws.copilotDir, nil
+	}
 	// Are we in the application directory?
 	inCopilotDir := filepath.Base(ws.workingDir) == CopilotDirName
 	if inCopilotDir {
@@ -390,6 +437,15 @@ func (ws *Workspace) CopilotDirPath() (string, error) {
 	}
 }
 
+// overrideCopilotDirPath returns the path of the copilot dir of the workspace set by the COPILOT_WORKSPACE environment variable.
+// The variable can point to either the copilot dir or the directory that contains it.
+func (ws *Workspace) overrideCopilotDirPath() string {
+	if filepath.Base(ws.overrideDir) == CopilotDirName {
+		return ws.overrideDir
+	}
+	return filepath.Join(ws.overrideDir, CopilotDirName)
+}
+
 func (ws *Workspace) readWorkloadType(dat []byte) (string, error) {
 	wl := struct {
 		Type string `yaml:"type"`
@@ -412,6 +468,11 @@ func (ws *Workspace) write(data []byte, elem ...string) (string, error) {
 	if err := ws.fsUtils.MkdirAll(filepath.Dir(filename), 0755 /* -rwxr-xr-x */); err != nil {
 		return "", fmt.Errorf("create directories for file %s: %w", filename, err)
 	}
+	unlock, err := ws.lock(filename)
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
 	exist, err := ws.fsUtils.Exists(filename)
 	if err != nil {
 		return "", fmt.Errorf("check if manifest file %s exists: %w", filename, err)
@@ -419,12 +480,62 @@ func (ws *Workspace) write(data []byte, elem ...string) (string, error) {
 	if exist {
 		return "", &ErrFileExists{FileName: filename}
 	}
-	if err := ws.fsUtils.WriteFile(filename, data, 0644 /* -rw-r--r-- */); err != nil {
+	if err := ws.writeFileAtomic(filename, data, 0644 /* -rw-r--r-- */); err != nil {
 		return "", fmt.Errorf("write manifest file: %w", err)
 	}
 	return filename, nil
 }
 
+// lock creates a lock file next to filename so that only one copilot command writes to the file at a time.
+// The returned function releases the lock.
+func (ws *Workspace) lock(filename string) (func(), error) {
+	lockFile := filename + lockFileSuffix
+	f, err := ws.fsUtils.OpenFile(lockFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644 /* -rw-r--r-- */)
+	if err != nil {
+		if os.IsExist(err) {
+			return nil, &ErrFileLocked{FileName: filename, LockFileName: lockFile}
+		}
+		return nil, fmt.Errorf("acquire lock on file %s: %w", filename, err)
+	}
+	_, _ = fmt.Fprintf(f, "%d\n", os.Getpid())
+	_ = f.Close()
+	return func() {
+		_ = ws.fsUtils.Remove(lockFile)
+	}, nil
+}
+
+// writeFileAtomic writes the data to a temporary file in the same directory and then renames it to filename,
+// so that an interrupted write never leaves a partially written file behind.
+func (ws *Workspace) writeFileAtomic(filename string, data []byte, perm os.FileMode) (err error) {
+	tmp, err := ws.fsUtils.TempFile(filepath.Dir(filename), "."+filepath.Base(filename)+".tmp-")
+	if err != nil {
+		return fmt.Errorf("create temporary file for %s: %w", filename, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = ws.fsUtils.Remove(tmp.Name())
+		}
+	}()
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write temporary file %s: %w", tmp.Name(), err)
+	}
+	if err = tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("sync temporary file %s: %w", tmp.Name(), err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("close temporary file %s: %w", tmp.Name(), err)
+	}
+	if err = ws.fsUtils.Chmod(tmp.Name(), perm); err != nil {
+		return fmt.Errorf("set permissions of temporary file %s: %w", tmp.Name(), err)
+	}
+	if err = ws.fsUtils.Rename(tmp.Name(), filename); err != nil {
+		return fmt.Errorf("rename temporary file %s to %s: %w", tmp.Name(), filename, err)
+	}
+	return nil
+}
+
 // read returns the contents of the file under the copilot directory joined by path elements.
 func (ws *Workspace) read(elem ...string) ([]byte, error) {
 	copilotPath, err := ws.CopilotDirPath()
@@ -435,46 +546,79 @@ func (ws *Workspace) read(elem ...string) ([]byte, error) {
 	return ws.fsUtils.ReadFile(filepath.Join(pathElems...))
 }
 
-// ListDockerfiles returns the list of Dockerfiles within the current
-// working directory and a sub-directory level below. If an error occurs while
-// reading directories, or no Dockerfiles found returns the error.
-func (ws *Workspace) ListDockerfiles() ([]string, error) {
-	wdFiles, err := ws.fsUtils.ReadDir(ws.workingDir)
+// ListDockerfiles returns the list of Dockerfiles within the workspace root and up to depth levels
+// of sub-directories below it. The workspace root is the directory set by the COPILOT_WORKSPACE
+// environment variable, or the current working directory if it's not set.
+// The paths are relative to the current working directory.
+// The depth is capped to MaxDockerfileSearchDepth. If an error occurs while
+// reading the workspace root returns the error.
+func (ws *Workspace) ListDockerfiles(depth int) ([]string, error) {
+	if depth > MaxDockerfileSearchDepth {
+		depth = MaxDockerfileSearchDepth
+	}
+	root := ws.dockerfileSearchRoot()
+	rootFiles, err := ws.fsUtils.ReadDir(root)
 	if err != nil {
 		return nil, fmt.Errorf("read directory: %w", err)
 	}
 	var dockerfiles = make([]string, 0)
-	for _, wdFile := range wdFiles {
+	for _, rootFile := range rootFiles {
 		// Add current file if it is a Dockerfile and not a directory; otherwise continue.
-		if !wdFile.IsDir() {
-			fname := wdFile.Name()
-			if strings.Contains(strings.ToLower(fname), dockerfileName) {
-				path := filepath.Dir(fname) + "/" + fname
+		if !rootFile.IsDir() {
+			fname := rootFile.Name()
+			if isDockerfile(fname) {
+				path := root + "/" + fname
 				dockerfiles = append(dockerfiles, path)
 			}
 			continue
 		}
+		if depth > 0 {
+			dockerfiles = append(dockerfiles, ws.listSubDirDockerfiles(filepath.Join(root, rootFile.Name()), depth-1)...)
+		}
+	}
+	sort.Strings(dockerfiles)
+	return dockerfiles, nil
+}
 
-		// Add sub-directories containing a Dockerfile one level below current directory.
-		subFiles, err := ws.fsUtils.ReadDir(wdFile.Name())
-		if err != nil {
-			// swallow errors for unreadable directories
+// dockerfileSearchRoot returns the path, relative to the working directory, of the directory to search Dockerfiles from.
+// It's the root of the workspace set by the COPILOT_WORKSPACE environment variable, or the working directory if it's not set.
+func (ws *Workspace) dockerfileSearchRoot() string {
+	if ws.overrideDir == "" {
+		return "."
+	}
+	root := filepath.Dir(ws.overrideCopilotDirPath())
+	if rel, err := filepath.Rel(ws.workingDir, root); err == nil {
+		return rel
+	}
+	return root
+}
+
+// listSubDirDockerfiles returns the Dockerfiles in a sub-directory of the workspace root,
+// and in up to depth levels of directories below it.
+func (ws *Workspace) listSubDirDockerfiles(dir string, depth int) []string {
+	files, err := ws.fsUtils.ReadDir(dir)
+	if err != nil {
+		// swallow errors for unreadable directories
+		return nil
+	}
+	var dockerfiles []string
+	for _, f := range files {
+		path := dir + "/" + f.Name()
+		if f.IsDir() {
+			if depth > 0 {
+				dockerfiles = append(dockerfiles, ws.listSubDirDockerfiles(path, depth-1)...)
+			}
 			continue
 		}
-		for _, f := range subFiles {
-			// NOTE: ignore directories in sub-directories.
-			if f.IsDir() {
-				continue
-			}
-			fname := f.Name()
-			if strings.Contains(strings.ToLower(fname), dockerfileName) {
-				path := wdFile.Name() + "/" + f.Name()
-				dockerfiles = append(dockerfiles, path)
-			}
+		if isDockerfile(f.Name()) {
+			dockerfiles = append(dockerfiles, path)
 		}
 	}
-	sort.Strings(dockerfiles)
-	return dockerfiles, nil
+	return dockerfiles
+}
+
+func isDockerfile(fname string) bool {
+	return strings.Contains(strings.ToLower(fname), dockerfileName)
 }
 
 // RelPath returns the path relative to the current working directory.
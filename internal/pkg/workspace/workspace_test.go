@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/spf13/afero"
@@ -35,6 +36,7 @@ func TestWorkspace_CopilotDirPath(t *testing.T) {
 		expectedManifestDir string
 		presetManifestDir   string
 		workingDir          string
+		overrideDir         string
 		expectedError       error
 		mockFileSystem      func(fs afero.Fs)
 	}{
@@ -86,6 +88,35 @@ func TestWorkspace_CopilotDirPath(t *testing.T) {
 			mockFileSystem:      func(fs afero.Fs) {},
 			presetManifestDir:   filepath.FromSlash("test/copilot"),
 		},
+
+		"overridden workspace": {
+			expectedManifestDir: filepath.FromSlash("/monorepo/api/copilot"),
+			workingDir:          filepath.FromSlash("/monorepo"),
+			overrideDir:         filepath.FromSlash("/monorepo/api"),
+			mockFileSystem: func(fs afero.Fs) {
+				fs.MkdirAll("/monorepo/copilot", 0755)
+				fs.MkdirAll("/monorepo/api/copilot", 0755)
+			},
+		},
+
+		"overridden copilot directory": {
+			expectedManifestDir: filepath.FromSlash("/monorepo/api/copilot"),
+			workingDir:          filepath.FromSlash("/monorepo"),
+			overrideDir:         filepath.FromSlash("/monorepo/api/copilot"),
+			mockFileSystem: func(fs afero.Fs) {
+				fs.MkdirAll("/monorepo/api/copilot", 0755)
+			},
+		},
+
+		"doesn't search the parents of an overridden workspace": {
+			expectedError: fmt.Errorf("couldn't find a directory called copilot in " + filepath.FromSlash("/monorepo/web") + " set by COPILOT_WORKSPACE"),
+			workingDir:    filepath.FromSlash("/monorepo"),
+			overrideDir:   filepath.FromSlash("/monorepo/web"),
+			mockFileSystem: func(fs afero.Fs) {
+				fs.MkdirAll("/monorepo/copilot", 0755)
+				fs.MkdirAll("/monorepo/web", 0755)
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -95,9 +126,10 @@ func TestWorkspace_CopilotDirPath(t *testing.T) {
 			tc.mockFileSystem(fs)
 
 			ws := Workspace{
-				workingDir: tc.workingDir,
-				fsUtils:    &afero.Afero{Fs: fs},
-				copilotDir: tc.presetManifestDir,
+				workingDir:  tc.workingDir,
+				fsUtils:     &afero.Afero{Fs: fs},
+				copilotDir:  tc.presetManifestDir,
+				overrideDir: tc.overrideDir,
 			}
 			manifestDirPath, err := ws.CopilotDirPath()
 			if tc.expectedError == nil {
@@ -160,10 +192,65 @@ func TestWorkspace_Summary(t *testing.T) {
 	}
 }
 
+func TestWorkspace_OverwriteSummary(t *testing.T) {
+	testCases := map[string]struct {
+		mockFileSystem func(fs afero.Fs)
+
+		expectedError error
+	}{
+		"replaces a summary that references another application": {
+			mockFileSystem: func(fs afero.Fs) {
+				fs.MkdirAll("test/copilot", 0755)
+				afero.WriteFile(fs, "test/copilot/.workspace", []byte("application: deleted-app\n"), 0644)
+			},
+		},
+		"replaces a corrupted summary": {
+			mockFileSystem: func(fs afero.Fs) {
+				fs.MkdirAll("test/copilot", 0755)
+				afero.WriteFile(fs, "test/copilot/.workspace", []byte("{{{"), 0644)
+			},
+		},
+		"writes a missing summary": {
+			mockFileSystem: func(fs afero.Fs) {
+				fs.MkdirAll("test/copilot", 0755)
+			},
+		},
+		"no existing manifest dir": {
+			mockFileSystem: func(fs afero.Fs) {},
+			expectedError:  fmt.Errorf("couldn't find a directory called copilot up to 5 levels up from test/"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			tc.mockFileSystem(fs)
+			ws := Workspace{
+				workingDir: "test/",
+				fsUtils:    &afero.Afero{Fs: fs},
+			}
+
+			// WHEN
+			err := ws.OverwriteSummary("my-app")
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			summary, err := ws.Summary()
+			require.NoError(t, err)
+			require.Equal(t, Summary{Application: "my-app"}, *summary)
+		})
+	}
+}
+
 func TestWorkspace_Create(t *testing.T) {
 	testCases := map[string]struct {
 		appName        string
 		workingDir     string
+		overrideDir    string
 		expectedError  error
 		expectNoWrites bool
 		mockFileSystem func(fs afero.Fs)
@@ -208,6 +295,16 @@ func TestWorkspace_Create(t *testing.T) {
 			appName:        "DavidsApp",
 			mockFileSystem: func(fs afero.Fs) {},
 		},
+		"no existing overridden workspace": {
+			workingDir:  "test/",
+			overrideDir: "test/api",
+			appName:     "DavidsApp",
+			mockFileSystem: func(fs afero.Fs) {
+				fs.MkdirAll("test/copilot", 0755)
+				fs.MkdirAll("test/api", 0755)
+				afero.WriteFile(fs, "test/copilot/.workspace", []byte(fmt.Sprintf("---\napplication: %s", "DavidsOtherApp")), 0644)
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -222,8 +319,9 @@ func TestWorkspace_Create(t *testing.T) {
 			}
 
 			ws := Workspace{
-				workingDir: tc.workingDir,
-				fsUtils:    &afero.Afero{Fs: fs},
+				workingDir:  tc.workingDir,
+				overrideDir: tc.overrideDir,
+				fsUtils:     &afero.Afero{Fs: fs},
 			}
 			err := ws.Create(tc.appName)
 			if tc.expectedError == nil {
@@ -556,7 +654,8 @@ func TestWorkspace_read(t *testing.T) {
 
 func TestWorkspace_write(t *testing.T) {
 	testCases := map[string]struct {
-		elems []string
+		elems         []string
+		existingLocks []string
 
 		wantedPath string
 		wantedErr  error
@@ -573,6 +672,14 @@ func TestWorkspace_write(t *testing.T) {
 			elems:     []string{"manifest.yml"},
 			wantedErr: &ErrFileExists{FileName: "/copilot/manifest.yml"},
 		},
+		"return ErrFileLocked if another command is writing the file": {
+			elems:         []string{pipelineFileName},
+			existingLocks: []string{"/copilot/pipeline.yml.lock"},
+			wantedErr: &ErrFileLocked{
+				FileName:     "/copilot/pipeline.yml",
+				LockFileName: "/copilot/pipeline.yml.lock",
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -584,6 +691,9 @@ func TestWorkspace_write(t *testing.T) {
 			}
 			utils.MkdirAll("/copilot", 0755)
 			utils.WriteFile("/copilot/manifest.yml", []byte{}, 0644)
+			for _, lock := range tc.existingLocks {
+				utils.WriteFile(lock, []byte{}, 0644)
+			}
 			ws := &Workspace{
 				workingDir: "/",
 				copilotDir: "/copilot",
@@ -591,14 +701,71 @@ func TestWorkspace_write(t *testing.T) {
 			}
 
 			// WHEN
-			actualPath, actualErr := ws.write(nil, tc.elems...)
+			actualPath, actualErr := ws.write([]byte("hello"), tc.elems...)
 
 			// THEN
 			if tc.wantedErr != nil {
 				require.EqualError(t, actualErr, tc.wantedErr.Error(), "expected the same error")
 			} else {
 				require.Equal(t, tc.wantedPath, actualPath, "expected the same path")
+				content, err := utils.ReadFile(actualPath)
+				require.NoError(t, err)
+				require.Equal(t, "hello", string(content))
+
+				// No lock or temporary files should be left behind.
+				files, err := utils.ReadDir(filepath.Dir(actualPath))
+				require.NoError(t, err)
+				for _, f := range files {
+					require.False(t, strings.HasSuffix(f.Name(), lockFileSuffix), "unexpected lock file %s", f.Name())
+					require.False(t, strings.Contains(f.Name(), ".tmp-"), "unexpected temporary file %s", f.Name())
+				}
+			}
+		})
+	}
+}
+
+func TestWorkspace_writeFileAtomic(t *testing.T) {
+	testCases := map[string]struct {
+		existingContent []byte
+
+		wantedContent string
+	}{
+		"creates a new file": {
+			wantedContent: "new",
+		},
+		"replaces the content of an existing file": {
+			existingContent: []byte("old content that is longer than the new one"),
+			wantedContent:   "new",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			utils := &afero.Afero{
+				Fs: afero.NewMemMapFs(),
+			}
+			utils.MkdirAll("/copilot", 0755)
+			if tc.existingContent != nil {
+				utils.WriteFile("/copilot/pipeline.yml", tc.existingContent, 0644)
+			}
+			ws := &Workspace{
+				workingDir: "/",
+				copilotDir: "/copilot",
+				fsUtils:    utils,
 			}
+
+			// WHEN
+			err := ws.writeFileAtomic("/copilot/pipeline.yml", []byte("new"), 0644)
+
+			// THEN
+			require.NoError(t, err)
+			content, err := utils.ReadFile("/copilot/pipeline.yml")
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, string(content))
+			files, err := utils.ReadDir("/copilot")
+			require.NoError(t, err)
+			require.Len(t, files, 1, "expected no temporary file to be left behind")
 		})
 	}
 }
@@ -823,11 +990,14 @@ func TestWorkspace_DeleteWorkspaceFile(t *testing.T) {
 func TestWorkspace_ListDockerfiles(t *testing.T) {
 	wantedDockerfiles := []string{"./Dockerfile", "backend/Dockerfile", "frontend/Dockerfile"}
 	testCases := map[string]struct {
+		depth          int
+		overrideDir    string
 		mockFileSystem func(mockFS afero.Fs)
 		err            error
 		dockerfiles    []string
 	}{
 		"find Dockerfiles": {
+			depth: 1,
 			mockFileSystem: func(mockFS afero.Fs) {
 				mockFS.MkdirAll("frontend", 0755)
 				mockFS.MkdirAll("backend", 0755)
@@ -840,6 +1010,7 @@ func TestWorkspace_ListDockerfiles(t *testing.T) {
 			dockerfiles: wantedDockerfiles,
 		},
 		"nonstandard Dockerfile names": {
+			depth: 1,
 			mockFileSystem: func(mockFS afero.Fs) {
 				mockFS.MkdirAll("frontend", 0755)
 				mockFS.MkdirAll("dockerfiles", 0755)
@@ -851,9 +1022,60 @@ func TestWorkspace_ListDockerfiles(t *testing.T) {
 			dockerfiles: []string{"./Dockerfile", "./Job.dockerfile", "frontend/dockerfile"},
 		},
 		"no Dockerfiles": {
+			depth:          1,
 			mockFileSystem: func(mockFS afero.Fs) {},
 			dockerfiles:    []string{},
 		},
+		"only the current directory": {
+			depth: 0,
+			mockFileSystem: func(mockFS afero.Fs) {
+				mockFS.MkdirAll("frontend", 0755)
+				afero.WriteFile(mockFS, "Dockerfile", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "frontend/Dockerfile", []byte("FROM nginx"), 0644)
+			},
+			dockerfiles: []string{"./Dockerfile"},
+		},
+		"nested Dockerfiles within the depth": {
+			depth: 3,
+			mockFileSystem: func(mockFS afero.Fs) {
+				mockFS.MkdirAll("services/foo/docker", 0755)
+				mockFS.MkdirAll("services/bar/a/b", 0755)
+				afero.WriteFile(mockFS, "services/foo/docker/Dockerfile", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "services/bar/Dockerfile", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "services/bar/a/b/Dockerfile", []byte("FROM nginx"), 0644)
+			},
+			dockerfiles: []string{"services/bar/Dockerfile", "services/foo/docker/Dockerfile"},
+		},
+		"caps the depth": {
+			depth: 10,
+			mockFileSystem: func(mockFS afero.Fs) {
+				mockFS.MkdirAll("1/2/3/4/5/6", 0755)
+				afero.WriteFile(mockFS, "1/2/3/4/5/Dockerfile", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "1/2/3/4/5/6/Dockerfile", []byte("FROM nginx"), 0644)
+			},
+			dockerfiles: []string{"1/2/3/4/5/Dockerfile"},
+		},
+		"searches from the workspace set by the environment variable": {
+			depth:       1,
+			overrideDir: "/services/api",
+			mockFileSystem: func(mockFS afero.Fs) {
+				mockFS.MkdirAll("services/api/copilot", 0755)
+				mockFS.MkdirAll("services/api/worker", 0755)
+				afero.WriteFile(mockFS, "Dockerfile", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "services/api/Dockerfile", []byte("FROM nginx"), 0644)
+				afero.WriteFile(mockFS, "services/api/worker/Dockerfile", []byte("FROM nginx"), 0644)
+			},
+			dockerfiles: []string{"services/api/Dockerfile", "services/api/worker/Dockerfile"},
+		},
+		"searches from the root of the workspace if the environment variable is set to its copilot dir": {
+			depth:       1,
+			overrideDir: "/services/api/copilot",
+			mockFileSystem: func(mockFS afero.Fs) {
+				mockFS.MkdirAll("services/api/copilot", 0755)
+				afero.WriteFile(mockFS, "services/api/Dockerfile", []byte("FROM nginx"), 0644)
+			},
+			dockerfiles: []string{"services/api/Dockerfile"},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -862,13 +1084,14 @@ func TestWorkspace_ListDockerfiles(t *testing.T) {
 			fs := &afero.Afero{Fs: afero.NewMemMapFs()}
 			tc.mockFileSystem(fs)
 			ws := &Workspace{
-				workingDir: "/",
-				copilotDir: "copilot",
+				workingDir:  "/",
+				copilotDir:  "copilot",
+				overrideDir: tc.overrideDir,
 				fsUtils: &afero.Afero{
 					Fs: fs,
 				},
 			}
-			got, err := ws.ListDockerfiles()
+			got, err := ws.ListDockerfiles(tc.depth)
 
 			if tc.err != nil {
 				require.EqualError(t, err, tc.err.Error())